@@ -67,7 +67,7 @@ func GetMaroto(customFontFile string) core.Maroto {
 		text.NewCol(8, header[1], props.Text{Style: fontstyle.Bold, Family: fontfamily.Arial, Align: align.Center}),
 	)
 
-	grey := props.Color{200, 200, 200}
+	grey := props.Color{Red: 200, Green: 200, Blue: 200}
 	for i, content := range contents {
 		r := m.AddRow(5,
 			text.NewCol(4, content[0], props.Text{Align: align.Center}),
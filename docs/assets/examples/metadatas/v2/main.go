@@ -36,6 +36,7 @@ func GetMaroto() core.Maroto {
 		WithCreator("creator", false).
 		WithSubject("subject", false).
 		WithTitle("title", false).
+		WithKeywords("keywords", false).
 		WithCreationDate(time.Now()).
 		Build()
 
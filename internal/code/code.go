@@ -6,15 +6,22 @@ import (
 	"image/jpeg"
 
 	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/aztec"
+	"github.com/boombuler/barcode/codabar"
 	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/code39"
 	"github.com/boombuler/barcode/datamatrix"
 	"github.com/boombuler/barcode/qr"
 
+	mbarcode "github.com/johnfercher/maroto/v2/pkg/consts/barcode"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
+// aztecMinECCPercent is the minimum percentage of error correction words of an aztec code.
+const aztecMinECCPercent = 23
+
 // codeInstance is the singleton of code, opted to use a singleton to ensure that
 // this will not be instantiated more than once since there is no need to do this
 // because code is stateless.
@@ -40,6 +47,17 @@ func (c *code) GenDataMatrix(code string) (*entity.Image, error) {
 	return c.getImage(dataMatrix)
 }
 
+// GenAztec is responsible to generate an aztec code byte array, with the 23% of error correction
+// recommended by ISO/IEC 24778 and the smallest number of layers which fits the code.
+func (c *code) GenAztec(code string) (*entity.Image, error) {
+	aztecCode, err := aztec.Encode([]byte(code), aztecMinECCPercent, aztec.DEFAULT_LAYERS)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getImage(aztecCode)
+}
+
 // GenQr is responsible to generate a qr code byte array.
 func (c *code) GenQr(code string) (*entity.Image, error) {
 	qrCode, err := qr.Encode(code, qr.M, qr.Auto)
@@ -50,9 +68,19 @@ func (c *code) GenQr(code string) (*entity.Image, error) {
 	return c.getImage(qrCode)
 }
 
+// GenMicroQr is responsible to generate a micro qr code byte array.
+func (c *code) GenMicroQr(code string) (*entity.Image, error) {
+	microQrCode, err := encodeMicroQr(code)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getImage(microQrCode)
+}
+
 // GenBar is responsible to generate a barcode byte array.
 func (c *code) GenBar(code string, cell *entity.Cell, prop *props.Barcode) (*entity.Image, error) {
-	barCode, err := code128.Encode(code)
+	barCode, err := c.encodeBar(code, prop.Type)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +97,25 @@ func (c *code) GenBar(code string, cell *entity.Cell, prop *props.Barcode) (*ent
 	return c.getImage(scaledBarCode)
 }
 
+func (c *code) encodeBar(code string, barcodeType mbarcode.Type) (barcode.Barcode, error) {
+	switch barcodeType {
+	case mbarcode.Codabar:
+		return codabar.Encode(code)
+	case mbarcode.Telepen:
+		return encodeTelepen(code, false)
+	case mbarcode.TelepenNumeric:
+		return encodeTelepen(code, true)
+	case mbarcode.EAN13:
+		return encodeEAN13(code)
+	case mbarcode.UPCA:
+		return encodeUPCA(code)
+	case mbarcode.Code39:
+		return code39.Encode(code, false, false)
+	}
+
+	return code128.Encode(code)
+}
+
 func (c *code) getImage(img image.Image) (*entity.Image, error) {
 	var buf bytes.Buffer
 	err := jpeg.Encode(&buf, img, nil)
@@ -7,6 +7,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 
 	"github.com/johnfercher/maroto/v2/internal/code"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/stretchr/testify/assert"
 )
@@ -107,6 +108,373 @@ func TestCode_GenBar(t *testing.T) {
 		assert.NotNil(t, bytes)
 		assert.Nil(t, err)
 	})
+	t.Run("When type is codabar and code is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.Codabar}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("123456", cell, prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When type is codabar and code is valid, should return bytes", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.Codabar}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("A40156B", cell, prop)
+
+		// Assert
+		assert.NotNil(t, bytes)
+		assert.Nil(t, err)
+	})
+	t.Run("When type is ean13 and code is not 12 or 13 digits long, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.EAN13}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("4006381", cell, prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When type is ean13 and code has no check digit, should return bytes", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.EAN13}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("400638133393", cell, prop)
+
+		// Assert
+		assert.NotNil(t, bytes)
+		assert.Nil(t, err)
+	})
+	t.Run("When type is upca and code is not 11 or 12 digits long, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.UPCA}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("0360002", cell, prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When type is upca and code has the check digit, should return bytes", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.UPCA}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("036000291452", cell, prop)
+
+		// Assert
+		assert.NotNil(t, bytes)
+		assert.Nil(t, err)
+	})
+	t.Run("When type is code39 and code has lower case letters, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.Code39}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("abc123", cell, prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When type is code39 and code is valid, should return bytes", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.Code39}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("ABC-123", cell, prop)
+
+		// Assert
+		assert.NotNil(t, bytes)
+		assert.Nil(t, err)
+	})
+	t.Run("When type is telepen and code is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.Telepen}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("código", cell, prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When type is telepen and code is valid, should encode 16 modules per character", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.Telepen}
+		prop.MakeValid()
+
+		// Act
+		image, err := sut.GenBar("AB", cell, prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 80.0, image.Dimensions.Width)
+	})
+	t.Run("When type is telepen numeric, should encode each pair of digits in one character", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.TelepenNumeric}
+		prop.MakeValid()
+
+		// Act
+		image, err := sut.GenBar("12345", cell, prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 96.0, image.Dimensions.Width)
+	})
+	t.Run("When type is telepen numeric and code has letters, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		cell := &entity.Cell{
+			X:      10,
+			Y:      10,
+			Width:  100,
+			Height: 100,
+		}
+
+		prop := &props.Barcode{Type: barcode.TelepenNumeric}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("12A4", cell, prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+}
+
+func TestCode_GenMicroQr(t *testing.T) {
+	t.Run("When code does not fit in a micro qr code, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		data := genStringWithLength(14)
+
+		// Act
+		bytes, err := sut.GenMicroQr(data)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When code fits in M3, should return a symbol of 15 modules", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		data := genStringWithLength(7)
+
+		// Act
+		img, err := sut.GenMicroQr(data)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 15.0, img.Dimensions.Width)
+		assert.Equal(t, 15.0, img.Dimensions.Height)
+	})
+	t.Run("When code fits only in M4, should return a symbol of 17 modules", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		data := genStringWithLength(13)
+
+		// Act
+		img, err := sut.GenMicroQr(data)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 17.0, img.Dimensions.Width)
+		assert.NotEmpty(t, img.Bytes)
+	})
+}
+
+func TestCode_GenAztec(t *testing.T) {
+	t.Run("When code does not fit in an aztec code, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		data := genStringWithLength(5000)
+
+		// Act
+		bytes, err := sut.GenAztec(data)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When can generate aztec code, should return a square symbol", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		data := genStringWithLength(50)
+
+		// Act
+		img, err := sut.GenAztec(data)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotEmpty(t, img.Bytes)
+		assert.Equal(t, img.Dimensions.Width, img.Dimensions.Height)
+	})
+}
+
+func TestCode_GenPDF417(t *testing.T) {
+	t.Run("When security level is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		// Act
+		bytes, err := sut.GenPDF417("code", &props.PDF417{SecurityLevel: 9})
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When code needs more columns than the limit, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		data := genStringWithLength(200)
+
+		// Act
+		bytes, err := sut.GenPDF417(data, &props.PDF417{Columns: 2, SecurityLevel: 2})
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When can generate pdf417, should return a symbol wider than tall", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		data := genStringWithLength(50)
+
+		// Act
+		img, err := sut.GenPDF417(data, &props.PDF417{SecurityLevel: 2})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotEmpty(t, img.Bytes)
+		assert.Greater(t, img.Dimensions.Width, img.Dimensions.Height)
+	})
 }
 
 func TestCode_GenQr(t *testing.T) {
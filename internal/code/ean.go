@@ -0,0 +1,37 @@
+package code
+
+import (
+	"errors"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/ean"
+)
+
+const (
+	ean13Length = 12
+	upcaLength  = 11
+)
+
+var (
+	errInvalidEAN13 = errors.New("ean-13 code must have 12 or 13 digits")
+	errInvalidUPCA  = errors.New("upc-a code must have 11 or 12 digits")
+)
+
+// encodeEAN13 encodes an EAN-13 barcode, the check digit is calculated when the code has 12 digits.
+// The ean encoder also accepts EAN-8 codes, so the length is validated before.
+func encodeEAN13(code string) (barcode.Barcode, error) {
+	if len(code) != ean13Length && len(code) != ean13Length+1 {
+		return nil, errInvalidEAN13
+	}
+
+	return ean.Encode(code)
+}
+
+// encodeUPCA encodes an UPC-A barcode, which is an EAN-13 barcode with the first digit 0.
+func encodeUPCA(code string) (barcode.Barcode, error) {
+	if len(code) != upcaLength && len(code) != upcaLength+1 {
+		return nil, errInvalidUPCA
+	}
+
+	return ean.Encode("0" + code)
+}
@@ -0,0 +1,319 @@
+package code
+
+import (
+	"errors"
+	"image"
+	"image/color"
+
+	"github.com/boombuler/barcode"
+)
+
+const (
+	microQrByteMode      = 2
+	microQrFinderSize    = 7
+	microQrFunctionSize  = 9
+	microQrFormatMask    = 0x4445
+	microQrFormatPoly    = 0x537
+	microQrPadEven       = 0xEC
+	microQrPadOdd        = 0x11
+	microQrGaloisPoly    = 0x11D
+	microQrMatchingScore = 16
+)
+
+var errMicroQrTooLong = errors.New("micro qr code too long")
+
+// microQrVersion is a Micro QR version with the M error correction level, the versions M1 and M2
+// are not used as they do not support the byte mode.
+type microQrVersion struct {
+	symbol         int
+	size           int
+	modeBits       int
+	countBits      int
+	dataBits       int
+	ecCodewords    int
+	terminatorBits int
+}
+
+var microQrVersions = []microQrVersion{
+	{symbol: 4, size: 15, modeBits: 2, countBits: 4, dataBits: 68, ecCodewords: 8, terminatorBits: 7},
+	{symbol: 6, size: 17, modeBits: 3, countBits: 5, dataBits: 112, ecCodewords: 10, terminatorBits: 9},
+}
+
+// microQrMasks are the data mask patterns of Micro QR, by row and column.
+var microQrMasks = []func(i, j int) bool{
+	func(i, _ int) bool { return i%2 == 0 },
+	func(i, j int) bool { return (i/2+j/3)%2 == 0 },
+	func(i, j int) bool { return ((i*j)%2+(i*j)%3)%2 == 0 },
+	func(i, j int) bool { return ((i+j)%2+(i*j)%3)%2 == 0 },
+}
+
+// microQr is a Micro QR symbol, it implements barcode.Barcode to be converted in image like the other codes.
+type microQr struct {
+	content string
+	modules [][]bool
+}
+
+func (m *microQr) Content() string {
+	return m.content
+}
+
+func (m *microQr) Metadata() barcode.Metadata {
+	return barcode.Metadata{CodeKind: "Micro QR", Dimensions: 2}
+}
+
+func (m *microQr) ColorModel() color.Model {
+	return color.Gray16Model
+}
+
+func (m *microQr) Bounds() image.Rectangle {
+	return image.Rect(0, 0, len(m.modules), len(m.modules))
+}
+
+func (m *microQr) At(x, y int) color.Color {
+	if m.modules[y][x] {
+		return color.Black
+	}
+
+	return color.White
+}
+
+// encodeMicroQr encodes the content in the byte mode of the smallest Micro QR version with the M
+// error correction level, M3 supports up to 7 bytes and M4 up to 13 bytes.
+func encodeMicroQr(content string) (barcode.Barcode, error) {
+	data := []byte(content)
+
+	for _, version := range microQrVersions {
+		if version.modeBits+version.countBits+len(data)*8 > version.dataBits {
+			continue
+		}
+
+		matrix := newMicroQrMatrix(version.size)
+		matrix.placeData(version.getBits(data))
+
+		mask := matrix.applyBestMask()
+		matrix.placeFormat(version.symbol<<2 | mask)
+
+		return &microQr{
+			content: content,
+			modules: matrix.modules,
+		}, nil
+	}
+
+	return nil, errMicroQrTooLong
+}
+
+// getBits returns the data bits, ended by the terminator and the pad codewords, followed by the error correction bits.
+func (v microQrVersion) getBits(data []byte) []bool {
+	var bits []bool
+	add := func(value, length int) {
+		for i := length - 1; i >= 0; i-- {
+			bits = append(bits, value>>i&1 == 1)
+		}
+	}
+
+	add(microQrByteMode, v.modeBits)
+	add(len(data), v.countBits)
+	for _, b := range data {
+		add(int(b), 8)
+	}
+
+	add(0, min(v.terminatorBits, v.dataBits-len(bits)))
+	add(0, min((8-len(bits)%8)%8, v.dataBits-len(bits)))
+
+	for pad := microQrPadEven; len(bits) < v.dataBits; pad ^= microQrPadEven ^ microQrPadOdd {
+		if v.dataBits-len(bits) < 8 {
+			add(0, v.dataBits-len(bits))
+			break
+		}
+		add(pad, 8)
+	}
+
+	// The last data codeword of M3 has only 4 bits, it is completed with zeros to calculate the error correction.
+	codewords := make([]byte, (v.dataBits+7)/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i/8] |= 1 << (7 - i%8)
+		}
+	}
+
+	for _, ec := range reedSolomon(codewords, v.ecCodewords) {
+		add(int(ec), 8)
+	}
+
+	return bits
+}
+
+type microQrMatrix struct {
+	modules  [][]bool
+	reserved [][]bool
+}
+
+// newMicroQrMatrix creates a matrix with the finder pattern, the separator, the timing patterns
+// and the area of the format information.
+func newMicroQrMatrix(size int) *microQrMatrix {
+	m := &microQrMatrix{
+		modules:  make([][]bool, size),
+		reserved: make([][]bool, size),
+	}
+
+	for i := range m.modules {
+		m.modules[i] = make([]bool, size)
+		m.reserved[i] = make([]bool, size)
+	}
+
+	for i := 0; i < microQrFunctionSize; i++ {
+		for j := 0; j < microQrFunctionSize; j++ {
+			m.reserved[i][j] = true
+			if i < microQrFinderSize && j < microQrFinderSize {
+				ring := i == 0 || i == microQrFinderSize-1 || j == 0 || j == microQrFinderSize-1
+				center := i >= 2 && i <= 4 && j >= 2 && j <= 4
+				m.modules[i][j] = ring || center
+			}
+		}
+	}
+
+	for i := microQrFunctionSize - 1; i < size; i++ {
+		m.modules[0][i] = i%2 == 0
+		m.modules[i][0] = i%2 == 0
+		m.reserved[0][i] = true
+		m.reserved[i][0] = true
+	}
+
+	return m
+}
+
+// placeData writes the bits in columns of two modules, starting upwards at the bottom right corner.
+func (m *microQrMatrix) placeData(bits []bool) {
+	size := len(m.modules)
+	index := 0
+	upwards := true
+
+	for right := size - 1; right > 0; right -= 2 {
+		for k := 0; k < size; k++ {
+			i := k
+			if upwards {
+				i = size - 1 - k
+			}
+
+			for j := right; j > right-2; j-- {
+				if m.reserved[i][j] {
+					continue
+				}
+
+				m.modules[i][j] = index < len(bits) && bits[index]
+				index++
+			}
+		}
+
+		upwards = !upwards
+	}
+}
+
+// applyBestMask applies the mask with the highest score, the one with more dark modules on the right and bottom edges.
+func (m *microQrMatrix) applyBestMask() int {
+	size := len(m.modules)
+	best, bestScore := 0, -1
+
+	for mask := range microQrMasks {
+		m.applyMask(mask)
+
+		right, bottom := 0, 0
+		for k := 1; k < size; k++ {
+			if m.modules[k][size-1] {
+				right++
+			}
+			if m.modules[size-1][k] {
+				bottom++
+			}
+		}
+
+		score := min(right, bottom)*microQrMatchingScore + max(right, bottom)
+		if score > bestScore {
+			best, bestScore = mask, score
+		}
+
+		m.applyMask(mask)
+	}
+
+	m.applyMask(best)
+
+	return best
+}
+
+func (m *microQrMatrix) applyMask(mask int) {
+	for i := range m.modules {
+		for j := range m.modules[i] {
+			if !m.reserved[i][j] && microQrMasks[mask](i, j) {
+				m.modules[i][j] = !m.modules[i][j]
+			}
+		}
+	}
+}
+
+// placeFormat writes the symbol and mask number protected by a BCH code, bits 0 to 7 in the column
+// on the right of the finder pattern and bits 7 to 14 in the row below it.
+func (m *microQrMatrix) placeFormat(data int) {
+	format := data << 10
+	for i := 14; i >= 10; i-- {
+		if format>>i&1 == 1 {
+			format ^= microQrFormatPoly << (i - 10)
+		}
+	}
+	format = (data<<10 | format) ^ microQrFormatMask
+
+	for i := 0; i < 8; i++ {
+		m.modules[i+1][8] = format>>i&1 == 1
+		m.modules[8][i+1] = format>>(14-i)&1 == 1
+	}
+}
+
+// reedSolomon returns the error correction codewords of the data over GF(256).
+func reedSolomon(data []byte, ecCodewords int) []byte {
+	exp, log := getGaloisTables()
+	multiply := func(a, b byte) byte {
+		if a == 0 || b == 0 {
+			return 0
+		}
+		return exp[(int(log[a])+int(log[b]))%255]
+	}
+
+	generator := []byte{1}
+	for i := 0; i < ecCodewords; i++ {
+		next := make([]byte, len(generator)+1)
+		for j, coefficient := range generator {
+			next[j] ^= coefficient
+			next[j+1] ^= multiply(coefficient, exp[i])
+		}
+		generator = next
+	}
+
+	ec := make([]byte, ecCodewords)
+	for _, d := range data {
+		factor := d ^ ec[0]
+		copy(ec, ec[1:])
+		ec[ecCodewords-1] = 0
+
+		for j := range ec {
+			ec[j] ^= multiply(generator[j+1], factor)
+		}
+	}
+
+	return ec
+}
+
+func getGaloisTables() ([256]byte, [256]byte) {
+	var exp, log [256]byte
+
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(x)
+		log[x] = byte(i)
+
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= microQrGaloisPoly
+		}
+	}
+
+	return exp, log
+}
@@ -0,0 +1,118 @@
+package code
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// microQrFormats is the format information of Micro QR by symbol number and mask, from the table C.1 of
+// ISO/IEC 18004.
+var microQrFormats = []int{
+	0x4445, 0x4172, 0x4e2b, 0x4b1c, 0x55ae, 0x5099, 0x5fc0, 0x5af7,
+	0x6793, 0x62a4, 0x6dfd, 0x68ca, 0x7678, 0x734f, 0x7c16, 0x7921,
+	0x06de, 0x03e9, 0x0cb0, 0x0987, 0x1735, 0x1202, 0x1d5b, 0x186c,
+	0x2508, 0x203f, 0x2f66, 0x2a51, 0x34e3, 0x31d4, 0x3e8d, 0x3bba,
+}
+
+func TestReedSolomon(t *testing.T) {
+	t.Run("when codewords are the 1-M example of ISO/IEC 18004 annex I, should return its error correction", func(t *testing.T) {
+		// Arrange
+		data := []byte{16, 32, 12, 86, 97, 128, 236, 17, 236, 17, 236, 17, 236, 17, 236, 17}
+
+		// Act
+		ec := reedSolomon(data, 10)
+
+		// Assert
+		assert.Equal(t, []byte{165, 36, 212, 193, 237, 54, 199, 135, 44, 85}, ec)
+	})
+	t.Run("when codewords are the M2-L example of ISO/IEC 18004 annex I, should return its error correction", func(t *testing.T) {
+		// Arrange
+		data := []byte{0x40, 0x18, 0xAC, 0xC3, 0x00}
+
+		// Act
+		ec := reedSolomon(data, 5)
+
+		// Assert
+		assert.Equal(t, []byte{0x86, 0x0D, 0x22, 0xAE, 0x30}, ec)
+	})
+}
+
+func TestMicroQrMatrix_PlaceFormat(t *testing.T) {
+	t.Run("should write the format information of ISO/IEC 18004 table C.1", func(t *testing.T) {
+		for data, expected := range microQrFormats {
+			// Arrange
+			sut := newMicroQrMatrix(15)
+
+			// Act
+			sut.placeFormat(data)
+
+			// Assert
+			assert.Equal(t, expected, readMicroQrFormat(sut.modules), "symbol %d, mask %d", data>>2, data&3)
+		}
+	})
+}
+
+func TestEncodeMicroQr(t *testing.T) {
+	t.Run("when content fits in M3, should write the finder, the timing patterns and the M3-M format", func(t *testing.T) {
+		// Act
+		symbol, err := encodeMicroQr("maroto")
+
+		// Assert
+		assert.Nil(t, err)
+		modules := symbol.(*microQr).modules
+		assert.Len(t, modules, 15)
+		assert.Equal(t, []bool{true, true, true, true, true, true, true, false, true, false, true, false, true, false, true}, modules[0])
+		assert.Equal(t, []bool{true, false, true, true, true, false, true}, modules[3][:7])
+
+		format := readMicroQrFormat(modules)
+		assert.Contains(t, microQrFormats[16:20], format)
+	})
+	t.Run("when content fits only in M4, should write the M4-M format and the data bits under the mask", func(t *testing.T) {
+		// Arrange
+		content := "maroto v2.0.0"
+
+		// Act
+		symbol, err := encodeMicroQr(content)
+
+		// Assert
+		assert.Nil(t, err)
+		modules := symbol.(*microQr).modules
+		assert.Len(t, modules, 17)
+
+		format := readMicroQrFormat(modules)
+		assert.Contains(t, microQrFormats[24:28], format)
+
+		mask := indexOf(microQrFormats, format) & 3
+		expected := newMicroQrMatrix(17)
+		expected.placeData(microQrVersions[1].getBits([]byte(content)))
+		expected.applyMask(mask)
+		expected.placeFormat(6<<2 | mask)
+		assert.Equal(t, expected.modules, modules)
+	})
+}
+
+// readMicroQrFormat returns the format information written in the row below and the column on the right of the finder.
+func readMicroQrFormat(modules [][]bool) int {
+	format := 0
+	for i := 0; i < 8; i++ {
+		if modules[i+1][8] {
+			format |= 1 << i
+		}
+		if modules[8][i+1] {
+			format |= 1 << (14 - i)
+		}
+	}
+
+	return format
+}
+
+func indexOf(values []int, value int) int {
+	for i := range values {
+		if values[i] == value {
+			return i
+		}
+	}
+
+	return -1
+}
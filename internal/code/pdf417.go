@@ -0,0 +1,37 @@
+package code
+
+import (
+	"fmt"
+
+	"github.com/boombuler/barcode/pdf417"
+
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	// pdf417CodewordWidth is the width in modules of each codeword, the start and stop patterns
+	// and the row indicators are four codewords added to the data columns.
+	pdf417CodewordWidth = 17
+	pdf417RowCodewords  = 4
+	pdf417ModuleHeight  = 2
+)
+
+// GenPDF417 is responsible to generate a pdf417 byte array.
+func (c *code) GenPDF417(code string, prop *props.PDF417) (*entity.Image, error) {
+	pdf417Code, err := pdf417.Encode(code, byte(prop.SecurityLevel))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := pdf417Code.Bounds()
+	columns := (bounds.Dx()-1)/pdf417CodewordWidth - pdf417RowCodewords
+	rows := bounds.Dy() / pdf417ModuleHeight
+
+	if prop.Columns > 0 && columns > prop.Columns || prop.Rows > 0 && rows > prop.Rows {
+		return nil, fmt.Errorf("pdf417 needs %d columns and %d rows, more than the limit of %d columns and %d rows",
+			columns, rows, prop.Columns, prop.Rows)
+	}
+
+	return c.getImage(pdf417Code)
+}
@@ -0,0 +1,140 @@
+package code
+
+import (
+	"errors"
+	"math/bits"
+	"unicode"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/utils"
+)
+
+const (
+	telepenStart         = '_'
+	telepenStop          = 'z'
+	telepenModulo        = 127
+	telepenNumericOffset = 27
+	telepenNarrow        = 1
+	telepenWide          = 3
+)
+
+var errInvalidTelepen = errors.New("invalid telepen code")
+
+// encodeTelepen encodes a Telepen barcode, in the numeric mode each pair of digits is encoded in one character.
+func encodeTelepen(code string, numeric bool) (barcode.Barcode, error) {
+	values, err := getTelepenValues(code, numeric)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := 0
+	for _, value := range values {
+		sum += value
+	}
+
+	check := telepenModulo - sum%telepenModulo
+	if check == telepenModulo {
+		check = 0
+	}
+
+	glyphs := append([]int{telepenStart}, values...)
+	glyphs = append(glyphs, check, telepenStop)
+
+	bars := utils.NewBitList(0)
+	for _, glyph := range glyphs {
+		addTelepenGlyph(bars, glyph)
+	}
+
+	return utils.New1DCode("Telepen", code, bars), nil
+}
+
+func getTelepenValues(code string, numeric bool) ([]int, error) {
+	if code == "" {
+		return nil, errInvalidTelepen
+	}
+
+	if !numeric {
+		values := make([]int, 0, len(code))
+		for _, c := range code {
+			if c > unicode.MaxASCII {
+				return nil, errInvalidTelepen
+			}
+			values = append(values, int(c))
+		}
+
+		return values, nil
+	}
+
+	if len(code)%2 != 0 {
+		code = "0" + code
+	}
+
+	values := make([]int, 0, len(code)/2)
+	for i := 0; i < len(code); i += 2 {
+		if !isDigit(code[i]) || !isDigit(code[i+1]) {
+			return nil, errInvalidTelepen
+		}
+		values = append(values, telepenNumericOffset+int(code[i]-'0')*10+int(code[i+1]-'0'))
+	}
+
+	return values, nil
+}
+
+// addTelepenGlyph writes the character with even parity, least significant bit first. A 1 is a narrow bar
+// and a narrow space, 00 is a wide bar and a narrow space, 010 is a wide bar and a wide space and on
+// 01...10 both the 01 and the 10 are a narrow bar and a wide space.
+func addTelepenGlyph(bars *utils.BitList, glyph int) {
+	if bits.OnesCount(uint(glyph))%2 != 0 {
+		glyph |= 0x80
+	}
+
+	stream := make([]bool, 8)
+	for i := range stream {
+		stream[i] = glyph&(1<<i) != 0
+	}
+
+	for i := 0; i < len(stream); i++ {
+		if stream[i] {
+			addTelepenElements(bars, telepenNarrow, telepenNarrow)
+			continue
+		}
+
+		if !stream[i+1] {
+			addTelepenElements(bars, telepenWide, telepenNarrow)
+			i++
+			continue
+		}
+
+		next := i + 1
+		for stream[next] {
+			next++
+		}
+
+		if next-i == 2 {
+			addTelepenElements(bars, telepenWide, telepenWide)
+			i = next
+			continue
+		}
+
+		addTelepenElements(bars, telepenNarrow, telepenWide)
+		for j := i + 2; j < next-1; j++ {
+			addTelepenElements(bars, telepenNarrow, telepenNarrow)
+		}
+		addTelepenElements(bars, telepenNarrow, telepenWide)
+		i = next
+	}
+}
+
+func addTelepenElements(bars *utils.BitList, bar, space int) {
+	for i := 0; i < bar; i++ {
+		bars.AddBit(true)
+	}
+
+	for i := 0; i < space; i++ {
+		bars.AddBit(false)
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
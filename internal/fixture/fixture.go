@@ -1,6 +1,8 @@
 package fixture
 
 import (
+	"time"
+
 	"github.com/johnfercher/go-tree/node"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
@@ -9,8 +11,10 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/legendposition"
 	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
+	"github.com/johnfercher/maroto/v2/pkg/consts/theme"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
@@ -79,6 +83,18 @@ func RectProp() props.Rect {
 	return prop
 }
 
+// PDF417Prop is responsible to give a valid props.PDF417.
+func PDF417Prop() props.PDF417 {
+	prop := props.PDF417{
+		Columns:       10,
+		Rows:          20,
+		SecurityLevel: 4,
+		Rect:          RectProp(),
+	}
+	prop.MakeValid()
+	return prop
+}
+
 // CellEntity is responsible to give a valid entity.Cell.
 func CellEntity() entity.Cell {
 	return entity.Cell{
@@ -194,3 +210,234 @@ func PageProp() props.Page {
 	}
 	return prop
 }
+
+// HeatmapProp is responsible to give a valid props.Heatmap.
+func HeatmapProp() props.Heatmap {
+	fontProp := FontProp()
+	prop := props.Heatmap{
+		MinColor:     &props.WhiteColor,
+		MaxColor:     &props.BlueColor,
+		RowLabels:    []string{"mon", "tue"},
+		ColLabels:    []string{"08h", "09h", "10h"},
+		LabelPercent: 20,
+		LabelFont:    fontProp,
+	}
+	prop.MakeValid(fontProp.Family)
+	return prop
+}
+
+// MarkdownProp is responsible to give a valid props.Markdown.
+func MarkdownProp() props.Markdown {
+	fontProp := FontProp()
+	prop := props.Markdown{
+		Family:        fontfamily.Helvetica,
+		Size:          12,
+		HeadingScales: []float64{3, 2},
+		CodeFamily:    fontfamily.Arial,
+		CodeTheme:     theme.Dark,
+		LinkColor:     &props.RedColor,
+		BlockSpacing:  4,
+		Indent:        8,
+	}
+	prop.MakeValid(&fontProp)
+	return prop
+}
+
+// PieChartProp is responsible to give a valid props.PieChart.
+func PieChartProp() props.PieChart {
+	fontProp := FontProp()
+	prop := props.PieChart{
+		DonutRadius:   5,
+		BorderColor:   &props.BlackColor,
+		LegendPercent: 40,
+		LegendFont:    fontProp,
+	}
+	prop.MakeValid(fontProp.Family)
+	return prop
+}
+
+// BarChartProp is responsible to give a valid props.BarChart.
+func BarChartProp() props.BarChart {
+	fontProp := FontProp()
+	prop := props.BarChart{
+		Horizontal:    true,
+		Stacked:       true,
+		ShowValues:    true,
+		Categories:    []string{"Q1", "Q2"},
+		AxisColor:     &props.RedColor,
+		LabelPercent:  25,
+		LegendPercent: 30,
+		Font:          fontProp,
+	}
+	prop.MakeValid(fontProp.Family)
+	return prop
+}
+
+// ChartProp is responsible to give a valid props.Chart.
+func ChartProp() props.Chart {
+	colorProp := ColorProp()
+	return props.Chart{
+		BackgroundColor: &colorProp,
+		HideAxes:        true,
+		Title:           "Revenue",
+		LegendPosition:  legendposition.Top,
+	}
+}
+
+// WaveProp is responsible to give a valid props.Wave.
+func WaveProp() props.Wave {
+	colorProp := ColorProp()
+	prop := props.Wave{
+		Color:            &colorProp,
+		Style:            linestyle.Dashed,
+		Thickness:        0.5,
+		Count:            2,
+		AmplitudePercent: 80,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// ShapeProp is responsible to give a valid props.Shape.
+func ShapeProp() props.Shape {
+	colorProp := ColorProp()
+	prop := props.Shape{
+		Color:     &colorProp,
+		FillColor: &props.RedColor,
+		Style:     linestyle.Dashed,
+		Thickness: 0.5,
+		Percent:   50,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// ProgressBarProp is responsible to give a valid props.ProgressBar.
+func ProgressBarProp() props.ProgressBar {
+	colorProp := ColorProp()
+	prop := props.ProgressBar{
+		FilledColor:     &colorProp,
+		EmptyColor:      &props.WhiteColor,
+		BorderColor:     &props.BlackColor,
+		BorderThickness: 0.5,
+		CornerRadius:    2,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// AddressProp is responsible to give a valid props.Address.
+func AddressProp() props.Address {
+	colorProp := ColorProp()
+	prop := props.Address{
+		Family:      fontfamily.Helvetica,
+		Style:       fontstyle.Bold,
+		Size:        12,
+		Color:       &colorProp,
+		Align:       align.Center,
+		LineSpacing: 1.5,
+	}
+	prop.MakeValid(fontfamily.Arial)
+	return prop
+}
+
+// InvoiceProp is responsible to give a valid props.Invoice.
+func InvoiceProp() props.Invoice {
+	colorProp := ColorProp()
+	prop := props.Invoice{
+		Family:      fontfamily.Helvetica,
+		Size:        10,
+		Color:       &colorProp,
+		HeaderColor: &colorProp,
+		Currency:    "€",
+		DateLayout:  "02/01/2006",
+		ItemHeight:  8,
+	}
+	prop.MakeValid(fontfamily.Arial)
+	return prop
+}
+
+// TableListProp is responsible to give a valid props.TableList.
+func TableListProp() props.TableList {
+	colorProp := ColorProp()
+	prop := props.TableList{
+		HeaderColor:       &colorProp,
+		OddRowColor:       &props.WhiteColor,
+		EvenRowColor:      &props.RedColor,
+		HeaderFontStyle:   fontstyle.BoldItalic,
+		ContentFontStyle:  fontstyle.Italic,
+		ColumnProportions: []float64{2, 1},
+		ColumnAligns:      []align.Type{align.Left, align.Right},
+		FontFamily:        fontfamily.Courier,
+		FontSize:          10,
+		FontColor:         &colorProp,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// GanttProp is responsible to give a valid props.Gantt.
+func GanttProp() props.Gantt {
+	fontProp := FontProp()
+	colorProp := ColorProp()
+	prop := props.Gantt{
+		BarColor:       &colorProp,
+		CriticalColor:  &props.RedColor,
+		MilestoneColor: &props.BlueColor,
+		Today:          time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC),
+		TodayColor:     &props.BlueColor,
+		DateLayout:     "02/01",
+		LabelPercent:   20,
+		LabelFont:      fontProp,
+	}
+	prop.MakeValid(fontProp.Family)
+	return prop
+}
+
+// MilestoneProp is responsible to give a valid props.Milestone.
+func MilestoneProp() props.Milestone {
+	fontProp := FontProp()
+	colorProp := ColorProp()
+	prop := props.Milestone{
+		LineColor:     &props.RedColor,
+		LineThickness: 0.5,
+		Color:         &colorProp,
+		DiamondSize:   4,
+		LabelFont:     fontProp,
+	}
+	prop.MakeValid(fontProp.Family)
+	return prop
+}
+
+// HeadingProp is responsible to give a valid props.Heading.
+func HeadingProp() props.Heading {
+	fontProp := FontProp()
+	colorProp := ColorProp()
+	prop := props.Heading{
+		Family: fontfamily.Helvetica,
+		Style:  fontstyle.BoldItalic,
+		Size:   12,
+		Color:  &colorProp,
+		Align:  align.Center,
+		Top:    2,
+		Scale:  []float64{3, 2},
+	}
+	prop.MakeValid(&fontProp)
+	return prop
+}
+
+// WatermarkProp is responsible to give a valid props.Watermark.
+func WatermarkProp() props.Watermark {
+	fontProp := FontProp()
+	colorProp := ColorProp()
+	prop := props.Watermark{
+		Family:  fontfamily.Helvetica,
+		Style:   fontstyle.Italic,
+		Size:    40,
+		Color:   &colorProp,
+		Angle:   30,
+		Opacity: 0.5,
+	}
+	prop.MakeValid(&fontProp)
+	return prop
+}
@@ -0,0 +1,59 @@
+// Package fontmetrics estimates the width of texts before the document is built, when there is no provider
+// to measure them with the fonts of the document.
+package fontmetrics
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/jung-kurt/gofpdf"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/pagesize"
+)
+
+var coreFamilies = []string{
+	fontfamily.Arial, fontfamily.Helvetica, fontfamily.Courier, "times", fontfamily.Symbol, fontfamily.ZapBats,
+}
+
+var (
+	mutex      sync.Mutex
+	pdf        *gofpdf.Fpdf
+	translator func(string) string
+)
+
+// GetStringWidth returns the width, in mm, of the text written in a single line with the font. The custom
+// fonts are not known before the document is built, so they are measured with arial, and a size not greater
+// than zero is the default font size.
+func GetStringWidth(text string, family string, style fontstyle.Type, size float64) float64 {
+	family = strings.ToLower(family)
+	if !isCoreFamily(family) {
+		family = fontfamily.Arial
+	}
+
+	if size <= 0 {
+		size = pagesize.DefaultFontSize
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if pdf == nil {
+		pdf = gofpdf.New("P", "mm", "A4", "")
+		translator = pdf.UnicodeTranslatorFromDescriptor("")
+	}
+
+	pdf.SetFont(family, string(style), size)
+	return pdf.GetStringWidth(translator(text))
+}
+
+func isCoreFamily(family string) bool {
+	for _, coreFamily := range coreFamilies {
+		if family == coreFamily {
+			return true
+		}
+	}
+
+	return false
+}
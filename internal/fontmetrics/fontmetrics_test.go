@@ -0,0 +1,52 @@
+package fontmetrics_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fontmetrics"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+func TestGetStringWidth(t *testing.T) {
+	t.Run("when font is bigger, should return a wider width", func(t *testing.T) {
+		// Act
+		small := fontmetrics.GetStringWidth("Total", fontfamily.Arial, fontstyle.Normal, 10)
+		big := fontmetrics.GetStringWidth("Total", fontfamily.Arial, fontstyle.Normal, 20)
+
+		// Assert
+		assert.Greater(t, small, 0.0)
+		assert.InDelta(t, 2*small, big, 0.01)
+	})
+	t.Run("when family is custom, should measure with arial", func(t *testing.T) {
+		// Act
+		width := fontmetrics.GetStringWidth("Total", "custom", fontstyle.Normal, 10)
+
+		// Assert
+		assert.Equal(t, fontmetrics.GetStringWidth("Total", fontfamily.Arial, fontstyle.Normal, 10), width)
+	})
+	t.Run("when size is not defined, should measure with the default size", func(t *testing.T) {
+		// Act
+		width := fontmetrics.GetStringWidth("Total", fontfamily.Arial, fontstyle.Normal, 0)
+
+		// Assert
+		assert.Equal(t, fontmetrics.GetStringWidth("Total", fontfamily.Arial, fontstyle.Normal, 10), width)
+	})
+	t.Run("when called concurrently, should return the same width", func(t *testing.T) {
+		// Arrange
+		expected := fontmetrics.GetStringWidth("Total", fontfamily.Courier, fontstyle.Bold, 12)
+		widths := make(chan float64, 10)
+
+		// Act
+		for i := 0; i < 10; i++ {
+			go func() { widths <- fontmetrics.GetStringWidth("Total", fontfamily.Courier, fontstyle.Bold, 12) }()
+		}
+
+		// Assert
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, expected, <-widths)
+		}
+	})
+}
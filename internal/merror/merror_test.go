@@ -15,7 +15,7 @@ func TestDefaultErrorText(t *testing.T) {
 	assert.Equal(t, fontfamily.Arial, merror.DefaultErrorText.Family)
 	assert.Equal(t, fontstyle.Bold, merror.DefaultErrorText.Style)
 	assert.Equal(t, 10.0, merror.DefaultErrorText.Size)
-	assert.Equal(t, 255, merror.DefaultErrorText.Color.Red)
-	assert.Equal(t, 0, merror.DefaultErrorText.Color.Green)
-	assert.Equal(t, 0, merror.DefaultErrorText.Color.Blue)
+	assert.Equal(t, 255, merror.DefaultErrorText.Color.ToColor().Red)
+	assert.Equal(t, 0, merror.DefaultErrorText.Color.ToColor().Green)
+	assert.Equal(t, 0, merror.DefaultErrorText.Color.ToColor().Blue)
 }
@@ -1,6 +1,8 @@
 package gofpdf
 
 import (
+	"strings"
+
 	"github.com/jung-kurt/gofpdf"
 
 	"github.com/johnfercher/maroto/v2/internal/cache"
@@ -20,6 +22,7 @@ type Dependencies struct {
 	Code       core.Code
 	Image      core.Image
 	Line       core.Line
+	Math       core.Math
 	Cache      cache.Cache
 	CellWriter cellwriter.CellWriter
 	Cfg        *entity.Config
@@ -49,14 +52,29 @@ func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
 		},
 	})
 
+	var lazyFonts []*entity.CustomFont
 	for _, font := range cfg.CustomFonts {
+		if cfg.DisableAutoFonts && !strings.EqualFold(font.Family, cfg.DefaultFont.Family) {
+			lazyFonts = append(lazyFonts, font)
+			continue
+		}
+
 		fpdf.AddUTF8FontFromBytes(font.Family, string(font.Style), font.Bytes)
 	}
 
+	if cfg.Bleed != nil {
+		setBleedBoxes(fpdf, cfg)
+	}
+
 	fpdf.SetMargins(cfg.Margins.Left, cfg.Margins.Top, cfg.Margins.Right)
+	fpdf.SetAutoPageBreak(true, cfg.Margins.Bottom)
 	fpdf.AddPage()
 
 	font := NewFont(fpdf, cfg.DefaultFont.Size, cfg.DefaultFont.Family, cfg.DefaultFont.Style)
+	font.setLazyFonts(lazyFonts)
+	if cfg.FallbackToDefaultFont {
+		font.setFallbackFamily(cfg.DefaultFont.Family)
+	}
 	math := math.New()
 	code := code.New()
 	text := NewText(fpdf, math, font)
@@ -72,8 +90,18 @@ func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
 		Code:       code,
 		Image:      image,
 		Line:       line,
+		Math:       math,
 		CellWriter: cellWriter,
 		Cfg:        cfg,
 		Cache:      cache,
 	}
 }
+
+// setBleedBoxes defines the BleedBox as the whole MediaBox and the TrimBox bleed millimeters inside it.
+func setBleedBoxes(fpdf gofpdfwrapper.Fpdf, cfg *entity.Config) {
+	bleed := cfg.Bleed.BleedMM
+	width, height := cfg.Dimensions.Width, cfg.Dimensions.Height
+
+	fpdf.SetPageBox("bleed", 0, 0, width, height)
+	fpdf.SetPageBox("trim", bleed, bleed, width-2*bleed, height-2*bleed)
+}
@@ -1,12 +1,16 @@
 package gofpdf_test
 
 import (
+	"bytes"
 	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,31 +25,184 @@ func TestNewBuilder(t *testing.T) {
 }
 
 func TestBuilder_Build(t *testing.T) {
-	// Arrange
-	sut := gofpdf.NewBuilder()
-	font := fixture.FontProp()
-	cfg := &entity.Config{
-		Dimensions: &entity.Dimensions{
-			Width:  100,
-			Height: 200,
-		},
-		Margins: &entity.Margins{
-			Left:   10,
-			Top:    10,
-			Right:  10,
-			Bottom: 10,
-		},
-		DefaultFont: &font,
-		CustomFonts: []*entity.CustomFont{
-			{
-				Family: fontfamily.Arial,
+	t.Run("when custom fonts are defined, should build dependencies", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewBuilder()
+		font := fixture.FontProp()
+		cfg := &entity.Config{
+			Dimensions: &entity.Dimensions{
+				Width:  100,
+				Height: 200,
 			},
-		},
-	}
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+			DefaultFont: &font,
+			CustomFonts: []*entity.CustomFont{
+				{
+					Family: fontfamily.Arial,
+				},
+			},
+		}
 
-	// Act
-	dep := sut.Build(cfg, nil)
+		// Act
+		dep := sut.Build(cfg, nil)
 
-	// Assert
-	assert.NotNil(t, dep)
+		// Assert
+		assert.NotNil(t, dep)
+	})
+	t.Run("when custom fonts share the family with different styles, should register all variants", func(t *testing.T) {
+		// Arrange
+		fontBytes, err := os.ReadFile(buildPath("docs/assets/fonts/arial-unicode-ms.ttf"))
+		assert.Nil(t, err)
+
+		family := "arial-unicode-ms"
+		font := fixture.FontProp()
+		cfg := &entity.Config{
+			Dimensions:  &entity.Dimensions{Width: 100, Height: 200},
+			Margins:     &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10},
+			DefaultFont: &font,
+			CustomFonts: []*entity.CustomFont{
+				{Family: family, Style: fontstyle.Normal, Bytes: fontBytes},
+				{Family: family, Style: fontstyle.Bold, Bytes: fontBytes},
+			},
+		}
+		sut := gofpdf.NewBuilder()
+
+		// Act
+		dep := sut.Build(cfg, nil)
+		dep.Font.SetFont(family, fontstyle.Bold, 10)
+
+		// Assert
+		assert.False(t, dep.Fpdf.Err())
+	})
+	t.Run("when auto fonts are disabled, should register custom fonts on first use", func(t *testing.T) {
+		// Arrange
+		fontBytes, err := os.ReadFile(buildPath("docs/assets/fonts/arial-unicode-ms.ttf"))
+		assert.Nil(t, err)
+
+		family := "arial-unicode-ms"
+		font := fixture.FontProp()
+		cfg := &entity.Config{
+			Dimensions:       &entity.Dimensions{Width: 100, Height: 200},
+			Margins:          &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10},
+			DefaultFont:      &font,
+			DisableAutoFonts: true,
+			CustomFonts: []*entity.CustomFont{
+				{Family: family, Style: fontstyle.Normal, Bytes: fontBytes},
+				{Family: family, Style: fontstyle.Bold, Bytes: fontBytes},
+				{Family: "unused", Style: fontstyle.Normal, Bytes: fontBytes},
+			},
+		}
+		sut := gofpdf.NewBuilder()
+
+		// Act
+		dep := sut.Build(cfg, nil)
+		registeredBeforeUse := dep.Fpdf.GetFontDesc(family, "B").Ascent != 0
+		dep.Font.SetFont(family, fontstyle.Bold, 10)
+		dep.Font.SetFont(family, fontstyle.Normal, 10)
+
+		// Assert
+		assert.False(t, dep.Fpdf.Err())
+		assert.False(t, registeredBeforeUse)
+		assert.NotZero(t, dep.Fpdf.GetFontDesc(family, "B").Ascent)
+		assert.Zero(t, dep.Fpdf.GetFontDesc("unused", "").Ascent)
+	})
+	t.Run("when auto fonts are disabled, should register the default family fonts when building", func(t *testing.T) {
+		// Arrange
+		fontBytes, err := os.ReadFile(buildPath("docs/assets/fonts/arial-unicode-ms.ttf"))
+		assert.Nil(t, err)
+
+		family := "arial-unicode-ms"
+		font := fixture.FontProp()
+		font.Family = family
+		font.Style = fontstyle.Normal
+		cfg := &entity.Config{
+			Dimensions:       &entity.Dimensions{Width: 100, Height: 200},
+			Margins:          &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10},
+			DefaultFont:      &font,
+			DisableAutoFonts: true,
+			CustomFonts: []*entity.CustomFont{
+				{Family: family, Style: fontstyle.Normal, Bytes: fontBytes},
+			},
+		}
+		sut := gofpdf.NewBuilder()
+
+		// Act
+		dep := sut.Build(cfg, nil)
+
+		// Assert
+		assert.False(t, dep.Fpdf.Err())
+		assert.NotZero(t, dep.Fpdf.GetFontDesc(family, "").Ascent)
+	})
+	t.Run("when fallback to default font is enabled, should use the default family for fonts not registered", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		cfg := &entity.Config{
+			Dimensions:            &entity.Dimensions{Width: 100, Height: 200},
+			Margins:               &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10},
+			DefaultFont:           &font,
+			FallbackToDefaultFont: true,
+		}
+		sut := gofpdf.NewBuilder()
+
+		// Act
+		dep := sut.Build(cfg, nil)
+		dep.Font.SetFont("missing", fontstyle.Bold, 10)
+
+		// Assert
+		assert.False(t, dep.Fpdf.Err())
+		assert.Equal(t, font.Family, dep.Font.GetFamily())
+	})
+	t.Run("when fallback to default font is not enabled, should keep the undefined font error", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		cfg := &entity.Config{
+			Dimensions:  &entity.Dimensions{Width: 100, Height: 200},
+			Margins:     &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10},
+			DefaultFont: &font,
+		}
+		sut := gofpdf.NewBuilder()
+
+		// Act
+		dep := sut.Build(cfg, nil)
+		dep.Font.SetFont("missing", fontstyle.Bold, 10)
+
+		// Assert
+		assert.True(t, dep.Fpdf.Err())
+		assert.Equal(t, "missing", dep.Font.GetFamily())
+	})
+	t.Run("when bleed is defined, should write bleed and trim boxes", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		cfg := &entity.Config{
+			Dimensions:  &entity.Dimensions{Width: 106, Height: 206},
+			Margins:     &entity.Margins{Left: 13, Top: 13, Right: 13, Bottom: 13},
+			DefaultFont: &font,
+			Bleed:       &entity.BleedBox{BleedMM: 3},
+		}
+		sut := gofpdf.NewBuilder()
+
+		// Act
+		dep := sut.Build(cfg, nil)
+		var buf bytes.Buffer
+		err := dep.Fpdf.Output(&buf)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, buf.String(), "/BleedBox")
+		assert.Contains(t, buf.String(), "/TrimBox")
+	})
+}
+
+func buildPath(file string) string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+
+	return strings.ReplaceAll(dir, "internal/providers/gofpdf", file)
 }
@@ -28,12 +28,11 @@ func (b *borderColorStyler) Apply(width, height float64, config *entity.Config,
 		return
 	}
 
-	if prop.BorderColor == nil {
+	if !gofpdfwrapper.ApplyDrawColor(b.fpdf, prop.BorderColor) {
 		b.GoToNext(width, height, config, prop)
 		return
 	}
 
-	b.fpdf.SetDrawColor(prop.BorderColor.Red, prop.BorderColor.Green, prop.BorderColor.Blue)
 	b.GoToNext(width, height, config, prop)
 	b.fpdf.SetDrawColor(b.defaultColor.Red, b.defaultColor.Green, b.defaultColor.Blue)
 }
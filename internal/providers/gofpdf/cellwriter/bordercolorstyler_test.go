@@ -82,7 +82,7 @@ func TestBorderColorStyler_Apply(t *testing.T) {
 		inner.EXPECT().Apply(width, height, cfg, prop)
 
 		fpdf := &mocks.Fpdf{}
-		fpdf.EXPECT().SetDrawColor(prop.BorderColor.Red, prop.BorderColor.Green, prop.BorderColor.Blue)
+		fpdf.EXPECT().SetDrawColor(prop.BorderColor.ToColor().Red, prop.BorderColor.ToColor().Green, prop.BorderColor.ToColor().Blue)
 		fpdf.EXPECT().SetDrawColor(0, 0, 0)
 
 		sut := cellwriter.NewBorderColorStyler(fpdf)
@@ -95,4 +95,31 @@ func TestBorderColorStyler_Apply(t *testing.T) {
 		inner.AssertNumberOfCalls(t, "Apply", 1)
 		fpdf.AssertNumberOfCalls(t, "SetDrawColor", 2)
 	})
+	t.Run("When has prop and border color is cmyk, should apply cmyk and call next", func(t *testing.T) {
+		// Arrange
+		width := 100.0
+		height := 100.0
+		cfg := &entity.Config{}
+		prop := &props.Cell{
+			BorderColor: &props.CMYKColor{Magenta: 100, Key: 25},
+		}
+
+		inner := &mocks.CellWriter{}
+		inner.EXPECT().Apply(width, height, cfg, prop)
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetDrawCMYKColor(0.0, 100.0, 0.0, 25.0)
+		fpdf.EXPECT().SetDrawColor(0, 0, 0)
+
+		sut := cellwriter.NewBorderColorStyler(fpdf)
+		sut.SetNext(inner)
+
+		// Act
+		sut.Apply(width, height, cfg, prop)
+
+		// Assert
+		inner.AssertNumberOfCalls(t, "Apply", 1)
+		fpdf.AssertNumberOfCalls(t, "SetDrawCMYKColor", 1)
+		fpdf.AssertNumberOfCalls(t, "SetDrawColor", 1)
+	})
 }
@@ -26,12 +26,24 @@ func (b *borderLineStyler) Apply(width, height float64, config *entity.Config, p
 		return
 	}
 
+	if props.IsValidDashPattern(prop.DashPattern) {
+		b.fpdf.SetDashPattern(prop.DashPattern, 0)
+		b.GoToNext(width, height, config, prop)
+		b.fpdf.SetDashPattern([]float64{1, 0}, 0)
+		return
+	}
+
 	if prop.LineStyle == linestyle.Solid || prop.LineStyle == "" {
 		b.GoToNext(width, height, config, prop)
 		return
 	}
 
-	b.fpdf.SetDashPattern([]float64{1, 1}, 0)
+	if prop.LineStyle == linestyle.Dotted {
+		b.fpdf.SetDashPattern(linestyle.GetDotPattern(prop.BorderThickness), 0)
+	} else {
+		b.fpdf.SetDashPattern([]float64{1, 1}, 0)
+	}
+
 	b.GoToNext(width, height, config, prop)
 	b.fpdf.SetDashPattern([]float64{1, 0}, 0)
 }
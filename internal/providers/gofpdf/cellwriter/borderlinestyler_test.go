@@ -116,4 +116,87 @@ func TestBorderLineStyler_Apply(t *testing.T) {
 		inner.AssertNumberOfCalls(t, "Apply", 1)
 		fpdf.AssertNumberOfCalls(t, "SetDashPattern", 2)
 	})
+	t.Run("When has prop and line style is dotted, should apply dot pattern and call next", func(t *testing.T) {
+		// Arrange
+		width := 100.0
+		height := 100.0
+		cfg := &entity.Config{}
+		prop := &props.Cell{
+			LineStyle:       linestyle.Dotted,
+			BorderThickness: 0.5,
+		}
+
+		inner := &mocks.CellWriter{}
+		inner.EXPECT().Apply(width, height, cfg, prop)
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetDashPattern([]float64{0.5, 1}, 0.0)
+		fpdf.EXPECT().SetDashPattern([]float64{1, 0}, 0.0)
+
+		sut := cellwriter.NewBorderLineStyler(fpdf)
+		sut.SetNext(inner)
+
+		// Act
+		sut.Apply(width, height, cfg, prop)
+
+		// Assert
+		inner.AssertNumberOfCalls(t, "Apply", 1)
+		fpdf.AssertCalled(t, "SetDashPattern", []float64{0.5, 1}, 0.0)
+	})
+	t.Run("When has prop and dash pattern, should apply dash pattern over line style and call next", func(t *testing.T) {
+		// Arrange
+		width := 100.0
+		height := 100.0
+		cfg := &entity.Config{}
+		prop := &props.Cell{
+			LineStyle:   linestyle.Dashed,
+			DashPattern: []float64{2, 1, 4, 1},
+		}
+
+		inner := &mocks.CellWriter{}
+		inner.EXPECT().Apply(width, height, cfg, prop)
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetDashPattern([]float64{2, 1, 4, 1}, 0.0)
+		fpdf.EXPECT().SetDashPattern([]float64{1, 0}, 0.0)
+
+		sut := cellwriter.NewBorderLineStyler(fpdf)
+		sut.SetNext(inner)
+
+		// Act
+		sut.Apply(width, height, cfg, prop)
+
+		// Assert
+		inner.AssertNumberOfCalls(t, "Apply", 1)
+		fpdf.AssertCalled(t, "SetDashPattern", []float64{2, 1, 4, 1}, 0.0)
+		fpdf.AssertNumberOfCalls(t, "SetDashPattern", 2)
+	})
+	t.Run("When has prop and invalid dash pattern, should apply line style and call next", func(t *testing.T) {
+		// Arrange
+		width := 100.0
+		height := 100.0
+		cfg := &entity.Config{}
+		prop := &props.Cell{
+			LineStyle:   linestyle.Dashed,
+			DashPattern: []float64{2, -1},
+		}
+
+		inner := &mocks.CellWriter{}
+		inner.EXPECT().Apply(width, height, cfg, prop)
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetDashPattern([]float64{1, 1}, 0.0)
+		fpdf.EXPECT().SetDashPattern([]float64{1, 0}, 0.0)
+
+		sut := cellwriter.NewBorderLineStyler(fpdf)
+		sut.SetNext(inner)
+
+		// Act
+		sut.Apply(width, height, cfg, prop)
+
+		// Assert
+		inner.AssertNumberOfCalls(t, "Apply", 1)
+		fpdf.AssertNotCalled(t, "SetDashPattern", []float64{2, -1}, 0.0)
+		fpdf.AssertNumberOfCalls(t, "SetDashPattern", 2)
+	})
 }
@@ -28,12 +28,11 @@ func (f *fillColorStyler) Apply(width, height float64, config *entity.Config, pr
 		return
 	}
 
-	if prop.BackgroundColor == nil {
+	if !gofpdfwrapper.ApplyFillColor(f.fpdf, prop.BackgroundColor) {
 		f.GoToNext(width, height, config, prop)
 		return
 	}
 
-	f.fpdf.SetFillColor(prop.BackgroundColor.Red, prop.BackgroundColor.Green, prop.BackgroundColor.Blue)
 	f.GoToNext(width, height, config, prop)
 	f.fpdf.SetFillColor(f.defaultFillColor.Red, f.defaultFillColor.Green, f.defaultFillColor.Blue)
 }
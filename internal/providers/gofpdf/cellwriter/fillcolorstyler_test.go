@@ -81,7 +81,7 @@ func TestFillColorStyle_Apply(t *testing.T) {
 		inner.EXPECT().Apply(width, height, cfg, prop)
 
 		fpdf := &mocks.Fpdf{}
-		fpdf.EXPECT().SetFillColor(prop.BackgroundColor.Red, prop.BackgroundColor.Green, prop.BackgroundColor.Blue)
+		fpdf.EXPECT().SetFillColor(prop.BackgroundColor.ToColor().Red, prop.BackgroundColor.ToColor().Green, prop.BackgroundColor.ToColor().Blue)
 		fpdf.EXPECT().SetFillColor(255, 255, 255)
 
 		sut := cellwriter.NewFillColorStyler(fpdf)
@@ -94,4 +94,31 @@ func TestFillColorStyle_Apply(t *testing.T) {
 		inner.AssertNumberOfCalls(t, "Apply", 1)
 		fpdf.AssertNumberOfCalls(t, "SetFillColor", 2)
 	})
+	t.Run("When has prop and color is cmyk, should apply cmyk and call next", func(t *testing.T) {
+		// Arrange
+		width := 100.0
+		height := 100.0
+		cfg := &entity.Config{}
+		prop := &props.Cell{
+			BackgroundColor: &props.CMYKColor{Cyan: 20, Magenta: 40, Yellow: 60, Key: 80},
+		}
+
+		inner := &mocks.CellWriter{}
+		inner.EXPECT().Apply(width, height, cfg, prop)
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetFillCMYKColor(20.0, 40.0, 60.0, 80.0)
+		fpdf.EXPECT().SetFillColor(255, 255, 255)
+
+		sut := cellwriter.NewFillColorStyler(fpdf)
+		sut.SetNext(inner)
+
+		// Act
+		sut.Apply(width, height, cfg, prop)
+
+		// Assert
+		inner.AssertNumberOfCalls(t, "Apply", 1)
+		fpdf.AssertNumberOfCalls(t, "SetFillCMYKColor", 1)
+		fpdf.AssertNumberOfCalls(t, "SetFillColor", 1)
+	})
 }
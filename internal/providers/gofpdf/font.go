@@ -1,14 +1,19 @@
 package gofpdf
 
 import (
+	"strings"
+
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
 const (
 	gofpdfFontScale1 = 72.0
 	gofpdfFontScale2 = 25.4
+	// undefinedFontError is the beginning of the error set by gofpdf when a font is not registered.
+	undefinedFontError = "undefined font"
 )
 
 type font struct {
@@ -17,7 +22,11 @@ type font struct {
 	family      string
 	style       fontstyle.Type
 	scaleFactor float64
-	fontColor   *props.Color
+	fontColor   props.AnyColor
+	// lazyFonts are the custom fonts not registered yet, by family, registered on the first use.
+	lazyFonts map[string][]*entity.CustomFont
+	// fallbackFamily is the family used when a family is not registered, empty to not fall back.
+	fallbackFamily string
 }
 
 // NewFont create a Font.
@@ -63,8 +72,10 @@ func (s *font) GetHeight(family string, style fontstyle.Type, size float64) floa
 // SetFamily defines a new Font family.
 func (s *font) SetFamily(family string) {
 	s.family = family
+	s.loadFamily(family)
 
 	s.pdf.SetFont(s.family, string(s.style), s.size)
+	s.fallBack()
 }
 
 // SetStyle defines a new Font style.
@@ -72,6 +83,7 @@ func (s *font) SetStyle(style fontstyle.Type) {
 	s.style = style
 
 	s.pdf.SetFontStyle(string(s.style))
+	s.fallBack()
 }
 
 // SetSize defines a new Font size.
@@ -83,21 +95,65 @@ func (s *font) SetSize(size float64) {
 // SetFont defines all new Font properties.
 func (s *font) SetFont(family string, style fontstyle.Type, size float64) {
 	s.family = family
+	s.loadFamily(family)
 	s.style = style
 	s.size = size
 
 	s.pdf.SetFont(s.family, string(s.style), s.size)
+	s.fallBack()
+}
+
+func (s *font) SetColor(color props.AnyColor) {
+	if gofpdfwrapper.ApplyTextColor(s.pdf, color) {
+		s.fontColor = color
+	}
 }
 
-func (s *font) SetColor(color *props.Color) {
-	if color == nil {
+func (s *font) GetColor() props.AnyColor {
+	return s.fontColor
+}
+
+// setLazyFonts defines custom fonts which are only registered on the first use of their family.
+func (s *font) setLazyFonts(fonts []*entity.CustomFont) {
+	s.lazyFonts = make(map[string][]*entity.CustomFont)
+	for _, customFont := range fonts {
+		family := strings.ToLower(customFont.Family)
+		s.lazyFonts[family] = append(s.lazyFonts[family], customFont)
+	}
+}
+
+// loadFamily registers the lazy fonts of the family, once.
+func (s *font) loadFamily(family string) {
+	fonts, ok := s.lazyFonts[strings.ToLower(family)]
+	if !ok {
 		return
 	}
 
-	s.fontColor = color
-	s.pdf.SetTextColor(color.Red, color.Green, color.Blue)
+	for _, customFont := range fonts {
+		s.pdf.AddUTF8FontFromBytes(customFont.Family, string(customFont.Style), customFont.Bytes)
+	}
+
+	delete(s.lazyFonts, strings.ToLower(family))
 }
 
-func (s *font) GetColor() *props.Color {
-	return s.fontColor
+// setFallbackFamily defines the family used when a family is not registered.
+func (s *font) setFallbackFamily(family string) {
+	s.fallbackFamily = family
+}
+
+// fallBack sets the fallbackFamily when the font set on the pdf is not registered, gofpdf keeps the
+// undefined font error and fails every following call otherwise.
+func (s *font) fallBack() {
+	if s.fallbackFamily == "" || !s.pdf.Err() {
+		return
+	}
+
+	if !strings.Contains(s.pdf.Error().Error(), undefinedFontError) || strings.EqualFold(s.family, s.fallbackFamily) {
+		return
+	}
+
+	s.pdf.ClearError()
+	s.family = s.fallbackFamily
+	s.loadFamily(s.family)
+	s.pdf.SetFont(s.family, string(s.style), s.size)
 }
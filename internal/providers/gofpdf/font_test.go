@@ -141,4 +141,40 @@ func TestFont_SetColor(t *testing.T) {
 		// Assert
 		assert.Equal(t, color, font.GetColor())
 	})
+	t.Run("when color is cmyk, should apply cmyk color", func(t *testing.T) {
+		// Arrange
+		size := 10.0
+		family := fontfamily.Arial
+		style := fontstyle.Bold
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetFont(family, string(style), size)
+		fpdf.EXPECT().SetTextCMYKColor(100.0, 0.0, 50.0, 10.0)
+		font := gofpdf.NewFont(fpdf, size, family, style)
+		color := &props.CMYKColor{Cyan: 100, Yellow: 50, Key: 10}
+
+		// Act
+		font.SetColor(color)
+
+		// Assert
+		assert.Equal(t, color, font.GetColor())
+		fpdf.AssertNotCalled(t, "SetTextColor")
+	})
+	t.Run("when cmyk color is invalid, should not apply color", func(t *testing.T) {
+		// Arrange
+		size := 10.0
+		family := fontfamily.Arial
+		style := fontstyle.Bold
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetFont(family, string(style), size)
+		font := gofpdf.NewFont(fpdf, size, family, style)
+
+		// Act
+		font.SetColor(&props.CMYKColor{Cyan: 101})
+
+		// Assert
+		assert.Equal(t, &props.Color{Red: 0, Green: 0, Blue: 0}, font.GetColor())
+		fpdf.AssertNotCalled(t, "SetTextCMYKColor")
+	})
 }
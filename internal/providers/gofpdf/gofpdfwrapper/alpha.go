@@ -0,0 +1,47 @@
+package gofpdfwrapper
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// SetAlphas sets the opacity of fills and texts and the opacity of strokes, from 0 (transparent) to 1 (opaque),
+// with the blend mode of everything drawn after it. gofpdf SetAlpha writes a single alpha in the /ca and /CA
+// entries of the /ExtGState, so when the opacities differ the state is added to the gofpdf blend list here.
+func (f *fpdf) SetAlphas(fillAlpha, strokeAlpha float64, blendModeStr string) {
+	f.Fpdf.SetAlpha(fillAlpha, blendModeStr)
+	if f.Fpdf.Err() || fillAlpha == strokeAlpha {
+		return
+	}
+
+	if strokeAlpha < 0 || strokeAlpha > 1 {
+		f.Fpdf.SetErrorf("alpha value (0.0 - 1.0) is out of range: %.3f", strokeAlpha)
+		return
+	}
+
+	fields := reflect.ValueOf(f.Fpdf).Elem()
+	blendList := settable(fields.FieldByName("blendList"))
+	blendMap := settable(fields.FieldByName("blendMap"))
+
+	// gofpdf keys its states by alpha and blend mode, the key with both alphas never matches one of them.
+	key := reflect.ValueOf(fmt.Sprintf("%.3f %.3f %s", fillAlpha, strokeAlpha, blendModeStr))
+	pos := blendMap.MapIndex(key)
+	if !pos.IsValid() {
+		state := reflect.New(blendList.Type().Elem()).Elem()
+		settable(state.FieldByName("strokeStr")).SetString(fmt.Sprintf("%.3f", strokeAlpha))
+		settable(state.FieldByName("fillStr")).SetString(fmt.Sprintf("%.3f", fillAlpha))
+		settable(state.FieldByName("modeStr")).SetString(blendModeStr)
+
+		pos = reflect.ValueOf(blendList.Len())
+		blendList.Set(reflect.Append(blendList, state))
+		blendMap.SetMapIndex(key, pos)
+	}
+
+	f.Fpdf.RawWriteStr(fmt.Sprintf("/GS%d gs", pos.Int()))
+}
+
+// settable returns the unexported field of gofpdf as a value which can be set.
+func settable(field reflect.Value) reflect.Value {
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
@@ -0,0 +1,78 @@
+package gofpdfwrapper
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// fpdf extends gofpdf with DeviceCMYK colors, which gofpdf doesn't support. The colors are written with the
+// k and K operators directly in the page, gofpdf keeps its last RGB colors and writes them again when they
+// are set.
+type fpdf struct {
+	*gofpdf.Fpdf
+	// textCMYK is the operator of the current text color, empty when it's RGB.
+	textCMYK string
+	// fillCMYK is the operator of the current fill color, empty when it's RGB.
+	fillCMYK string
+}
+
+// SetDrawCMYKColor defines the color used for all drawing operations in CMYK components (0 - 100).
+func (f *fpdf) SetDrawCMYKColor(c, m, y, k float64) {
+	f.Fpdf.RawWriteStr(cmykOperator(c, m, y, k, "K"))
+}
+
+// SetFillCMYKColor defines the color used for all filling operations in CMYK components (0 - 100).
+func (f *fpdf) SetFillCMYKColor(c, m, y, k float64) {
+	f.fillCMYK = cmykOperator(c, m, y, k, "k")
+	f.Fpdf.RawWriteStr(f.fillCMYK)
+}
+
+// SetFillColor defines the color used for all filling operations in RGB components (0 - 255).
+func (f *fpdf) SetFillColor(r, g, b int) {
+	f.fillCMYK = ""
+	f.Fpdf.SetFillColor(r, g, b)
+}
+
+// SetTextCMYKColor defines the color used for text in CMYK components (0 - 100).
+func (f *fpdf) SetTextCMYKColor(c, m, y, k float64) {
+	f.textCMYK = cmykOperator(c, m, y, k, "k")
+}
+
+// SetTextColor defines the color used for text in RGB components (0 - 255).
+func (f *fpdf) SetTextColor(r, g, b int) {
+	f.textCMYK = ""
+	f.Fpdf.SetTextColor(r, g, b)
+}
+
+// Text prints a character string, with the CMYK text color when it's defined.
+func (f *fpdf) Text(x, y float64, txtStr string) {
+	if f.textCMYK == "" && f.fillCMYK == "" {
+		f.Fpdf.Text(x, y, txtStr)
+		return
+	}
+
+	// gofpdf only writes the text color when it differs from its fill color, which is not the one
+	// used when the fill color is CMYK, so the text color is written here and gofpdf writes none.
+	r, g, b := f.Fpdf.GetTextColor()
+	color := f.textCMYK
+	if color == "" {
+		color = fmt.Sprintf("%.3f %.3f %.3f rg", float64(r)/255, float64(g)/255, float64(b)/255)
+	}
+
+	f.Fpdf.SetTextColor(f.Fpdf.GetFillColor())
+	f.Fpdf.RawWriteStr("q " + color)
+	f.Fpdf.Text(x, y, txtStr)
+	f.Fpdf.RawWriteStr("Q")
+	f.Fpdf.SetTextColor(r, g, b)
+}
+
+// cmykOperator returns the operator which sets the color, with percentages out of 0 to 100 bounded to it.
+func cmykOperator(c, m, y, k float64, operator string) string {
+	return fmt.Sprintf("%.3f %.3f %.3f %.3f %s", toUnit(c), toUnit(m), toUnit(y), toUnit(k), operator)
+}
+
+func toUnit(percent float64) float64 {
+	return math.Max(0, math.Min(100, percent)) / 100
+}
@@ -0,0 +1,72 @@
+package gofpdfwrapper
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ApplyDrawColor sets the draw color, in CMYK when it's a props.CMYKColor, otherwise in RGB. It returns
+// false, without changing the color, when the color is nil or an invalid CMYKColor.
+func ApplyDrawColor(pdf Fpdf, color props.AnyColor) bool {
+	if cmyk, ok := color.(*props.CMYKColor); ok {
+		if !isValidCMYK(cmyk) {
+			return false
+		}
+
+		pdf.SetDrawCMYKColor(cmyk.Cyan, cmyk.Magenta, cmyk.Yellow, cmyk.Key)
+		return true
+	}
+
+	if props.IsNilColor(color) {
+		return false
+	}
+
+	rgb := color.ToColor()
+	pdf.SetDrawColor(rgb.Red, rgb.Green, rgb.Blue)
+	return true
+}
+
+// ApplyFillColor sets the fill color, in CMYK when it's a props.CMYKColor, otherwise in RGB. It returns
+// false, without changing the color, when the color is nil or an invalid CMYKColor.
+func ApplyFillColor(pdf Fpdf, color props.AnyColor) bool {
+	if cmyk, ok := color.(*props.CMYKColor); ok {
+		if !isValidCMYK(cmyk) {
+			return false
+		}
+
+		pdf.SetFillCMYKColor(cmyk.Cyan, cmyk.Magenta, cmyk.Yellow, cmyk.Key)
+		return true
+	}
+
+	if props.IsNilColor(color) {
+		return false
+	}
+
+	rgb := color.ToColor()
+	pdf.SetFillColor(rgb.Red, rgb.Green, rgb.Blue)
+	return true
+}
+
+// ApplyTextColor sets the text color, in CMYK when it's a props.CMYKColor, otherwise in RGB. It returns
+// false, without changing the color, when the color is nil or an invalid CMYKColor.
+func ApplyTextColor(pdf Fpdf, color props.AnyColor) bool {
+	if cmyk, ok := color.(*props.CMYKColor); ok {
+		if !isValidCMYK(cmyk) {
+			return false
+		}
+
+		pdf.SetTextCMYKColor(cmyk.Cyan, cmyk.Magenta, cmyk.Yellow, cmyk.Key)
+		return true
+	}
+
+	if props.IsNilColor(color) {
+		return false
+	}
+
+	rgb := color.ToColor()
+	pdf.SetTextColor(rgb.Red, rgb.Green, rgb.Blue)
+	return true
+}
+
+func isValidCMYK(cmyk *props.CMYKColor) bool {
+	return cmyk != nil && cmyk.Validate() == nil
+}
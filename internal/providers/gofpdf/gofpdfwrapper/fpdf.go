@@ -106,6 +106,7 @@ type Fpdf interface {
 	RegisterImageReader(imgName, tp string, r io.Reader) (info *gofpdf.ImageInfoType)
 	SetAcceptPageBreakFunc(fnc func() bool)
 	SetAlpha(alpha float64, blendModeStr string)
+	SetAlphas(fillAlpha, strokeAlpha float64, blendModeStr string)
 	SetAuthor(authorStr string, isUTF8 bool)
 	SetAutoPageBreak(auto bool, margin float64)
 	SetCatalogSort(flag bool)
@@ -115,10 +116,12 @@ type Fpdf interface {
 	SetCreator(creatorStr string, isUTF8 bool)
 	SetDashPattern(dashArray []float64, dashPhase float64)
 	SetDisplayMode(zoomStr, layoutStr string)
+	SetDrawCMYKColor(c, m, y, k float64)
 	SetDrawColor(r, g, b int)
 	SetDrawSpotColor(nameStr string, tint byte)
 	SetError(err error)
 	SetErrorf(fmtStr string, args ...interface{})
+	SetFillCMYKColor(c, m, y, k float64)
 	SetFillColor(r, g, b int)
 	SetFillSpotColor(nameStr string, tint byte)
 	SetFont(familyStr, styleStr string, size float64)
@@ -140,12 +143,14 @@ type Fpdf interface {
 	SetLineWidth(width float64)
 	SetLink(link int, y float64, page int)
 	SetMargins(left, top, right float64)
+	SetModificationDate(tm time.Time)
 	SetPageBoxRec(t string, pb gofpdf.PageBox)
 	SetPageBox(t string, x, y, wd, ht float64)
 	SetPage(pageNum int)
 	SetProtection(actionFlag byte, userPassStr, ownerPassStr string)
 	SetRightMargin(margin float64)
 	SetSubject(subjectStr string, isUTF8 bool)
+	SetTextCMYKColor(c, m, y, k float64)
 	SetTextColor(r, g, b int)
 	SetTextSpotColor(nameStr string, tint byte)
 	SetTitle(titleStr string, isUTF8 bool)
@@ -189,5 +194,5 @@ type Fpdf interface {
 }
 
 func NewCustom(init *gofpdf.InitType) Fpdf {
-	return gofpdf.NewCustom(init)
+	return &fpdf{Fpdf: gofpdf.NewCustom(init)}
 }
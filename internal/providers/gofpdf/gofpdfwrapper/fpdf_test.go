@@ -1,7 +1,9 @@
 package gofpdfwrapper_test
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
@@ -16,3 +18,111 @@ func TestNewCustom(t *testing.T) {
 	// Assert
 	assert.NotNil(t, "", fmt.Sprintf("%T", sut))
 }
+
+func TestFpdf_CMYKColors(t *testing.T) {
+	t.Run("when cmyk colors are set, should write them as device cmyk", func(t *testing.T) {
+		// Arrange
+		sut := gofpdfwrapper.NewCustom(&gofpdf.InitType{})
+		sut.SetCompression(false)
+		sut.AddPage()
+		sut.SetFont("Arial", "", 10)
+
+		// Act
+		sut.SetTextCMYKColor(100, 0, 0, 0)
+		sut.Text(10, 10, "cmyk")
+		sut.SetFillCMYKColor(0, 0, 50, 0)
+		sut.SetDrawCMYKColor(0, 100, 0, 25)
+		sut.Rect(10, 20, 10, 10, "DF")
+
+		// Assert
+		var buf bytes.Buffer
+		assert.Nil(t, sut.Output(&buf))
+		pdf := buf.String()
+		assert.Contains(t, pdf, "q 1.000 0.000 0.000 0.000 k\nBT")
+		assert.Contains(t, pdf, "0.000 0.000 0.500 0.000 k\n0.000 1.000 0.000 0.250 K\n")
+		assert.NotContains(t, pdf, "/Separation")
+	})
+	t.Run("when fill color is cmyk, should write the text with its rgb color", func(t *testing.T) {
+		// Arrange
+		sut := gofpdfwrapper.NewCustom(&gofpdf.InitType{})
+		sut.SetCompression(false)
+		sut.AddPage()
+		sut.SetFont("Arial", "", 10)
+		sut.SetTextColor(255, 0, 0)
+
+		// Act
+		sut.SetFillCMYKColor(0, 0, 100, 0)
+		sut.Text(10, 10, "rgb")
+
+		// Assert
+		var buf bytes.Buffer
+		assert.Nil(t, sut.Output(&buf))
+		assert.Contains(t, buf.String(), "q 1.000 0.000 0.000 rg\nBT")
+	})
+	t.Run("when text color is rgb again, should write the text without cmyk", func(t *testing.T) {
+		// Arrange
+		sut := gofpdfwrapper.NewCustom(&gofpdf.InitType{})
+		sut.SetCompression(false)
+		sut.AddPage()
+		sut.SetFont("Arial", "", 10)
+		sut.SetTextCMYKColor(100, 0, 0, 0)
+
+		// Act
+		sut.SetTextColor(0, 0, 255)
+		sut.Text(10, 10, "rgb")
+
+		// Assert
+		var buf bytes.Buffer
+		assert.Nil(t, sut.Output(&buf))
+		pdf := buf.String()
+		assert.Contains(t, pdf, "q 0.000 0.000 1.000 rg BT")
+		assert.NotContains(t, pdf, " k\n")
+	})
+}
+
+func TestFpdf_SetAlphas(t *testing.T) {
+	t.Run("when alphas differ, should write them in the ca and CA entries", func(t *testing.T) {
+		// Arrange
+		sut := gofpdfwrapper.NewCustom(&gofpdf.InitType{})
+		sut.SetCompression(false)
+		sut.AddPage()
+
+		// Act
+		sut.SetAlphas(0.5, 0.8, "Multiply")
+		sut.SetAlphas(0.5, 0.8, "Multiply")
+
+		// Assert
+		var buf bytes.Buffer
+		assert.Nil(t, sut.Output(&buf))
+		pdf := buf.String()
+		assert.Contains(t, pdf, "<</Type /ExtGState /ca 0.500 /CA 0.800 /BM /Multiply>>")
+		assert.Equal(t, 2, strings.Count(pdf, "/GS2 gs"))
+	})
+	t.Run("when alphas are the same, should write a single alpha", func(t *testing.T) {
+		// Arrange
+		sut := gofpdfwrapper.NewCustom(&gofpdf.InitType{})
+		sut.SetCompression(false)
+		sut.AddPage()
+
+		// Act
+		sut.SetAlphas(0.5, 0.5, "Screen")
+
+		// Assert
+		var buf bytes.Buffer
+		assert.Nil(t, sut.Output(&buf))
+		pdf := buf.String()
+		assert.Contains(t, pdf, "<</Type /ExtGState /ca 0.500 /CA 0.500 /BM /Screen>>")
+		assert.Equal(t, 1, strings.Count(pdf, "/Type /ExtGState"))
+	})
+	t.Run("when stroke alpha is out of range, should set an error", func(t *testing.T) {
+		// Arrange
+		sut := gofpdfwrapper.NewCustom(&gofpdf.InitType{})
+		sut.AddPage()
+
+		// Act
+		sut.SetAlphas(0.5, 2, "Normal")
+
+		// Assert
+		assert.True(t, sut.Err())
+	})
+}
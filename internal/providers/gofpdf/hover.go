@@ -0,0 +1,145 @@
+package gofpdf
+
+import (
+	"fmt"
+
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// pointsPerMM converts the millimeters used by maroto to the points used by pdf annotations.
+const pointsPerMM = 72.0 / 25.4
+
+// pushButtonFlag is the field flag which defines a button field as a push button.
+const pushButtonFlag = 1 << 16
+
+// hoverArea is a region of a page which background changes when the mouse is over it.
+type hoverArea struct {
+	id    string
+	page  int
+	rect  [4]float64
+	color *props.Color
+}
+
+// addHoverAreas writes each hover area as a push button widget with a JavaScript action pair,
+// mouseEnter applies the hover color and mouseLeave makes the field transparent again.
+func addHoverAreas(ctx *model.Context, areas []hoverArea) error {
+	fields, err := getFormFields(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, area := range areas {
+		err = addWidget(ctx, fields, area.page, func(pageRef types.IndirectRef) (types.Dict, error) {
+			return newHoverWidget(area, pageRef)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addWidget creates the widget with the reference of the page and adds it to the annotations of the page and to the form fields.
+func addWidget(ctx *model.Context, fields types.Dict, page int, newWidget func(pageRef types.IndirectRef) (types.Dict, error)) error {
+	pageDict, pageRef, _, err := ctx.PageDict(page, false)
+	if err != nil {
+		return err
+	}
+
+	widget, err := newWidget(*pageRef)
+	if err != nil {
+		return err
+	}
+
+	widgetRef, err := ctx.IndRefForNewObject(widget)
+	if err != nil {
+		return err
+	}
+
+	annots, err := ctx.DereferenceArray(pageDict["Annots"])
+	if err != nil {
+		return err
+	}
+
+	pageDict["Annots"] = append(annots, *widgetRef)
+	fields["Fields"] = append(fields["Fields"].(types.Array), *widgetRef)
+
+	return nil
+}
+
+func getFormFields(ctx *model.Context) (types.Dict, error) {
+	root, err := ctx.Catalog()
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := ctx.DereferenceDict(root["AcroForm"])
+	if err != nil {
+		return nil, err
+	}
+
+	if form == nil {
+		form = types.Dict{}
+		root["AcroForm"] = form
+	}
+
+	fields, err := ctx.DereferenceArray(form["Fields"])
+	if err != nil {
+		return nil, err
+	}
+
+	form["Fields"] = fields
+	form["NeedAppearances"] = types.Boolean(true)
+
+	return form, nil
+}
+
+func newHoverWidget(area hoverArea, pageRef types.IndirectRef) (types.Dict, error) {
+	id, err := types.Escape(area.id)
+	if err != nil {
+		return nil, err
+	}
+
+	enter, err := newJavaScriptAction(fmt.Sprintf("this.getField(%q).fillColor = [\"RGB\", %.3f, %.3f, %.3f];",
+		area.id, float64(area.color.Red)/255.0, float64(area.color.Green)/255.0, float64(area.color.Blue)/255.0))
+	if err != nil {
+		return nil, err
+	}
+
+	leave, err := newJavaScriptAction(fmt.Sprintf("this.getField(%q).fillColor = color.transparent;", area.id))
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Dict{
+		"Type":    types.Name("Annot"),
+		"Subtype": types.Name("Widget"),
+		"FT":      types.Name("Btn"),
+		"Ff":      types.Integer(pushButtonFlag),
+		"T":       types.StringLiteral(*id),
+		"F":       types.Integer(model.AnnPrint),
+		"P":       pageRef,
+		"Rect":    types.NewNumberArray(area.rect[0], area.rect[1], area.rect[2], area.rect[3]),
+		"MK":      types.Dict{},
+		"AA": types.Dict{
+			"E": enter,
+			"X": leave,
+		},
+	}, nil
+}
+
+func newJavaScriptAction(script string) (types.Dict, error) {
+	js, err := types.Escape(script)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Dict{
+		"S":  types.Name("JavaScript"),
+		"JS": types.StringLiteral(*js),
+	}, nil
+}
@@ -3,6 +3,7 @@ package gofpdf
 import (
 	"bytes"
 	"errors"
+	"math"
 
 	"github.com/google/uuid"
 	"github.com/jung-kurt/gofpdf"
@@ -31,6 +32,46 @@ func NewImage(pdf gofpdfwrapper.Fpdf, math core.Math) *image {
 func (s *image) Add(img *entity.Image, cell *entity.Cell, margins *entity.Margins,
 	prop *props.Rect, extension extension.Type, flow bool,
 ) error {
+	imageID, info, err := s.register(img, extension)
+	if err != nil {
+		return err
+	}
+
+	s.addImageToPdf(imageID, info, cell, margins, prop, flow)
+	return nil
+}
+
+// AddTiled repeats the image, scaled by the prop percent, to fill the cell. The first tile is placed on the
+// top left corner of the cell shifted by the tile offsets, and the tiles are clipped to the cell.
+func (s *image) AddTiled(img *entity.Image, cell *entity.Cell, margins *entity.Margins,
+	prop *props.Rect, extension extension.Type,
+) error {
+	imageID, info, err := s.register(img, extension)
+	if err != nil {
+		return err
+	}
+
+	tileWidth := info.Width() * prop.Percent / 100.0
+	tileHeight := info.Height() * prop.Percent / 100.0
+	if !isValidTileSize(tileWidth) || !isValidTileSize(tileHeight) {
+		return errors.New("could not tile image without dimensions")
+	}
+
+	x := cell.X + margins.Left
+	y := cell.Y + margins.Top
+
+	s.pdf.ClipRect(x, y, cell.Width, cell.Height, false)
+	for tileY := y + getTileStart(prop.TileOffsetY, tileHeight); tileY < y+cell.Height; tileY += tileHeight {
+		for tileX := x + getTileStart(prop.TileOffsetX, tileWidth); tileX < x+cell.Width; tileX += tileWidth {
+			s.pdf.Image(imageID, tileX, tileY, tileWidth, tileHeight, false, "", 0, "")
+		}
+	}
+	s.pdf.ClipEnd()
+
+	return nil
+}
+
+func (s *image) register(img *entity.Image, extension extension.Type) (string, *gofpdf.ImageInfoType, error) {
 	imageID, _ := uuid.NewRandom()
 
 	info := s.pdf.RegisterImageOptionsReader(
@@ -43,11 +84,25 @@ func (s *image) Add(img *entity.Image, cell *entity.Cell, margins *entity.Margin
 	)
 
 	if info == nil {
-		return errors.New("could not register image options, maybe path/name is wrong")
+		return "", nil, errors.New("could not register image options, maybe path/name is wrong")
 	}
 
-	s.addImageToPdf(imageID.String(), info, cell, margins, prop, flow)
-	return nil
+	return imageID.String(), info, nil
+}
+
+// isValidTileSize checks that the size is positive and not NaN or +Inf, which images without dimensions return.
+func isValidTileSize(size float64) bool {
+	return size > 0 && !math.IsInf(size, 1)
+}
+
+// getTileStart returns where the first tile starts, relative to the cell, so the offset tiles still cover the cell.
+func getTileStart(offset, size float64) float64 {
+	start := math.Mod(offset, size)
+	if start > 0 {
+		start -= size
+	}
+
+	return start
 }
 
 func (s *image) addImageToPdf(imageLabel string, info *gofpdf.ImageInfoType, cell *entity.Cell, margins *entity.Margins,
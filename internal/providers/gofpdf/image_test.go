@@ -3,12 +3,16 @@ package gofpdf_test
 import (
 	"bytes"
 	"fmt"
+	goimage "image"
+	"image/png"
 	"testing"
 
 	gofpdf2 "github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/internal/math"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/jung-kurt/gofpdf"
 	"github.com/stretchr/testify/mock"
 
@@ -98,3 +102,108 @@ func TestImage_Add(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestImage_AddTiled(t *testing.T) {
+	t.Run("when RegisterImageOptionsReader return nil, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := fixture.RectProp()
+		img := fixture.ImageEntity()
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		image := gofpdf2.NewImage(pdf, &mocks.Math{})
+
+		// Act
+		err := image.AddTiled(&img, &cell, &margins, &rect, img.Extension)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when image has no dimensions, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := fixture.RectProp()
+		img := fixture.ImageEntity()
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, mock.Anything, mock.Anything).Return(&gofpdf.ImageInfoType{})
+
+		image := gofpdf2.NewImage(pdf, &mocks.Math{})
+
+		// Act
+		err := image.AddTiled(&img, &cell, &margins, &rect, img.Extension)
+
+		// Assert
+		assert.NotNil(t, err)
+		pdf.AssertNotCalled(t, "ClipRect", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("when image is registered, should fill the cell with clipped tiles", func(t *testing.T) {
+		// Arrange
+		info := newImageInfo(t, 10, 10)
+		tileSize := info.Width() / 2
+		cell := entity.Cell{X: 0, Y: 0, Width: tileSize * 2.5, Height: tileSize}
+		margins := entity.Margins{Left: 10, Top: 20}
+		rect := props.Rect{Percent: 50}
+		img := fixture.ImageEntity()
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, mock.Anything, mock.Anything).Return(info)
+		pdf.EXPECT().ClipRect(10.0, 20.0, cell.Width, cell.Height, false)
+		pdf.EXPECT().Image(mock.Anything, mock.Anything, 20.0, tileSize, tileSize, false, "", 0, "")
+		pdf.EXPECT().ClipEnd()
+
+		image := gofpdf2.NewImage(pdf, &mocks.Math{})
+
+		// Act
+		err := image.AddTiled(&img, &cell, &margins, &rect, img.Extension)
+
+		// Assert
+		assert.Nil(t, err)
+		pdf.AssertNumberOfCalls(t, "Image", 3)
+		pdf.AssertCalled(t, "Image", mock.Anything, 10.0, 20.0, tileSize, tileSize, false, "", 0, "")
+		pdf.AssertCalled(t, "Image", mock.Anything, 10.0+2*tileSize, 20.0, tileSize, tileSize, false, "", 0, "")
+		pdf.AssertNumberOfCalls(t, "ClipEnd", 1)
+	})
+	t.Run("when tiles have offset, should start before the cell to cover it", func(t *testing.T) {
+		// Arrange
+		info := newImageInfo(t, 10, 10)
+		tileSize := info.Width()
+		cell := entity.Cell{X: 0, Y: 0, Width: tileSize, Height: tileSize}
+		margins := entity.Margins{}
+		rect := props.Rect{Percent: 100, TileOffsetX: tileSize / 2}
+		img := fixture.ImageEntity()
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, mock.Anything, mock.Anything).Return(info)
+		pdf.EXPECT().ClipRect(mock.Anything, mock.Anything, mock.Anything, mock.Anything, false)
+		pdf.EXPECT().Image(mock.Anything, mock.Anything, 0.0, tileSize, tileSize, false, "", 0, "")
+		pdf.EXPECT().ClipEnd()
+
+		image := gofpdf2.NewImage(pdf, &mocks.Math{})
+
+		// Act
+		err := image.AddTiled(&img, &cell, &margins, &rect, img.Extension)
+
+		// Assert
+		assert.Nil(t, err)
+		pdf.AssertNumberOfCalls(t, "Image", 2)
+		pdf.AssertCalled(t, "Image", mock.Anything, -tileSize/2, 0.0, tileSize, tileSize, false, "", 0, "")
+		pdf.AssertCalled(t, "Image", mock.Anything, tileSize/2, 0.0, tileSize, tileSize, false, "", 0, "")
+	})
+}
+
+// newImageInfo registers a png with the dimensions in pixels to get its gofpdf.ImageInfoType.
+func newImageInfo(t *testing.T, width, height int) *gofpdf.ImageInfoType {
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, goimage.NewRGBA(goimage.Rect(0, 0, width, height))))
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	info := pdf.RegisterImageOptionsReader("tile", gofpdf.ImageOptions{ImageType: "png"}, &buf)
+	assert.NotNil(t, info)
+
+	return info
+}
@@ -1,6 +1,8 @@
 package gofpdf
 
 import (
+	"math"
+
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
 	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
@@ -8,9 +10,14 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
+// maxArcStep is the widest angle, in degrees, drawn by a single call of ArcTo, wider arcs
+// are split to keep the bezier approximation of gofpdf accurate in both directions.
+const maxArcStep = 90.0
+
 type line struct {
 	pdf              gofpdfwrapper.Fpdf
 	defaultColor     *props.Color
+	defaultFillColor *props.Color
 	defaultThickness float64
 }
 
@@ -18,6 +25,7 @@ func NewLine(pdf gofpdfwrapper.Fpdf) *line {
 	return &line{
 		pdf:              pdf,
 		defaultColor:     &props.BlackColor,
+		defaultFillColor: &props.WhiteColor,
 		defaultThickness: linestyle.DefaultLineThickness,
 	}
 }
@@ -30,35 +38,130 @@ func (l *line) Add(cell *entity.Cell, prop *props.Line) {
 	}
 }
 
-func (l *line) renderVertical(cell *entity.Cell, prop *props.Line) {
-	size := cell.Height * (prop.SizePercent / 100.0)
-	position := cell.Width * (prop.OffsetPercent / 100.0)
+func (l *line) AddBezier(p0, p1, p2, p3 entity.Point, prop *props.Line) {
+	left, top, _, _ := l.pdf.GetMargins()
 
-	space := (cell.Height - size) / 2.0
+	l.applyStyle(prop)
+	l.pdf.CurveBezierCubic(left+p0.X, top+p0.Y, left+p1.X, top+p1.Y, left+p2.X, top+p2.Y, left+p3.X, top+p3.Y, "D")
+	l.resetStyle(prop)
+}
 
+func (l *line) AddSegment(p0, p1 entity.Point, prop *props.Line) {
 	left, top, _, _ := l.pdf.GetMargins()
 
-	if prop.Color != nil {
-		l.pdf.SetDrawColor(prop.Color.Red, prop.Color.Green, prop.Color.Blue)
+	l.applyStyle(prop)
+	l.pdf.Line(left+p0.X, top+p0.Y, left+p1.X, top+p1.Y)
+	l.resetStyle(prop)
+}
+
+// AddPolygon draws a closed path through the points, it is filled when the props has a fill color.
+func (l *line) AddPolygon(points []entity.Point, prop *props.Line) {
+	minPoints := 3
+	if len(points) < minPoints {
+		return
 	}
-	l.pdf.SetLineWidth(prop.Thickness)
 
-	if prop.Style != linestyle.Solid {
-		l.pdf.SetDashPattern([]float64{1, 1}, 0)
+	left, top, _, _ := l.pdf.GetMargins()
+
+	l.applyStyle(prop)
+
+	style := "D"
+	if !props.IsNilColor(prop.FillColor) {
+		gofpdfwrapper.ApplyFillColor(l.pdf, prop.FillColor)
+		style = "DF"
 	}
 
-	l.pdf.Line(left+cell.X+position, top+cell.Y+space, left+cell.X+position, top+cell.Y+cell.Height-space)
+	l.pdf.MoveTo(left+points[0].X, top+points[0].Y)
+	for _, point := range points[1:] {
+		l.pdf.LineTo(left+point.X, top+point.Y)
+	}
+	l.pdf.ClosePath()
+	l.pdf.DrawPath(style)
 
-	if prop.Color != nil {
-		l.pdf.SetDrawColor(l.defaultColor.Red, l.defaultColor.Green, l.defaultColor.Blue)
+	if !props.IsNilColor(prop.FillColor) {
+		l.pdf.SetFillColor(l.defaultFillColor.Red, l.defaultFillColor.Green, l.defaultFillColor.Blue)
 	}
-	l.pdf.SetLineWidth(l.defaultThickness)
 
-	if prop.Style != linestyle.Solid {
-		l.pdf.SetDashPattern([]float64{1, 0}, 0)
+	l.resetStyle(prop)
+}
+
+// AddArc draws a circular sector between two angles, in degrees clockwise from the top of the circle.
+// When the inner radius is greater than zero the sector is a ring segment, like the slices of a donut chart.
+// It is filled when the props has a fill color.
+func (l *line) AddArc(center entity.Point, radius, innerRadius, startAngle, endAngle float64, prop *props.Line) {
+	if radius <= 0 || !isFinite(startAngle) || !isFinite(endAngle) || endAngle <= startAngle {
+		return
+	}
+
+	left, top, _, _ := l.pdf.GetMargins()
+	x, y := left+center.X, top+center.Y
+
+	l.applyStyle(prop)
+
+	style := "D"
+	if !props.IsNilColor(prop.FillColor) {
+		gofpdfwrapper.ApplyFillColor(l.pdf, prop.FillColor)
+		style = "DF"
+	}
+
+	// gofpdf angles are counter-clockwise starting at 3 o'clock.
+	from, to := 90-endAngle, 90-startAngle
+
+	if innerRadius > 0 {
+		radians := from * math.Pi / 180
+		l.pdf.MoveTo(x+radius*math.Cos(radians), y-radius*math.Sin(radians))
+		l.arcTo(x, y, radius, from, to)
+		l.arcTo(x, y, innerRadius, to, from)
+	} else {
+		l.pdf.MoveTo(x, y)
+		l.arcTo(x, y, radius, from, to)
+	}
+
+	l.pdf.ClosePath()
+	l.pdf.DrawPath(style)
+
+	if !props.IsNilColor(prop.FillColor) {
+		l.pdf.SetFillColor(l.defaultFillColor.Red, l.defaultFillColor.Green, l.defaultFillColor.Blue)
+	}
+
+	l.resetStyle(prop)
+}
+
+// arcTo draws the arc from and to the angles in steps of up to maxArcStep degrees.
+func (l *line) arcTo(x, y, radius, from, to float64) {
+	step := math.Copysign(maxArcStep, to-from)
+	steps := int(math.Ceil(math.Abs(to-from) / maxArcStep))
+
+	for i := 0; i < steps; i++ {
+		start := from + float64(i)*step
+		end := start + step
+		if i == steps-1 {
+			end = to
+		}
+
+		l.pdf.ArcTo(x, y, radius, radius, 0, start, end)
 	}
 }
 
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+func (l *line) renderVertical(cell *entity.Cell, prop *props.Line) {
+	size := cell.Height * (prop.SizePercent / 100.0)
+	position := cell.Width * (prop.OffsetPercent / 100.0)
+
+	space := (cell.Height - size) / 2.0
+
+	left, top, _, _ := l.pdf.GetMargins()
+
+	l.applyStyle(prop)
+
+	l.pdf.Line(left+cell.X+position, top+cell.Y+space, left+cell.X+position, top+cell.Y+cell.Height-space)
+
+	l.resetStyle(prop)
+}
+
 func (l *line) renderHorizontal(cell *entity.Cell, prop *props.Line) {
 	size := cell.Width * (prop.SizePercent / 100.0)
 	position := cell.Height * (prop.OffsetPercent / 100.0)
@@ -67,23 +170,35 @@ func (l *line) renderHorizontal(cell *entity.Cell, prop *props.Line) {
 
 	left, top, _, _ := l.pdf.GetMargins()
 
-	if prop.Color != nil {
-		l.pdf.SetDrawColor(prop.Color.Red, prop.Color.Green, prop.Color.Blue)
+	l.applyStyle(prop)
+
+	l.pdf.Line(left+cell.X+space, top+cell.Y+position, left+cell.X+cell.Width-space, top+cell.Y+position)
+
+	l.resetStyle(prop)
+}
+
+func (l *line) applyStyle(prop *props.Line) {
+	if !props.IsNilColor(prop.Color) {
+		gofpdfwrapper.ApplyDrawColor(l.pdf, prop.Color)
 	}
 	l.pdf.SetLineWidth(prop.Thickness)
 
-	if prop.Style != linestyle.Solid {
+	if props.IsValidDashPattern(prop.DashPattern) {
+		l.pdf.SetDashPattern(prop.DashPattern, 0)
+	} else if prop.Style == linestyle.Dotted {
+		l.pdf.SetDashPattern(linestyle.GetDotPattern(prop.Thickness), 0)
+	} else if prop.Style != linestyle.Solid {
 		l.pdf.SetDashPattern([]float64{1, 1}, 0)
 	}
+}
 
-	l.pdf.Line(left+cell.X+space, top+cell.Y+position, left+cell.X+cell.Width-space, top+cell.Y+position)
-
-	if prop.Color != nil {
+func (l *line) resetStyle(prop *props.Line) {
+	if !props.IsNilColor(prop.Color) {
 		l.pdf.SetDrawColor(l.defaultColor.Red, l.defaultColor.Green, l.defaultColor.Blue)
 	}
 	l.pdf.SetLineWidth(l.defaultThickness)
 
-	if prop.Style != linestyle.Solid {
+	if props.IsValidDashPattern(prop.DashPattern) || prop.Style != linestyle.Solid {
 		l.pdf.SetDashPattern([]float64{1, 0}, 0)
 	}
 }
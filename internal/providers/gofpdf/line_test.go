@@ -2,11 +2,18 @@ package gofpdf_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
+	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewLine(t *testing.T) {
@@ -17,3 +24,281 @@ func TestNewLine(t *testing.T) {
 	assert.NotNil(t, sut)
 	assert.Equal(t, "*gofpdf.line", fmt.Sprintf("%T", sut))
 }
+
+func TestLine_AddBezier(t *testing.T) {
+	t.Run("when style is dashed, should draw curve with dash pattern and restore defaults", func(t *testing.T) {
+		// Arrange
+		prop := fixture.LineProp()
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+		pdf.EXPECT().SetDrawColor(prop.Color.ToColor().Red, prop.Color.ToColor().Green, prop.Color.ToColor().Blue)
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetLineWidth(prop.Thickness)
+		pdf.EXPECT().SetLineWidth(linestyle.DefaultLineThickness)
+		pdf.EXPECT().SetDashPattern([]float64{1, 1}, 0.0)
+		pdf.EXPECT().SetDashPattern([]float64{1, 0}, 0.0)
+		pdf.EXPECT().CurveBezierCubic(10.0, 30.0, 15.0, 20.0, 20.0, 20.0, 25.0, 30.0, "D")
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddBezier(entity.Point{X: 0, Y: 10}, entity.Point{X: 5, Y: 0}, entity.Point{X: 10, Y: 0}, entity.Point{X: 15, Y: 10}, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "CurveBezierCubic", 1)
+		pdf.AssertNumberOfCalls(t, "SetDrawColor", 2)
+		pdf.AssertNumberOfCalls(t, "SetDashPattern", 2)
+	})
+	t.Run("when style is solid and color is nil, should only set thickness", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{Thickness: 0.5}
+		prop.MakeValid()
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+		pdf.EXPECT().SetLineWidth(0.5)
+		pdf.EXPECT().SetLineWidth(linestyle.DefaultLineThickness)
+		pdf.EXPECT().CurveBezierCubic(10.0, 20.0, 10.0, 20.0, 10.0, 20.0, 10.0, 20.0, "D")
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddBezier(entity.Point{}, entity.Point{}, entity.Point{}, entity.Point{}, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "CurveBezierCubic", 1)
+		pdf.AssertNumberOfCalls(t, "SetLineWidth", 2)
+	})
+	t.Run("when style is dotted, should draw curve with dot pattern", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{Style: linestyle.Dotted, Thickness: 0.4}
+		prop.MakeValid()
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+		pdf.EXPECT().SetLineWidth(0.4)
+		pdf.EXPECT().SetLineWidth(linestyle.DefaultLineThickness)
+		pdf.EXPECT().SetDashPattern([]float64{0.4, 0.8}, 0.0)
+		pdf.EXPECT().SetDashPattern([]float64{1, 0}, 0.0)
+		pdf.EXPECT().CurveBezierCubic(10.0, 20.0, 10.0, 20.0, 10.0, 20.0, 10.0, 20.0, "D")
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddBezier(entity.Point{}, entity.Point{}, entity.Point{}, entity.Point{}, &prop)
+
+		// Assert
+		pdf.AssertCalled(t, "SetDashPattern", []float64{0.4, 0.8}, 0.0)
+		pdf.AssertNumberOfCalls(t, "SetDashPattern", 2)
+	})
+}
+
+func TestLine_AddSegment(t *testing.T) {
+	// Arrange
+	prop := fixture.LineProp()
+
+	pdf := &mocks.Fpdf{}
+	pdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+	pdf.EXPECT().SetDrawColor(prop.Color.ToColor().Red, prop.Color.ToColor().Green, prop.Color.ToColor().Blue)
+	pdf.EXPECT().SetDrawColor(0, 0, 0)
+	pdf.EXPECT().SetLineWidth(prop.Thickness)
+	pdf.EXPECT().SetLineWidth(linestyle.DefaultLineThickness)
+	pdf.EXPECT().SetDashPattern([]float64{1, 1}, 0.0)
+	pdf.EXPECT().SetDashPattern([]float64{1, 0}, 0.0)
+	pdf.EXPECT().Line(10.0, 20.0, 25.0, 30.0)
+
+	sut := gofpdf.NewLine(pdf)
+
+	// Act
+	sut.AddSegment(entity.Point{X: 0, Y: 0}, entity.Point{X: 15, Y: 10}, &prop)
+
+	// Assert
+	pdf.AssertNumberOfCalls(t, "Line", 1)
+	pdf.AssertNumberOfCalls(t, "SetDrawColor", 2)
+}
+
+func TestLine_AddPolygon(t *testing.T) {
+	t.Run("when has less than 3 points, should not draw", func(t *testing.T) {
+		// Arrange
+		prop := fixture.LineProp()
+		pdf := mocks.NewFpdf(t)
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddPolygon([]entity.Point{{X: 0, Y: 0}, {X: 10, Y: 10}}, &prop)
+
+		// Assert
+		pdf.AssertNotCalled(t, "DrawPath", mock.Anything)
+	})
+	t.Run("when fill color is not defined, should draw only the outline", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{Thickness: 0.5}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+		pdf.EXPECT().SetLineWidth(0.5)
+		pdf.EXPECT().SetLineWidth(linestyle.DefaultLineThickness)
+		pdf.EXPECT().MoveTo(10.0, 20.0)
+		pdf.EXPECT().LineTo(20.0, 20.0)
+		pdf.EXPECT().LineTo(15.0, 30.0)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("D")
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddPolygon([]entity.Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 5, Y: 10}}, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "LineTo", 2)
+	})
+	t.Run("when fill color is defined, should fill and restore fill color", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{Thickness: 0.5, FillColor: &props.RedColor}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(0.0, 0.0, 0.0, 0.0)
+		pdf.EXPECT().SetLineWidth(mock.Anything)
+		pdf.EXPECT().SetFillColor(255, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().MoveTo(0.0, 0.0)
+		pdf.EXPECT().LineTo(mock.Anything, mock.Anything)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("DF")
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddPolygon([]entity.Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 5, Y: 10}}, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "SetFillColor", 2)
+	})
+}
+
+func TestLine_AddArc(t *testing.T) {
+	t.Run("when end angle is not after start angle, should not draw", func(t *testing.T) {
+		// Arrange
+		prop := fixture.LineProp()
+		pdf := mocks.NewFpdf(t)
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddArc(entity.Point{X: 10, Y: 10}, 5, 0, 90, 90, &prop)
+
+		// Assert
+		pdf.AssertNotCalled(t, "DrawPath", mock.Anything)
+	})
+	t.Run("when an angle is not finite, should not draw", func(t *testing.T) {
+		// Arrange
+		prop := fixture.LineProp()
+		pdf := mocks.NewFpdf(t)
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddArc(entity.Point{X: 10, Y: 10}, 5, 0, 0, math.NaN(), &prop)
+		sut.AddArc(entity.Point{X: 10, Y: 10}, 5, 0, 0, math.Inf(1), &prop)
+		sut.AddArc(entity.Point{X: 10, Y: 10}, 5, 0, math.Inf(-1), 90, &prop)
+
+		// Assert
+		pdf.AssertNotCalled(t, "DrawPath", mock.Anything)
+	})
+	t.Run("when inner radius is zero, should draw a sector split in steps of 90 degrees", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{Thickness: 0.5}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+		pdf.EXPECT().SetLineWidth(0.5)
+		pdf.EXPECT().SetLineWidth(linestyle.DefaultLineThickness)
+		pdf.EXPECT().MoveTo(10.0, 20.0)
+		pdf.EXPECT().ArcTo(10.0, 20.0, 5.0, 5.0, 0.0, -90.0, 0.0)
+		pdf.EXPECT().ArcTo(10.0, 20.0, 5.0, 5.0, 0.0, 0.0, 90.0)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("D")
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddArc(entity.Point{X: 0, Y: 0}, 5, 0, 0, 180, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "ArcTo", 2)
+	})
+	t.Run("when inner radius is defined, should draw a filled ring segment", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{Thickness: 0.5, FillColor: &props.RedColor}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(0.0, 0.0, 0.0, 0.0)
+		pdf.EXPECT().SetLineWidth(mock.Anything)
+		pdf.EXPECT().SetFillColor(255, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().MoveTo(15.0, 10.0)
+		pdf.EXPECT().ArcTo(10.0, 10.0, 5.0, 5.0, 0.0, 0.0, 90.0)
+		pdf.EXPECT().ArcTo(10.0, 10.0, 2.0, 2.0, 0.0, 90.0, 0.0)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("DF")
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.AddArc(entity.Point{X: 10, Y: 10}, 5, 2, 0, 90, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "SetFillColor", 2)
+	})
+}
+
+func TestLine_Add(t *testing.T) {
+	t.Run("when dash pattern is defined, should draw line with dash pattern", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 100, Height: 10}
+		prop := props.Line{DashPattern: []float64{2, 1, 4, 1}}
+		prop.MakeValid()
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().SetLineWidth(linestyle.DefaultLineThickness)
+		pdf.EXPECT().SetDashPattern([]float64{2, 1, 4, 1}, 0.0)
+		pdf.EXPECT().SetDashPattern([]float64{1, 0}, 0.0)
+		pdf.EXPECT().Line(15.0, 10.5, 105.0, 10.5)
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.Add(cell, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Line", 1)
+		pdf.AssertCalled(t, "SetDashPattern", []float64{2, 1, 4, 1}, 0.0)
+		pdf.AssertNumberOfCalls(t, "SetDashPattern", 2)
+	})
+	t.Run("when dash pattern has only zeros, should draw solid line", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 100, Height: 10}
+		prop := &props.Line{DashPattern: []float64{0, 0}, Style: linestyle.Solid, Thickness: 0.2, OffsetPercent: 5, SizePercent: 90}
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().SetLineWidth(mock.Anything)
+		pdf.EXPECT().Line(mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		sut := gofpdf.NewLine(pdf)
+
+		// Act
+		sut.Add(cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Line", 1)
+		pdf.AssertNotCalled(t, "SetDashPattern", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,44 @@
+package gofpdf
+
+import (
+	"bytes"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+)
+
+// postProcess rewrites the document generated by gofpdf with pdfcpu, to apply the features
+// which gofpdf doesn't support, like the hover and tooltip areas and the object streams.
+func postProcess(pdf []byte, areas []hoverArea, tooltips []tooltipArea, objectStreams bool) ([]byte, error) {
+	conf := model.NewDefaultConfiguration()
+	conf.WriteObjectStream = objectStreams
+	conf.WriteXRefStream = objectStreams
+
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = api.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(areas) > 0 {
+		if err = addHoverAreas(ctx, areas); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(tooltips) > 0 {
+		if err = addTooltipAreas(ctx, tooltips); err != nil {
+			return nil, err
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err = api.WriteContext(ctx, &buffer); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -2,6 +2,10 @@ package gofpdf
 
 import (
 	"bytes"
+	"fmt"
+	goimage "image"
+	"image/png"
+	"io"
 	"path/filepath"
 	"strings"
 
@@ -9,7 +13,11 @@ import (
 	"github.com/johnfercher/maroto/v2/internal/merror"
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/cellwriter"
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/consts/blend"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
@@ -22,9 +30,13 @@ type provider struct {
 	code       core.Code
 	image      core.Image
 	line       core.Line
+	math       core.Math
 	cache      cache.Cache
 	cellWriter cellwriter.CellWriter
 	cfg        *entity.Config
+	bookmarks  []entity.Bookmark
+	hovers     []hoverArea
+	tooltips   []tooltipArea
 }
 
 // New is the constructor of provider for gofpdf
@@ -36,6 +48,7 @@ func New(dep *Dependencies) core.Provider {
 		code:       dep.Code,
 		image:      dep.Image,
 		line:       dep.Line,
+		math:       dep.Math,
 		cellWriter: dep.CellWriter,
 		cfg:        dep.Cfg,
 		cache:      dep.Cache,
@@ -50,10 +63,46 @@ func (g *provider) GetTextHeight(prop *props.Font) float64 {
 	return g.font.GetHeight(prop.Family, prop.Style, prop.Size)
 }
 
+// GetStringWidth returns the width, in mm, of the text written in a single line with the font, including the
+// custom fonts of the document.
+func (g *provider) GetStringWidth(text string, prop *props.Font) float64 {
+	g.font.SetFont(prop.Family, prop.Style, prop.Size)
+	return g.fpdf.GetStringWidth(toUnicode(g.fpdf, text, prop.Family))
+}
+
+func (g *provider) GetPageSize() (width, height float64) {
+	return g.fpdf.GetPageSize()
+}
+
 func (g *provider) AddLine(cell *entity.Cell, prop *props.Line) {
 	g.line.Add(cell, prop)
 }
 
+func (g *provider) DrawBezier(p0, p1, p2, p3 entity.Point, prop *props.Line) {
+	g.line.AddBezier(p0, p1, p2, p3, prop)
+}
+
+func (g *provider) DrawLine(p0, p1 entity.Point, prop *props.Line) {
+	g.line.AddSegment(p0, p1, prop)
+}
+
+func (g *provider) DrawArc(center entity.Point, radius, innerRadius, startAngle, endAngle float64, prop *props.Line) {
+	g.line.AddArc(center, radius, innerRadius, startAngle, endAngle, prop)
+}
+
+func (g *provider) DrawPolygon(points []entity.Point, prop *props.Line) {
+	g.line.AddPolygon(points, prop)
+}
+
+func (g *provider) DrawRect(cell *entity.Cell, prop *props.Cell) {
+	x, y := g.fpdf.GetXY()
+	left, top, _, _ := g.fpdf.GetMargins()
+
+	g.fpdf.SetXY(cell.X+left, cell.Y+top)
+	g.cellWriter.Apply(cell.Width, cell.Height, g.cfg, prop)
+	g.fpdf.SetXY(x, y)
+}
+
 func (g *provider) AddMatrixCode(code string, cell *entity.Cell, prop *props.Rect) {
 	image, err := g.cache.GetImage(code, extension.Jpg)
 	if err != nil {
@@ -90,21 +139,121 @@ func (g *provider) AddQrCode(code string, cell *entity.Cell, prop *props.Rect) {
 	}
 }
 
+func (g *provider) AddMicroQrCode(code string, cell *entity.Cell, prop *props.Rect) {
+	key := microQrCacheKey + code
+	image, err := g.cache.GetImage(key, extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenMicroQr(code)
+	}
+	if err != nil {
+		g.text.Add("could not generate micro qrcode", cell, merror.DefaultErrorText)
+		return
+	}
+
+	g.cache.AddImage(key, image)
+	err = g.image.Add(image, cell, g.cfg.Margins, prop, extension.Jpg, false)
+	if err != nil {
+		g.fpdf.ClearError()
+		g.text.Add("could not add micro qrcode to document", cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) AddAztecCode(code string, cell *entity.Cell, prop *props.Rect) {
+	key := aztecCacheKey + code
+	image, err := g.cache.GetImage(key, extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenAztec(code)
+	}
+	if err != nil {
+		g.text.Add("could not generate aztec code", cell, merror.DefaultErrorText)
+		return
+	}
+
+	g.cache.AddImage(key, image)
+	err = g.image.Add(image, cell, g.cfg.Margins, prop, extension.Jpg, false)
+	if err != nil {
+		g.fpdf.ClearError()
+		g.text.Add("could not add aztec code to document", cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) AddPDF417Code(code string, cell *entity.Cell, prop *props.PDF417) {
+	key := pdf417CacheKey(code, prop)
+	image, err := g.cache.GetImage(key, extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenPDF417(code, prop)
+	}
+	if err != nil {
+		g.text.Add("could not generate pdf417", cell, merror.DefaultErrorText)
+		return
+	}
+
+	g.cache.AddImage(key, image)
+	err = g.image.Add(image, cell, g.cfg.Margins, &prop.Rect, extension.Jpg, false)
+	if err != nil {
+		g.fpdf.ClearError()
+		g.text.Add("could not add pdf417 to document", cell, merror.DefaultErrorText)
+	}
+}
+
 func (g *provider) AddBarCode(code string, cell *entity.Cell, prop *props.Barcode) {
-	image, err := g.cache.GetImage(code, extension.Jpg)
+	barCell := cell
+	var font *props.Font
+	if prop.ShowText {
+		font = g.getBarCodeFont()
+		barCell = &entity.Cell{X: cell.X, Y: cell.Y, Width: cell.Width, Height: max(cell.Height-g.GetTextHeight(font), 0)}
+	}
+
+	key := barCodeCacheKey(code, prop)
+	image, err := g.cache.GetImage(key, extension.Jpg)
 	if err != nil {
-		image, err = g.code.GenBar(code, cell, prop)
+		image, err = g.code.GenBar(code, barCell, prop)
 	}
 	if err != nil {
 		g.text.Add("could not generate barcode", cell, merror.DefaultErrorText)
 		return
 	}
 
-	g.cache.AddImage(code, image)
-	err = g.image.Add(image, cell, g.cfg.Margins, prop.ToRectProp(), extension.Jpg, false)
+	g.cache.AddImage(key, image)
+	rectProp := prop.ToRectProp()
+	err = g.image.Add(image, barCell, g.cfg.Margins, rectProp, extension.Jpg, false)
 	if err != nil {
 		g.fpdf.ClearError()
 		g.text.Add("could not add barcode to document", cell, merror.DefaultErrorText)
+		return
+	}
+
+	if prop.ShowText {
+		g.addBarCodeText(prop.GetText(code), image, barCell, rectProp, font)
+	}
+}
+
+// addBarCodeText writes the text centered right below the bars, which are placed in the cell like an image.
+func (g *provider) addBarCodeText(text string, image *entity.Image, cell *entity.Cell, prop *props.Rect, font *props.Font) {
+	var rect *entity.Cell
+	if prop.Center {
+		rect = g.math.GetInnerCenterCell(image.Dimensions, cell.GetDimensions(), prop.Percent)
+	} else {
+		rect = g.math.GetInnerNonCenterCell(image.Dimensions, cell.GetDimensions(), prop)
+	}
+
+	textCell := &entity.Cell{
+		X:      cell.X + rect.X,
+		Y:      cell.Y + rect.Y + rect.Height,
+		Width:  rect.Width,
+		Height: g.GetTextHeight(font),
+	}
+
+	g.text.Add(text, textCell, font.ToTextProp(align.Center, 0, 0))
+}
+
+// getBarCodeFont returns the default font with the normal style, used to write the text of the barcodes.
+func (g *provider) getBarCodeFont() *props.Font {
+	return &props.Font{
+		Family: g.cfg.DefaultFont.Family,
+		Style:  fontstyle.Normal,
+		Size:   g.cfg.DefaultFont.Size,
+		Color:  g.cfg.DefaultFont.Color,
 	}
 }
 
@@ -146,6 +295,31 @@ func (g *provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *prop
 	}
 }
 
+// DrawImage encodes the img as png in memory and adds it like AddImageFromBytes.
+func (g *provider) DrawImage(img goimage.Image, cell *entity.Cell, prop *props.Rect) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		g.text.Add("could not encode image", cell, merror.DefaultErrorText)
+		return
+	}
+
+	g.AddImageFromBytes(buf.Bytes(), cell, prop, extension.Png)
+}
+
+func (g *provider) AddTiledImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type) {
+	img, err := FromBytes(bytes, extension)
+	if err != nil {
+		g.text.Add("could not parse image bytes", cell, merror.DefaultErrorText)
+		return
+	}
+
+	err = g.image.AddTiled(img, cell, g.cfg.Margins, prop, extension)
+	if err != nil {
+		g.fpdf.ClearError()
+		g.text.Add("could not add image to document", cell, merror.DefaultErrorText)
+	}
+}
+
 func (g *provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type) {
 	img, err := FromBytes(bytes, extension)
 	if err != nil {
@@ -161,10 +335,118 @@ func (g *provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell,
 	g.fpdf.SetHomeXY()
 }
 
+func (g *provider) AddBookmark(title string, level int, cell *entity.Cell) {
+	_, top, _, _ := g.fpdf.GetMargins()
+	g.fpdf.Bookmark(title, level, top+cell.Y)
+
+	g.bookmarks = append(g.bookmarks, entity.Bookmark{
+		Title: title,
+		Page:  g.fpdf.PageNo(),
+		Level: level,
+	})
+}
+
+func (g *provider) AddHoverArea(id string, cell *entity.Cell, color *props.Color) {
+	g.hovers = append(g.hovers, hoverArea{
+		id:    id,
+		page:  g.fpdf.PageNo(),
+		rect:  g.getAnnotationRect(cell),
+		color: color,
+	})
+}
+
+func (g *provider) AddTooltipArea(tooltip string, cell *entity.Cell) {
+	g.tooltips = append(g.tooltips, tooltipArea{
+		tooltip: tooltip,
+		page:    g.fpdf.PageNo(),
+		rect:    g.getAnnotationRect(cell),
+	})
+}
+
+// getAnnotationRect returns the rect of the cell in points, from the bottom left of the page as used by annotations.
+func (g *provider) getAnnotationRect(cell *entity.Cell) [4]float64 {
+	left, top, _, _ := g.fpdf.GetMargins()
+	_, pageHeight := g.fpdf.GetPageSize()
+
+	x := left + cell.X
+	y := top + cell.Y
+
+	return [4]float64{
+		x * pointsPerMM,
+		(pageHeight - y - cell.Height) * pointsPerMM,
+		(x + cell.Width) * pointsPerMM,
+		(pageHeight - y) * pointsPerMM,
+	}
+}
+
+func (g *provider) SetClipRect(x, y, width, height float64) {
+	left, top, _, _ := g.fpdf.GetMargins()
+	g.fpdf.ClipRect(left+x, top+y, width, height, false)
+}
+
+func (g *provider) SetClipCircle(x, y, radius float64) {
+	left, top, _, _ := g.fpdf.GetMargins()
+	g.fpdf.ClipCircle(left+x, top+y, radius, false)
+}
+
+func (g *provider) ResetClip() {
+	g.fpdf.ClipEnd()
+}
+
+// SetGraphicsState sets the blend mode and the opacities of everything drawn after it, the /ExtGState
+// has the Opacity in its /ca entry and the StrokeOpacity in its /CA entry.
+func (g *provider) SetGraphicsState(state props.GraphicsState) {
+	state.MakeValid()
+	g.fpdf.SetAlphas(state.Opacity, state.StrokeOpacity, string(state.BlendMode))
+}
+
+// AddWatermark writes the text rotated around the center of the page, over everything drawn before it.
+// The rotation and the opacity are applied inside a saved graphics state, so they don't affect what is
+// drawn after it.
+func (g *provider) AddWatermark(text string, prop *props.Watermark) {
+	width, height := g.fpdf.GetPageSize()
+	x, y := width/2, height/2
+
+	originalColor := g.font.GetColor()
+	g.font.SetFont(prop.Family, prop.Style, prop.Size)
+	g.font.SetColor(prop.Color)
+
+	unicodeText := toUnicode(g.fpdf, text, prop.Family)
+	textWidth := g.fpdf.GetStringWidth(unicodeText)
+	fontHeight := g.font.GetHeight(prop.Family, prop.Style, prop.Size)
+
+	g.fpdf.TransformBegin()
+	g.fpdf.TransformRotate(prop.Angle, x, y)
+	g.fpdf.SetAlpha(prop.Opacity, string(blend.Normal))
+	// the baseline is a third of the font height below the center, which centers the capital letters
+	g.fpdf.Text(x-textWidth/2, y+fontHeight/3, unicodeText)
+	g.fpdf.TransformEnd()
+
+	g.font.SetColor(originalColor)
+}
+
+func (g *provider) GetBookmarks() []entity.Bookmark {
+	return g.bookmarks
+}
+
 func (g *provider) CreateRow(height float64) {
 	g.fpdf.Ln(height)
 }
 
+// GetCursor returns the position where the next row is created, relative to the margins like the cells.
+func (g *provider) GetCursor() (x, y float64) {
+	x, y = g.fpdf.GetXY()
+	left, top, _, _ := g.fpdf.GetMargins()
+
+	return x - left, y - top
+}
+
+// SetCursor moves the position where the next row is created, relative to the margins like the cells.
+func (g *provider) SetCursor(x, y float64) {
+	left, top, _, _ := g.fpdf.GetMargins()
+	g.fpdf.SetXY(x+left, y+top)
+}
+
 func (g *provider) SetProtection(protection *entity.Protection) {
 	if protection == nil {
 		return
@@ -194,16 +476,54 @@ func (g *provider) SetMetadata(metadata *entity.Metadata) {
 		g.fpdf.SetTitle(metadata.Title.Text, metadata.Title.UTF8)
 	}
 
+	if metadata.Keywords != nil {
+		g.fpdf.SetKeywords(metadata.Keywords.Text, metadata.Keywords.UTF8)
+	}
+
 	if metadata.CreationDate != nil {
 		g.fpdf.SetCreationDate(*metadata.CreationDate)
 	}
+
+	if metadata.ModificationDate != nil {
+		g.fpdf.SetModificationDate(*metadata.ModificationDate)
+	}
 }
 
 func (g *provider) GenerateBytes() ([]byte, error) {
 	var buffer bytes.Buffer
 	err := g.fpdf.Output(&buffer)
+	if err != nil || !g.needsPostProcess() {
+		return buffer.Bytes(), err
+	}
+
+	return postProcess(buffer.Bytes(), g.hovers, g.tooltips, g.useObjectStreams())
+}
 
-	return buffer.Bytes(), err
+// GenerateTo writes the document to w as it's output by gofpdf, without buffering it. When the document
+// has form fields or object streams it must be rewritten, so it's generated in memory before being written.
+func (g *provider) GenerateTo(w io.Writer) error {
+	if !g.needsPostProcess() {
+		return g.fpdf.Output(w)
+	}
+
+	documentBytes, err := g.GenerateBytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(documentBytes)
+	return err
+}
+
+// needsPostProcess defines if the document has features written by postProcess.
+func (g *provider) needsPostProcess() bool {
+	return len(g.hovers) > 0 || len(g.tooltips) > 0 || g.useObjectStreams()
+}
+
+// useObjectStreams defines if the objects are grouped in object streams, protected documents
+// are not rewritten since they can only be read again with the passwords.
+func (g *provider) useObjectStreams() bool {
+	return g.cfg != nil && g.cfg.ObjectStreams && g.cfg.Protection == nil
 }
 
 func (g *provider) CreateCol(width, height float64, config *entity.Config, prop *props.Cell) {
@@ -213,3 +533,23 @@ func (g *provider) CreateCol(width, height float64, config *entity.Config, prop
 func (g *provider) SetCompression(compression bool) {
 	g.fpdf.SetCompression(compression)
 }
+
+// microQrCacheKey prefixes the micro qr codes, so they do not share the cached image of a qr code with the same content.
+const microQrCacheKey = "microqr:"
+
+// aztecCacheKey prefixes the aztec codes, so they do not share the cached image of other codes with the same content.
+const aztecCacheKey = "aztec:"
+
+// pdf417CacheKey avoids that the same code generated with different security levels or limits share the cached image.
+func pdf417CacheKey(code string, prop *props.PDF417) string {
+	return fmt.Sprintf("pdf417:%d:%dx%d:%s", prop.SecurityLevel, prop.Columns, prop.Rows, code)
+}
+
+// barCodeCacheKey avoids that the same code generated with different symbologies share the cached image.
+func barCodeCacheKey(code string, prop *props.Barcode) string {
+	if prop.Type == "" || prop.Type == barcode.Code128 {
+		return code
+	}
+
+	return string(prop.Type) + ":" + code
+}
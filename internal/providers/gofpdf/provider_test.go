@@ -1,21 +1,31 @@
 package gofpdf_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	goimage "image"
+	"image/color"
+	"image/png"
 	"testing"
 	"time"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/internal/merror"
 	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/consts/blend"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/protection"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
 
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -74,6 +84,51 @@ func TestProvider_GetTextHeight(t *testing.T) {
 	assert.Equal(t, fontHeightToReturn, fontHeight)
 }
 
+func TestProvider_GetStringWidth(t *testing.T) {
+	// Arrange
+	prop := fixture.FontProp()
+	translator := func(s string) string { return s }
+
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(translator)
+	fpdf.EXPECT().GetStringWidth("Total").Return(12.5)
+
+	font := &mocks.Font{}
+	font.EXPECT().SetFont(prop.Family, prop.Style, prop.Size)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+		Font: font,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	width := sut.GetStringWidth("Total", &prop)
+
+	// Assert
+	font.AssertNumberOfCalls(t, "SetFont", 1)
+	assert.Equal(t, 12.5, width)
+}
+
+func TestProvider_GetPageSize(t *testing.T) {
+	// Arrange
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().GetPageSize().Return(210.0, 297.0)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	width, height := sut.GetPageSize()
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "GetPageSize", 1)
+	assert.Equal(t, 210.0, width)
+	assert.Equal(t, 297.0, height)
+}
+
 func TestProvider_AddLine(t *testing.T) {
 	// Arrange
 	cell := &entity.Cell{}
@@ -94,6 +149,116 @@ func TestProvider_AddLine(t *testing.T) {
 	line.AssertNumberOfCalls(t, "Add", 1)
 }
 
+func TestProvider_DrawArc(t *testing.T) {
+	// Arrange
+	center := entity.Point{X: 10, Y: 10}
+	prop := fixture.LineProp()
+
+	line := &mocks.Line{}
+	line.EXPECT().AddArc(center, 5.0, 2.0, 0.0, 90.0, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Line: line,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.DrawArc(center, 5, 2, 0, 90, &prop)
+
+	// Assert
+	line.AssertNumberOfCalls(t, "AddArc", 1)
+}
+
+func TestProvider_DrawBezier(t *testing.T) {
+	// Arrange
+	p0, p1, p2, p3 := entity.Point{X: 0, Y: 10}, entity.Point{X: 5, Y: 0}, entity.Point{X: 10, Y: 0}, entity.Point{X: 15, Y: 10}
+	prop := fixture.LineProp()
+
+	line := &mocks.Line{}
+	line.EXPECT().AddBezier(p0, p1, p2, p3, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Line: line,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.DrawBezier(p0, p1, p2, p3, &prop)
+
+	// Assert
+	line.AssertNumberOfCalls(t, "AddBezier", 1)
+}
+
+func TestProvider_DrawLine(t *testing.T) {
+	// Arrange
+	p0, p1 := entity.Point{X: 0, Y: 0}, entity.Point{X: 15, Y: 10}
+	prop := fixture.LineProp()
+
+	line := &mocks.Line{}
+	line.EXPECT().AddSegment(p0, p1, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Line: line,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.DrawLine(p0, p1, &prop)
+
+	// Assert
+	line.AssertNumberOfCalls(t, "AddSegment", 1)
+}
+
+func TestProvider_DrawPolygon(t *testing.T) {
+	// Arrange
+	points := []entity.Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 5, Y: 10}}
+	prop := fixture.LineProp()
+
+	line := &mocks.Line{}
+	line.EXPECT().AddPolygon(points, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Line: line,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.DrawPolygon(points, &prop)
+
+	// Assert
+	line.AssertNumberOfCalls(t, "AddPolygon", 1)
+}
+
+func TestProvider_DrawRect(t *testing.T) {
+	// Arrange
+	cell := fixture.CellEntity()
+	cfg := &entity.Config{}
+	prop := fixture.CellProp()
+
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().GetXY().Return(1.0, 2.0)
+	fpdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+	fpdf.EXPECT().SetXY(cell.X+10.0, cell.Y+10.0)
+	fpdf.EXPECT().SetXY(1.0, 2.0)
+
+	cellWriter := &mocks.CellWriter{}
+	cellWriter.EXPECT().Apply(cell.Width, cell.Height, cfg, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf:       fpdf,
+		CellWriter: cellWriter,
+		Cfg:        cfg,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.DrawRect(&cell, &prop)
+
+	// Assert
+	cellWriter.AssertNumberOfCalls(t, "Apply", 1)
+	fpdf.AssertNumberOfCalls(t, "SetXY", 2)
+}
+
 // nolint: dupl
 func TestProvider_AddMatrixCode(t *testing.T) {
 	t.Run("when cannot find image on cache and cannot generate data matrix, should apply error message", func(t *testing.T) {
@@ -345,20 +510,20 @@ func TestProvider_AddQrCode(t *testing.T) {
 }
 
 // nolint: dupl
-func TestProvider_AddBarCode(t *testing.T) {
-	t.Run("when cannot find image on cache and cannot generate bar code, should apply error message", func(t *testing.T) {
+func TestProvider_AddMicroQrCode(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate micro qr code, should apply error message", func(t *testing.T) {
 		// Arrange
 		cell := &entity.Cell{}
-		prop := fixture.BarcodeProp()
+		prop := fixture.RectProp()
 
 		cache := &mocks.Cache{}
-		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().GetImage("microqr:"+codeContent, extension.Jpg).Return(nil, errors.New("anyError1"))
 
 		code := &mocks.Code{}
-		code.EXPECT().GenBar(codeContent, cell, &prop).Return(nil, errors.New("anyError2"))
+		code.EXPECT().GenMicroQr(codeContent).Return(nil, errors.New("anyError2"))
 
 		text := &mocks.Text{}
-		text.EXPECT().Add("could not generate barcode", cell, merror.DefaultErrorText)
+		text.EXPECT().Add("could not generate micro qrcode", cell, merror.DefaultErrorText)
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -369,26 +534,26 @@ func TestProvider_AddBarCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		sut.AddBarCode(codeContent, cell, &prop)
+		sut.AddMicroQrCode(codeContent, cell, &prop)
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
-		code.AssertNumberOfCalls(t, "GenBar", 1)
+		code.AssertNumberOfCalls(t, "GenMicroQr", 1)
 		text.AssertNumberOfCalls(t, "Add", 1)
 	})
 	t.Run("when can find image on cache but cannot add image, should apply error message", func(t *testing.T) {
 		// Arrange
 		cell := &entity.Cell{}
-		prop := fixture.BarcodeProp()
+		prop := fixture.RectProp()
 
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
 		cache := &mocks.Cache{}
-		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(img, nil)
-		cache.EXPECT().AddImage(codeContent, img)
+		cache.EXPECT().GetImage("microqr:"+codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage("microqr:"+codeContent, img)
 
 		text := &mocks.Text{}
-		text.EXPECT().Add("could not add barcode to document", cell, merror.DefaultErrorText)
+		text.EXPECT().Add("could not add micro qrcode to document", cell, merror.DefaultErrorText)
 
 		cfg := &entity.Config{
 			Margins: &entity.Margins{
@@ -400,7 +565,7 @@ func TestProvider_AddBarCode(t *testing.T) {
 		}
 
 		image := &mocks.Image{}
-		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Jpg, false).Return(errors.New("anyError"))
+		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Jpg, false).Return(errors.New("anyError"))
 
 		fpdf := &mocks.Fpdf{}
 		fpdf.EXPECT().ClearError()
@@ -416,7 +581,7 @@ func TestProvider_AddBarCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		sut.AddBarCode(codeContent, cell, &prop)
+		sut.AddMicroQrCode(codeContent, cell, &prop)
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
@@ -427,13 +592,13 @@ func TestProvider_AddBarCode(t *testing.T) {
 	t.Run("when can find image on cache and can add image, should not apply error message", func(t *testing.T) {
 		// Arrange
 		cell := &entity.Cell{}
-		prop := fixture.BarcodeProp()
+		prop := fixture.RectProp()
 
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
 		cache := &mocks.Cache{}
-		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(img, nil)
-		cache.EXPECT().AddImage(codeContent, img)
+		cache.EXPECT().GetImage("microqr:"+codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage("microqr:"+codeContent, img)
 
 		cfg := &entity.Config{
 			Margins: &entity.Margins{
@@ -445,7 +610,7 @@ func TestProvider_AddBarCode(t *testing.T) {
 		}
 
 		image := &mocks.Image{}
-		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Jpg, false).Return(nil)
+		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Jpg, false).Return(nil)
 
 		fpdf := &mocks.Fpdf{}
 		fpdf.EXPECT().ClearError()
@@ -460,7 +625,7 @@ func TestProvider_AddBarCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		sut.AddBarCode(codeContent, cell, &prop)
+		sut.AddMicroQrCode(codeContent, cell, &prop)
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
@@ -469,81 +634,757 @@ func TestProvider_AddBarCode(t *testing.T) {
 	})
 }
 
-func TestProvider_CreateRow(t *testing.T) {
-	// Arrange
-	height := 10.0
+// nolint: dupl
+func TestProvider_AddAztecCode(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate aztec code, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.RectProp()
 
-	fpdf := &mocks.Fpdf{}
-	fpdf.EXPECT().Ln(height)
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage("aztec:"+codeContent, extension.Jpg).Return(nil, errors.New("anyError1"))
 
-	dep := &gofpdf.Dependencies{
-		Fpdf: fpdf,
-	}
+		code := &mocks.Code{}
+		code.EXPECT().GenAztec(codeContent).Return(nil, errors.New("anyError2"))
 
-	sut := gofpdf.New(dep)
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not generate aztec code", cell, merror.DefaultErrorText)
 
-	// Act
-	sut.CreateRow(height)
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+			Text:  text,
+		}
 
-	// Assert
-	fpdf.AssertNumberOfCalls(t, "Ln", 1)
-}
+		sut := gofpdf.New(dep)
 
-func TestProvider_CreateCol(t *testing.T) {
-	// Arrange
-	width := 10.0
-	height := 20.0
-	cfg := &entity.Config{}
-	prop := fixture.CellProp()
+		// Act
+		sut.AddAztecCode(codeContent, cell, &prop)
 
-	cellWriter := &mocks.CellWriter{}
-	cellWriter.EXPECT().Apply(width, height, cfg, &prop)
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		code.AssertNumberOfCalls(t, "GenAztec", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when can find image on cache but cannot add image, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.RectProp()
 
-	dep := &gofpdf.Dependencies{
-		CellWriter: cellWriter,
-	}
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
-	sut := gofpdf.New(dep)
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage("aztec:"+codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage("aztec:"+codeContent, img)
 
-	// Act
-	sut.CreateCol(width, height, cfg, &prop)
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not add aztec code to document", cell, merror.DefaultErrorText)
 
-	// Assert
-	cellWriter.AssertNumberOfCalls(t, "Apply", 1)
-}
+		cfg := &entity.Config{
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+		}
+
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Jpg, false).Return(errors.New("anyError"))
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().ClearError()
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Text:  text,
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   cfg,
+		}
 
-func TestProvider_SetProtection(t *testing.T) {
-	t.Run("when protection is nil, should ignore protection", func(t *testing.T) {
-		// Act
-		dep := &gofpdf.Dependencies{}
 		sut := gofpdf.New(dep)
 
 		// Act
-		sut.SetProtection(nil)
+		sut.AddAztecCode(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
 	})
-	t.Run("when protection is valid, should apply protection", func(t *testing.T) {
+	t.Run("when can find image on cache and can add image, should not apply error message", func(t *testing.T) {
 		// Arrange
-		p := &entity.Protection{
-			Type:          protection.Print,
-			UserPassword:  "userPassword",
-			OwnerPassword: "ownerPassword",
+		cell := &entity.Cell{}
+		prop := fixture.RectProp()
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage("aztec:"+codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage("aztec:"+codeContent, img)
+
+		cfg := &entity.Config{
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
 		}
 
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Jpg, false).Return(nil)
+
 		fpdf := &mocks.Fpdf{}
-		fpdf.EXPECT().SetProtection(byte(p.Type), p.UserPassword, p.OwnerPassword)
+		fpdf.EXPECT().ClearError()
 
 		dep := &gofpdf.Dependencies{
-			Fpdf: fpdf,
+			Cache: cache,
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   cfg,
 		}
 
 		sut := gofpdf.New(dep)
 
 		// Act
-		sut.SetProtection(p)
+		sut.AddAztecCode(codeContent, cell, &prop)
 
 		// Assert
-		fpdf.AssertNumberOfCalls(t, "SetProtection", 1)
-	})
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+}
+
+// nolint: dupl
+func TestProvider_AddPDF417Code(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate pdf417, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.PDF417Prop()
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage("pdf417:4:10x20:"+codeContent, extension.Jpg).Return(nil, errors.New("anyError1"))
+
+		code := &mocks.Code{}
+		code.EXPECT().GenPDF417(codeContent, &prop).Return(nil, errors.New("anyError2"))
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not generate pdf417", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+			Text:  text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddPDF417Code(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		code.AssertNumberOfCalls(t, "GenPDF417", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when can find image on cache but cannot add image, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.PDF417Prop()
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage("pdf417:4:10x20:"+codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage("pdf417:4:10x20:"+codeContent, img)
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not add pdf417 to document", cell, merror.DefaultErrorText)
+
+		cfg := &entity.Config{
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+		}
+
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, cell, cfg.Margins, &prop.Rect, extension.Jpg, false).Return(errors.New("anyError"))
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().ClearError()
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Text:  text,
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddPDF417Code(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when can find image on cache and can add image, should not apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.PDF417Prop()
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage("pdf417:4:10x20:"+codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage("pdf417:4:10x20:"+codeContent, img)
+
+		cfg := &entity.Config{
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+		}
+
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, cell, cfg.Margins, &prop.Rect, extension.Jpg, false).Return(nil)
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().ClearError()
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddPDF417Code(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+}
+
+// nolint: dupl
+func TestProvider_AddBarCode(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate bar code, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.BarcodeProp()
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(nil, errors.New("anyError1"))
+
+		code := &mocks.Code{}
+		code.EXPECT().GenBar(codeContent, cell, &prop).Return(nil, errors.New("anyError2"))
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not generate barcode", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+			Text:  text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		code.AssertNumberOfCalls(t, "GenBar", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when can find image on cache but cannot add image, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.BarcodeProp()
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage(codeContent, img)
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not add barcode to document", cell, merror.DefaultErrorText)
+
+		cfg := &entity.Config{
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+		}
+
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Jpg, false).Return(errors.New("anyError"))
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().ClearError()
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Text:  text,
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when can find image on cache and can add image, should not apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.BarcodeProp()
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage(codeContent, img)
+
+		cfg := &entity.Config{
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+		}
+
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Jpg, false).Return(nil)
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().ClearError()
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when type is not code128, should use type on cache key", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.BarcodeProp()
+		prop.Type = barcode.Codabar
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage("codabar:"+codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage("codabar:"+codeContent, img)
+
+		cfg := &entity.Config{
+			Margins: &entity.Margins{},
+		}
+
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Jpg, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when barcode shows the text, should write the text centered below the bars", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 5, Y: 5, Width: 100, Height: 30}
+		barCell := &entity.Cell{X: 5, Y: 5, Width: 100, Height: 25}
+		prop := fixture.BarcodeProp()
+		prop.Type = barcode.EAN13
+		prop.ShowText = true
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Dimensions: &entity.Dimensions{Width: 50, Height: 20}}
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage("ean13:400638133393", extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage("ean13:400638133393", img)
+
+		cfg := &entity.Config{
+			Margins:     &entity.Margins{},
+			DefaultFont: &props.Font{Family: fontfamily.Arial, Style: fontstyle.Bold, Size: 10},
+		}
+		font := &props.Font{Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10}
+
+		fontMock := &mocks.Font{}
+		fontMock.EXPECT().GetHeight(font.Family, font.Style, font.Size).Return(5.0)
+
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, barCell, cfg.Margins, prop.ToRectProp(), extension.Jpg, false).Return(nil)
+
+		math := &mocks.Math{}
+		math.EXPECT().GetInnerNonCenterCell(img.Dimensions, barCell.GetDimensions(), prop.ToRectProp()).
+			Return(&entity.Cell{X: 10, Y: 2, Width: 40, Height: 16})
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("4006381333931", &entity.Cell{X: 15, Y: 23, Width: 40, Height: 5}, font.ToTextProp(align.Center, 0, 0))
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Font:  fontMock,
+			Image: image,
+			Math:  math,
+			Text:  text,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode("400638133393", cell, &prop)
+
+		// Assert
+		image.AssertNumberOfCalls(t, "Add", 1)
+		math.AssertNumberOfCalls(t, "GetInnerNonCenterCell", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+}
+
+func TestProvider_AddBookmark(t *testing.T) {
+	// Arrange
+	cell := fixture.CellEntity()
+
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+	fpdf.EXPECT().Bookmark("title", 1, cell.Y+10.0)
+	fpdf.EXPECT().PageNo().Return(2)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddBookmark("title", 1, &cell)
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "Bookmark", 1)
+	assert.Equal(t, []entity.Bookmark{{Title: "title", Page: 2, Level: 1}}, sut.GetBookmarks())
+}
+
+func TestProvider_AddHoverArea(t *testing.T) {
+	t.Run("when document has hover areas and output is not a pdf, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		fpdf.EXPECT().GetPageSize().Return(210.0, 297.0)
+		fpdf.EXPECT().PageNo().Return(1)
+		fpdf.EXPECT().Output(mock.Anything).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+		}
+		sut := gofpdf.New(dep)
+		sut.AddHoverArea("total", &cell, &props.Color{Red: 255})
+
+		// Act
+		bytes, err := sut.GenerateBytes()
+
+		// Assert
+		assert.Nil(t, bytes)
+		assert.NotNil(t, err)
+		fpdf.AssertNumberOfCalls(t, "PageNo", 1)
+	})
+}
+
+func TestProvider_SetClipRect(t *testing.T) {
+	// Arrange
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+	fpdf.EXPECT().ClipRect(15.0, 27.0, 30.0, 40.0, false)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.SetClipRect(5, 7, 30, 40)
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "ClipRect", 1)
+}
+
+func TestProvider_SetClipCircle(t *testing.T) {
+	// Arrange
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+	fpdf.EXPECT().ClipCircle(15.0, 27.0, 8.0, false)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.SetClipCircle(5, 7, 8)
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "ClipCircle", 1)
+}
+
+func TestProvider_ResetClip(t *testing.T) {
+	// Arrange
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().ClipEnd()
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.ResetClip()
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "ClipEnd", 1)
+}
+
+func TestProvider_AddWatermark(t *testing.T) {
+	// Arrange
+	prop := fixture.WatermarkProp()
+	originalColor := &props.Color{}
+	translator := func(s string) string { return s }
+
+	var calls []string
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().GetPageSize().Return(210.0, 297.0)
+	fpdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(translator)
+	fpdf.EXPECT().GetStringWidth("DRAFT").Return(50.0)
+	fpdf.EXPECT().TransformBegin().Run(func() { calls = append(calls, "TransformBegin") })
+	fpdf.EXPECT().TransformRotate(30.0, 105.0, 148.5).Run(func(float64, float64, float64) { calls = append(calls, "TransformRotate") })
+	fpdf.EXPECT().SetAlpha(0.5, "Normal").Run(func(float64, string) { calls = append(calls, "SetAlpha") })
+	fpdf.EXPECT().Text(80.0, 152.5, "DRAFT").Run(func(float64, float64, string) { calls = append(calls, "Text") })
+	fpdf.EXPECT().TransformEnd().Run(func() { calls = append(calls, "TransformEnd") })
+
+	font := &mocks.Font{}
+	font.EXPECT().GetColor().Return(originalColor)
+	font.EXPECT().SetFont(prop.Family, prop.Style, prop.Size)
+	font.EXPECT().SetColor(prop.Color)
+	font.EXPECT().SetColor(originalColor)
+	font.EXPECT().GetHeight(prop.Family, prop.Style, prop.Size).Return(12.0)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+		Font: font,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddWatermark("DRAFT", &prop)
+
+	// Assert
+	assert.Equal(t, []string{"TransformBegin", "TransformRotate", "SetAlpha", "Text", "TransformEnd"}, calls)
+	font.AssertNumberOfCalls(t, "SetColor", 2)
+}
+
+func TestProvider_SetGraphicsState(t *testing.T) {
+	t.Run("when state is valid, should set the alphas with blend mode", func(t *testing.T) {
+		// Arrange
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetAlphas(0.5, 0.8, "Multiply")
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.SetGraphicsState(props.GraphicsState{BlendMode: blend.Multiply, Opacity: 0.5, StrokeOpacity: 0.8})
+
+		// Assert
+		fpdf.AssertNumberOfCalls(t, "SetAlphas", 1)
+	})
+	t.Run("when state is invalid, should set the alphas with default values", func(t *testing.T) {
+		// Arrange
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetAlphas(1.0, 1.0, "Normal")
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.SetGraphicsState(props.GraphicsState{BlendMode: "invalid", Opacity: 2})
+
+		// Assert
+		fpdf.AssertNumberOfCalls(t, "SetAlphas", 1)
+	})
+}
+
+func TestProvider_CreateRow(t *testing.T) {
+	// Arrange
+	height := 10.0
+
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().Ln(height)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.CreateRow(height)
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "Ln", 1)
+}
+
+func TestProvider_GetCursor(t *testing.T) {
+	// Arrange
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().GetXY().Return(15.0, 40.0)
+	fpdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	x, y := sut.GetCursor()
+
+	// Assert
+	assert.Equal(t, 5.0, x)
+	assert.Equal(t, 20.0, y)
+}
+
+func TestProvider_SetCursor(t *testing.T) {
+	// Arrange
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().GetMargins().Return(10.0, 20.0, 10.0, 10.0)
+	fpdf.EXPECT().SetXY(15.0, 40.0)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.SetCursor(5, 20)
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "SetXY", 1)
+}
+
+func TestProvider_CreateCol(t *testing.T) {
+	// Arrange
+	width := 10.0
+	height := 20.0
+	cfg := &entity.Config{}
+	prop := fixture.CellProp()
+
+	cellWriter := &mocks.CellWriter{}
+	cellWriter.EXPECT().Apply(width, height, cfg, &prop)
+
+	dep := &gofpdf.Dependencies{
+		CellWriter: cellWriter,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.CreateCol(width, height, cfg, &prop)
+
+	// Assert
+	cellWriter.AssertNumberOfCalls(t, "Apply", 1)
+}
+
+func TestProvider_SetProtection(t *testing.T) {
+	t.Run("when protection is nil, should ignore protection", func(t *testing.T) {
+		// Act
+		dep := &gofpdf.Dependencies{}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.SetProtection(nil)
+	})
+	t.Run("when protection is valid, should apply protection", func(t *testing.T) {
+		// Arrange
+		p := &entity.Protection{
+			Type:          protection.Print,
+			UserPassword:  "userPassword",
+			OwnerPassword: "ownerPassword",
+		}
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().SetProtection(byte(p.Type), p.UserPassword, p.OwnerPassword)
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.SetProtection(p)
+
+		// Assert
+		fpdf.AssertNumberOfCalls(t, "SetProtection", 1)
+	})
 }
 
 func TestProvider_SetCompression(t *testing.T) {
@@ -583,7 +1424,9 @@ func TestProvider_SetMetadata(t *testing.T) {
 		fpdf.EXPECT().SetCreator("creator", true)
 		fpdf.EXPECT().SetSubject("subject", true)
 		fpdf.EXPECT().SetTitle("title", true)
+		fpdf.EXPECT().SetKeywords("keywords", true)
 		fpdf.EXPECT().SetCreationDate(timeNow)
+		fpdf.EXPECT().SetModificationDate(timeNow)
 
 		dep := &gofpdf.Dependencies{
 			Fpdf: fpdf,
@@ -608,7 +1451,12 @@ func TestProvider_SetMetadata(t *testing.T) {
 				Text: "title",
 				UTF8: true,
 			},
-			CreationDate: &timeNow,
+			Keywords: &entity.Utf8Text{
+				Text: "keywords",
+				UTF8: true,
+			},
+			CreationDate:     &timeNow,
+			ModificationDate: &timeNow,
 		})
 
 		// Assert
@@ -616,7 +1464,9 @@ func TestProvider_SetMetadata(t *testing.T) {
 		fpdf.AssertNumberOfCalls(t, "SetCreator", 1)
 		fpdf.AssertNumberOfCalls(t, "SetSubject", 1)
 		fpdf.AssertNumberOfCalls(t, "SetTitle", 1)
+		fpdf.AssertNumberOfCalls(t, "SetKeywords", 1)
 		fpdf.AssertNumberOfCalls(t, "SetCreationDate", 1)
+		fpdf.AssertNumberOfCalls(t, "SetModificationDate", 1)
 	})
 }
 
@@ -639,6 +1489,25 @@ func TestProvider_GenerateBytes(t *testing.T) {
 	fpdf.AssertNumberOfCalls(t, "Output", 1)
 }
 
+func TestProvider_GenerateTo(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().Output(&buffer).Return(nil)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	err := sut.GenerateTo(&buffer)
+
+	// Assert
+	assert.Nil(t, err)
+	fpdf.AssertNumberOfCalls(t, "Output", 1)
+}
+
 func TestProvider_AddImageFromBytes(t *testing.T) {
 	t.Run("when image is invalid, should apply message error", func(t *testing.T) {
 		// Arrange
@@ -740,6 +1609,149 @@ func TestProvider_AddImageFromBytes(t *testing.T) {
 	})
 }
 
+func TestProvider_DrawImage(t *testing.T) {
+	t.Run("when image cannot be encoded, should apply message error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not encode image", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Text: text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.DrawImage(goimage.NewRGBA(goimage.Rect(0, 0, 0, 0)), cell, &prop)
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when image can be encoded, should add it as png", func(t *testing.T) {
+		// Arrange
+		img := goimage.NewRGBA(goimage.Rect(0, 0, 2, 2))
+		img.Set(1, 1, color.RGBA{R: 255, A: 255})
+
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+		cfg := &entity.Config{
+			Margins: &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10},
+		}
+
+		var added *entity.Image
+		image := &mocks.Image{}
+		image.EXPECT().Add(mock.Anything, cell, cfg.Margins, &prop, extension.Png, false).
+			Run(func(img *entity.Image, _ *entity.Cell, _ *entity.Margins, _ *props.Rect, _ extension.Type, _ bool) {
+				added = img
+			}).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Image: image,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.DrawImage(img, cell, &prop)
+
+		// Assert
+		image.AssertNumberOfCalls(t, "Add", 1)
+		decoded, err := png.Decode(bytes.NewReader(added.Bytes))
+		assert.Nil(t, err)
+		assert.Equal(t, color.RGBA{R: 255, A: 255}, color.RGBAModel.Convert(decoded.At(1, 1)))
+		assert.Equal(t, extension.Png, added.Extension)
+	})
+}
+
+func TestProvider_AddTiledImageFromBytes(t *testing.T) {
+	t.Run("when image is invalid, should apply message error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not parse image bytes", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Text: text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddTiledImageFromBytes([]byte{1, 2, 3}, cell, &prop, "invalid")
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when image is valid but cannot add to document, should apply message error", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{
+			Bytes:     []byte{1, 2, 3},
+			Extension: extension.Png,
+		}
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+		cfg := &entity.Config{Margins: &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10}}
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not add image to document", cell, merror.DefaultErrorText)
+
+		image := &mocks.Image{}
+		image.EXPECT().AddTiled(img, cell, cfg.Margins, &prop, img.Extension).Return(errors.New("anyError"))
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().ClearError()
+
+		dep := &gofpdf.Dependencies{
+			Text:  text,
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddTiledImageFromBytes(img.Bytes, cell, &prop, img.Extension)
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+		image.AssertNumberOfCalls(t, "AddTiled", 1)
+		fpdf.AssertNumberOfCalls(t, "ClearError", 1)
+	})
+	t.Run("when image is valid and can add to document, should not apply", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{
+			Bytes:     []byte{1, 2, 3},
+			Extension: extension.Png,
+		}
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+		cfg := &entity.Config{Margins: &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10}}
+
+		image := &mocks.Image{}
+		image.EXPECT().AddTiled(img, cell, cfg.Margins, &prop, img.Extension).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Image: image,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddTiledImageFromBytes(img.Bytes, cell, &prop, img.Extension)
+
+		// Assert
+		image.AssertNumberOfCalls(t, "AddTiled", 1)
+	})
+}
+
 func TestProvider_AddBackgroundImageFromBytes(t *testing.T) {
 	t.Run("when image is invalid, should apply message error", func(t *testing.T) {
 		// Arrange
@@ -8,11 +8,15 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/whitespace"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
+// autoFontSizeStep is how much the font size is decreased on each attempt to fit a text.
+const autoFontSizeStep = 0.5
+
 type text struct {
 	pdf  gofpdfwrapper.Fpdf
 	math core.Math
@@ -30,9 +34,6 @@ func NewText(pdf gofpdfwrapper.Fpdf, math core.Math, font core.Font) *text {
 
 // Add a text inside a cell.
 func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
-	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
-	fontHeight := s.font.GetHeight(textProp.Family, textProp.Style, textProp.Size)
-
 	if textProp.Top > cell.Height {
 		textProp.Top = cell.Height
 	}
@@ -50,11 +51,28 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 		width = 0
 	}
 
+	if textProp.AutoFontSize {
+		textProp = s.fitFontSize(text, width, textProp)
+	}
+
+	if textProp.Rotate != 0 {
+		s.beginRotation(cell, textProp)
+		defer s.pdf.TransformEnd()
+	}
+
+	if textProp.Hyperlink != nil && textProp.UnderlineLink {
+		textProp = getUnderlinedProp(textProp)
+	}
+
+	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
+	fontHeight := s.font.GetHeight(textProp.Family, textProp.Style, textProp.Size)
+	lineHeight := textProp.GetLineHeight(fontHeight)
+
 	x := cell.X + textProp.Left
 	y := cell.Y + textProp.Top
 
 	originalColor := s.font.GetColor()
-	if textProp.Color != nil {
+	if !props.IsNilColor(textProp.Color) {
 		s.font.SetColor(textProp.Color)
 	}
 
@@ -67,38 +85,106 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 
 	// Apply Unicode before calc spaces
 	unicodeText := s.textToUnicode(text, textProp)
-	stringWidth := s.pdf.GetStringWidth(unicodeText)
-
-	// If should add one line
-	if stringWidth < width {
-		s.addLine(textProp, x, width, y, stringWidth, unicodeText)
-		if textProp.Color != nil {
+	// If should align segments to tab stops
+	if s.hasTabStops(unicodeText, textProp) {
+		s.addTabbedLine(textProp, cell.X, x, y, unicodeText)
+		if !props.IsNilColor(textProp.Color) {
 			s.font.SetColor(originalColor)
 		}
 		return
 	}
 
-	var lines []string
+	// The last line of a justified text is not stretched
+	lastLineProp := textProp
+	if textProp.Align == align.Justify {
+		lastLineProp = s.getLastLineProp(textProp)
+	}
 
-	if textProp.BreakLineStrategy == breakline.EmptySpaceStrategy {
-		words := strings.Split(unicodeText, " ")
-		lines = s.getLinesBreakingLineFromSpace(words, width)
-	} else {
-		lines = s.getLinesBreakingLineWithDash(unicodeText, width)
+	switch textProp.WhiteSpace {
+	case whitespace.NoWrap:
+		line := s.truncate(strings.ReplaceAll(unicodeText, "\n", " "), width)
+		s.addLine(lastLineProp, x, width, y, s.pdf.GetStringWidth(line), line)
+	case whitespace.Pre:
+		for _, paragraph := range strings.Split(unicodeText, "\n") {
+			lines := s.getLines(paragraph, width, textProp)
+			s.addLines(textProp, lastLineProp, x, width, y, lineHeight, lines)
+			y += float64(len(lines)) * (lineHeight + textProp.VerticalPadding)
+		}
+	default:
+		stringWidth := s.pdf.GetStringWidth(unicodeText)
+
+		// If should add one line
+		if stringWidth < width {
+			s.addLine(lastLineProp, x, width, y, stringWidth, unicodeText)
+			break
+		}
+
+		lines := s.breakLines(unicodeText, width, textProp)
+		s.addLines(textProp, lastLineProp, x, width, y, lineHeight, lines)
 	}
 
+	if !props.IsNilColor(textProp.Color) {
+		s.font.SetColor(originalColor)
+	}
+}
+
+// beginRotation rotates the text written until TransformEnd around the origin of the props in the cell.
+func (s *text) beginRotation(cell *entity.Cell, textProp *props.Text) {
+	left, top, _, _ := s.pdf.GetMargins()
+	x := left + cell.X + cell.Width*textProp.RotateOriginX
+	y := top + cell.Y + cell.Height*textProp.RotateOriginY
+
+	s.pdf.TransformBegin()
+	s.pdf.TransformRotate(textProp.Rotate, x, y)
+}
+
+// addLines writes the lines one below the other, lineHeight apart, justifying all of them but the last.
+func (s *text) addLines(textProp, lastLineProp *props.Text, x, width, y, lineHeight float64, lines []string) {
 	accumulateOffsetY := 0.0
 
 	for index, line := range lines {
 		lineWidth := s.pdf.GetStringWidth(line)
-
-		s.addLine(textProp, x, width, y+float64(index)*fontHeight+accumulateOffsetY, lineWidth, line)
+		lineY := y + float64(index)*lineHeight + accumulateOffsetY
+
+		switch {
+		case line == "":
+		case textProp.Align == align.Justify && index < len(lines)-1:
+			s.addJustifiedLine(textProp, x, width, lineY, line)
+		case index == len(lines)-1:
+			s.addLine(lastLineProp, x, width, lineY, lineWidth, line)
+		default:
+			s.addLine(textProp, x, width, lineY, lineWidth, line)
+		}
 		accumulateOffsetY += textProp.VerticalPadding
 	}
+}
 
-	if textProp.Color != nil {
-		s.font.SetColor(originalColor)
+// getLines returns the text in one line when it fits in the width, otherwise it breaks the text.
+func (s *text) getLines(text string, width float64, textProp *props.Text) []string {
+	if s.pdf.GetStringWidth(text) < width {
+		return []string{text}
 	}
+
+	return s.breakLines(text, width, textProp)
+}
+
+func (s *text) breakLines(text string, width float64, textProp *props.Text) []string {
+	if textProp.BreakLineStrategy == breakline.EmptySpaceStrategy {
+		words := strings.Split(text, " ")
+		return s.getLinesBreakingLineFromSpace(words, width)
+	}
+
+	return s.getLinesBreakingLineWithDash(text, width)
+}
+
+// truncate removes the last characters of the text until it fits in the width.
+func (s *text) truncate(text string, width float64) string {
+	runes := []rune(text)
+	for len(runes) > 0 && s.pdf.GetStringWidth(string(runes)) > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return string(runes)
 }
 
 // GetLinesQuantity retrieve the quantity of lines which a text will occupy to avoid that text to extrapolate a cell.
@@ -109,6 +195,24 @@ func (s *text) GetLinesQuantity(text string, textProp props.Text, colWidth float
 	// Apply Unicode.
 	textTranslated := translator(text)
 
+	// Text aligned to tab stops or without wrapping is always written in a single line.
+	if s.hasTabStops(textTranslated, &textProp) || textProp.WhiteSpace == whitespace.NoWrap {
+		return 1
+	}
+
+	if textProp.WhiteSpace == whitespace.Pre {
+		quantity := 0
+		for _, paragraph := range strings.Split(textTranslated, "\n") {
+			quantity += s.getLinesQuantity(paragraph, colWidth)
+		}
+
+		return quantity
+	}
+
+	return s.getLinesQuantity(textTranslated, colWidth)
+}
+
+func (s *text) getLinesQuantity(textTranslated string, colWidth float64) int {
 	stringWidth := s.pdf.GetStringWidth(textTranslated)
 	words := strings.Split(textTranslated, " ")
 
@@ -202,13 +306,127 @@ func (s *text) addLine(textProp *props.Text, xColOffset, colWidth, yColOffset, t
 	s.pdf.Text(dx+xColOffset+left, yColOffset+top, text)
 }
 
+// fitFontSize returns a copy of the props with the greatest size, from the text size down to
+// the min font size, where the text fits in one line.
+func (s *text) fitFontSize(text string, width float64, textProp *props.Text) *props.Text {
+	fitted := *textProp
+	unicodeText := s.textToUnicode(text, textProp)
+
+	for fitted.Size > fitted.MinFontSize {
+		s.font.SetFont(fitted.Family, fitted.Style, fitted.Size)
+		if s.pdf.GetStringWidth(unicodeText) < width {
+			break
+		}
+
+		fitted.Size -= autoFontSizeStep
+		if fitted.Size < fitted.MinFontSize {
+			fitted.Size = fitted.MinFontSize
+		}
+	}
+
+	return &fitted
+}
+
+// getUnderlinedProp returns a copy of the props with the "U" style of gofpdf, which underlines the texts
+// written while the font is set.
+func getUnderlinedProp(textProp *props.Text) *props.Text {
+	underlined := *textProp
+	if !strings.Contains(string(underlined.Style), "U") {
+		underlined.Style += "U"
+	}
+
+	return &underlined
+}
+
+func (s *text) getLastLineProp(textProp *props.Text) *props.Text {
+	lastLineProp := *textProp
+	lastLineProp.Align = textProp.AlignLastLine
+	if lastLineProp.Align == "" || lastLineProp.Align == align.Justify {
+		lastLineProp.Align = align.Left
+	}
+
+	return &lastLineProp
+}
+
+// addJustifiedLine distributes the remaining width of the line between its words.
+func (s *text) addJustifiedLine(textProp *props.Text, xColOffset, colWidth, yColOffset float64, line string) {
+	words := strings.Fields(line)
+	if len(words) < 2 {
+		lineWidth := s.pdf.GetStringWidth(line)
+		s.addLine(s.getLastLineProp(textProp), xColOffset, colWidth, yColOffset, lineWidth, line)
+		return
+	}
+
+	left, top, _, _ := s.pdf.GetMargins()
+
+	wordsWidth := 0.0
+	widths := make([]float64, len(words))
+	for i, word := range words {
+		widths[i] = s.pdf.GetStringWidth(word)
+		wordsWidth += widths[i]
+	}
+
+	gap := (colWidth - wordsWidth) / float64(len(words)-1)
+
+	cursor := xColOffset
+	for i, word := range words {
+		s.pdf.Text(cursor+left, yColOffset+top, word)
+		cursor += widths[i] + gap
+	}
+
+	if textProp.Hyperlink != nil {
+		fontHeight := s.font.GetHeight(textProp.Family, textProp.Style, textProp.Size)
+		s.pdf.LinkString(xColOffset+left, yColOffset+top-fontHeight, colWidth, fontHeight, *textProp.Hyperlink)
+	}
+}
+
+func (s *text) hasTabStops(text string, textProp *props.Text) bool {
+	return len(textProp.TabStops) > 0 && strings.Contains(text, "\t")
+}
+
+// addTabbedLine writes each tab separated segment at the next tab stop after the end of the previous segment.
+func (s *text) addTabbedLine(textProp *props.Text, cellX, xColOffset, yColOffset float64, text string) {
+	left, top, _, _ := s.pdf.GetMargins()
+	spaceWidth := s.pdf.GetStringWidth(" ")
+
+	cursor := xColOffset
+	for index, segment := range strings.Split(text, "\t") {
+		if index > 0 {
+			cursor = nextTabStop(textProp.TabStops, cellX, cursor, spaceWidth)
+		}
+
+		if segment == "" {
+			continue
+		}
+
+		s.pdf.Text(cursor+left, yColOffset+top, segment)
+		cursor += s.pdf.GetStringWidth(segment)
+	}
+}
+
+// nextTabStop returns the first tab stop after the cursor, when there is none the cursor moves one space.
+func nextTabStop(tabStops []float64, cellX, cursor, spaceWidth float64) float64 {
+	for _, tabStop := range tabStops {
+		if cellX+tabStop > cursor {
+			return cellX + tabStop
+		}
+	}
+
+	return cursor + spaceWidth
+}
+
 func (s *text) textToUnicode(txt string, props *props.Text) string {
-	if props.Family == fontfamily.Arial ||
-		props.Family == fontfamily.Helvetica ||
-		props.Family == fontfamily.Symbol ||
-		props.Family == fontfamily.ZapBats ||
-		props.Family == fontfamily.Courier {
-		translator := s.pdf.UnicodeTranslatorFromDescriptor("")
+	return toUnicode(s.pdf, txt, props.Family)
+}
+
+// toUnicode translates the text to the encoding of the standard fonts, the custom fonts are UTF-8.
+func toUnicode(pdf gofpdfwrapper.Fpdf, txt string, family string) string {
+	if family == fontfamily.Arial ||
+		family == fontfamily.Helvetica ||
+		family == fontfamily.Symbol ||
+		family == fontfamily.ZapBats ||
+		family == fontfamily.Courier {
+		translator := pdf.UnicodeTranslatorFromDescriptor("")
 		return translator(txt)
 	}
 
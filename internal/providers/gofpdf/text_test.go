@@ -7,7 +7,15 @@ import (
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
 
 	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/whitespace"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewText(t *testing.T) {
@@ -17,6 +25,449 @@ func TestNewText(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("%T", text), "*gofpdf.text")
 }
 
+func TestText_Add_WhenHasTabStops(t *testing.T) {
+	t.Run("when text has tabs, should write each segment at the next tab stop", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 5, Y: 10, Width: 100, Height: 20}
+		prop := &props.Text{Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, TabStops: []float64{30, 60}}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, 10.0).Return(4.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().GetStringWidth(" ").Return(1.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).Return(10.0)
+		pdf.EXPECT().Text(15.0, 24.0, "Description")
+		pdf.EXPECT().Text(75.0, 24.0, "Amount")
+		pdf.EXPECT().Text(86.0, 24.0, "Tax")
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("Description\t\tAmount\tTax", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 3)
+	})
+}
+
+func TestText_Add_WhenIsJustified(t *testing.T) {
+	t.Run("when text breaks lines, should stretch all lines but the last", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 10, Height: 20}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10,
+			Align: align.Justify, BreakLineStrategy: breakline.EmptySpaceStrategy,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, 10.0).Return(2.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(0.0, 0.0, 0.0, 0.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).RunAndReturn(func(value string) float64 {
+			return float64(len(value))
+		})
+		pdf.EXPECT().Text(0.0, 2.0, "aa")
+		pdf.EXPECT().Text(4.0, 2.0, "bb")
+		pdf.EXPECT().Text(8.0, 2.0, "cc")
+		pdf.EXPECT().Text(0.0, 4.0, "dd ee ")
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("aa bb cc dd ee", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 4)
+	})
+	t.Run("when last line align is right, should align the last line to the right", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 10, Height: 20}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10,
+			Align: align.Justify, AlignLastLine: align.Right,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, 10.0).Return(2.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(0.0, 0.0, 0.0, 0.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).RunAndReturn(func(value string) float64 {
+			return float64(len(value))
+		})
+		pdf.EXPECT().Text(5.0, 2.0, "aa bb")
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("aa bb", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 1)
+	})
+}
+
+func TestText_Add_WhenAutoFontSize(t *testing.T) {
+	autoFontSizeStep := 0.5
+
+	cases := []struct {
+		name         string
+		minFontSize  float64
+		expectedSize float64
+	}{
+		{"when text does not fit, should shrink until the text fits in one line", 6, 7},
+		{"when text does not fit even with min font size, should use min font size", 8, 8},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// Arrange
+			cell := &entity.Cell{Width: 15, Height: 20}
+			prop := &props.Text{
+				Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, Align: align.Left,
+				BreakLineStrategy: breakline.EmptySpaceStrategy, AutoFontSize: true, MinFontSize: c.minFontSize,
+			}
+
+			currentSize := 0.0
+			font := mocks.NewFont(t)
+			font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, mock.Anything).Run(func(_ string, _ fontstyle.Type, size float64) {
+				currentSize = size
+			})
+			font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, c.expectedSize).Return(2.0)
+			font.EXPECT().GetColor().Return(&props.BlackColor)
+
+			pdf := mocks.NewFpdf(t)
+			pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+			pdf.EXPECT().GetMargins().Return(0.0, 0.0, 0.0, 0.0)
+			pdf.EXPECT().GetStringWidth(mock.Anything).RunAndReturn(func(string) float64 {
+				return currentSize * 2
+			})
+			pdf.EXPECT().Text(mock.Anything, mock.Anything, mock.Anything)
+
+			sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+			// Act
+			sut.Add("word", cell, prop)
+
+			// Assert
+			font.AssertCalled(t, "SetFont", fontfamily.Arial, fontstyle.Normal, c.expectedSize)
+			font.AssertNotCalled(t, "SetFont", fontfamily.Arial, fontstyle.Normal, c.expectedSize-autoFontSizeStep)
+		})
+	}
+}
+
+func TestText_Add_WhenHasHyperlink(t *testing.T) {
+	t.Run("when underline link is true, should write with the underline style", func(t *testing.T) {
+		// Arrange
+		link := "https://maroto.io"
+		cell := &entity.Cell{Width: 100, Height: 20}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Style: fontstyle.Bold, Size: 10, Align: align.Left,
+			Hyperlink: &link, UnderlineLink: true,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Type("BU"), 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type("BU"), 10.0).Return(4.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+		font.EXPECT().SetColor(&props.BlueColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).Return(12.0)
+		pdf.EXPECT().Text(10.0, 14.0, "maroto")
+		pdf.EXPECT().LinkString(10.0, 10.0, 12.0, 4.0, link)
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("maroto", cell, prop)
+
+		// Assert
+		assert.Equal(t, fontstyle.Bold, prop.Style)
+		pdf.AssertNumberOfCalls(t, "LinkString", 1)
+	})
+	t.Run("when underline link is false, should keep the style", func(t *testing.T) {
+		// Arrange
+		link := "https://maroto.io"
+		cell := &entity.Cell{Width: 100, Height: 20}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Style: fontstyle.Bold, Size: 10, Align: align.Left,
+			Hyperlink: &link,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Bold, 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Bold, 10.0).Return(4.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+		font.EXPECT().SetColor(&props.BlueColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).Return(12.0)
+		pdf.EXPECT().Text(10.0, 14.0, "maroto")
+		pdf.EXPECT().LinkString(10.0, 10.0, 12.0, 4.0, link)
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("maroto", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "LinkString", 1)
+	})
+}
+
+func TestText_Add_WhenRotated(t *testing.T) {
+	// Arrange
+	cell := &entity.Cell{X: 5, Y: 10, Width: 100, Height: 20}
+	prop := &props.Text{
+		Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, Align: align.Left,
+		Rotate: 90, RotateOriginX: 0.5, RotateOriginY: 1,
+	}
+
+	font := mocks.NewFont(t)
+	font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+	font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, 10.0).Return(4.0)
+	font.EXPECT().GetColor().Return(&props.BlackColor)
+
+	pdf := mocks.NewFpdf(t)
+	pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+	pdf.EXPECT().TransformBegin()
+	pdf.EXPECT().TransformRotate(90.0, 65.0, 40.0)
+	pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+	pdf.EXPECT().GetStringWidth(mock.Anything).Return(12.0)
+	pdf.EXPECT().Text(15.0, 24.0, "maroto")
+	pdf.EXPECT().TransformEnd()
+
+	sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+	// Act
+	sut.Add("maroto", cell, prop)
+
+	// Assert
+	pdf.AssertCalled(t, "TransformRotate", 90.0, 65.0, 40.0)
+	pdf.AssertNumberOfCalls(t, "TransformEnd", 1)
+}
+
+func TestText_Add_WhenWhiteSpace(t *testing.T) {
+	t.Run("when white space is no wrap, should truncate the text in one line", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 20, Height: 20}
+		prop := &props.Text{Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, Align: align.Left, WhiteSpace: whitespace.NoWrap}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, 10.0).Return(4.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).RunAndReturn(func(value string) float64 { return float64(len(value)) })
+		pdf.EXPECT().Text(10.0, 14.0, "the quick brown fox ")
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("the quick brown fox\njumps over the lazy dog", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 1)
+	})
+	t.Run("when white space is pre, should break lines on line breaks", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 100, Height: 20}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, Align: align.Left,
+			BreakLineStrategy: breakline.EmptySpaceStrategy, WhiteSpace: whitespace.Pre,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, 10.0).Return(4.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).RunAndReturn(func(value string) float64 { return float64(len(value)) })
+		pdf.EXPECT().Text(10.0, 14.0, "Name: John")
+		pdf.EXPECT().Text(10.0, 22.0, "City: Springfield")
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("Name: John\n\nCity: Springfield", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 2)
+	})
+	t.Run("when white space is pre, should still wrap long lines", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 10, Height: 20}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, Align: align.Left,
+			BreakLineStrategy: breakline.EmptySpaceStrategy, WhiteSpace: whitespace.Pre,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, 10.0).Return(4.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).RunAndReturn(func(value string) float64 { return float64(len(value)) })
+		pdf.EXPECT().Text(10.0, 14.0, "hello ")
+		pdf.EXPECT().Text(10.0, 18.0, "world ")
+		pdf.EXPECT().Text(10.0, 22.0, "bye")
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("hello world\nbye", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 3)
+	})
+}
+
+func TestText_Add_WhenHasLineHeight(t *testing.T) {
+	t.Run("when line height is defined, should write the lines line height times the font height apart", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 10, Height: 20}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, Align: align.Left,
+			BreakLineStrategy: breakline.EmptySpaceStrategy, LineHeight: 1.5,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, 10.0).Return(4.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).RunAndReturn(func(value string) float64 { return float64(len(value)) })
+		pdf.EXPECT().Text(10.0, 14.0, "hello ")
+		pdf.EXPECT().Text(10.0, 20.0, "world ")
+		pdf.EXPECT().Text(10.0, 26.0, "bye ")
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("hello world bye", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 3)
+	})
+	t.Run("when line height is not defined, should write the lines one font height apart", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 10, Height: 20}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, Align: align.Left,
+			BreakLineStrategy: breakline.EmptySpaceStrategy,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Normal, 10.0).Return(4.0)
+		font.EXPECT().GetColor().Return(&props.BlackColor)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+		pdf.EXPECT().GetStringWidth(mock.Anything).RunAndReturn(func(value string) float64 { return float64(len(value)) })
+		pdf.EXPECT().Text(10.0, 14.0, "hello ")
+		pdf.EXPECT().Text(10.0, 18.0, "world ")
+		pdf.EXPECT().Text(10.0, 22.0, "bye ")
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		sut.Add("hello world bye", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 3)
+	})
+}
+
+func TestText_GetLinesQuantity_WhenWhiteSpace(t *testing.T) {
+	t.Run("when white space is no wrap, should return one line", func(t *testing.T) {
+		// Arrange
+		prop := props.Text{Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, WhiteSpace: whitespace.NoWrap}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		lines := sut.GetLinesQuantity("a very long text\nwith line breaks", prop, 5)
+
+		// Assert
+		assert.Equal(t, 1, lines)
+	})
+	t.Run("when white space is pre, should count the lines of each paragraph", func(t *testing.T) {
+		// Arrange
+		prop := props.Text{Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, WhiteSpace: whitespace.Pre}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetStringWidth(mock.Anything).RunAndReturn(func(value string) float64 { return float64(len(value)) })
+
+		sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+		// Act
+		lines := sut.GetLinesQuantity("hello world\nbye\nok", prop, 8)
+
+		// Assert
+		assert.Equal(t, 4, lines)
+	})
+}
+
+func TestText_GetLinesQuantity_WhenHasTabStops(t *testing.T) {
+	// Arrange
+	prop := props.Text{Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, TabStops: []float64{30}}
+
+	font := mocks.NewFont(t)
+	font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Normal, 10.0)
+
+	pdf := mocks.NewFpdf(t)
+	pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+
+	sut := gofpdf.NewText(pdf, &mocks.Math{}, font)
+
+	// Act
+	lines := sut.GetLinesQuantity("Very long description\tAmount", prop, 5)
+
+	// Assert
+	assert.Equal(t, 1, lines)
+}
+
 /*func TestText_GetLinesQuantity_WhenStringSmallerThanLimits(t *testing.T) {
 	// Arrange
 	pdf := &mocks.Fpdf{}
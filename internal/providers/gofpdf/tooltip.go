@@ -0,0 +1,56 @@
+package gofpdf
+
+import (
+	"fmt"
+
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+)
+
+// tooltipArea is a region of a page which shows a text when the mouse is over it.
+type tooltipArea struct {
+	tooltip string
+	page    int
+	rect    [4]float64
+}
+
+// addTooltipAreas writes each tooltip area as a transparent push button widget, the viewers
+// show its alternate field name as the tooltip.
+func addTooltipAreas(ctx *model.Context, areas []tooltipArea) error {
+	fields, err := getFormFields(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, area := range areas {
+		name := fmt.Sprintf("tooltip_%d", i+1)
+		err = addWidget(ctx, fields, area.page, func(pageRef types.IndirectRef) (types.Dict, error) {
+			return newTooltipWidget(name, area, pageRef)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newTooltipWidget(name string, area tooltipArea, pageRef types.IndirectRef) (types.Dict, error) {
+	tooltip, err := types.EscapeUTF16String(area.tooltip)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Dict{
+		"Type":    types.Name("Annot"),
+		"Subtype": types.Name("Widget"),
+		"FT":      types.Name("Btn"),
+		"Ff":      types.Integer(pushButtonFlag),
+		"T":       types.StringLiteral(name),
+		"TU":      types.StringLiteral(*tooltip),
+		"F":       types.Integer(model.AnnPrint),
+		"P":       pageRef,
+		"Rect":    types.NewNumberArray(area.rect[0], area.rect[1], area.rect[2], area.rect[3]),
+		"MK":      types.Dict{},
+	}, nil
+}
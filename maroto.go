@@ -2,6 +2,9 @@ package maroto
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"slices"
 
 	"github.com/johnfercher/maroto/v2/internal/cache"
 
@@ -15,13 +18,12 @@ import (
 
 	"github.com/johnfercher/maroto/v2/pkg/props"
 
-	"github.com/f-amaral/go-async/async"
-	"github.com/f-amaral/go-async/pool"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/page"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/config"
 	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/pool"
 )
 
 type maroto struct {
@@ -31,6 +33,7 @@ type maroto struct {
 
 	// Building
 	cell          entity.Cell
+	document      []documentRow
 	pages         []core.Page
 	rows          []core.Row
 	header        []core.Row
@@ -38,9 +41,28 @@ type maroto struct {
 	headerHeight  float64
 	footerHeight  float64
 	currentHeight float64
+	bookmarks     []pendingBookmark
+}
+
+// pendingBookmark is a bookmark added with AddBookmark, waiting for the next row.
+type pendingBookmark struct {
+	title string
+	level int
+}
 
-	// Processing
-	pool async.Processor[[]core.Page, []byte]
+// pageGroup is the result of a group of pages generated concurrently.
+type pageGroup struct {
+	bytes     []byte
+	bookmarks []entity.Bookmark
+	errors    []core.PageError
+}
+
+// documentRow is a row added to the document or, when newPage is true, the
+// beginning of a page added with AddPages, which has no row and is kept by
+// the pagination even when the page is empty.
+type documentRow struct {
+	row     core.Row
+	newPage bool
 }
 
 // New is responsible for create a new instance of core.Maroto.
@@ -63,11 +85,10 @@ func New(cfgs ...*entity.Config) core.Maroto {
 		config: cfg,
 	}
 
-	if cfg.WorkersQuantity > 0 {
-		p := pool.NewPool[[]core.Page, []byte](cfg.WorkersQuantity, m.processPage,
-			pool.WithSortingOutput[[]core.Page, []byte]())
-		m.pool = p
-	}
+	m.header, m.headerHeight = getConfigRows(cfg.Header)
+	m.footer, m.footerHeight = getConfigRows(cfg.Footer)
+	m.addHeader()
+
 	return m
 }
 
@@ -78,11 +99,15 @@ func New(cfgs ...*entity.Config) core.Maroto {
 // that page in more than one.
 func (m *maroto) AddPages(pages ...core.Page) {
 	for _, page := range pages {
-		if m.currentHeight != m.headerHeight {
-			m.fillPageToAddNew()
-			m.addHeader()
+		rows := page.GetRows()
+		if len(rows) > 0 {
+			m.attachBookmarks(rows[0])
+		}
+		m.document = append(m.document, documentRow{newPage: true})
+		for _, r := range rows {
+			m.document = append(m.document, documentRow{row: r})
 		}
-		m.addRows(page.GetRows()...)
+		m.addPage(rows...)
 	}
 }
 
@@ -92,6 +117,7 @@ func (m *maroto) AddPages(pages ...core.Page) {
 // PageSize, PageMargin, FooterSize and HeaderSize to calculate the useful
 // area of a page.
 func (m *maroto) AddRows(rows ...core.Row) {
+	m.appendDocument(rows...)
 	m.addRows(rows...)
 }
 
@@ -102,15 +128,58 @@ func (m *maroto) AddRows(rows ...core.Row) {
 // area of a page.
 func (m *maroto) AddRow(rowHeight float64, cols ...core.Col) core.Row {
 	r := row.New(rowHeight).Add(cols...)
+	m.appendDocument(r)
 	m.addRow(r)
 	return r
 }
 
+// AddBookmark is responsible for add an entry to the outline of the document, level 0 is a
+// root entry and higher levels are children of the previous lower level. The entry points
+// to the page and position of the next row added to the document, so it follows the row
+// when it's moved to a new page. When no row is added after it, an empty row is added at
+// the end of the document.
+func (m *maroto) AddBookmark(title string, level int) {
+	m.bookmarks = append(m.bookmarks, pendingBookmark{title: title, level: level})
+}
+
+// InsertAt is responsible for insert rows at a position of the document,
+// the position is the 0-indexed position of the rows added to the document,
+// the rows are inserted before the row at the position. Negative positions
+// count from the end, -1 inserts after the last row and -2 before it.
+// By inserting rows, maroto will paginate the whole document again, keeping
+// the pages added with AddPages. If the position is out of range, the method
+// will return an error.
+func (m *maroto) InsertAt(position int, rows ...core.Row) error {
+	count := m.getDocumentRowsQuantity()
+	if position < 0 {
+		position += count + 1
+	}
+
+	if position < 0 || position > count {
+		return errors.New("position is out of document range")
+	}
+
+	inserted := make([]documentRow, len(rows))
+	for i, r := range rows {
+		inserted[i] = documentRow{row: r}
+	}
+
+	m.document = slices.Insert(m.document, m.getDocumentIndex(position), inserted...)
+	m.paginate()
+
+	return nil
+}
+
 // RegisterHeader is responsible to define a set of rows as a header
 // of the document. The header will appear in every new page of the document.
 // The header cannot occupy an area greater than the useful area of the page,
-// it this case the method will return an error.
+// it this case the method will return an error. It also returns an error when the
+// header is already defined by the config WithHeader, as the header would be written twice.
 func (m *maroto) RegisterHeader(rows ...core.Row) error {
+	if len(m.config.Header) > 0 {
+		return errors.New("header is already defined by the config")
+	}
+
 	height := m.getRowsHeight(rows...)
 	if height+m.footerHeight > m.config.Dimensions.Height {
 		return errors.New("header height is greater than page useful area")
@@ -119,6 +188,10 @@ func (m *maroto) RegisterHeader(rows ...core.Row) error {
 	m.headerHeight = height
 	m.header = rows
 
+	if m.getHeaderHeight() == 0 {
+		return nil
+	}
+
 	for _, headerRow := range rows {
 		m.addRow(headerRow)
 	}
@@ -129,8 +202,13 @@ func (m *maroto) RegisterHeader(rows ...core.Row) error {
 // RegisterFooter is responsible to define a set of rows as a footer
 // of the document. The footer will appear in every new page of the document.
 // The footer cannot occupy an area greater than the useful area of the page,
-// it this case the method will return an error.
+// it this case the method will return an error. It also returns an error when the
+// footer is already defined by the config WithFooter.
 func (m *maroto) RegisterFooter(rows ...core.Row) error {
+	if len(m.config.Footer) > 0 {
+		return errors.New("footer is already defined by the config")
+	}
+
 	height := m.getRowsHeight(rows...)
 	if height > m.config.Dimensions.Height {
 		return errors.New("footer height is greater than page useful area")
@@ -143,13 +221,10 @@ func (m *maroto) RegisterFooter(rows ...core.Row) error {
 
 // Generate is responsible to compute the component tree created by
 // the usage of all other Maroto methods, and generate the PDF document.
+// When the rendering of a page panics, the document is still generated and the
+// page is reported on Document.Errors, sequentially or concurrently.
 func (m *maroto) Generate() (core.Document, error) {
-	m.provider.SetProtection(m.config.Protection)
-	m.provider.SetCompression(m.config.Compression)
-	m.provider.SetMetadata(m.config.Metadata)
-
-	m.fillPageToAddNew()
-	m.setConfig()
+	m.prepareGeneration()
 
 	if m.config.WorkersQuantity > 0 {
 		return m.generateConcurrently()
@@ -158,10 +233,42 @@ func (m *maroto) Generate() (core.Document, error) {
 	return m.generate()
 }
 
+// GenerateTo is responsible to compute the component tree like Generate, but writes
+// the PDF document to w. When the document is generated sequentially, the output of the provider
+// is written to w without being buffered. When it's generated concurrently, the groups of pages
+// must be merged in memory, so the merged document is written to w. The errors of the pages
+// are joined and returned after the document is written.
+func (m *maroto) GenerateTo(w io.Writer) error {
+	m.prepareGeneration()
+
+	if m.config.WorkersQuantity > 0 {
+		document, err := m.generateConcurrently()
+		if err != nil {
+			return err
+		}
+
+		if _, err = w.Write(document.GetBytes()); err != nil {
+			return err
+		}
+
+		return joinPageErrors(document.Errors())
+	}
+
+	pageErrors := m.renderPages(m.provider, m.pages, 0, 0)
+	if err := m.provider.GenerateTo(w); err != nil {
+		return err
+	}
+
+	return joinPageErrors(pageErrors)
+}
+
 // GetStructure is responsible for return the component tree, this is useful
-// on unit tests cases.
+// on unit tests cases. The last page is built on a copy, so the document is not
+// changed and can still receive rows and be generated.
 func (m *maroto) GetStructure() *node.Node[core.Structure] {
-	m.fillPageToAddNew()
+	snapshot := *m
+	snapshot.addPendingBookmarks()
+	snapshot.fillPageToAddNew()
 
 	str := core.Structure{
 		Type:    "maroto",
@@ -169,7 +276,7 @@ func (m *maroto) GetStructure() *node.Node[core.Structure] {
 	}
 	node := node.New(str)
 
-	for _, p := range m.pages {
+	for _, p := range snapshot.pages {
 		inner := p.GetStructure()
 		node.AddNext(inner)
 	}
@@ -177,6 +284,87 @@ func (m *maroto) GetStructure() *node.Node[core.Structure] {
 	return node
 }
 
+func (m *maroto) appendDocument(rows ...core.Row) {
+	if len(rows) > 0 {
+		m.attachBookmarks(rows[0])
+	}
+
+	for _, r := range rows {
+		m.document = append(m.document, documentRow{row: r})
+	}
+}
+
+// attachBookmarks adds the bookmarks waiting for a row to r.
+func (m *maroto) attachBookmarks(r core.Row) {
+	for _, b := range m.bookmarks {
+		r.WithBookmark(b.title, b.level)
+	}
+
+	m.bookmarks = nil
+}
+
+// addPendingBookmarks adds the bookmarks not followed by any row in an empty row.
+func (m *maroto) addPendingBookmarks() {
+	if len(m.bookmarks) == 0 {
+		return
+	}
+
+	m.AddRows(row.New(0))
+}
+
+func (m *maroto) paginate() {
+	m.pages = nil
+	m.rows = nil
+	m.currentHeight = 0
+
+	m.addHeader()
+
+	for _, documentRow := range m.document {
+		if documentRow.newPage {
+			m.addPage()
+			continue
+		}
+		m.addRow(documentRow.row)
+	}
+}
+
+// getDocumentRowsQuantity returns the quantity of rows of the document, without the beginnings of pages.
+func (m *maroto) getDocumentRowsQuantity() int {
+	quantity := 0
+	for _, documentRow := range m.document {
+		if !documentRow.newPage {
+			quantity++
+		}
+	}
+
+	return quantity
+}
+
+// getDocumentIndex returns the index, in the document, of the row at the position, after the beginning
+// of its page, or the end of the document when the position is the quantity of rows.
+func (m *maroto) getDocumentIndex(position int) int {
+	for i, documentRow := range m.document {
+		if documentRow.newPage {
+			continue
+		}
+
+		if position == 0 {
+			return i
+		}
+		position--
+	}
+
+	return len(m.document)
+}
+
+func (m *maroto) addPage(rows ...core.Row) {
+	if m.currentHeight != m.getHeaderHeight() {
+		m.fillPageToAddNew()
+		m.addHeader()
+	}
+	m.addRows(rows...)
+}
+
 func (m *maroto) addRows(rows ...core.Row) {
 	for _, row := range rows {
 		m.addRow(row)
@@ -184,10 +372,15 @@ func (m *maroto) addRows(rows ...core.Row) {
 }
 
 func (m *maroto) addRow(r core.Row) {
+	if flowRow, ok := r.(core.FlowRow); ok {
+		m.addFlowRow(flowRow)
+		return
+	}
+
 	maxHeight := m.cell.Height
 
 	rowHeight := r.GetHeight()
-	sumHeight := rowHeight + m.currentHeight + m.footerHeight
+	sumHeight := rowHeight + m.currentHeight + m.getFooterHeight()
 
 	// Row smaller than the remain space on page
 	if sumHeight < maxHeight {
@@ -207,7 +400,36 @@ func (m *maroto) addRow(r core.Row) {
 	m.rows = append(m.rows, r)
 }
 
+// addFlowRow adds the rows the flow row is split in, each one with the space left on a page. The first part is
+// moved to the next page when not even its first line fits in the space left on the current one.
+func (m *maroto) addFlowRow(r core.FlowRow) {
+	for r != nil {
+		space := m.cell.Height - m.currentHeight - m.getFooterHeight()
+		part, rest := r.Split(space, m.cell.Width, m.config)
+
+		if part.GetHeight() > space && m.currentHeight != m.getHeaderHeight() {
+			m.fillPageToAddNew()
+			m.addHeader()
+			continue
+		}
+
+		m.currentHeight += part.GetHeight()
+		m.rows = append(m.rows, part)
+
+		if rest != nil {
+			m.fillPageToAddNew()
+			m.addHeader()
+		}
+
+		r = rest
+	}
+}
+
 func (m *maroto) addHeader() {
+	if m.isFirstPageSkipped() {
+		return
+	}
+
 	for _, headerRow := range m.header {
 		m.currentHeight += headerRow.GetHeight()
 		m.rows = append(m.rows, headerRow)
@@ -215,14 +437,16 @@ func (m *maroto) addHeader() {
 }
 
 func (m *maroto) fillPageToAddNew() {
-	space := m.cell.Height - m.currentHeight - m.footerHeight
+	space := m.cell.Height - m.currentHeight - m.getFooterHeight()
 
 	c := col.New(m.config.MaxGridSize)
 	spaceRow := row.New(space)
 	spaceRow.Add(c)
 
 	m.rows = append(m.rows, spaceRow)
-	m.rows = append(m.rows, m.footer...)
+	if !m.isFirstPageSkipped() {
+		m.rows = append(m.rows, m.footer...)
+	}
 
 	prop := props.Page{
 		Pattern: m.config.PageNumberPattern,
@@ -232,6 +456,13 @@ func (m *maroto) fillPageToAddNew() {
 		Size:    m.config.DefaultFont.Size,
 		Color:   m.config.DefaultFont.Color,
 	}
+
+	if m.config.AutoNumberPages && prop.Pattern == "" {
+		prop.Pattern = props.AutoPageNumberPattern
+		prop.Place = props.Bottom
+		prop.Size = props.AutoPageNumberSize
+	}
+
 	p := page.New(prop)
 	p.Add(m.rows...)
 
@@ -240,33 +471,95 @@ func (m *maroto) fillPageToAddNew() {
 	m.currentHeight = 0
 }
 
+// isFirstPageSkipped returns true when the page being built is the first one and it has no header and footer.
+func (m *maroto) isFirstPageSkipped() bool {
+	return m.config.SkipFirstPageHeaderFooter && len(m.pages) == 0
+}
+
+// getHeaderHeight returns the height of the header on the page being built.
+func (m *maroto) getHeaderHeight() float64 {
+	if m.isFirstPageSkipped() {
+		return 0
+	}
+
+	return m.headerHeight
+}
+
+// getFooterHeight returns the height of the footer on the page being built.
+func (m *maroto) getFooterHeight() float64 {
+	if m.isFirstPageSkipped() {
+		return 0
+	}
+
+	return m.footerHeight
+}
+
 func (m *maroto) setConfig() {
+	offset := 0
+	if m.config.PageNumberOffset > 0 {
+		offset = m.config.PageNumberOffset - 1
+	}
+
 	for i, page := range m.pages {
 		page.SetConfig(m.config)
-		page.SetNumber(i+1, len(m.pages))
+		page.SetNumber(i+1+offset, len(m.pages)+offset)
 	}
 }
 
-func (m *maroto) generate() (core.Document, error) {
+func (m *maroto) prepareGeneration() {
+	m.provider.SetProtection(m.config.Protection)
+	m.provider.SetCompression(m.config.Compression)
+	m.provider.SetMetadata(m.config.Metadata)
+
+	m.addPendingBookmarks()
+	m.fillPageToAddNew()
+	m.setConfig()
+}
+
+// renderPages renders the pages into the provider, the first one is the page firstIndex of the document.
+// The pages whose rendering panics are returned as errors of the worker.
+func (m *maroto) renderPages(provider core.Provider, pages []core.Page, firstIndex, worker int) []core.PageError {
 	innerCtx := m.cell.Copy()
 
-	for _, page := range m.pages {
-		page.Render(m.provider, innerCtx)
+	var pageErrors []core.PageError
+	for i, page := range pages {
+		if err := renderPage(provider, page, innerCtx); err != nil {
+			pageErrors = append(pageErrors, core.PageError{PageIndex: firstIndex + i, Worker: worker, Err: err})
+		}
 	}
 
+	return pageErrors
+}
+
+func renderPage(provider core.Provider, page core.Page, cell entity.Cell) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", core.ErrPageRender, r)
+		}
+	}()
+
+	page.Render(provider, cell)
+	return nil
+}
+
+func (m *maroto) generate() (core.Document, error) {
+	pageErrors := m.renderPages(m.provider, m.pages, 0, 0)
+
 	documentBytes, err := m.provider.GenerateBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	return core.NewPDF(documentBytes, nil), nil
+	return core.NewPDFWithErrors(documentBytes, nil, pageErrors, m.provider.GetBookmarks()...), nil
 }
 
 func (m *maroto) generateConcurrently() (core.Document, error) {
-	chunks := len(m.pages) / m.config.WorkersQuantity
-	if chunks == 0 {
-		chunks = 1
+	strategy := m.config.WorkersStrategy
+	if strategy == nil {
+		strategy = pool.FIFO
 	}
+
+	chunks := strategy.GetTaskSize(len(m.pages), m.config.WorkersQuantity)
 	pageGroups := make([][]core.Page, 0)
 	for i := 0; i < len(m.pages); i += chunks {
 		end := i + chunks
@@ -278,15 +571,32 @@ func (m *maroto) generateConcurrently() (core.Document, error) {
 		pageGroups = append(pageGroups, m.pages[i:end])
 	}
 
-	processed := m.pool.Process(pageGroups)
-	if processed.HasError {
-		return nil, errors.New("an error has occurred while trying to generate PDFs concurrently")
+	groups := make([]*pageGroup, len(pageGroups))
+	errs := make([]error, len(pageGroups))
+	strategy.Run(m.config.WorkersQuantity, len(pageGroups), func(worker, task int) {
+		groups[task], errs[task] = m.processPage(pageGroups[task], task*chunks, worker)
+	})
+
+	var pageErrors []core.PageError
+	for _, group := range groups {
+		pageErrors = append(pageErrors, group.errors...)
 	}
 
-	pdfs := make([][]byte, len(processed.Results))
-	for i, result := range processed.Results {
-		bytes := result.Output.([]byte)
-		pdfs[i] = bytes
+	for _, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("an error has occurred while trying to generate PDFs concurrently: %w",
+				joinPageErrors(pageErrors))
+		}
+	}
+
+	pdfs := make([][]byte, len(groups))
+	var bookmarks []entity.Bookmark
+	for i, group := range groups {
+		pdfs[i] = group.bytes
+		for _, bookmark := range group.bookmarks {
+			bookmark.Page += i * chunks
+			bookmarks = append(bookmarks, bookmark)
+		}
 	}
 
 	mergedBytes, err := merge.Bytes(pdfs...)
@@ -294,18 +604,40 @@ func (m *maroto) generateConcurrently() (core.Document, error) {
 		return nil, err
 	}
 
-	return core.NewPDF(mergedBytes, nil), nil
+	return core.NewPDFWithErrors(mergedBytes, nil, pageErrors, bookmarks...), nil
 }
 
-func (m *maroto) processPage(pages []core.Page) ([]byte, error) {
-	innerCtx := m.cell.Copy()
-
+// processPage generates a group of pages, the first one is the page firstIndex of the document. When
+// the group cannot be generated, the error is returned and reported on each of its pages.
+func (m *maroto) processPage(pages []core.Page, firstIndex, worker int) (*pageGroup, error) {
 	innerProvider := getProvider(cache.NewMutexDecorator(cache.New()), m.config)
-	for _, page := range pages {
-		page.Render(innerProvider, innerCtx)
+	pageErrors := m.renderPages(innerProvider, pages, firstIndex, worker)
+
+	bytes, err := innerProvider.GenerateBytes()
+	if err != nil {
+		pageErrors = pageErrors[:0]
+		for i := range pages {
+			pageErrors = append(pageErrors, core.PageError{PageIndex: firstIndex + i, Worker: worker, Err: err})
+		}
+
+		return &pageGroup{errors: pageErrors}, err
 	}
 
-	return innerProvider.GenerateBytes()
+	return &pageGroup{
+		bytes:     bytes,
+		bookmarks: innerProvider.GetBookmarks(),
+		errors:    pageErrors,
+	}, nil
+}
+
+// joinPageErrors returns the errors joined, nil when there are no errors.
+func joinPageErrors(pageErrors []core.PageError) error {
+	errs := make([]error, 0, len(pageErrors))
+	for _, pageError := range pageErrors {
+		errs = append(errs, pageError)
+	}
+
+	return errors.Join(errs...)
 }
 
 func (m *maroto) getRowsHeight(rows ...core.Row) float64 {
@@ -317,6 +649,20 @@ func (m *maroto) getRowsHeight(rows ...core.Row) float64 {
 	return height
 }
 
+// getConfigRows returns the rows of the header or footer defined on the config and their height.
+func getConfigRows(configRows []entity.PageRow) ([]core.Row, float64) {
+	var rows []core.Row
+	var height float64
+	for _, configRow := range configRows {
+		if r, ok := configRow.(core.Row); ok {
+			rows = append(rows, r)
+			height += r.GetHeight()
+		}
+	}
+
+	return rows, height
+}
+
 func getConfig(configs ...*entity.Config) *entity.Config {
 	if len(configs) > 0 {
 		return configs[0]
@@ -1,21 +1,37 @@
 package maroto_test
 
 import (
+	"bytes"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/page"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
 	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/border"
+	"github.com/johnfercher/maroto/v2/pkg/consts/legendposition"
 	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/pool"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
+	mtesting "github.com/johnfercher/maroto/v2/pkg/testing"
 
 	"github.com/johnfercher/maroto/v2"
 
+	"github.com/johnfercher/go-tree/node"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
+var outlineRegex = regexp.MustCompile(`(\d+) 0 obj\n<</Title \((.*?)\)\n/Parent (\d+) 0 R`)
+
 func TestNew(t *testing.T) {
 	t.Run("new default", func(t *testing.T) {
 		// Act
@@ -172,6 +188,87 @@ func TestMaroto_AddPages(t *testing.T) {
 	})
 }
 
+func TestMaroto_InsertAt(t *testing.T) {
+	t.Run("insert row at the beginning", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(row.New(10).Add(col.New(12)), row.New(20).Add(col.New(12)))
+
+		// Act
+		err := sut.InsertAt(0, row.New(30).Add(col.New(12)))
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_insert_at_1.json")
+	})
+	t.Run("insert row with negative position after the last row", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(row.New(10).Add(col.New(12)), row.New(20).Add(col.New(12)))
+
+		// Act
+		err := sut.InsertAt(-1, row.New(30).Add(col.New(12)))
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_insert_at_2.json")
+	})
+	t.Run("insert row in the second page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddPages(
+			page.New().Add(row.New(20).Add(col.New(12))),
+			page.New().Add(row.New(20).Add(col.New(12))),
+		)
+
+		// Act
+		err := sut.InsertAt(1, row.New(30).Add(col.New(12)))
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_insert_at_3.json")
+	})
+	t.Run("insert row with negative position before the last row", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(row.New(10).Add(col.New(12)), row.New(20).Add(col.New(12)))
+
+		// Act
+		err := sut.InsertAt(-2, row.New(30).Add(col.New(12)))
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_insert_at_4.json")
+	})
+	t.Run("insert row keeping the empty pages", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(row.New(10).Add(col.New(12)))
+		sut.AddPages(page.New())
+		sut.AddRows(row.New(20).Add(col.New(12)))
+
+		// Act
+		err := sut.InsertAt(0, row.New(30).Add(col.New(12)))
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_insert_at_5.json")
+	})
+	t.Run("insert row out of range", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(row.New(10).Add(col.New(12)))
+
+		// Act
+		errAfter := sut.InsertAt(2, row.New(30).Add(col.New(12)))
+		errBefore := sut.InsertAt(-3, row.New(30).Add(col.New(12)))
+
+		// Assert
+		assert.NotNil(t, errAfter)
+		assert.NotNil(t, errBefore)
+	})
+}
+
 func TestMaroto_Generate(t *testing.T) {
 	t.Run("add one row", func(t *testing.T) {
 		// Arrange
@@ -230,4 +327,665 @@ func TestMaroto_Generate(t *testing.T) {
 		assert.Nil(t, err)
 		assert.NotNil(t, doc)
 	})
+	t.Run("add col with hover color and javascript enabled, should write hover field", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithJavaScriptEnabled(true).
+			Build()
+		style := &props.Cell{HoverColor: &props.Color{Red: 255}}
+
+		sut := maroto.New(cfg)
+
+		// Act
+		sut.AddRow(10, col.New(12).WithID("total").WithStyle(style))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "/Subtype/Widget")
+		assert.Contains(t, string(doc.GetBytes()), `this.getField\("total"\).fillColor = ["RGB", 1.000, 0.000, 0.000];`)
+	})
+	t.Run("add col with tooltip, should write tooltip field", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddRow(10, col.New(6).WithTooltip("Total with taxes"), col.New(6).WithStyle(&props.Cell{Tooltip: "Due date"}))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "/Subtype/Widget")
+		assert.Contains(t, string(doc.GetBytes()), "/T(tooltip_2)")
+	})
+	t.Run("add text with font not registered and fallback to default font, should use the default font", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithFallbackToDefaultFont(true).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		sut.AddRows(text.NewRow(10, "fallback", props.Text{Family: "missing"}))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "/BaseFont /Helvetica")
+		assert.NotContains(t, string(doc.GetBytes()), "missing")
+	})
+	t.Run("add text with font not registered, should return error", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddRows(text.NewRow(10, "fallback", props.Text{Family: "missing"}))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, doc)
+		assert.ErrorContains(t, err, "undefined font: missing")
+	})
+	t.Run("add rows with object streams enabled, should group objects in object streams", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithObjectStreams(true).
+			Build()
+
+		sut := maroto.New(cfg)
+		plain := maroto.New()
+
+		// Act
+		for i := 0; i < 100; i++ {
+			sut.AddRow(10, text.NewCol(12, fmt.Sprintf("row %d", i)))
+			plain.AddRow(10, text.NewCol(12, fmt.Sprintf("row %d", i)))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		plainDoc, err := plain.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "/ObjStm")
+		assert.Less(t, len(doc.GetBytes()), len(plainDoc.GetBytes()))
+	})
+	t.Run("add pages with page number offset, should shift the page numbers", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithPageNumber("{current}/{total}", props.Bottom).
+			WithPageNumberOffset(5).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 6; i++ {
+			sut.AddPages(page.New().Add(row.New(20).Add(col.New(12))))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "(5/10)")
+		assert.Contains(t, string(doc.GetBytes()), "(10/10)")
+		assert.NotContains(t, string(doc.GetBytes()), "(11/10)")
+	})
+	t.Run("add pages with auto page numbers, should number all pages", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithAutoPageNumbers().
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		sut.AddPages(page.New().Add(row.New(20).Add(col.New(12))), page.New().Add(row.New(20).Add(col.New(12))))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "(Page 1 of 2)")
+		assert.Contains(t, string(doc.GetBytes()), "(Page 2 of 2)")
+		assert.Contains(t, string(doc.GetBytes()), " 8.00 Tf")
+	})
+	t.Run("add pages with auto page numbers and pattern, should use the pattern", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithAutoPageNumbers().
+			WithPageNumber("{current}/{total}", props.RightTop).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		sut.AddPages(page.New().Add(row.New(20).Add(col.New(12))))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "(1/1)")
+		assert.NotContains(t, string(doc.GetBytes()), "(Page 1 of 1)")
+	})
+	t.Run("add bookmarks, should return them on document", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddRow(10, col.New(12).Add(bookmarkComponent("first", 0)))
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, col.New(12))
+		}
+		sut.AddRow(10, col.New(12).Add(bookmarkComponent("second", 1)))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, []entity.Bookmark{
+			{Title: "first", Page: 1, Level: 0},
+			{Title: "second", Page: 2, Level: 1},
+		}, doc.GetBookmarks())
+	})
+	t.Run("add bookmarks, execute in parallel, should return them on document", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(7).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		sut.AddRow(10, col.New(12).Add(bookmarkComponent("first", 0)))
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, col.New(12))
+		}
+		sut.AddRow(10, col.New(12).Add(bookmarkComponent("second", 1)))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, []entity.Bookmark{
+			{Title: "first", Page: 1, Level: 0},
+			{Title: "second", Page: 2, Level: 1},
+		}, doc.GetBookmarks())
+	})
+	t.Run("add bookmarks, execute in parallel with work stealing, should return them on document", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(2).
+			WithWorkerPoolStrategy(pool.WorkStealing).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		sut.AddRow(10, col.New(12).Add(bookmarkComponent("first", 0)))
+		for i := 0; i < 100; i++ {
+			sut.AddRow(10, col.New(12))
+		}
+		sut.AddRow(10, col.New(12).Add(bookmarkComponent("second", 1)))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, []entity.Bookmark{
+			{Title: "first", Page: 1, Level: 0},
+			{Title: "second", Page: 4, Level: 1},
+		}, doc.GetBookmarks())
+	})
+}
+
+func TestMaroto_AddBookmark(t *testing.T) {
+	t.Run("when bookmarks are added in many pages, should write the outline tree", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddBookmark("Chapter 1", 0)
+		sut.AddRows(text.NewRow(200, "introduction"))
+		sut.AddBookmark("Section 1.1", 1)
+		sut.AddRows(text.NewRow(200, "moved to the second page"))
+		sut.AddRows(text.NewRow(50, "same page").WithBookmark("Section 1.2", 1))
+		sut.AddBookmark("Chapter 2", 0)
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"Chapter 1":   "",
+			"Section 1.1": "Chapter 1",
+			"Section 1.2": "Chapter 1",
+			"Chapter 2":   "",
+		}, getOutlineParents(doc.GetBytes()))
+		assert.ElementsMatch(t, []entity.Bookmark{
+			{Title: "Chapter 1", Page: 1, Level: 0},
+			{Title: "Section 1.1", Page: 2, Level: 1},
+			{Title: "Section 1.2", Page: 2, Level: 1},
+			{Title: "Chapter 2", Page: 2, Level: 0},
+		}, doc.GetBookmarks())
+	})
+	t.Run("when bookmarks are added before a page, should point to its first row", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddRows(text.NewRow(10, "first page"))
+		sut.AddBookmark("Appendix", 0)
+		sut.AddPages(page.New().Add(text.NewRow(10, "second page")))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, []entity.Bookmark{{Title: "Appendix", Page: 2, Level: 0}}, doc.GetBookmarks())
+	})
+}
+
+func TestMaroto_GenerateTo(t *testing.T) {
+	t.Run("when generated sequentially, should write the pdf", func(t *testing.T) {
+		// Arrange
+		var buffer bytes.Buffer
+		sut := maroto.New()
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, text.NewCol(12, "text"))
+		}
+
+		// Act
+		err := sut.GenerateTo(&buffer)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.True(t, bytes.HasPrefix(buffer.Bytes(), []byte("%PDF-")))
+	})
+	t.Run("when generated concurrently, should write the merged pdf", func(t *testing.T) {
+		// Arrange
+		var buffer bytes.Buffer
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(7).
+			Build()
+
+		sut := maroto.New(cfg)
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, text.NewCol(12, "text"))
+		}
+
+		// Act
+		err := sut.GenerateTo(&buffer)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.True(t, bytes.HasPrefix(buffer.Bytes(), []byte("%PDF-")))
+	})
+}
+
+func TestMaroto_Generate_PageErrors(t *testing.T) {
+	t.Run("when a page panics sequentially, should generate the document and report the page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		addPagesWithPanic(t, sut, 4, 2)
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.True(t, bytes.HasPrefix(doc.GetBytes(), []byte("%PDF-")))
+		assert.Len(t, doc.Errors(), 1)
+		assert.Equal(t, 2, doc.Errors()[0].PageIndex)
+		assert.Equal(t, 0, doc.Errors()[0].Worker)
+		assert.ErrorIs(t, doc.Errors()[0], core.ErrPageRender)
+		assert.ErrorContains(t, doc.Errors()[0], "boom")
+	})
+	t.Run("when a page panics concurrently, should generate the document and report the page", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(2).
+			Build()
+		sut := maroto.New(cfg)
+		addPagesWithPanic(t, sut, 4, 3)
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.True(t, bytes.HasPrefix(doc.GetBytes(), []byte("%PDF-")))
+		assert.Len(t, doc.Errors(), 1)
+		assert.Equal(t, 3, doc.Errors()[0].PageIndex)
+		assert.True(t, doc.Errors()[0].Worker == 0 || doc.Errors()[0].Worker == 1)
+		assert.ErrorIs(t, doc.Errors()[0], core.ErrPageRender)
+	})
+	t.Run("when no page fails, should not report errors", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRow(10, text.NewCol(12, "text"))
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Empty(t, doc.Errors())
+	})
+	t.Run("when a page panics on GenerateTo, should write the document and return the error", func(t *testing.T) {
+		// Arrange
+		var buffer bytes.Buffer
+		sut := maroto.New()
+		addPagesWithPanic(t, sut, 2, 1)
+
+		// Act
+		err := sut.GenerateTo(&buffer)
+
+		// Assert
+		assert.ErrorIs(t, err, core.ErrPageRender)
+		assert.ErrorContains(t, err, "page 1, worker 0")
+		assert.True(t, bytes.HasPrefix(buffer.Bytes(), []byte("%PDF-")))
+	})
+}
+
+// addPagesWithPanic adds the quantity of pages, the page failIndex has a component which panics on render.
+func addPagesWithPanic(t *testing.T, sut core.Maroto, quantity, failIndex int) {
+	for i := 0; i < quantity; i++ {
+		if i != failIndex {
+			sut.AddPages(page.New().Add(text.NewRow(10, "text")))
+			continue
+		}
+
+		component := mocks.NewComponent(t)
+		component.EXPECT().SetConfig(mock.Anything)
+		component.EXPECT().Render(mock.Anything, mock.Anything).Run(func(core.Provider, *entity.Cell) {
+			panic("boom")
+		})
+		sut.AddPages(page.New().Add(row.New(10).Add(col.New(12).Add(component))))
+	}
+}
+
+func TestMaroto_ConfigHeaderFooter(t *testing.T) {
+	t.Run("when header and footer are defined on config, should add them to every page", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithHeader(text.NewRow(20, "header")).
+			WithFooter(text.NewRow(10, "footer")).
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 20; i++ {
+			sut.AddRows(row.New(15).Add(col.New(12)))
+		}
+
+		// Assert
+		pages := sut.GetStructure().GetNexts()
+		assert.Len(t, pages, 2)
+		for _, p := range pages {
+			rows := p.GetNexts()
+			assert.Equal(t, 20.0, rows[0].GetData().Value)
+			assert.Equal(t, 10.0, rows[len(rows)-1].GetData().Value)
+		}
+		assert.Len(t, pages[0].GetNexts(), 18)
+	})
+	t.Run("when header and footer are skipped on first page, should add them from the second page", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithHeader(text.NewRow(20, "header")).
+			WithFooter(text.NewRow(10, "footer")).
+			WithHeaderFooterOnFirstPage(false).
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 25; i++ {
+			sut.AddRows(row.New(15).Add(col.New(12)))
+		}
+
+		// Assert
+		pages := sut.GetStructure().GetNexts()
+		assert.Len(t, pages, 2)
+
+		first := pages[0].GetNexts()
+		assert.Len(t, first, 18)
+		assert.Equal(t, 15.0, first[0].GetData().Value)
+
+		second := pages[1].GetNexts()
+		assert.Equal(t, 20.0, second[0].GetData().Value)
+		assert.Equal(t, 10.0, second[len(second)-1].GetData().Value)
+	})
+	t.Run("when header is defined on config, should not register another header", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().WithHeader(text.NewRow(20, "header")).Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		err := sut.RegisterHeader(text.NewRow(15, "registered"))
+
+		// Assert
+		assert.NotNil(t, err)
+		rows := sut.GetStructure().GetNexts()[0].GetNexts()
+		assert.Equal(t, 20.0, rows[0].GetData().Value)
+		assert.Equal(t, 1, countRows(rows, 20.0)+countRows(rows, 15.0))
+	})
+	t.Run("when footer is defined on config, should not register another footer", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().WithFooter(text.NewRow(10, "footer")).Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		err := sut.RegisterFooter(text.NewRow(15, "registered"))
+
+		// Assert
+		assert.NotNil(t, err)
+		rows := sut.GetStructure().GetNexts()[0].GetNexts()
+		assert.Equal(t, 10.0, rows[len(rows)-1].GetData().Value)
+	})
+}
+
+// countRows returns how many rows have the height.
+func countRows(rows []*node.Node[core.Structure], height float64) int {
+	count := 0
+	for _, r := range rows {
+		if r.GetData().Value == height {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestMaroto_ConfigPageTemplate(t *testing.T) {
+	t.Run("when page template is defined, should render its rows over the pages without moving the content", func(t *testing.T) {
+		// Arrange
+		var calls [][2]int
+		style := &props.Cell{BorderType: border.Full, BackgroundColor: &props.Color{Red: 240}}
+		cfg := config.NewBuilder().
+			WithPageNumber("{current}/{total}", props.Bottom).
+			WithPageTemplate(func(pageNumber, totalPages int) []core.Row {
+				calls = append(calls, [2]int{pageNumber, totalPages})
+				if pageNumber%2 == 1 {
+					return nil
+				}
+
+				return []core.Row{
+					row.New(100).WithStyle(style),
+					text.NewRow(100, "even page watermark"),
+				}
+			}).
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 1; i <= 3; i++ {
+			title := fmt.Sprintf("page %d", i)
+			sut.AddPages(page.New().Add(text.NewRow(15, title).WithBookmark(title, 0)))
+		}
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, [][2]int{{1, 3}, {2, 3}, {3, 3}}, calls)
+		mtesting.AssertPageCount(t, doc, 3)
+		mtesting.AssertContainsText(t, doc, "even page watermark")
+		assert.Equal(t, []entity.Bookmark{
+			{Title: "page 1", Page: 1},
+			{Title: "page 2", Page: 2},
+			{Title: "page 3", Page: 3},
+		}, doc.GetBookmarks())
+	})
+	t.Run("when page template returns nil, should render only the content", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithPageTemplate(func(int, int) []core.Row { return nil }).
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 40; i++ {
+			sut.AddRows(text.NewRow(15, "content"))
+		}
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		mtesting.AssertPageCount(t, doc, 3)
+	})
+}
+
+func TestMaroto_ConfigWatermark(t *testing.T) {
+	t.Run("when watermark is defined, should write it and the watermark of the page template", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWatermarkText("CONFIDENTIAL", props.Watermark{Opacity: 0.2}).
+			WithPageTemplate(func(pageNumber, _ int) []core.Row {
+				if pageNumber == 2 {
+					return []core.Row{text.NewWatermarkRow(0, "COPY")}
+				}
+
+				return nil
+			}).
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		sut.AddPages(page.New().Add(text.NewRow(15, "first")), page.New().Add(text.NewRow(15, "second")))
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		mtesting.AssertPageCount(t, doc, 2)
+		mtesting.AssertContainsText(t, doc, "CONFIDENTIAL")
+		mtesting.AssertContainsText(t, doc, "COPY")
+		mtesting.AssertContainsText(t, doc, "second")
+	})
+}
+
+func TestMaroto_AddPaginatedRow(t *testing.T) {
+	t.Run("when text doesn't fit in the page, should continue on the next pages", func(t *testing.T) {
+		// Arrange
+		lines := make([]string, 120)
+		for i := range lines {
+			lines[i] = fmt.Sprintf("clause %d", i+1)
+		}
+		sut := maroto.New()
+
+		// Act
+		sut.AddRows(text.NewRow(10, "Terms"), text.NewPaginatedRow(strings.Join(lines, "\n")), text.NewRow(10, "Signature"))
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		mtesting.AssertPageCount(t, doc, 2)
+		mtesting.AssertContainsText(t, doc, "clause 1")
+		mtesting.AssertContainsText(t, doc, "clause 120")
+		mtesting.AssertContainsText(t, doc, "Signature")
+	})
+	t.Run("when flow state is saved, should resume the text on another document", func(t *testing.T) {
+		// Arrange
+		value := "first clause\nsecond clause\nthird clause"
+		paginated := text.NewPaginated(value).(*text.Paginated)
+		first := maroto.New()
+		first.AddRows(row.New(5).Add(col.New().Add(paginated)))
+		_, err := first.Generate()
+		state := paginated.Next(190, 5)
+		second := maroto.New()
+
+		// Act
+		second.AddRows(text.NewPaginatedRowFromState(state, value))
+		doc, secondErr := second.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Nil(t, secondErr)
+		assert.Equal(t, text.FlowState{Offset: len("first clause\n")}, state)
+		mtesting.AssertContainsText(t, doc, "second clause")
+	})
+}
+
+// getOutlineParents returns the title of the parent of each outline entry of the pdf, empty for the root ones.
+func getOutlineParents(pdf []byte) map[string]string {
+	titles := make(map[string]string)
+	parents := make(map[string]string)
+	for _, match := range outlineRegex.FindAllStringSubmatch(string(pdf), -1) {
+		titles[match[1]] = match[2]
+		parents[match[2]] = match[3]
+	}
+
+	outline := make(map[string]string)
+	for title, parent := range parents {
+		outline[title] = titles[parent]
+	}
+
+	return outline
+}
+
+func bookmarkComponent(title string, level int) core.Component {
+	component := &mocks.Component{}
+	component.EXPECT().SetConfig(mock.Anything)
+	component.EXPECT().Render(mock.Anything, mock.Anything).Run(func(provider core.Provider, cell *entity.Cell) {
+		provider.AddBookmark(title, level, cell)
+	})
+
+	return component
+}
+
+func TestMaroto_AddChart(t *testing.T) {
+	t.Run("when a line chart is added, should embed it as an image", func(t *testing.T) {
+		// Arrange
+		series := []chart.Series{
+			{Label: "revenue", Values: []float64{120, 150, 90, 180, 210}},
+			{Label: "cost", Values: []float64{80, 95, 100, 110, 120}, Color: &props.RedColor},
+		}
+		sut := maroto.New()
+
+		// Act
+		sut.AddRows(
+			text.NewRow(10, "Quarterly report"),
+			chart.NewRow(60, chart.Line, series, props.Chart{Title: "Revenue", LegendPosition: legendposition.Top}),
+		)
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		mtesting.AssertPageCount(t, doc, 1)
+		mtesting.AssertImageCount(t, doc, 1)
+		mtesting.AssertContainsText(t, doc, "Quarterly report")
+	})
+}
+
+func TestMaroto_GetStructure(t *testing.T) {
+	t.Run("when structure is read before generating, should not change the document", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(text.NewRow(10, "first"))
+
+		// Act
+		first := sut.GetStructure()
+		second := sut.GetStructure()
+		sut.AddRows(text.NewRow(10, "second"))
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, first.GetNexts(), 1)
+		assert.Len(t, second.GetNexts(), 1)
+		mtesting.AssertPageCount(t, doc, 1)
+		mtesting.AssertContainsText(t, doc, "second")
+	})
 }
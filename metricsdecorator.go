@@ -1,6 +1,8 @@
 package maroto
 
 import (
+	"io"
+
 	"github.com/johnfercher/go-tree/node"
 	"github.com/johnfercher/maroto/v2/internal/time"
 	"github.com/johnfercher/maroto/v2/pkg/core"
@@ -11,6 +13,7 @@ type metricsDecorator struct {
 	addRowsTime   []*metrics.Time
 	addRowTime    []*metrics.Time
 	addPageTime   []*metrics.Time
+	insertAtTime  []*metrics.Time
 	headerTime    *metrics.Time
 	footerTime    *metrics.Time
 	generateTime  *metrics.Time
@@ -43,7 +46,20 @@ func (m *metricsDecorator) Generate() (core.Document, error) {
 
 	report := m.buildMetrics(len(bytes)).Normalize()
 
-	return core.NewPDF(bytes, report), nil
+	return core.NewPDFWithErrors(bytes, report, document.Errors(), document.GetBookmarks()...), nil
+}
+
+// GenerateTo generates the document into w measuring the time spent, as the document is not
+// returned, the metrics are not reported.
+func (m *metricsDecorator) GenerateTo(w io.Writer) error {
+	var err error
+
+	timeSpent := time.GetTimeSpent(func() {
+		err = m.inner.GenerateTo(w)
+	})
+	m.generateTime = timeSpent
+
+	return err
 }
 
 func (m *metricsDecorator) AddPages(pages ...core.Page) {
@@ -54,6 +70,10 @@ func (m *metricsDecorator) AddPages(pages ...core.Page) {
 	m.addPageTime = append(m.addPageTime, timeSpent)
 }
 
+func (m *metricsDecorator) AddBookmark(title string, level int) {
+	m.inner.AddBookmark(title, level)
+}
+
 func (m *metricsDecorator) AddRows(rows ...core.Row) {
 	timeSpent := time.GetTimeSpent(func() {
 		m.inner.AddRows(rows...)
@@ -72,6 +92,16 @@ func (m *metricsDecorator) AddRow(rowHeight float64, cols ...core.Col) core.Row
 	return r
 }
 
+func (m *metricsDecorator) InsertAt(position int, rows ...core.Row) error {
+	var err error
+	timeSpent := time.GetTimeSpent(func() {
+		err = m.inner.InsertAt(position, rows...)
+	})
+
+	m.insertAtTime = append(m.insertAtTime, timeSpent)
+	return err
+}
+
 func (m *metricsDecorator) RegisterHeader(rows ...core.Row) error {
 	var err error
 	timeSpent := time.GetTimeSpent(func() {
@@ -160,6 +190,14 @@ func (m *metricsDecorator) buildMetrics(bytesSize int) *metrics.Report {
 		})
 	}
 
+	if len(m.insertAtTime) > 0 {
+		timeMetrics = append(timeMetrics, metrics.TimeMetric{
+			Key:   "insert_at",
+			Times: m.insertAtTime,
+			Avg:   m.getAVG(m.insertAtTime),
+		})
+	}
+
 	return &metrics.Report{
 		TimeMetrics: timeMetrics,
 		SizeMetric: metrics.SizeMetric{
@@ -1,6 +1,8 @@
 package maroto
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -29,6 +31,8 @@ func TestMetricsDecorator_AddPages(t *testing.T) {
 
 	docToReturn := &mocks.Document{}
 	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	docToReturn.EXPECT().GetBookmarks().Return(nil)
+	docToReturn.EXPECT().Errors().Return(nil)
 	inner := &mocks.Maroto{}
 	inner.EXPECT().AddPages(pg)
 	inner.EXPECT().Generate().Return(docToReturn, nil)
@@ -53,12 +57,28 @@ func TestMetricsDecorator_AddPages(t *testing.T) {
 	inner.AssertNumberOfCalls(t, "AddPages", 2)
 }
 
+func TestMetricsDecorator_AddBookmark(t *testing.T) {
+	// Arrange
+	inner := &mocks.Maroto{}
+	inner.EXPECT().AddBookmark("Chapter 1", 0)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	sut.AddBookmark("Chapter 1", 0)
+
+	// Assert
+	inner.AssertNumberOfCalls(t, "AddBookmark", 1)
+}
+
 func TestMetricsDecorator_AddRow(t *testing.T) {
 	// Arrange
 	col := col.New(12)
 
 	docToReturn := &mocks.Document{}
 	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	docToReturn.EXPECT().GetBookmarks().Return(nil)
+	docToReturn.EXPECT().Errors().Return(nil)
 	inner := &mocks.Maroto{}
 	inner.EXPECT().AddRow(10.0, col).Return(nil)
 	inner.EXPECT().Generate().Return(docToReturn, nil)
@@ -89,6 +109,8 @@ func TestMetricsDecorator_AddRows(t *testing.T) {
 
 	docToReturn := &mocks.Document{}
 	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	docToReturn.EXPECT().GetBookmarks().Return(nil)
+	docToReturn.EXPECT().Errors().Return(nil)
 	inner := &mocks.Maroto{}
 	inner.EXPECT().AddRows(row)
 	inner.EXPECT().Generate().Return(docToReturn, nil)
@@ -113,12 +135,48 @@ func TestMetricsDecorator_AddRows(t *testing.T) {
 	inner.AssertNumberOfCalls(t, "AddRows", 2)
 }
 
+func TestMetricsDecorator_InsertAt(t *testing.T) {
+	// Arrange
+	row := row.New(10).Add(col.New(12))
+
+	docToReturn := &mocks.Document{}
+	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	docToReturn.EXPECT().GetBookmarks().Return(nil)
+	docToReturn.EXPECT().Errors().Return(nil)
+	inner := &mocks.Maroto{}
+	inner.EXPECT().InsertAt(0, row).Return(nil)
+	inner.EXPECT().Generate().Return(docToReturn, nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	err1 := sut.InsertAt(0, row)
+	err2 := sut.InsertAt(0, row)
+
+	// Assert
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+	doc, err := sut.Generate()
+	assert.Nil(t, err)
+	assert.NotNil(t, doc)
+
+	report := doc.GetReport()
+	assert.NotNil(t, report)
+	assert.Equal(t, 2, len(report.TimeMetrics))
+	assert.Equal(t, "generate", report.TimeMetrics[0].Key)
+	assert.Equal(t, "insert_at", report.TimeMetrics[1].Key)
+	assert.Equal(t, 2, len(report.TimeMetrics[1].Times))
+	inner.AssertNumberOfCalls(t, "InsertAt", 2)
+}
+
 func TestMetricsDecorator_GetStructure(t *testing.T) {
 	// Arrange
 	row := row.New(10).Add(col.New(12))
 
 	docToReturn := &mocks.Document{}
 	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	docToReturn.EXPECT().GetBookmarks().Return(nil)
+	docToReturn.EXPECT().Errors().Return(nil)
 	inner := &mocks.Maroto{}
 	inner.EXPECT().AddRows(row)
 	inner.EXPECT().GetStructure().Return(&node.Node[core.Structure]{})
@@ -145,3 +203,19 @@ func TestMetricsDecorator_GetStructure(t *testing.T) {
 	inner.AssertNumberOfCalls(t, "AddRows", 1)
 	inner.AssertNumberOfCalls(t, "GetStructure", 1)
 }
+
+func TestMetricsDecorator_GenerateTo(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+	inner := &mocks.Maroto{}
+	inner.EXPECT().GenerateTo(&buffer).Return(errors.New("anyError"))
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	err := sut.GenerateTo(&buffer)
+
+	// Assert
+	assert.NotNil(t, err)
+	inner.AssertNumberOfCalls(t, "GenerateTo", 1)
+}
@@ -0,0 +1,120 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	props "github.com/johnfercher/maroto/v2/pkg/props"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnyColor is an autogenerated mock type for the AnyColor type
+type AnyColor struct {
+	mock.Mock
+}
+
+type AnyColor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnyColor) EXPECT() *AnyColor_Expecter {
+	return &AnyColor_Expecter{mock: &_m.Mock}
+}
+
+// ToColor provides a mock function with given fields:
+func (_m *AnyColor) ToColor() *props.Color {
+	ret := _m.Called()
+
+	var r0 *props.Color
+	if rf, ok := ret.Get(0).(func() *props.Color); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*props.Color)
+		}
+	}
+
+	return r0
+}
+
+// AnyColor_ToColor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToColor'
+type AnyColor_ToColor_Call struct {
+	*mock.Call
+}
+
+// ToColor is a helper method to define mock.On call
+func (_e *AnyColor_Expecter) ToColor() *AnyColor_ToColor_Call {
+	return &AnyColor_ToColor_Call{Call: _e.mock.On("ToColor")}
+}
+
+func (_c *AnyColor_ToColor_Call) Run(run func()) *AnyColor_ToColor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AnyColor_ToColor_Call) Return(_a0 *props.Color) *AnyColor_ToColor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AnyColor_ToColor_Call) RunAndReturn(run func() *props.Color) *AnyColor_ToColor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToString provides a mock function with given fields:
+func (_m *AnyColor) ToString() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AnyColor_ToString_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToString'
+type AnyColor_ToString_Call struct {
+	*mock.Call
+}
+
+// ToString is a helper method to define mock.On call
+func (_e *AnyColor_Expecter) ToString() *AnyColor_ToString_Call {
+	return &AnyColor_ToString_Call{Call: _e.mock.On("ToString")}
+}
+
+func (_c *AnyColor_ToString_Call) Run(run func()) *AnyColor_ToString_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AnyColor_ToString_Call) Return(_a0 string) *AnyColor_ToString_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AnyColor_ToString_Call) RunAndReturn(run func() string) *AnyColor_ToString_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnyColor creates a new instance of AnyColor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnyColor(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *AnyColor {
+	mock := &AnyColor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -4,16 +4,22 @@ package mocks
 
 import (
 	config "github.com/johnfercher/maroto/v2/pkg/config"
+	core "github.com/johnfercher/maroto/v2/pkg/core"
+
 	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
 
 	extension "github.com/johnfercher/maroto/v2/pkg/consts/extension"
 
+	log "log"
+
 	mock "github.com/stretchr/testify/mock"
 
 	orientation "github.com/johnfercher/maroto/v2/pkg/consts/orientation"
 
 	pagesize "github.com/johnfercher/maroto/v2/pkg/consts/pagesize"
 
+	pool "github.com/johnfercher/maroto/v2/pkg/pool"
+
 	props "github.com/johnfercher/maroto/v2/pkg/props"
 
 	protection "github.com/johnfercher/maroto/v2/pkg/consts/protection"
@@ -122,6 +128,49 @@ func (_c *Builder_WithAuthor_Call) RunAndReturn(run func(string, bool) config.Bu
 	return _c
 }
 
+// WithAutoPageNumbers provides a mock function with given fields:
+func (_m *Builder) WithAutoPageNumbers() config.Builder {
+	ret := _m.Called()
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func() config.Builder); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithAutoPageNumbers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithAutoPageNumbers'
+type Builder_WithAutoPageNumbers_Call struct {
+	*mock.Call
+}
+
+// WithAutoPageNumbers is a helper method to define mock.On call
+func (_e *Builder_Expecter) WithAutoPageNumbers() *Builder_WithAutoPageNumbers_Call {
+	return &Builder_WithAutoPageNumbers_Call{Call: _e.mock.On("WithAutoPageNumbers")}
+}
+
+func (_c *Builder_WithAutoPageNumbers_Call) Run(run func()) *Builder_WithAutoPageNumbers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Builder_WithAutoPageNumbers_Call) Return(_a0 config.Builder) *Builder_WithAutoPageNumbers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithAutoPageNumbers_Call) RunAndReturn(run func() config.Builder) *Builder_WithAutoPageNumbers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithBackgroundImage provides a mock function with given fields: _a0, _a1
 func (_m *Builder) WithBackgroundImage(_a0 []byte, _a1 extension.Type) config.Builder {
 	ret := _m.Called(_a0, _a1)
@@ -167,6 +216,94 @@ func (_c *Builder_WithBackgroundImage_Call) RunAndReturn(run func([]byte, extens
 	return _c
 }
 
+// WithBleed provides a mock function with given fields: bleedMM
+func (_m *Builder) WithBleed(bleedMM float64) config.Builder {
+	ret := _m.Called(bleedMM)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(float64) config.Builder); ok {
+		r0 = rf(bleedMM)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithBleed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithBleed'
+type Builder_WithBleed_Call struct {
+	*mock.Call
+}
+
+// WithBleed is a helper method to define mock.On call
+//   - bleedMM float64
+func (_e *Builder_Expecter) WithBleed(bleedMM interface{}) *Builder_WithBleed_Call {
+	return &Builder_WithBleed_Call{Call: _e.mock.On("WithBleed", bleedMM)}
+}
+
+func (_c *Builder_WithBleed_Call) Run(run func(bleedMM float64)) *Builder_WithBleed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *Builder_WithBleed_Call) Return(_a0 config.Builder) *Builder_WithBleed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithBleed_Call) RunAndReturn(run func(float64) config.Builder) *Builder_WithBleed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithBottomMargin provides a mock function with given fields: bottom
+func (_m *Builder) WithBottomMargin(bottom float64) config.Builder {
+	ret := _m.Called(bottom)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(float64) config.Builder); ok {
+		r0 = rf(bottom)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithBottomMargin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithBottomMargin'
+type Builder_WithBottomMargin_Call struct {
+	*mock.Call
+}
+
+// WithBottomMargin is a helper method to define mock.On call
+//   - bottom float64
+func (_e *Builder_Expecter) WithBottomMargin(bottom interface{}) *Builder_WithBottomMargin_Call {
+	return &Builder_WithBottomMargin_Call{Call: _e.mock.On("WithBottomMargin", bottom)}
+}
+
+func (_c *Builder_WithBottomMargin_Call) Run(run func(bottom float64)) *Builder_WithBottomMargin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *Builder_WithBottomMargin_Call) Return(_a0 config.Builder) *Builder_WithBottomMargin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithBottomMargin_Call) RunAndReturn(run func(float64) config.Builder) *Builder_WithBottomMargin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithCompression provides a mock function with given fields: compression
 func (_m *Builder) WithCompression(compression bool) config.Builder {
 	ret := _m.Called(compression)
@@ -477,13 +614,13 @@ func (_c *Builder_WithDimensions_Call) RunAndReturn(run func(float64, float64) c
 	return _c
 }
 
-// WithMargins provides a mock function with given fields: left, top, right
-func (_m *Builder) WithMargins(left float64, top float64, right float64) config.Builder {
-	ret := _m.Called(left, top, right)
+// WithDisableAutoFonts provides a mock function with given fields: on
+func (_m *Builder) WithDisableAutoFonts(on bool) config.Builder {
+	ret := _m.Called(on)
 
 	var r0 config.Builder
-	if rf, ok := ret.Get(0).(func(float64, float64, float64) config.Builder); ok {
-		r0 = rf(left, top, right)
+	if rf, ok := ret.Get(0).(func(bool) config.Builder); ok {
+		r0 = rf(on)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(config.Builder)
@@ -493,43 +630,41 @@ func (_m *Builder) WithMargins(left float64, top float64, right float64) config.
 	return r0
 }
 
-// Builder_WithMargins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithMargins'
-type Builder_WithMargins_Call struct {
+// Builder_WithDisableAutoFonts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDisableAutoFonts'
+type Builder_WithDisableAutoFonts_Call struct {
 	*mock.Call
 }
 
-// WithMargins is a helper method to define mock.On call
-//   - left float64
-//   - top float64
-//   - right float64
-func (_e *Builder_Expecter) WithMargins(left interface{}, top interface{}, right interface{}) *Builder_WithMargins_Call {
-	return &Builder_WithMargins_Call{Call: _e.mock.On("WithMargins", left, top, right)}
+// WithDisableAutoFonts is a helper method to define mock.On call
+//   - on bool
+func (_e *Builder_Expecter) WithDisableAutoFonts(on interface{}) *Builder_WithDisableAutoFonts_Call {
+	return &Builder_WithDisableAutoFonts_Call{Call: _e.mock.On("WithDisableAutoFonts", on)}
 }
 
-func (_c *Builder_WithMargins_Call) Run(run func(left float64, top float64, right float64)) *Builder_WithMargins_Call {
+func (_c *Builder_WithDisableAutoFonts_Call) Run(run func(on bool)) *Builder_WithDisableAutoFonts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(float64), args[1].(float64), args[2].(float64))
+		run(args[0].(bool))
 	})
 	return _c
 }
 
-func (_c *Builder_WithMargins_Call) Return(_a0 config.Builder) *Builder_WithMargins_Call {
+func (_c *Builder_WithDisableAutoFonts_Call) Return(_a0 config.Builder) *Builder_WithDisableAutoFonts_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Builder_WithMargins_Call) RunAndReturn(run func(float64, float64, float64) config.Builder) *Builder_WithMargins_Call {
+func (_c *Builder_WithDisableAutoFonts_Call) RunAndReturn(run func(bool) config.Builder) *Builder_WithDisableAutoFonts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithMaxGridSize provides a mock function with given fields: maxGridSize
-func (_m *Builder) WithMaxGridSize(maxGridSize int) config.Builder {
-	ret := _m.Called(maxGridSize)
+// WithFallbackToDefaultFont provides a mock function with given fields: on
+func (_m *Builder) WithFallbackToDefaultFont(on bool) config.Builder {
+	ret := _m.Called(on)
 
 	var r0 config.Builder
-	if rf, ok := ret.Get(0).(func(int) config.Builder); ok {
-		r0 = rf(maxGridSize)
+	if rf, ok := ret.Get(0).(func(bool) config.Builder); ok {
+		r0 = rf(on)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(config.Builder)
@@ -539,41 +674,41 @@ func (_m *Builder) WithMaxGridSize(maxGridSize int) config.Builder {
 	return r0
 }
 
-// Builder_WithMaxGridSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithMaxGridSize'
-type Builder_WithMaxGridSize_Call struct {
+// Builder_WithFallbackToDefaultFont_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithFallbackToDefaultFont'
+type Builder_WithFallbackToDefaultFont_Call struct {
 	*mock.Call
 }
 
-// WithMaxGridSize is a helper method to define mock.On call
-//   - maxGridSize int
-func (_e *Builder_Expecter) WithMaxGridSize(maxGridSize interface{}) *Builder_WithMaxGridSize_Call {
-	return &Builder_WithMaxGridSize_Call{Call: _e.mock.On("WithMaxGridSize", maxGridSize)}
+// WithFallbackToDefaultFont is a helper method to define mock.On call
+//   - on bool
+func (_e *Builder_Expecter) WithFallbackToDefaultFont(on interface{}) *Builder_WithFallbackToDefaultFont_Call {
+	return &Builder_WithFallbackToDefaultFont_Call{Call: _e.mock.On("WithFallbackToDefaultFont", on)}
 }
 
-func (_c *Builder_WithMaxGridSize_Call) Run(run func(maxGridSize int)) *Builder_WithMaxGridSize_Call {
+func (_c *Builder_WithFallbackToDefaultFont_Call) Run(run func(on bool)) *Builder_WithFallbackToDefaultFont_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(bool))
 	})
 	return _c
 }
 
-func (_c *Builder_WithMaxGridSize_Call) Return(_a0 config.Builder) *Builder_WithMaxGridSize_Call {
+func (_c *Builder_WithFallbackToDefaultFont_Call) Return(_a0 config.Builder) *Builder_WithFallbackToDefaultFont_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Builder_WithMaxGridSize_Call) RunAndReturn(run func(int) config.Builder) *Builder_WithMaxGridSize_Call {
+func (_c *Builder_WithFallbackToDefaultFont_Call) RunAndReturn(run func(bool) config.Builder) *Builder_WithFallbackToDefaultFont_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithOrientation provides a mock function with given fields: _a0
-func (_m *Builder) WithOrientation(_a0 orientation.Type) config.Builder {
-	ret := _m.Called(_a0)
+// WithFooter provides a mock function with given fields: row
+func (_m *Builder) WithFooter(row core.Row) config.Builder {
+	ret := _m.Called(row)
 
 	var r0 config.Builder
-	if rf, ok := ret.Get(0).(func(orientation.Type) config.Builder); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(core.Row) config.Builder); ok {
+		r0 = rf(row)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(config.Builder)
@@ -583,41 +718,41 @@ func (_m *Builder) WithOrientation(_a0 orientation.Type) config.Builder {
 	return r0
 }
 
-// Builder_WithOrientation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithOrientation'
-type Builder_WithOrientation_Call struct {
+// Builder_WithFooter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithFooter'
+type Builder_WithFooter_Call struct {
 	*mock.Call
 }
 
-// WithOrientation is a helper method to define mock.On call
-//   - _a0 orientation.Type
-func (_e *Builder_Expecter) WithOrientation(_a0 interface{}) *Builder_WithOrientation_Call {
-	return &Builder_WithOrientation_Call{Call: _e.mock.On("WithOrientation", _a0)}
+// WithFooter is a helper method to define mock.On call
+//   - row core.Row
+func (_e *Builder_Expecter) WithFooter(row interface{}) *Builder_WithFooter_Call {
+	return &Builder_WithFooter_Call{Call: _e.mock.On("WithFooter", row)}
 }
 
-func (_c *Builder_WithOrientation_Call) Run(run func(_a0 orientation.Type)) *Builder_WithOrientation_Call {
+func (_c *Builder_WithFooter_Call) Run(run func(row core.Row)) *Builder_WithFooter_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(orientation.Type))
+		run(args[0].(core.Row))
 	})
 	return _c
 }
 
-func (_c *Builder_WithOrientation_Call) Return(_a0 config.Builder) *Builder_WithOrientation_Call {
+func (_c *Builder_WithFooter_Call) Return(_a0 config.Builder) *Builder_WithFooter_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Builder_WithOrientation_Call) RunAndReturn(run func(orientation.Type) config.Builder) *Builder_WithOrientation_Call {
+func (_c *Builder_WithFooter_Call) RunAndReturn(run func(core.Row) config.Builder) *Builder_WithFooter_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithPageNumber provides a mock function with given fields: pattern, place
-func (_m *Builder) WithPageNumber(pattern string, place props.Place) config.Builder {
-	ret := _m.Called(pattern, place)
+// WithHeader provides a mock function with given fields: row
+func (_m *Builder) WithHeader(row core.Row) config.Builder {
+	ret := _m.Called(row)
 
 	var r0 config.Builder
-	if rf, ok := ret.Get(0).(func(string, props.Place) config.Builder); ok {
-		r0 = rf(pattern, place)
+	if rf, ok := ret.Get(0).(func(core.Row) config.Builder); ok {
+		r0 = rf(row)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(config.Builder)
@@ -627,42 +762,41 @@ func (_m *Builder) WithPageNumber(pattern string, place props.Place) config.Buil
 	return r0
 }
 
-// Builder_WithPageNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithPageNumber'
-type Builder_WithPageNumber_Call struct {
+// Builder_WithHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithHeader'
+type Builder_WithHeader_Call struct {
 	*mock.Call
 }
 
-// WithPageNumber is a helper method to define mock.On call
-//   - pattern string
-//   - place props.Place
-func (_e *Builder_Expecter) WithPageNumber(pattern interface{}, place interface{}) *Builder_WithPageNumber_Call {
-	return &Builder_WithPageNumber_Call{Call: _e.mock.On("WithPageNumber", pattern, place)}
+// WithHeader is a helper method to define mock.On call
+//   - row core.Row
+func (_e *Builder_Expecter) WithHeader(row interface{}) *Builder_WithHeader_Call {
+	return &Builder_WithHeader_Call{Call: _e.mock.On("WithHeader", row)}
 }
 
-func (_c *Builder_WithPageNumber_Call) Run(run func(pattern string, place props.Place)) *Builder_WithPageNumber_Call {
+func (_c *Builder_WithHeader_Call) Run(run func(row core.Row)) *Builder_WithHeader_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(props.Place))
+		run(args[0].(core.Row))
 	})
 	return _c
 }
 
-func (_c *Builder_WithPageNumber_Call) Return(_a0 config.Builder) *Builder_WithPageNumber_Call {
+func (_c *Builder_WithHeader_Call) Return(_a0 config.Builder) *Builder_WithHeader_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Builder_WithPageNumber_Call) RunAndReturn(run func(string, props.Place) config.Builder) *Builder_WithPageNumber_Call {
+func (_c *Builder_WithHeader_Call) RunAndReturn(run func(core.Row) config.Builder) *Builder_WithHeader_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithPageSize provides a mock function with given fields: size
-func (_m *Builder) WithPageSize(size pagesize.Type) config.Builder {
-	ret := _m.Called(size)
+// WithHeaderFooterOnFirstPage provides a mock function with given fields: on
+func (_m *Builder) WithHeaderFooterOnFirstPage(on bool) config.Builder {
+	ret := _m.Called(on)
 
 	var r0 config.Builder
-	if rf, ok := ret.Get(0).(func(pagesize.Type) config.Builder); ok {
-		r0 = rf(size)
+	if rf, ok := ret.Get(0).(func(bool) config.Builder); ok {
+		r0 = rf(on)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(config.Builder)
@@ -672,41 +806,41 @@ func (_m *Builder) WithPageSize(size pagesize.Type) config.Builder {
 	return r0
 }
 
-// Builder_WithPageSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithPageSize'
-type Builder_WithPageSize_Call struct {
+// Builder_WithHeaderFooterOnFirstPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithHeaderFooterOnFirstPage'
+type Builder_WithHeaderFooterOnFirstPage_Call struct {
 	*mock.Call
 }
 
-// WithPageSize is a helper method to define mock.On call
-//   - size pagesize.Type
-func (_e *Builder_Expecter) WithPageSize(size interface{}) *Builder_WithPageSize_Call {
-	return &Builder_WithPageSize_Call{Call: _e.mock.On("WithPageSize", size)}
+// WithHeaderFooterOnFirstPage is a helper method to define mock.On call
+//   - on bool
+func (_e *Builder_Expecter) WithHeaderFooterOnFirstPage(on interface{}) *Builder_WithHeaderFooterOnFirstPage_Call {
+	return &Builder_WithHeaderFooterOnFirstPage_Call{Call: _e.mock.On("WithHeaderFooterOnFirstPage", on)}
 }
 
-func (_c *Builder_WithPageSize_Call) Run(run func(size pagesize.Type)) *Builder_WithPageSize_Call {
+func (_c *Builder_WithHeaderFooterOnFirstPage_Call) Run(run func(on bool)) *Builder_WithHeaderFooterOnFirstPage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(pagesize.Type))
+		run(args[0].(bool))
 	})
 	return _c
 }
 
-func (_c *Builder_WithPageSize_Call) Return(_a0 config.Builder) *Builder_WithPageSize_Call {
+func (_c *Builder_WithHeaderFooterOnFirstPage_Call) Return(_a0 config.Builder) *Builder_WithHeaderFooterOnFirstPage_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Builder_WithPageSize_Call) RunAndReturn(run func(pagesize.Type) config.Builder) *Builder_WithPageSize_Call {
+func (_c *Builder_WithHeaderFooterOnFirstPage_Call) RunAndReturn(run func(bool) config.Builder) *Builder_WithHeaderFooterOnFirstPage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithProtection provides a mock function with given fields: protectionType, userPassword, ownerPassword
-func (_m *Builder) WithProtection(protectionType protection.Type, userPassword string, ownerPassword string) config.Builder {
-	ret := _m.Called(protectionType, userPassword, ownerPassword)
+// WithJavaScriptEnabled provides a mock function with given fields: on
+func (_m *Builder) WithJavaScriptEnabled(on bool) config.Builder {
+	ret := _m.Called(on)
 
 	var r0 config.Builder
-	if rf, ok := ret.Get(0).(func(protection.Type, string, string) config.Builder); ok {
-		r0 = rf(protectionType, userPassword, ownerPassword)
+	if rf, ok := ret.Get(0).(func(bool) config.Builder); ok {
+		r0 = rf(on)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(config.Builder)
@@ -716,43 +850,41 @@ func (_m *Builder) WithProtection(protectionType protection.Type, userPassword s
 	return r0
 }
 
-// Builder_WithProtection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithProtection'
-type Builder_WithProtection_Call struct {
+// Builder_WithJavaScriptEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithJavaScriptEnabled'
+type Builder_WithJavaScriptEnabled_Call struct {
 	*mock.Call
 }
 
-// WithProtection is a helper method to define mock.On call
-//   - protectionType protection.Type
-//   - userPassword string
-//   - ownerPassword string
-func (_e *Builder_Expecter) WithProtection(protectionType interface{}, userPassword interface{}, ownerPassword interface{}) *Builder_WithProtection_Call {
-	return &Builder_WithProtection_Call{Call: _e.mock.On("WithProtection", protectionType, userPassword, ownerPassword)}
+// WithJavaScriptEnabled is a helper method to define mock.On call
+//   - on bool
+func (_e *Builder_Expecter) WithJavaScriptEnabled(on interface{}) *Builder_WithJavaScriptEnabled_Call {
+	return &Builder_WithJavaScriptEnabled_Call{Call: _e.mock.On("WithJavaScriptEnabled", on)}
 }
 
-func (_c *Builder_WithProtection_Call) Run(run func(protectionType protection.Type, userPassword string, ownerPassword string)) *Builder_WithProtection_Call {
+func (_c *Builder_WithJavaScriptEnabled_Call) Run(run func(on bool)) *Builder_WithJavaScriptEnabled_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(protection.Type), args[1].(string), args[2].(string))
+		run(args[0].(bool))
 	})
 	return _c
 }
 
-func (_c *Builder_WithProtection_Call) Return(_a0 config.Builder) *Builder_WithProtection_Call {
+func (_c *Builder_WithJavaScriptEnabled_Call) Return(_a0 config.Builder) *Builder_WithJavaScriptEnabled_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Builder_WithProtection_Call) RunAndReturn(run func(protection.Type, string, string) config.Builder) *Builder_WithProtection_Call {
+func (_c *Builder_WithJavaScriptEnabled_Call) RunAndReturn(run func(bool) config.Builder) *Builder_WithJavaScriptEnabled_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithSubject provides a mock function with given fields: subject, isUTF8
-func (_m *Builder) WithSubject(subject string, isUTF8 bool) config.Builder {
-	ret := _m.Called(subject, isUTF8)
+// WithKeywords provides a mock function with given fields: keywords, isUTF8
+func (_m *Builder) WithKeywords(keywords string, isUTF8 bool) config.Builder {
+	ret := _m.Called(keywords, isUTF8)
 
 	var r0 config.Builder
 	if rf, ok := ret.Get(0).(func(string, bool) config.Builder); ok {
-		r0 = rf(subject, isUTF8)
+		r0 = rf(keywords, isUTF8)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(config.Builder)
@@ -762,42 +894,42 @@ func (_m *Builder) WithSubject(subject string, isUTF8 bool) config.Builder {
 	return r0
 }
 
-// Builder_WithSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithSubject'
-type Builder_WithSubject_Call struct {
+// Builder_WithKeywords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithKeywords'
+type Builder_WithKeywords_Call struct {
 	*mock.Call
 }
 
-// WithSubject is a helper method to define mock.On call
-//   - subject string
+// WithKeywords is a helper method to define mock.On call
+//   - keywords string
 //   - isUTF8 bool
-func (_e *Builder_Expecter) WithSubject(subject interface{}, isUTF8 interface{}) *Builder_WithSubject_Call {
-	return &Builder_WithSubject_Call{Call: _e.mock.On("WithSubject", subject, isUTF8)}
+func (_e *Builder_Expecter) WithKeywords(keywords interface{}, isUTF8 interface{}) *Builder_WithKeywords_Call {
+	return &Builder_WithKeywords_Call{Call: _e.mock.On("WithKeywords", keywords, isUTF8)}
 }
 
-func (_c *Builder_WithSubject_Call) Run(run func(subject string, isUTF8 bool)) *Builder_WithSubject_Call {
+func (_c *Builder_WithKeywords_Call) Run(run func(keywords string, isUTF8 bool)) *Builder_WithKeywords_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string), args[1].(bool))
 	})
 	return _c
 }
 
-func (_c *Builder_WithSubject_Call) Return(_a0 config.Builder) *Builder_WithSubject_Call {
+func (_c *Builder_WithKeywords_Call) Return(_a0 config.Builder) *Builder_WithKeywords_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Builder_WithSubject_Call) RunAndReturn(run func(string, bool) config.Builder) *Builder_WithSubject_Call {
+func (_c *Builder_WithKeywords_Call) RunAndReturn(run func(string, bool) config.Builder) *Builder_WithKeywords_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithTitle provides a mock function with given fields: title, isUTF8
-func (_m *Builder) WithTitle(title string, isUTF8 bool) config.Builder {
-	ret := _m.Called(title, isUTF8)
+// WithLogger provides a mock function with given fields: logger
+func (_m *Builder) WithLogger(logger *log.Logger) config.Builder {
+	ret := _m.Called(logger)
 
 	var r0 config.Builder
-	if rf, ok := ret.Get(0).(func(string, bool) config.Builder); ok {
-		r0 = rf(title, isUTF8)
+	if rf, ok := ret.Get(0).(func(*log.Logger) config.Builder); ok {
+		r0 = rf(logger)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(config.Builder)
@@ -807,42 +939,41 @@ func (_m *Builder) WithTitle(title string, isUTF8 bool) config.Builder {
 	return r0
 }
 
-// Builder_WithTitle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTitle'
-type Builder_WithTitle_Call struct {
+// Builder_WithLogger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithLogger'
+type Builder_WithLogger_Call struct {
 	*mock.Call
 }
 
-// WithTitle is a helper method to define mock.On call
-//   - title string
-//   - isUTF8 bool
-func (_e *Builder_Expecter) WithTitle(title interface{}, isUTF8 interface{}) *Builder_WithTitle_Call {
-	return &Builder_WithTitle_Call{Call: _e.mock.On("WithTitle", title, isUTF8)}
+// WithLogger is a helper method to define mock.On call
+//   - logger *log.Logger
+func (_e *Builder_Expecter) WithLogger(logger interface{}) *Builder_WithLogger_Call {
+	return &Builder_WithLogger_Call{Call: _e.mock.On("WithLogger", logger)}
 }
 
-func (_c *Builder_WithTitle_Call) Run(run func(title string, isUTF8 bool)) *Builder_WithTitle_Call {
+func (_c *Builder_WithLogger_Call) Run(run func(logger *log.Logger)) *Builder_WithLogger_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(bool))
+		run(args[0].(*log.Logger))
 	})
 	return _c
 }
 
-func (_c *Builder_WithTitle_Call) Return(_a0 config.Builder) *Builder_WithTitle_Call {
+func (_c *Builder_WithLogger_Call) Return(_a0 config.Builder) *Builder_WithLogger_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Builder_WithTitle_Call) RunAndReturn(run func(string, bool) config.Builder) *Builder_WithTitle_Call {
+func (_c *Builder_WithLogger_Call) RunAndReturn(run func(*log.Logger) config.Builder) *Builder_WithLogger_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithWorkerPoolSize provides a mock function with given fields: poolSize
-func (_m *Builder) WithWorkerPoolSize(poolSize int) config.Builder {
-	ret := _m.Called(poolSize)
+// WithMargins provides a mock function with given fields: left, top, right
+func (_m *Builder) WithMargins(left float64, top float64, right float64) config.Builder {
+	ret := _m.Called(left, top, right)
 
 	var r0 config.Builder
-	if rf, ok := ret.Get(0).(func(int) config.Builder); ok {
-		r0 = rf(poolSize)
+	if rf, ok := ret.Get(0).(func(float64, float64, float64) config.Builder); ok {
+		r0 = rf(left, top, right)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(config.Builder)
@@ -852,16 +983,654 @@ func (_m *Builder) WithWorkerPoolSize(poolSize int) config.Builder {
 	return r0
 }
 
-// Builder_WithWorkerPoolSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithWorkerPoolSize'
-type Builder_WithWorkerPoolSize_Call struct {
+// Builder_WithMargins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithMargins'
+type Builder_WithMargins_Call struct {
 	*mock.Call
 }
 
-// WithWorkerPoolSize is a helper method to define mock.On call
-//   - poolSize int
-func (_e *Builder_Expecter) WithWorkerPoolSize(poolSize interface{}) *Builder_WithWorkerPoolSize_Call {
-	return &Builder_WithWorkerPoolSize_Call{Call: _e.mock.On("WithWorkerPoolSize", poolSize)}
-}
+// WithMargins is a helper method to define mock.On call
+//   - left float64
+//   - top float64
+//   - right float64
+func (_e *Builder_Expecter) WithMargins(left interface{}, top interface{}, right interface{}) *Builder_WithMargins_Call {
+	return &Builder_WithMargins_Call{Call: _e.mock.On("WithMargins", left, top, right)}
+}
+
+func (_c *Builder_WithMargins_Call) Run(run func(left float64, top float64, right float64)) *Builder_WithMargins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Builder_WithMargins_Call) Return(_a0 config.Builder) *Builder_WithMargins_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithMargins_Call) RunAndReturn(run func(float64, float64, float64) config.Builder) *Builder_WithMargins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithMaxGridSize provides a mock function with given fields: maxGridSize
+func (_m *Builder) WithMaxGridSize(maxGridSize int) config.Builder {
+	ret := _m.Called(maxGridSize)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(int) config.Builder); ok {
+		r0 = rf(maxGridSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithMaxGridSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithMaxGridSize'
+type Builder_WithMaxGridSize_Call struct {
+	*mock.Call
+}
+
+// WithMaxGridSize is a helper method to define mock.On call
+//   - maxGridSize int
+func (_e *Builder_Expecter) WithMaxGridSize(maxGridSize interface{}) *Builder_WithMaxGridSize_Call {
+	return &Builder_WithMaxGridSize_Call{Call: _e.mock.On("WithMaxGridSize", maxGridSize)}
+}
+
+func (_c *Builder_WithMaxGridSize_Call) Run(run func(maxGridSize int)) *Builder_WithMaxGridSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Builder_WithMaxGridSize_Call) Return(_a0 config.Builder) *Builder_WithMaxGridSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithMaxGridSize_Call) RunAndReturn(run func(int) config.Builder) *Builder_WithMaxGridSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithModificationDate provides a mock function with given fields: _a0
+func (_m *Builder) WithModificationDate(_a0 time.Time) config.Builder {
+	ret := _m.Called(_a0)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(time.Time) config.Builder); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithModificationDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithModificationDate'
+type Builder_WithModificationDate_Call struct {
+	*mock.Call
+}
+
+// WithModificationDate is a helper method to define mock.On call
+//   - _a0 time.Time
+func (_e *Builder_Expecter) WithModificationDate(_a0 interface{}) *Builder_WithModificationDate_Call {
+	return &Builder_WithModificationDate_Call{Call: _e.mock.On("WithModificationDate", _a0)}
+}
+
+func (_c *Builder_WithModificationDate_Call) Run(run func(_a0 time.Time)) *Builder_WithModificationDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Builder_WithModificationDate_Call) Return(_a0 config.Builder) *Builder_WithModificationDate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithModificationDate_Call) RunAndReturn(run func(time.Time) config.Builder) *Builder_WithModificationDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithObjectStreams provides a mock function with given fields: enabled
+func (_m *Builder) WithObjectStreams(enabled bool) config.Builder {
+	ret := _m.Called(enabled)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(bool) config.Builder); ok {
+		r0 = rf(enabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithObjectStreams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithObjectStreams'
+type Builder_WithObjectStreams_Call struct {
+	*mock.Call
+}
+
+// WithObjectStreams is a helper method to define mock.On call
+//   - enabled bool
+func (_e *Builder_Expecter) WithObjectStreams(enabled interface{}) *Builder_WithObjectStreams_Call {
+	return &Builder_WithObjectStreams_Call{Call: _e.mock.On("WithObjectStreams", enabled)}
+}
+
+func (_c *Builder_WithObjectStreams_Call) Run(run func(enabled bool)) *Builder_WithObjectStreams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *Builder_WithObjectStreams_Call) Return(_a0 config.Builder) *Builder_WithObjectStreams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithObjectStreams_Call) RunAndReturn(run func(bool) config.Builder) *Builder_WithObjectStreams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithOrientation provides a mock function with given fields: _a0
+func (_m *Builder) WithOrientation(_a0 orientation.Type) config.Builder {
+	ret := _m.Called(_a0)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(orientation.Type) config.Builder); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithOrientation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithOrientation'
+type Builder_WithOrientation_Call struct {
+	*mock.Call
+}
+
+// WithOrientation is a helper method to define mock.On call
+//   - _a0 orientation.Type
+func (_e *Builder_Expecter) WithOrientation(_a0 interface{}) *Builder_WithOrientation_Call {
+	return &Builder_WithOrientation_Call{Call: _e.mock.On("WithOrientation", _a0)}
+}
+
+func (_c *Builder_WithOrientation_Call) Run(run func(_a0 orientation.Type)) *Builder_WithOrientation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(orientation.Type))
+	})
+	return _c
+}
+
+func (_c *Builder_WithOrientation_Call) Return(_a0 config.Builder) *Builder_WithOrientation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithOrientation_Call) RunAndReturn(run func(orientation.Type) config.Builder) *Builder_WithOrientation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithPageNumber provides a mock function with given fields: pattern, place
+func (_m *Builder) WithPageNumber(pattern string, place props.Place) config.Builder {
+	ret := _m.Called(pattern, place)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(string, props.Place) config.Builder); ok {
+		r0 = rf(pattern, place)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithPageNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithPageNumber'
+type Builder_WithPageNumber_Call struct {
+	*mock.Call
+}
+
+// WithPageNumber is a helper method to define mock.On call
+//   - pattern string
+//   - place props.Place
+func (_e *Builder_Expecter) WithPageNumber(pattern interface{}, place interface{}) *Builder_WithPageNumber_Call {
+	return &Builder_WithPageNumber_Call{Call: _e.mock.On("WithPageNumber", pattern, place)}
+}
+
+func (_c *Builder_WithPageNumber_Call) Run(run func(pattern string, place props.Place)) *Builder_WithPageNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(props.Place))
+	})
+	return _c
+}
+
+func (_c *Builder_WithPageNumber_Call) Return(_a0 config.Builder) *Builder_WithPageNumber_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithPageNumber_Call) RunAndReturn(run func(string, props.Place) config.Builder) *Builder_WithPageNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithPageNumberOffset provides a mock function with given fields: offset
+func (_m *Builder) WithPageNumberOffset(offset int) config.Builder {
+	ret := _m.Called(offset)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(int) config.Builder); ok {
+		r0 = rf(offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithPageNumberOffset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithPageNumberOffset'
+type Builder_WithPageNumberOffset_Call struct {
+	*mock.Call
+}
+
+// WithPageNumberOffset is a helper method to define mock.On call
+//   - offset int
+func (_e *Builder_Expecter) WithPageNumberOffset(offset interface{}) *Builder_WithPageNumberOffset_Call {
+	return &Builder_WithPageNumberOffset_Call{Call: _e.mock.On("WithPageNumberOffset", offset)}
+}
+
+func (_c *Builder_WithPageNumberOffset_Call) Run(run func(offset int)) *Builder_WithPageNumberOffset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Builder_WithPageNumberOffset_Call) Return(_a0 config.Builder) *Builder_WithPageNumberOffset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithPageNumberOffset_Call) RunAndReturn(run func(int) config.Builder) *Builder_WithPageNumberOffset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithPageSize provides a mock function with given fields: size
+func (_m *Builder) WithPageSize(size pagesize.Type) config.Builder {
+	ret := _m.Called(size)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(pagesize.Type) config.Builder); ok {
+		r0 = rf(size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithPageSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithPageSize'
+type Builder_WithPageSize_Call struct {
+	*mock.Call
+}
+
+// WithPageSize is a helper method to define mock.On call
+//   - size pagesize.Type
+func (_e *Builder_Expecter) WithPageSize(size interface{}) *Builder_WithPageSize_Call {
+	return &Builder_WithPageSize_Call{Call: _e.mock.On("WithPageSize", size)}
+}
+
+func (_c *Builder_WithPageSize_Call) Run(run func(size pagesize.Type)) *Builder_WithPageSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pagesize.Type))
+	})
+	return _c
+}
+
+func (_c *Builder_WithPageSize_Call) Return(_a0 config.Builder) *Builder_WithPageSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithPageSize_Call) RunAndReturn(run func(pagesize.Type) config.Builder) *Builder_WithPageSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithPageTemplate provides a mock function with given fields: fn
+func (_m *Builder) WithPageTemplate(fn func(int, int) []core.Row) config.Builder {
+	ret := _m.Called(fn)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(func(int, int) []core.Row) config.Builder); ok {
+		r0 = rf(fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithPageTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithPageTemplate'
+type Builder_WithPageTemplate_Call struct {
+	*mock.Call
+}
+
+// WithPageTemplate is a helper method to define mock.On call
+//   - fn func(int , int) []core.Row
+func (_e *Builder_Expecter) WithPageTemplate(fn interface{}) *Builder_WithPageTemplate_Call {
+	return &Builder_WithPageTemplate_Call{Call: _e.mock.On("WithPageTemplate", fn)}
+}
+
+func (_c *Builder_WithPageTemplate_Call) Run(run func(fn func(int, int) []core.Row)) *Builder_WithPageTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(int, int) []core.Row))
+	})
+	return _c
+}
+
+func (_c *Builder_WithPageTemplate_Call) Return(_a0 config.Builder) *Builder_WithPageTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithPageTemplate_Call) RunAndReturn(run func(func(int, int) []core.Row) config.Builder) *Builder_WithPageTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithProtection provides a mock function with given fields: protectionType, userPassword, ownerPassword
+func (_m *Builder) WithProtection(protectionType protection.Type, userPassword string, ownerPassword string) config.Builder {
+	ret := _m.Called(protectionType, userPassword, ownerPassword)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(protection.Type, string, string) config.Builder); ok {
+		r0 = rf(protectionType, userPassword, ownerPassword)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithProtection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithProtection'
+type Builder_WithProtection_Call struct {
+	*mock.Call
+}
+
+// WithProtection is a helper method to define mock.On call
+//   - protectionType protection.Type
+//   - userPassword string
+//   - ownerPassword string
+func (_e *Builder_Expecter) WithProtection(protectionType interface{}, userPassword interface{}, ownerPassword interface{}) *Builder_WithProtection_Call {
+	return &Builder_WithProtection_Call{Call: _e.mock.On("WithProtection", protectionType, userPassword, ownerPassword)}
+}
+
+func (_c *Builder_WithProtection_Call) Run(run func(protectionType protection.Type, userPassword string, ownerPassword string)) *Builder_WithProtection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(protection.Type), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Builder_WithProtection_Call) Return(_a0 config.Builder) *Builder_WithProtection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithProtection_Call) RunAndReturn(run func(protection.Type, string, string) config.Builder) *Builder_WithProtection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithRTLLayout provides a mock function with given fields: enabled
+func (_m *Builder) WithRTLLayout(enabled bool) config.Builder {
+	ret := _m.Called(enabled)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(bool) config.Builder); ok {
+		r0 = rf(enabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithRTLLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithRTLLayout'
+type Builder_WithRTLLayout_Call struct {
+	*mock.Call
+}
+
+// WithRTLLayout is a helper method to define mock.On call
+//   - enabled bool
+func (_e *Builder_Expecter) WithRTLLayout(enabled interface{}) *Builder_WithRTLLayout_Call {
+	return &Builder_WithRTLLayout_Call{Call: _e.mock.On("WithRTLLayout", enabled)}
+}
+
+func (_c *Builder_WithRTLLayout_Call) Run(run func(enabled bool)) *Builder_WithRTLLayout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *Builder_WithRTLLayout_Call) Return(_a0 config.Builder) *Builder_WithRTLLayout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithRTLLayout_Call) RunAndReturn(run func(bool) config.Builder) *Builder_WithRTLLayout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithSubject provides a mock function with given fields: subject, isUTF8
+func (_m *Builder) WithSubject(subject string, isUTF8 bool) config.Builder {
+	ret := _m.Called(subject, isUTF8)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(string, bool) config.Builder); ok {
+		r0 = rf(subject, isUTF8)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithSubject'
+type Builder_WithSubject_Call struct {
+	*mock.Call
+}
+
+// WithSubject is a helper method to define mock.On call
+//   - subject string
+//   - isUTF8 bool
+func (_e *Builder_Expecter) WithSubject(subject interface{}, isUTF8 interface{}) *Builder_WithSubject_Call {
+	return &Builder_WithSubject_Call{Call: _e.mock.On("WithSubject", subject, isUTF8)}
+}
+
+func (_c *Builder_WithSubject_Call) Run(run func(subject string, isUTF8 bool)) *Builder_WithSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *Builder_WithSubject_Call) Return(_a0 config.Builder) *Builder_WithSubject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithSubject_Call) RunAndReturn(run func(string, bool) config.Builder) *Builder_WithSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTitle provides a mock function with given fields: title, isUTF8
+func (_m *Builder) WithTitle(title string, isUTF8 bool) config.Builder {
+	ret := _m.Called(title, isUTF8)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(string, bool) config.Builder); ok {
+		r0 = rf(title, isUTF8)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithTitle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTitle'
+type Builder_WithTitle_Call struct {
+	*mock.Call
+}
+
+// WithTitle is a helper method to define mock.On call
+//   - title string
+//   - isUTF8 bool
+func (_e *Builder_Expecter) WithTitle(title interface{}, isUTF8 interface{}) *Builder_WithTitle_Call {
+	return &Builder_WithTitle_Call{Call: _e.mock.On("WithTitle", title, isUTF8)}
+}
+
+func (_c *Builder_WithTitle_Call) Run(run func(title string, isUTF8 bool)) *Builder_WithTitle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *Builder_WithTitle_Call) Return(_a0 config.Builder) *Builder_WithTitle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithTitle_Call) RunAndReturn(run func(string, bool) config.Builder) *Builder_WithTitle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithWatermarkText provides a mock function with given fields: text, ps
+func (_m *Builder) WithWatermarkText(text string, ps ...props.Watermark) config.Builder {
+	_va := make([]interface{}, len(ps))
+	for _i := range ps {
+		_va[_i] = ps[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, text)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(string, ...props.Watermark) config.Builder); ok {
+		r0 = rf(text, ps...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithWatermarkText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithWatermarkText'
+type Builder_WithWatermarkText_Call struct {
+	*mock.Call
+}
+
+// WithWatermarkText is a helper method to define mock.On call
+//   - text string
+//   - ps ...props.Watermark
+func (_e *Builder_Expecter) WithWatermarkText(text interface{}, ps ...interface{}) *Builder_WithWatermarkText_Call {
+	return &Builder_WithWatermarkText_Call{Call: _e.mock.On("WithWatermarkText",
+		append([]interface{}{text}, ps...)...)}
+}
+
+func (_c *Builder_WithWatermarkText_Call) Run(run func(text string, ps ...props.Watermark)) *Builder_WithWatermarkText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]props.Watermark, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(props.Watermark)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Builder_WithWatermarkText_Call) Return(_a0 config.Builder) *Builder_WithWatermarkText_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithWatermarkText_Call) RunAndReturn(run func(string, ...props.Watermark) config.Builder) *Builder_WithWatermarkText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithWorkerPoolSize provides a mock function with given fields: poolSize
+func (_m *Builder) WithWorkerPoolSize(poolSize int) config.Builder {
+	ret := _m.Called(poolSize)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(int) config.Builder); ok {
+		r0 = rf(poolSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithWorkerPoolSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithWorkerPoolSize'
+type Builder_WithWorkerPoolSize_Call struct {
+	*mock.Call
+}
+
+// WithWorkerPoolSize is a helper method to define mock.On call
+//   - poolSize int
+func (_e *Builder_Expecter) WithWorkerPoolSize(poolSize interface{}) *Builder_WithWorkerPoolSize_Call {
+	return &Builder_WithWorkerPoolSize_Call{Call: _e.mock.On("WithWorkerPoolSize", poolSize)}
+}
 
 func (_c *Builder_WithWorkerPoolSize_Call) Run(run func(poolSize int)) *Builder_WithWorkerPoolSize_Call {
 	_c.Call.Run(func(args mock.Arguments) {
@@ -880,6 +1649,50 @@ func (_c *Builder_WithWorkerPoolSize_Call) RunAndReturn(run func(int) config.Bui
 	return _c
 }
 
+// WithWorkerPoolStrategy provides a mock function with given fields: strategy
+func (_m *Builder) WithWorkerPoolStrategy(strategy pool.Strategy) config.Builder {
+	ret := _m.Called(strategy)
+
+	var r0 config.Builder
+	if rf, ok := ret.Get(0).(func(pool.Strategy) config.Builder); ok {
+		r0 = rf(strategy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Builder)
+		}
+	}
+
+	return r0
+}
+
+// Builder_WithWorkerPoolStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithWorkerPoolStrategy'
+type Builder_WithWorkerPoolStrategy_Call struct {
+	*mock.Call
+}
+
+// WithWorkerPoolStrategy is a helper method to define mock.On call
+//   - strategy pool.Strategy
+func (_e *Builder_Expecter) WithWorkerPoolStrategy(strategy interface{}) *Builder_WithWorkerPoolStrategy_Call {
+	return &Builder_WithWorkerPoolStrategy_Call{Call: _e.mock.On("WithWorkerPoolStrategy", strategy)}
+}
+
+func (_c *Builder_WithWorkerPoolStrategy_Call) Run(run func(strategy pool.Strategy)) *Builder_WithWorkerPoolStrategy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pool.Strategy))
+	})
+	return _c
+}
+
+func (_c *Builder_WithWorkerPoolStrategy_Call) Return(_a0 config.Builder) *Builder_WithWorkerPoolStrategy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_WithWorkerPoolStrategy_Call) RunAndReturn(run func(pool.Strategy) config.Builder) *Builder_WithWorkerPoolStrategy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBuilder creates a new instance of Builder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBuilder(t interface {
@@ -22,6 +22,60 @@ func (_m *Code) EXPECT() *Code_Expecter {
 	return &Code_Expecter{mock: &_m.Mock}
 }
 
+// GenAztec provides a mock function with given fields: code
+func (_m *Code) GenAztec(code string) (*entity.Image, error) {
+	ret := _m.Called(code)
+
+	var r0 *entity.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entity.Image, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entity.Image); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GenAztec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenAztec'
+type Code_GenAztec_Call struct {
+	*mock.Call
+}
+
+// GenAztec is a helper method to define mock.On call
+//   - code string
+func (_e *Code_Expecter) GenAztec(code interface{}) *Code_GenAztec_Call {
+	return &Code_GenAztec_Call{Call: _e.mock.On("GenAztec", code)}
+}
+
+func (_c *Code_GenAztec_Call) Run(run func(code string)) *Code_GenAztec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Code_GenAztec_Call) Return(_a0 *entity.Image, _a1 error) *Code_GenAztec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GenAztec_Call) RunAndReturn(run func(string) (*entity.Image, error)) *Code_GenAztec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenBar provides a mock function with given fields: code, cell, prop
 func (_m *Code) GenBar(code string, cell *entity.Cell, prop *props.Barcode) (*entity.Image, error) {
 	ret := _m.Called(code, cell, prop)
@@ -132,6 +186,115 @@ func (_c *Code_GenDataMatrix_Call) RunAndReturn(run func(string) (*entity.Image,
 	return _c
 }
 
+// GenMicroQr provides a mock function with given fields: code
+func (_m *Code) GenMicroQr(code string) (*entity.Image, error) {
+	ret := _m.Called(code)
+
+	var r0 *entity.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entity.Image, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entity.Image); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GenMicroQr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenMicroQr'
+type Code_GenMicroQr_Call struct {
+	*mock.Call
+}
+
+// GenMicroQr is a helper method to define mock.On call
+//   - code string
+func (_e *Code_Expecter) GenMicroQr(code interface{}) *Code_GenMicroQr_Call {
+	return &Code_GenMicroQr_Call{Call: _e.mock.On("GenMicroQr", code)}
+}
+
+func (_c *Code_GenMicroQr_Call) Run(run func(code string)) *Code_GenMicroQr_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Code_GenMicroQr_Call) Return(_a0 *entity.Image, _a1 error) *Code_GenMicroQr_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GenMicroQr_Call) RunAndReturn(run func(string) (*entity.Image, error)) *Code_GenMicroQr_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenPDF417 provides a mock function with given fields: code, prop
+func (_m *Code) GenPDF417(code string, prop *props.PDF417) (*entity.Image, error) {
+	ret := _m.Called(code, prop)
+
+	var r0 *entity.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *props.PDF417) (*entity.Image, error)); ok {
+		return rf(code, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *props.PDF417) *entity.Image); ok {
+		r0 = rf(code, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *props.PDF417) error); ok {
+		r1 = rf(code, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GenPDF417_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenPDF417'
+type Code_GenPDF417_Call struct {
+	*mock.Call
+}
+
+// GenPDF417 is a helper method to define mock.On call
+//   - code string
+//   - prop *props.PDF417
+func (_e *Code_Expecter) GenPDF417(code interface{}, prop interface{}) *Code_GenPDF417_Call {
+	return &Code_GenPDF417_Call{Call: _e.mock.On("GenPDF417", code, prop)}
+}
+
+func (_c *Code_GenPDF417_Call) Run(run func(code string, prop *props.PDF417)) *Code_GenPDF417_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.PDF417))
+	})
+	return _c
+}
+
+func (_c *Code_GenPDF417_Call) Return(_a0 *entity.Image, _a1 error) *Code_GenPDF417_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GenPDF417_Call) RunAndReturn(run func(string, *props.PDF417) (*entity.Image, error)) *Code_GenPDF417_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenQr provides a mock function with given fields: code
 func (_m *Code) GenQr(code string) (*entity.Image, error) {
 	ret := _m.Called(code)
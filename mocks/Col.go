@@ -124,6 +124,47 @@ func (_c *Col_GetSize_Call) RunAndReturn(run func() int) *Col_GetSize_Call {
 	return _c
 }
 
+// GetSpan provides a mock function with given fields:
+func (_m *Col) GetSpan() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Col_GetSpan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpan'
+type Col_GetSpan_Call struct {
+	*mock.Call
+}
+
+// GetSpan is a helper method to define mock.On call
+func (_e *Col_Expecter) GetSpan() *Col_GetSpan_Call {
+	return &Col_GetSpan_Call{Call: _e.mock.On("GetSpan")}
+}
+
+func (_c *Col_GetSpan_Call) Run(run func()) *Col_GetSpan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Col_GetSpan_Call) Return(_a0 int) *Col_GetSpan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Col_GetSpan_Call) RunAndReturn(run func() int) *Col_GetSpan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStructure provides a mock function with given fields:
 func (_m *Col) GetStructure() *node.Node[core.Structure] {
 	ret := _m.Called()
@@ -235,6 +276,94 @@ func (_c *Col_SetConfig_Call) RunAndReturn(run func(*entity.Config)) *Col_SetCon
 	return _c
 }
 
+// Span provides a mock function with given fields: n
+func (_m *Col) Span(n int) core.Col {
+	ret := _m.Called(n)
+
+	var r0 core.Col
+	if rf, ok := ret.Get(0).(func(int) core.Col); ok {
+		r0 = rf(n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Col)
+		}
+	}
+
+	return r0
+}
+
+// Col_Span_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Span'
+type Col_Span_Call struct {
+	*mock.Call
+}
+
+// Span is a helper method to define mock.On call
+//   - n int
+func (_e *Col_Expecter) Span(n interface{}) *Col_Span_Call {
+	return &Col_Span_Call{Call: _e.mock.On("Span", n)}
+}
+
+func (_c *Col_Span_Call) Run(run func(n int)) *Col_Span_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Col_Span_Call) Return(_a0 core.Col) *Col_Span_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Col_Span_Call) RunAndReturn(run func(int) core.Col) *Col_Span_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithID provides a mock function with given fields: id
+func (_m *Col) WithID(id string) core.Col {
+	ret := _m.Called(id)
+
+	var r0 core.Col
+	if rf, ok := ret.Get(0).(func(string) core.Col); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Col)
+		}
+	}
+
+	return r0
+}
+
+// Col_WithID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithID'
+type Col_WithID_Call struct {
+	*mock.Call
+}
+
+// WithID is a helper method to define mock.On call
+//   - id string
+func (_e *Col_Expecter) WithID(id interface{}) *Col_WithID_Call {
+	return &Col_WithID_Call{Call: _e.mock.On("WithID", id)}
+}
+
+func (_c *Col_WithID_Call) Run(run func(id string)) *Col_WithID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Col_WithID_Call) Return(_a0 core.Col) *Col_WithID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Col_WithID_Call) RunAndReturn(run func(string) core.Col) *Col_WithID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithStyle provides a mock function with given fields: style
 func (_m *Col) WithStyle(style *props.Cell) core.Col {
 	ret := _m.Called(style)
@@ -279,6 +408,50 @@ func (_c *Col_WithStyle_Call) RunAndReturn(run func(*props.Cell) core.Col) *Col_
 	return _c
 }
 
+// WithTooltip provides a mock function with given fields: tooltip
+func (_m *Col) WithTooltip(tooltip string) core.Col {
+	ret := _m.Called(tooltip)
+
+	var r0 core.Col
+	if rf, ok := ret.Get(0).(func(string) core.Col); ok {
+		r0 = rf(tooltip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Col)
+		}
+	}
+
+	return r0
+}
+
+// Col_WithTooltip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTooltip'
+type Col_WithTooltip_Call struct {
+	*mock.Call
+}
+
+// WithTooltip is a helper method to define mock.On call
+//   - tooltip string
+func (_e *Col_Expecter) WithTooltip(tooltip interface{}) *Col_WithTooltip_Call {
+	return &Col_WithTooltip_Call{Call: _e.mock.On("WithTooltip", tooltip)}
+}
+
+func (_c *Col_WithTooltip_Call) Run(run func(tooltip string)) *Col_WithTooltip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Col_WithTooltip_Call) Return(_a0 core.Col) *Col_WithTooltip_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Col_WithTooltip_Call) RunAndReturn(run func(string) core.Col) *Col_WithTooltip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewCol creates a new instance of Col. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCol(t interface {
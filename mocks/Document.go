@@ -3,7 +3,13 @@
 package mocks
 
 import (
+	core "github.com/johnfercher/maroto/v2/pkg/core"
+	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
+
+	exporter "github.com/johnfercher/maroto/v2/pkg/exporter"
+
 	metrics "github.com/johnfercher/maroto/v2/pkg/metrics"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -20,6 +26,172 @@ func (_m *Document) EXPECT() *Document_Expecter {
 	return &Document_Expecter{mock: &_m.Mock}
 }
 
+// Errors provides a mock function with given fields:
+func (_m *Document) Errors() []core.PageError {
+	ret := _m.Called()
+
+	var r0 []core.PageError
+	if rf, ok := ret.Get(0).(func() []core.PageError); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.PageError)
+		}
+	}
+
+	return r0
+}
+
+// Document_Errors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Errors'
+type Document_Errors_Call struct {
+	*mock.Call
+}
+
+// Errors is a helper method to define mock.On call
+func (_e *Document_Expecter) Errors() *Document_Errors_Call {
+	return &Document_Errors_Call{Call: _e.mock.On("Errors")}
+}
+
+func (_c *Document_Errors_Call) Run(run func()) *Document_Errors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Document_Errors_Call) Return(_a0 []core.PageError) *Document_Errors_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Document_Errors_Call) RunAndReturn(run func() []core.PageError) *Document_Errors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportToZIP provides a mock function with given fields: pages, format, opts
+func (_m *Document) ExportToZIP(pages []int, format exporter.Format, opts ...exporter.Option) ([]byte, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, pages, format)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int, exporter.Format, ...exporter.Option) ([]byte, error)); ok {
+		return rf(pages, format, opts...)
+	}
+	if rf, ok := ret.Get(0).(func([]int, exporter.Format, ...exporter.Option) []byte); ok {
+		r0 = rf(pages, format, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int, exporter.Format, ...exporter.Option) error); ok {
+		r1 = rf(pages, format, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Document_ExportToZIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportToZIP'
+type Document_ExportToZIP_Call struct {
+	*mock.Call
+}
+
+// ExportToZIP is a helper method to define mock.On call
+//   - pages []int
+//   - format exporter.Format
+//   - opts ...exporter.Option
+func (_e *Document_Expecter) ExportToZIP(pages interface{}, format interface{}, opts ...interface{}) *Document_ExportToZIP_Call {
+	return &Document_ExportToZIP_Call{Call: _e.mock.On("ExportToZIP",
+		append([]interface{}{pages, format}, opts...)...)}
+}
+
+func (_c *Document_ExportToZIP_Call) Run(run func(pages []int, format exporter.Format, opts ...exporter.Option)) *Document_ExportToZIP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]exporter.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(exporter.Option)
+			}
+		}
+		run(args[0].([]int), args[1].(exporter.Format), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Document_ExportToZIP_Call) Return(_a0 []byte, _a1 error) *Document_ExportToZIP_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Document_ExportToZIP_Call) RunAndReturn(run func([]int, exporter.Format, ...exporter.Option) ([]byte, error)) *Document_ExportToZIP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlattenForms provides a mock function with given fields:
+func (_m *Document) FlattenForms() (core.Document, error) {
+	ret := _m.Called()
+
+	var r0 core.Document
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (core.Document, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() core.Document); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Document)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Document_FlattenForms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlattenForms'
+type Document_FlattenForms_Call struct {
+	*mock.Call
+}
+
+// FlattenForms is a helper method to define mock.On call
+func (_e *Document_Expecter) FlattenForms() *Document_FlattenForms_Call {
+	return &Document_FlattenForms_Call{Call: _e.mock.On("FlattenForms")}
+}
+
+func (_c *Document_FlattenForms_Call) Run(run func()) *Document_FlattenForms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Document_FlattenForms_Call) Return(_a0 core.Document, _a1 error) *Document_FlattenForms_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Document_FlattenForms_Call) RunAndReturn(run func() (core.Document, error)) *Document_FlattenForms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBase64 provides a mock function with given fields:
 func (_m *Document) GetBase64() string {
 	ret := _m.Called()
@@ -61,6 +233,49 @@ func (_c *Document_GetBase64_Call) RunAndReturn(run func() string) *Document_Get
 	return _c
 }
 
+// GetBookmarks provides a mock function with given fields:
+func (_m *Document) GetBookmarks() []entity.Bookmark {
+	ret := _m.Called()
+
+	var r0 []entity.Bookmark
+	if rf, ok := ret.Get(0).(func() []entity.Bookmark); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Bookmark)
+		}
+	}
+
+	return r0
+}
+
+// Document_GetBookmarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBookmarks'
+type Document_GetBookmarks_Call struct {
+	*mock.Call
+}
+
+// GetBookmarks is a helper method to define mock.On call
+func (_e *Document_Expecter) GetBookmarks() *Document_GetBookmarks_Call {
+	return &Document_GetBookmarks_Call{Call: _e.mock.On("GetBookmarks")}
+}
+
+func (_c *Document_GetBookmarks_Call) Run(run func()) *Document_GetBookmarks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Document_GetBookmarks_Call) Return(_a0 []entity.Bookmark) *Document_GetBookmarks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Document_GetBookmarks_Call) RunAndReturn(run func() []entity.Bookmark) *Document_GetBookmarks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBytes provides a mock function with given fields:
 func (_m *Document) GetBytes() []byte {
 	ret := _m.Called()
@@ -0,0 +1,397 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	core "github.com/johnfercher/maroto/v2/pkg/core"
+	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	node "github.com/johnfercher/go-tree/node"
+
+	props "github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// FlowRow is an autogenerated mock type for the FlowRow type
+type FlowRow struct {
+	mock.Mock
+}
+
+type FlowRow_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FlowRow) EXPECT() *FlowRow_Expecter {
+	return &FlowRow_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: cols
+func (_m *FlowRow) Add(cols ...core.Col) core.Row {
+	_va := make([]interface{}, len(cols))
+	for _i := range cols {
+		_va[_i] = cols[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(...core.Col) core.Row); ok {
+		r0 = rf(cols...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// FlowRow_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type FlowRow_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - cols ...core.Col
+func (_e *FlowRow_Expecter) Add(cols ...interface{}) *FlowRow_Add_Call {
+	return &FlowRow_Add_Call{Call: _e.mock.On("Add",
+		append([]interface{}{}, cols...)...)}
+}
+
+func (_c *FlowRow_Add_Call) Run(run func(cols ...core.Col)) *FlowRow_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Col, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Col)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FlowRow_Add_Call) Return(_a0 core.Row) *FlowRow_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlowRow_Add_Call) RunAndReturn(run func(...core.Col) core.Row) *FlowRow_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHeight provides a mock function with given fields:
+func (_m *FlowRow) GetHeight() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// FlowRow_GetHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeight'
+type FlowRow_GetHeight_Call struct {
+	*mock.Call
+}
+
+// GetHeight is a helper method to define mock.On call
+func (_e *FlowRow_Expecter) GetHeight() *FlowRow_GetHeight_Call {
+	return &FlowRow_GetHeight_Call{Call: _e.mock.On("GetHeight")}
+}
+
+func (_c *FlowRow_GetHeight_Call) Run(run func()) *FlowRow_GetHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FlowRow_GetHeight_Call) Return(_a0 float64) *FlowRow_GetHeight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlowRow_GetHeight_Call) RunAndReturn(run func() float64) *FlowRow_GetHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStructure provides a mock function with given fields:
+func (_m *FlowRow) GetStructure() *node.Node[core.Structure] {
+	ret := _m.Called()
+
+	var r0 *node.Node[core.Structure]
+	if rf, ok := ret.Get(0).(func() *node.Node[core.Structure]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*node.Node[core.Structure])
+		}
+	}
+
+	return r0
+}
+
+// FlowRow_GetStructure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStructure'
+type FlowRow_GetStructure_Call struct {
+	*mock.Call
+}
+
+// GetStructure is a helper method to define mock.On call
+func (_e *FlowRow_Expecter) GetStructure() *FlowRow_GetStructure_Call {
+	return &FlowRow_GetStructure_Call{Call: _e.mock.On("GetStructure")}
+}
+
+func (_c *FlowRow_GetStructure_Call) Run(run func()) *FlowRow_GetStructure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FlowRow_GetStructure_Call) Return(_a0 *node.Node[core.Structure]) *FlowRow_GetStructure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlowRow_GetStructure_Call) RunAndReturn(run func() *node.Node[core.Structure]) *FlowRow_GetStructure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Render provides a mock function with given fields: provider, cell
+func (_m *FlowRow) Render(provider core.Provider, cell entity.Cell) {
+	_m.Called(provider, cell)
+}
+
+// FlowRow_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type FlowRow_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell entity.Cell
+func (_e *FlowRow_Expecter) Render(provider interface{}, cell interface{}) *FlowRow_Render_Call {
+	return &FlowRow_Render_Call{Call: _e.mock.On("Render", provider, cell)}
+}
+
+func (_c *FlowRow_Render_Call) Run(run func(provider core.Provider, cell entity.Cell)) *FlowRow_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(entity.Cell))
+	})
+	return _c
+}
+
+func (_c *FlowRow_Render_Call) Return() *FlowRow_Render_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FlowRow_Render_Call) RunAndReturn(run func(core.Provider, entity.Cell)) *FlowRow_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetConfig provides a mock function with given fields: config
+func (_m *FlowRow) SetConfig(config *entity.Config) {
+	_m.Called(config)
+}
+
+// FlowRow_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
+type FlowRow_SetConfig_Call struct {
+	*mock.Call
+}
+
+// SetConfig is a helper method to define mock.On call
+//   - config *entity.Config
+func (_e *FlowRow_Expecter) SetConfig(config interface{}) *FlowRow_SetConfig_Call {
+	return &FlowRow_SetConfig_Call{Call: _e.mock.On("SetConfig", config)}
+}
+
+func (_c *FlowRow_SetConfig_Call) Run(run func(config *entity.Config)) *FlowRow_SetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Config))
+	})
+	return _c
+}
+
+func (_c *FlowRow_SetConfig_Call) Return() *FlowRow_SetConfig_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FlowRow_SetConfig_Call) RunAndReturn(run func(*entity.Config)) *FlowRow_SetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Split provides a mock function with given fields: height, width, config
+func (_m *FlowRow) Split(height float64, width float64, config *entity.Config) (core.Row, core.FlowRow) {
+	ret := _m.Called(height, width, config)
+
+	var r0 core.Row
+	var r1 core.FlowRow
+	if rf, ok := ret.Get(0).(func(float64, float64, *entity.Config) (core.Row, core.FlowRow)); ok {
+		return rf(height, width, config)
+	}
+	if rf, ok := ret.Get(0).(func(float64, float64, *entity.Config) core.Row); ok {
+		r0 = rf(height, width, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(float64, float64, *entity.Config) core.FlowRow); ok {
+		r1 = rf(height, width, config)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(core.FlowRow)
+		}
+	}
+
+	return r0, r1
+}
+
+// FlowRow_Split_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Split'
+type FlowRow_Split_Call struct {
+	*mock.Call
+}
+
+// Split is a helper method to define mock.On call
+//   - height float64
+//   - width float64
+//   - config *entity.Config
+func (_e *FlowRow_Expecter) Split(height interface{}, width interface{}, config interface{}) *FlowRow_Split_Call {
+	return &FlowRow_Split_Call{Call: _e.mock.On("Split", height, width, config)}
+}
+
+func (_c *FlowRow_Split_Call) Run(run func(height float64, width float64, config *entity.Config)) *FlowRow_Split_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(*entity.Config))
+	})
+	return _c
+}
+
+func (_c *FlowRow_Split_Call) Return(_a0 core.Row, _a1 core.FlowRow) *FlowRow_Split_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FlowRow_Split_Call) RunAndReturn(run func(float64, float64, *entity.Config) (core.Row, core.FlowRow)) *FlowRow_Split_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithBookmark provides a mock function with given fields: title, level
+func (_m *FlowRow) WithBookmark(title string, level int) core.Row {
+	ret := _m.Called(title, level)
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(string, int) core.Row); ok {
+		r0 = rf(title, level)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// FlowRow_WithBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithBookmark'
+type FlowRow_WithBookmark_Call struct {
+	*mock.Call
+}
+
+// WithBookmark is a helper method to define mock.On call
+//   - title string
+//   - level int
+func (_e *FlowRow_Expecter) WithBookmark(title interface{}, level interface{}) *FlowRow_WithBookmark_Call {
+	return &FlowRow_WithBookmark_Call{Call: _e.mock.On("WithBookmark", title, level)}
+}
+
+func (_c *FlowRow_WithBookmark_Call) Run(run func(title string, level int)) *FlowRow_WithBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *FlowRow_WithBookmark_Call) Return(_a0 core.Row) *FlowRow_WithBookmark_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlowRow_WithBookmark_Call) RunAndReturn(run func(string, int) core.Row) *FlowRow_WithBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithStyle provides a mock function with given fields: style
+func (_m *FlowRow) WithStyle(style *props.Cell) core.Row {
+	ret := _m.Called(style)
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(*props.Cell) core.Row); ok {
+		r0 = rf(style)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// FlowRow_WithStyle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithStyle'
+type FlowRow_WithStyle_Call struct {
+	*mock.Call
+}
+
+// WithStyle is a helper method to define mock.On call
+//   - style *props.Cell
+func (_e *FlowRow_Expecter) WithStyle(style interface{}) *FlowRow_WithStyle_Call {
+	return &FlowRow_WithStyle_Call{Call: _e.mock.On("WithStyle", style)}
+}
+
+func (_c *FlowRow_WithStyle_Call) Run(run func(style *props.Cell)) *FlowRow_WithStyle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*props.Cell))
+	})
+	return _c
+}
+
+func (_c *FlowRow_WithStyle_Call) Return(_a0 core.Row) *FlowRow_WithStyle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlowRow_WithStyle_Call) RunAndReturn(run func(*props.Cell) core.Row) *FlowRow_WithStyle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFlowRow creates a new instance of FlowRow. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFlowRow(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *FlowRow {
+	mock := &FlowRow{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
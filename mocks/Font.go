@@ -23,15 +23,15 @@ func (_m *Font) EXPECT() *Font_Expecter {
 }
 
 // GetColor provides a mock function with given fields:
-func (_m *Font) GetColor() *props.Color {
+func (_m *Font) GetColor() props.AnyColor {
 	ret := _m.Called()
 
-	var r0 *props.Color
-	if rf, ok := ret.Get(0).(func() *props.Color); ok {
+	var r0 props.AnyColor
+	if rf, ok := ret.Get(0).(func() props.AnyColor); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*props.Color)
+			r0 = ret.Get(0).(props.AnyColor)
 		}
 	}
 
@@ -55,12 +55,12 @@ func (_c *Font_GetColor_Call) Run(run func()) *Font_GetColor_Call {
 	return _c
 }
 
-func (_c *Font_GetColor_Call) Return(_a0 *props.Color) *Font_GetColor_Call {
+func (_c *Font_GetColor_Call) Return(_a0 props.AnyColor) *Font_GetColor_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Font_GetColor_Call) RunAndReturn(run func() *props.Color) *Font_GetColor_Call {
+func (_c *Font_GetColor_Call) RunAndReturn(run func() props.AnyColor) *Font_GetColor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -291,7 +291,7 @@ func (_c *Font_GetStyle_Call) RunAndReturn(run func() fontstyle.Type) *Font_GetS
 }
 
 // SetColor provides a mock function with given fields: color
-func (_m *Font) SetColor(color *props.Color) {
+func (_m *Font) SetColor(color props.AnyColor) {
 	_m.Called(color)
 }
 
@@ -301,14 +301,14 @@ type Font_SetColor_Call struct {
 }
 
 // SetColor is a helper method to define mock.On call
-//   - color *props.Color
+//   - color props.AnyColor
 func (_e *Font_Expecter) SetColor(color interface{}) *Font_SetColor_Call {
 	return &Font_SetColor_Call{Call: _e.mock.On("SetColor", color)}
 }
 
-func (_c *Font_SetColor_Call) Run(run func(color *props.Color)) *Font_SetColor_Call {
+func (_c *Font_SetColor_Call) Run(run func(color props.AnyColor)) *Font_SetColor_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*props.Color))
+		run(args[0].(props.AnyColor))
 	})
 	return _c
 }
@@ -318,7 +318,7 @@ func (_c *Font_SetColor_Call) Return() *Font_SetColor_Call {
 	return _c
 }
 
-func (_c *Font_SetColor_Call) RunAndReturn(run func(*props.Color)) *Font_SetColor_Call {
+func (_c *Font_SetColor_Call) RunAndReturn(run func(props.AnyColor)) *Font_SetColor_Call {
 	_c.Call.Return(run)
 	return _c
 }
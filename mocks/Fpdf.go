@@ -4012,6 +4012,41 @@ func (_c *Fpdf_SetAlpha_Call) RunAndReturn(run func(float64, string)) *Fpdf_SetA
 	return _c
 }
 
+// SetAlphas provides a mock function with given fields: fillAlpha, strokeAlpha, blendModeStr
+func (_m *Fpdf) SetAlphas(fillAlpha float64, strokeAlpha float64, blendModeStr string) {
+	_m.Called(fillAlpha, strokeAlpha, blendModeStr)
+}
+
+// Fpdf_SetAlphas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAlphas'
+type Fpdf_SetAlphas_Call struct {
+	*mock.Call
+}
+
+// SetAlphas is a helper method to define mock.On call
+//   - fillAlpha float64
+//   - strokeAlpha float64
+//   - blendModeStr string
+func (_e *Fpdf_Expecter) SetAlphas(fillAlpha interface{}, strokeAlpha interface{}, blendModeStr interface{}) *Fpdf_SetAlphas_Call {
+	return &Fpdf_SetAlphas_Call{Call: _e.mock.On("SetAlphas", fillAlpha, strokeAlpha, blendModeStr)}
+}
+
+func (_c *Fpdf_SetAlphas_Call) Run(run func(fillAlpha float64, strokeAlpha float64, blendModeStr string)) *Fpdf_SetAlphas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Fpdf_SetAlphas_Call) Return() *Fpdf_SetAlphas_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fpdf_SetAlphas_Call) RunAndReturn(run func(float64, float64, string)) *Fpdf_SetAlphas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetAuthor provides a mock function with given fields: authorStr, isUTF8
 func (_m *Fpdf) SetAuthor(authorStr string, isUTF8 bool) {
 	_m.Called(authorStr, isUTF8)
@@ -4314,6 +4349,42 @@ func (_c *Fpdf_SetDisplayMode_Call) RunAndReturn(run func(string, string)) *Fpdf
 	return _c
 }
 
+// SetDrawCMYKColor provides a mock function with given fields: c, m, y, k
+func (_m *Fpdf) SetDrawCMYKColor(c float64, m float64, y float64, k float64) {
+	_m.Called(c, m, y, k)
+}
+
+// Fpdf_SetDrawCMYKColor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDrawCMYKColor'
+type Fpdf_SetDrawCMYKColor_Call struct {
+	*mock.Call
+}
+
+// SetDrawCMYKColor is a helper method to define mock.On call
+//   - c float64
+//   - m float64
+//   - y float64
+//   - k float64
+func (_e *Fpdf_Expecter) SetDrawCMYKColor(c interface{}, m interface{}, y interface{}, k interface{}) *Fpdf_SetDrawCMYKColor_Call {
+	return &Fpdf_SetDrawCMYKColor_Call{Call: _e.mock.On("SetDrawCMYKColor", c, m, y, k)}
+}
+
+func (_c *Fpdf_SetDrawCMYKColor_Call) Run(run func(c float64, m float64, y float64, k float64)) *Fpdf_SetDrawCMYKColor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *Fpdf_SetDrawCMYKColor_Call) Return() *Fpdf_SetDrawCMYKColor_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fpdf_SetDrawCMYKColor_Call) RunAndReturn(run func(float64, float64, float64, float64)) *Fpdf_SetDrawCMYKColor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetDrawColor provides a mock function with given fields: r, g, b
 func (_m *Fpdf) SetDrawColor(r int, g int, b int) {
 	_m.Called(r, g, b)
@@ -4460,6 +4531,42 @@ func (_c *Fpdf_SetErrorf_Call) RunAndReturn(run func(string, ...interface{})) *F
 	return _c
 }
 
+// SetFillCMYKColor provides a mock function with given fields: c, m, y, k
+func (_m *Fpdf) SetFillCMYKColor(c float64, m float64, y float64, k float64) {
+	_m.Called(c, m, y, k)
+}
+
+// Fpdf_SetFillCMYKColor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFillCMYKColor'
+type Fpdf_SetFillCMYKColor_Call struct {
+	*mock.Call
+}
+
+// SetFillCMYKColor is a helper method to define mock.On call
+//   - c float64
+//   - m float64
+//   - y float64
+//   - k float64
+func (_e *Fpdf_Expecter) SetFillCMYKColor(c interface{}, m interface{}, y interface{}, k interface{}) *Fpdf_SetFillCMYKColor_Call {
+	return &Fpdf_SetFillCMYKColor_Call{Call: _e.mock.On("SetFillCMYKColor", c, m, y, k)}
+}
+
+func (_c *Fpdf_SetFillCMYKColor_Call) Run(run func(c float64, m float64, y float64, k float64)) *Fpdf_SetFillCMYKColor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *Fpdf_SetFillCMYKColor_Call) Return() *Fpdf_SetFillCMYKColor_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fpdf_SetFillCMYKColor_Call) RunAndReturn(run func(float64, float64, float64, float64)) *Fpdf_SetFillCMYKColor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetFillColor provides a mock function with given fields: r, g, b
 func (_m *Fpdf) SetFillColor(r int, g int, b int) {
 	_m.Called(r, g, b)
@@ -5163,6 +5270,39 @@ func (_c *Fpdf_SetMargins_Call) RunAndReturn(run func(float64, float64, float64)
 	return _c
 }
 
+// SetModificationDate provides a mock function with given fields: tm
+func (_m *Fpdf) SetModificationDate(tm time.Time) {
+	_m.Called(tm)
+}
+
+// Fpdf_SetModificationDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetModificationDate'
+type Fpdf_SetModificationDate_Call struct {
+	*mock.Call
+}
+
+// SetModificationDate is a helper method to define mock.On call
+//   - tm time.Time
+func (_e *Fpdf_Expecter) SetModificationDate(tm interface{}) *Fpdf_SetModificationDate_Call {
+	return &Fpdf_SetModificationDate_Call{Call: _e.mock.On("SetModificationDate", tm)}
+}
+
+func (_c *Fpdf_SetModificationDate_Call) Run(run func(tm time.Time)) *Fpdf_SetModificationDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Fpdf_SetModificationDate_Call) Return() *Fpdf_SetModificationDate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fpdf_SetModificationDate_Call) RunAndReturn(run func(time.Time)) *Fpdf_SetModificationDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPage provides a mock function with given fields: pageNum
 func (_m *Fpdf) SetPage(pageNum int) {
 	_m.Called(pageNum)
@@ -5369,6 +5509,42 @@ func (_c *Fpdf_SetSubject_Call) RunAndReturn(run func(string, bool)) *Fpdf_SetSu
 	return _c
 }
 
+// SetTextCMYKColor provides a mock function with given fields: c, m, y, k
+func (_m *Fpdf) SetTextCMYKColor(c float64, m float64, y float64, k float64) {
+	_m.Called(c, m, y, k)
+}
+
+// Fpdf_SetTextCMYKColor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTextCMYKColor'
+type Fpdf_SetTextCMYKColor_Call struct {
+	*mock.Call
+}
+
+// SetTextCMYKColor is a helper method to define mock.On call
+//   - c float64
+//   - m float64
+//   - y float64
+//   - k float64
+func (_e *Fpdf_Expecter) SetTextCMYKColor(c interface{}, m interface{}, y interface{}, k interface{}) *Fpdf_SetTextCMYKColor_Call {
+	return &Fpdf_SetTextCMYKColor_Call{Call: _e.mock.On("SetTextCMYKColor", c, m, y, k)}
+}
+
+func (_c *Fpdf_SetTextCMYKColor_Call) Run(run func(c float64, m float64, y float64, k float64)) *Fpdf_SetTextCMYKColor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *Fpdf_SetTextCMYKColor_Call) Return() *Fpdf_SetTextCMYKColor_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fpdf_SetTextCMYKColor_Call) RunAndReturn(run func(float64, float64, float64, float64)) *Fpdf_SetTextCMYKColor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetTextColor provides a mock function with given fields: r, g, b
 func (_m *Fpdf) SetTextColor(r int, g int, b int) {
 	_m.Called(r, g, b)
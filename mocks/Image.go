@@ -71,6 +71,52 @@ func (_c *Image_Add_Call) RunAndReturn(run func(*entity.Image, *entity.Cell, *en
 	return _c
 }
 
+// AddTiled provides a mock function with given fields: img, cell, margins, prop, _a4
+func (_m *Image) AddTiled(img *entity.Image, cell *entity.Cell, margins *entity.Margins, prop *props.Rect, _a4 extension.Type) error {
+	ret := _m.Called(img, cell, margins, prop, _a4)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Image, *entity.Cell, *entity.Margins, *props.Rect, extension.Type) error); ok {
+		r0 = rf(img, cell, margins, prop, _a4)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Image_AddTiled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTiled'
+type Image_AddTiled_Call struct {
+	*mock.Call
+}
+
+// AddTiled is a helper method to define mock.On call
+//   - img *entity.Image
+//   - cell *entity.Cell
+//   - margins *entity.Margins
+//   - prop *props.Rect
+//   - _a4 extension.Type
+func (_e *Image_Expecter) AddTiled(img interface{}, cell interface{}, margins interface{}, prop interface{}, _a4 interface{}) *Image_AddTiled_Call {
+	return &Image_AddTiled_Call{Call: _e.mock.On("AddTiled", img, cell, margins, prop, _a4)}
+}
+
+func (_c *Image_AddTiled_Call) Run(run func(img *entity.Image, cell *entity.Cell, margins *entity.Margins, prop *props.Rect, _a4 extension.Type)) *Image_AddTiled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Image), args[1].(*entity.Cell), args[2].(*entity.Margins), args[3].(*props.Rect), args[4].(extension.Type))
+	})
+	return _c
+}
+
+func (_c *Image_AddTiled_Call) Return(_a0 error) *Image_AddTiled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Image_AddTiled_Call) RunAndReturn(run func(*entity.Image, *entity.Cell, *entity.Margins, *props.Rect, extension.Type) error) *Image_AddTiled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewImage creates a new instance of Image. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewImage(t interface {
@@ -56,6 +56,150 @@ func (_c *Line_Add_Call) RunAndReturn(run func(*entity.Cell, *props.Line)) *Line
 	return _c
 }
 
+// AddArc provides a mock function with given fields: center, radius, innerRadius, startAngle, endAngle, prop
+func (_m *Line) AddArc(center entity.Point, radius float64, innerRadius float64, startAngle float64, endAngle float64, prop *props.Line) {
+	_m.Called(center, radius, innerRadius, startAngle, endAngle, prop)
+}
+
+// Line_AddArc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddArc'
+type Line_AddArc_Call struct {
+	*mock.Call
+}
+
+// AddArc is a helper method to define mock.On call
+//   - center entity.Point
+//   - radius float64
+//   - innerRadius float64
+//   - startAngle float64
+//   - endAngle float64
+//   - prop *props.Line
+func (_e *Line_Expecter) AddArc(center interface{}, radius interface{}, innerRadius interface{}, startAngle interface{}, endAngle interface{}, prop interface{}) *Line_AddArc_Call {
+	return &Line_AddArc_Call{Call: _e.mock.On("AddArc", center, radius, innerRadius, startAngle, endAngle, prop)}
+}
+
+func (_c *Line_AddArc_Call) Run(run func(center entity.Point, radius float64, innerRadius float64, startAngle float64, endAngle float64, prop *props.Line)) *Line_AddArc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.Point), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(float64), args[5].(*props.Line))
+	})
+	return _c
+}
+
+func (_c *Line_AddArc_Call) Return() *Line_AddArc_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Line_AddArc_Call) RunAndReturn(run func(entity.Point, float64, float64, float64, float64, *props.Line)) *Line_AddArc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddBezier provides a mock function with given fields: p0, p1, p2, p3, prop
+func (_m *Line) AddBezier(p0 entity.Point, p1 entity.Point, p2 entity.Point, p3 entity.Point, prop *props.Line) {
+	_m.Called(p0, p1, p2, p3, prop)
+}
+
+// Line_AddBezier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBezier'
+type Line_AddBezier_Call struct {
+	*mock.Call
+}
+
+// AddBezier is a helper method to define mock.On call
+//   - p0 entity.Point
+//   - p1 entity.Point
+//   - p2 entity.Point
+//   - p3 entity.Point
+//   - prop *props.Line
+func (_e *Line_Expecter) AddBezier(p0 interface{}, p1 interface{}, p2 interface{}, p3 interface{}, prop interface{}) *Line_AddBezier_Call {
+	return &Line_AddBezier_Call{Call: _e.mock.On("AddBezier", p0, p1, p2, p3, prop)}
+}
+
+func (_c *Line_AddBezier_Call) Run(run func(p0 entity.Point, p1 entity.Point, p2 entity.Point, p3 entity.Point, prop *props.Line)) *Line_AddBezier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.Point), args[1].(entity.Point), args[2].(entity.Point), args[3].(entity.Point), args[4].(*props.Line))
+	})
+	return _c
+}
+
+func (_c *Line_AddBezier_Call) Return() *Line_AddBezier_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Line_AddBezier_Call) RunAndReturn(run func(entity.Point, entity.Point, entity.Point, entity.Point, *props.Line)) *Line_AddBezier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPolygon provides a mock function with given fields: points, prop
+func (_m *Line) AddPolygon(points []entity.Point, prop *props.Line) {
+	_m.Called(points, prop)
+}
+
+// Line_AddPolygon_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPolygon'
+type Line_AddPolygon_Call struct {
+	*mock.Call
+}
+
+// AddPolygon is a helper method to define mock.On call
+//   - points []entity.Point
+//   - prop *props.Line
+func (_e *Line_Expecter) AddPolygon(points interface{}, prop interface{}) *Line_AddPolygon_Call {
+	return &Line_AddPolygon_Call{Call: _e.mock.On("AddPolygon", points, prop)}
+}
+
+func (_c *Line_AddPolygon_Call) Run(run func(points []entity.Point, prop *props.Line)) *Line_AddPolygon_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Point), args[1].(*props.Line))
+	})
+	return _c
+}
+
+func (_c *Line_AddPolygon_Call) Return() *Line_AddPolygon_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Line_AddPolygon_Call) RunAndReturn(run func([]entity.Point, *props.Line)) *Line_AddPolygon_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddSegment provides a mock function with given fields: p0, p1, prop
+func (_m *Line) AddSegment(p0 entity.Point, p1 entity.Point, prop *props.Line) {
+	_m.Called(p0, p1, prop)
+}
+
+// Line_AddSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSegment'
+type Line_AddSegment_Call struct {
+	*mock.Call
+}
+
+// AddSegment is a helper method to define mock.On call
+//   - p0 entity.Point
+//   - p1 entity.Point
+//   - prop *props.Line
+func (_e *Line_Expecter) AddSegment(p0 interface{}, p1 interface{}, prop interface{}) *Line_AddSegment_Call {
+	return &Line_AddSegment_Call{Call: _e.mock.On("AddSegment", p0, p1, prop)}
+}
+
+func (_c *Line_AddSegment_Call) Run(run func(p0 entity.Point, p1 entity.Point, prop *props.Line)) *Line_AddSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.Point), args[1].(entity.Point), args[2].(*props.Line))
+	})
+	return _c
+}
+
+func (_c *Line_AddSegment_Call) Return() *Line_AddSegment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Line_AddSegment_Call) RunAndReturn(run func(entity.Point, entity.Point, *props.Line)) *Line_AddSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewLine creates a new instance of Line. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLine(t interface {
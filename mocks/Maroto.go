@@ -3,7 +3,10 @@
 package mocks
 
 import (
+	io "io"
+
 	core "github.com/johnfercher/maroto/v2/pkg/core"
+
 	mock "github.com/stretchr/testify/mock"
 
 	node "github.com/johnfercher/go-tree/node"
@@ -22,6 +25,40 @@ func (_m *Maroto) EXPECT() *Maroto_Expecter {
 	return &Maroto_Expecter{mock: &_m.Mock}
 }
 
+// AddBookmark provides a mock function with given fields: title, level
+func (_m *Maroto) AddBookmark(title string, level int) {
+	_m.Called(title, level)
+}
+
+// Maroto_AddBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBookmark'
+type Maroto_AddBookmark_Call struct {
+	*mock.Call
+}
+
+// AddBookmark is a helper method to define mock.On call
+//   - title string
+//   - level int
+func (_e *Maroto_Expecter) AddBookmark(title interface{}, level interface{}) *Maroto_AddBookmark_Call {
+	return &Maroto_AddBookmark_Call{Call: _e.mock.On("AddBookmark", title, level)}
+}
+
+func (_c *Maroto_AddBookmark_Call) Run(run func(title string, level int)) *Maroto_AddBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Maroto_AddBookmark_Call) Return() *Maroto_AddBookmark_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Maroto_AddBookmark_Call) RunAndReturn(run func(string, int)) *Maroto_AddBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddPages provides a mock function with given fields: pages
 func (_m *Maroto) AddPages(pages ...core.Page) {
 	_va := make([]interface{}, len(pages))
@@ -226,6 +263,48 @@ func (_c *Maroto_Generate_Call) RunAndReturn(run func() (core.Document, error))
 	return _c
 }
 
+// GenerateTo provides a mock function with given fields: w
+func (_m *Maroto) GenerateTo(w io.Writer) error {
+	ret := _m.Called(w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer) error); ok {
+		r0 = rf(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Maroto_GenerateTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateTo'
+type Maroto_GenerateTo_Call struct {
+	*mock.Call
+}
+
+// GenerateTo is a helper method to define mock.On call
+//   - w io.Writer
+func (_e *Maroto_Expecter) GenerateTo(w interface{}) *Maroto_GenerateTo_Call {
+	return &Maroto_GenerateTo_Call{Call: _e.mock.On("GenerateTo", w)}
+}
+
+func (_c *Maroto_GenerateTo_Call) Run(run func(w io.Writer)) *Maroto_GenerateTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *Maroto_GenerateTo_Call) Return(_a0 error) *Maroto_GenerateTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_GenerateTo_Call) RunAndReturn(run func(io.Writer) error) *Maroto_GenerateTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStructure provides a mock function with given fields:
 func (_m *Maroto) GetStructure() *node.Node[core.Structure] {
 	ret := _m.Called()
@@ -269,6 +348,63 @@ func (_c *Maroto_GetStructure_Call) RunAndReturn(run func() *node.Node[core.Stru
 	return _c
 }
 
+// InsertAt provides a mock function with given fields: position, rows
+func (_m *Maroto) InsertAt(position int, rows ...core.Row) error {
+	_va := make([]interface{}, len(rows))
+	for _i := range rows {
+		_va[_i] = rows[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, position)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, ...core.Row) error); ok {
+		r0 = rf(position, rows...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Maroto_InsertAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertAt'
+type Maroto_InsertAt_Call struct {
+	*mock.Call
+}
+
+// InsertAt is a helper method to define mock.On call
+//   - position int
+//   - rows ...core.Row
+func (_e *Maroto_Expecter) InsertAt(position interface{}, rows ...interface{}) *Maroto_InsertAt_Call {
+	return &Maroto_InsertAt_Call{Call: _e.mock.On("InsertAt",
+		append([]interface{}{position}, rows...)...)}
+}
+
+func (_c *Maroto_InsertAt_Call) Run(run func(position int, rows ...core.Row)) *Maroto_InsertAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Row, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Row)
+			}
+		}
+		run(args[0].(int), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Maroto_InsertAt_Call) Return(_a0 error) *Maroto_InsertAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_InsertAt_Call) RunAndReturn(run func(int, ...core.Row) error) *Maroto_InsertAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterFooter provides a mock function with given fields: rows
 func (_m *Maroto) RegisterFooter(rows ...core.Row) error {
 	_va := make([]interface{}, len(rows))
@@ -0,0 +1,74 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// PageRow is an autogenerated mock type for the PageRow type
+type PageRow struct {
+	mock.Mock
+}
+
+type PageRow_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PageRow) EXPECT() *PageRow_Expecter {
+	return &PageRow_Expecter{mock: &_m.Mock}
+}
+
+// GetHeight provides a mock function with given fields:
+func (_m *PageRow) GetHeight() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// PageRow_GetHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeight'
+type PageRow_GetHeight_Call struct {
+	*mock.Call
+}
+
+// GetHeight is a helper method to define mock.On call
+func (_e *PageRow_Expecter) GetHeight() *PageRow_GetHeight_Call {
+	return &PageRow_GetHeight_Call{Call: _e.mock.On("GetHeight")}
+}
+
+func (_c *PageRow_GetHeight_Call) Run(run func()) *PageRow_GetHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PageRow_GetHeight_Call) Return(_a0 float64) *PageRow_GetHeight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PageRow_GetHeight_Call) RunAndReturn(run func() float64) *PageRow_GetHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPageRow creates a new instance of PageRow. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPageRow(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *PageRow {
+	mock := &PageRow{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PageTemplate is an autogenerated mock type for the PageTemplate type
+type PageTemplate struct {
+	mock.Mock
+}
+
+type PageTemplate_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PageTemplate) EXPECT() *PageTemplate_Expecter {
+	return &PageTemplate_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: pageNumber, totalPages
+func (_m *PageTemplate) Execute(pageNumber int, totalPages int) []entity.PageRow {
+	ret := _m.Called(pageNumber, totalPages)
+
+	var r0 []entity.PageRow
+	if rf, ok := ret.Get(0).(func(int, int) []entity.PageRow); ok {
+		r0 = rf(pageNumber, totalPages)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PageRow)
+		}
+	}
+
+	return r0
+}
+
+// PageTemplate_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type PageTemplate_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - pageNumber int
+//   - totalPages int
+func (_e *PageTemplate_Expecter) Execute(pageNumber interface{}, totalPages interface{}) *PageTemplate_Execute_Call {
+	return &PageTemplate_Execute_Call{Call: _e.mock.On("Execute", pageNumber, totalPages)}
+}
+
+func (_c *PageTemplate_Execute_Call) Run(run func(pageNumber int, totalPages int)) *PageTemplate_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PageTemplate_Execute_Call) Return(_a0 []entity.PageRow) *PageTemplate_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PageTemplate_Execute_Call) RunAndReturn(run func(int, int) []entity.PageRow) *PageTemplate_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPageTemplate creates a new instance of PageTemplate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPageTemplate(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *PageTemplate {
+	mock := &PageTemplate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,11 +3,12 @@
 package mocks
 
 import (
-	cache "github.com/johnfercher/maroto/v2/internal/cache"
-
+	extension "github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
 
-	extension "github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	image "image"
+
+	io "io"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -27,6 +28,41 @@ func (_m *Provider) EXPECT() *Provider_Expecter {
 	return &Provider_Expecter{mock: &_m.Mock}
 }
 
+// AddAztecCode provides a mock function with given fields: code, cell, prop
+func (_m *Provider) AddAztecCode(code string, cell *entity.Cell, prop *props.Rect) {
+	_m.Called(code, cell, prop)
+}
+
+// Provider_AddAztecCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAztecCode'
+type Provider_AddAztecCode_Call struct {
+	*mock.Call
+}
+
+// AddAztecCode is a helper method to define mock.On call
+//   - code string
+//   - cell *entity.Cell
+//   - prop *props.Rect
+func (_e *Provider_Expecter) AddAztecCode(code interface{}, cell interface{}, prop interface{}) *Provider_AddAztecCode_Call {
+	return &Provider_AddAztecCode_Call{Call: _e.mock.On("AddAztecCode", code, cell, prop)}
+}
+
+func (_c *Provider_AddAztecCode_Call) Run(run func(code string, cell *entity.Cell, prop *props.Rect)) *Provider_AddAztecCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Rect))
+	})
+	return _c
+}
+
+func (_c *Provider_AddAztecCode_Call) Return() *Provider_AddAztecCode_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddAztecCode_Call) RunAndReturn(run func(string, *entity.Cell, *props.Rect)) *Provider_AddAztecCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddBackgroundImageFromBytes provides a mock function with given fields: bytes, cell, prop, _a3
 func (_m *Provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, _a3 extension.Type) {
 	_m.Called(bytes, cell, prop, _a3)
@@ -98,6 +134,76 @@ func (_c *Provider_AddBarCode_Call) RunAndReturn(run func(string, *entity.Cell,
 	return _c
 }
 
+// AddBookmark provides a mock function with given fields: title, level, cell
+func (_m *Provider) AddBookmark(title string, level int, cell *entity.Cell) {
+	_m.Called(title, level, cell)
+}
+
+// Provider_AddBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBookmark'
+type Provider_AddBookmark_Call struct {
+	*mock.Call
+}
+
+// AddBookmark is a helper method to define mock.On call
+//   - title string
+//   - level int
+//   - cell *entity.Cell
+func (_e *Provider_Expecter) AddBookmark(title interface{}, level interface{}, cell interface{}) *Provider_AddBookmark_Call {
+	return &Provider_AddBookmark_Call{Call: _e.mock.On("AddBookmark", title, level, cell)}
+}
+
+func (_c *Provider_AddBookmark_Call) Run(run func(title string, level int, cell *entity.Cell)) *Provider_AddBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *Provider_AddBookmark_Call) Return() *Provider_AddBookmark_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddBookmark_Call) RunAndReturn(run func(string, int, *entity.Cell)) *Provider_AddBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddHoverArea provides a mock function with given fields: id, cell, color
+func (_m *Provider) AddHoverArea(id string, cell *entity.Cell, color *props.Color) {
+	_m.Called(id, cell, color)
+}
+
+// Provider_AddHoverArea_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddHoverArea'
+type Provider_AddHoverArea_Call struct {
+	*mock.Call
+}
+
+// AddHoverArea is a helper method to define mock.On call
+//   - id string
+//   - cell *entity.Cell
+//   - color *props.Color
+func (_e *Provider_Expecter) AddHoverArea(id interface{}, cell interface{}, color interface{}) *Provider_AddHoverArea_Call {
+	return &Provider_AddHoverArea_Call{Call: _e.mock.On("AddHoverArea", id, cell, color)}
+}
+
+func (_c *Provider_AddHoverArea_Call) Run(run func(id string, cell *entity.Cell, color *props.Color)) *Provider_AddHoverArea_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Color))
+	})
+	return _c
+}
+
+func (_c *Provider_AddHoverArea_Call) Return() *Provider_AddHoverArea_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddHoverArea_Call) RunAndReturn(run func(string, *entity.Cell, *props.Color)) *Provider_AddHoverArea_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddImageFromBytes provides a mock function with given fields: bytes, cell, prop, _a3
 func (_m *Provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, _a3 extension.Type) {
 	_m.Called(bytes, cell, prop, _a3)
@@ -238,6 +344,76 @@ func (_c *Provider_AddMatrixCode_Call) RunAndReturn(run func(string, *entity.Cel
 	return _c
 }
 
+// AddMicroQrCode provides a mock function with given fields: code, cell, rect
+func (_m *Provider) AddMicroQrCode(code string, cell *entity.Cell, rect *props.Rect) {
+	_m.Called(code, cell, rect)
+}
+
+// Provider_AddMicroQrCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMicroQrCode'
+type Provider_AddMicroQrCode_Call struct {
+	*mock.Call
+}
+
+// AddMicroQrCode is a helper method to define mock.On call
+//   - code string
+//   - cell *entity.Cell
+//   - rect *props.Rect
+func (_e *Provider_Expecter) AddMicroQrCode(code interface{}, cell interface{}, rect interface{}) *Provider_AddMicroQrCode_Call {
+	return &Provider_AddMicroQrCode_Call{Call: _e.mock.On("AddMicroQrCode", code, cell, rect)}
+}
+
+func (_c *Provider_AddMicroQrCode_Call) Run(run func(code string, cell *entity.Cell, rect *props.Rect)) *Provider_AddMicroQrCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Rect))
+	})
+	return _c
+}
+
+func (_c *Provider_AddMicroQrCode_Call) Return() *Provider_AddMicroQrCode_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddMicroQrCode_Call) RunAndReturn(run func(string, *entity.Cell, *props.Rect)) *Provider_AddMicroQrCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPDF417Code provides a mock function with given fields: code, cell, prop
+func (_m *Provider) AddPDF417Code(code string, cell *entity.Cell, prop *props.PDF417) {
+	_m.Called(code, cell, prop)
+}
+
+// Provider_AddPDF417Code_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPDF417Code'
+type Provider_AddPDF417Code_Call struct {
+	*mock.Call
+}
+
+// AddPDF417Code is a helper method to define mock.On call
+//   - code string
+//   - cell *entity.Cell
+//   - prop *props.PDF417
+func (_e *Provider_Expecter) AddPDF417Code(code interface{}, cell interface{}, prop interface{}) *Provider_AddPDF417Code_Call {
+	return &Provider_AddPDF417Code_Call{Call: _e.mock.On("AddPDF417Code", code, cell, prop)}
+}
+
+func (_c *Provider_AddPDF417Code_Call) Run(run func(code string, cell *entity.Cell, prop *props.PDF417)) *Provider_AddPDF417Code_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.PDF417))
+	})
+	return _c
+}
+
+func (_c *Provider_AddPDF417Code_Call) Return() *Provider_AddPDF417Code_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddPDF417Code_Call) RunAndReturn(run func(string, *entity.Cell, *props.PDF417)) *Provider_AddPDF417Code_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddQrCode provides a mock function with given fields: code, cell, rect
 func (_m *Provider) AddQrCode(code string, cell *entity.Cell, rect *props.Rect) {
 	_m.Called(code, cell, rect)
@@ -308,6 +484,110 @@ func (_c *Provider_AddText_Call) RunAndReturn(run func(string, *entity.Cell, *pr
 	return _c
 }
 
+// AddTiledImageFromBytes provides a mock function with given fields: bytes, cell, prop, _a3
+func (_m *Provider) AddTiledImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, _a3 extension.Type) {
+	_m.Called(bytes, cell, prop, _a3)
+}
+
+// Provider_AddTiledImageFromBytes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTiledImageFromBytes'
+type Provider_AddTiledImageFromBytes_Call struct {
+	*mock.Call
+}
+
+// AddTiledImageFromBytes is a helper method to define mock.On call
+//   - bytes []byte
+//   - cell *entity.Cell
+//   - prop *props.Rect
+//   - _a3 extension.Type
+func (_e *Provider_Expecter) AddTiledImageFromBytes(bytes interface{}, cell interface{}, prop interface{}, _a3 interface{}) *Provider_AddTiledImageFromBytes_Call {
+	return &Provider_AddTiledImageFromBytes_Call{Call: _e.mock.On("AddTiledImageFromBytes", bytes, cell, prop, _a3)}
+}
+
+func (_c *Provider_AddTiledImageFromBytes_Call) Run(run func(bytes []byte, cell *entity.Cell, prop *props.Rect, _a3 extension.Type)) *Provider_AddTiledImageFromBytes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(*entity.Cell), args[2].(*props.Rect), args[3].(extension.Type))
+	})
+	return _c
+}
+
+func (_c *Provider_AddTiledImageFromBytes_Call) Return() *Provider_AddTiledImageFromBytes_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddTiledImageFromBytes_Call) RunAndReturn(run func([]byte, *entity.Cell, *props.Rect, extension.Type)) *Provider_AddTiledImageFromBytes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTooltipArea provides a mock function with given fields: tooltip, cell
+func (_m *Provider) AddTooltipArea(tooltip string, cell *entity.Cell) {
+	_m.Called(tooltip, cell)
+}
+
+// Provider_AddTooltipArea_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTooltipArea'
+type Provider_AddTooltipArea_Call struct {
+	*mock.Call
+}
+
+// AddTooltipArea is a helper method to define mock.On call
+//   - tooltip string
+//   - cell *entity.Cell
+func (_e *Provider_Expecter) AddTooltipArea(tooltip interface{}, cell interface{}) *Provider_AddTooltipArea_Call {
+	return &Provider_AddTooltipArea_Call{Call: _e.mock.On("AddTooltipArea", tooltip, cell)}
+}
+
+func (_c *Provider_AddTooltipArea_Call) Run(run func(tooltip string, cell *entity.Cell)) *Provider_AddTooltipArea_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *Provider_AddTooltipArea_Call) Return() *Provider_AddTooltipArea_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddTooltipArea_Call) RunAndReturn(run func(string, *entity.Cell)) *Provider_AddTooltipArea_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddWatermark provides a mock function with given fields: text, prop
+func (_m *Provider) AddWatermark(text string, prop *props.Watermark) {
+	_m.Called(text, prop)
+}
+
+// Provider_AddWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWatermark'
+type Provider_AddWatermark_Call struct {
+	*mock.Call
+}
+
+// AddWatermark is a helper method to define mock.On call
+//   - text string
+//   - prop *props.Watermark
+func (_e *Provider_Expecter) AddWatermark(text interface{}, prop interface{}) *Provider_AddWatermark_Call {
+	return &Provider_AddWatermark_Call{Call: _e.mock.On("AddWatermark", text, prop)}
+}
+
+func (_c *Provider_AddWatermark_Call) Run(run func(text string, prop *props.Watermark)) *Provider_AddWatermark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Watermark))
+	})
+	return _c
+}
+
+func (_c *Provider_AddWatermark_Call) Return() *Provider_AddWatermark_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddWatermark_Call) RunAndReturn(run func(string, *props.Watermark)) *Provider_AddWatermark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCol provides a mock function with given fields: width, height, config, prop
 func (_m *Provider) CreateCol(width float64, height float64, config *entity.Config, prop *props.Cell) {
 	_m.Called(width, height, config, prop)
@@ -377,145 +657,658 @@ func (_c *Provider_CreateRow_Call) RunAndReturn(run func(float64)) *Provider_Cre
 	return _c
 }
 
-// GenerateBytes provides a mock function with given fields:
-func (_m *Provider) GenerateBytes() ([]byte, error) {
-	ret := _m.Called()
-
-	var r0 []byte
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]byte, error)); ok {
-		return rf()
-	}
-	if rf, ok := ret.Get(0).(func() []byte); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]byte)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+// DrawArc provides a mock function with given fields: center, radius, innerRadius, startAngle, endAngle, prop
+func (_m *Provider) DrawArc(center entity.Point, radius float64, innerRadius float64, startAngle float64, endAngle float64, prop *props.Line) {
+	_m.Called(center, radius, innerRadius, startAngle, endAngle, prop)
 }
 
-// Provider_GenerateBytes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateBytes'
-type Provider_GenerateBytes_Call struct {
+// Provider_DrawArc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrawArc'
+type Provider_DrawArc_Call struct {
 	*mock.Call
 }
 
-// GenerateBytes is a helper method to define mock.On call
-func (_e *Provider_Expecter) GenerateBytes() *Provider_GenerateBytes_Call {
-	return &Provider_GenerateBytes_Call{Call: _e.mock.On("GenerateBytes")}
+// DrawArc is a helper method to define mock.On call
+//   - center entity.Point
+//   - radius float64
+//   - innerRadius float64
+//   - startAngle float64
+//   - endAngle float64
+//   - prop *props.Line
+func (_e *Provider_Expecter) DrawArc(center interface{}, radius interface{}, innerRadius interface{}, startAngle interface{}, endAngle interface{}, prop interface{}) *Provider_DrawArc_Call {
+	return &Provider_DrawArc_Call{Call: _e.mock.On("DrawArc", center, radius, innerRadius, startAngle, endAngle, prop)}
 }
 
-func (_c *Provider_GenerateBytes_Call) Run(run func()) *Provider_GenerateBytes_Call {
+func (_c *Provider_DrawArc_Call) Run(run func(center entity.Point, radius float64, innerRadius float64, startAngle float64, endAngle float64, prop *props.Line)) *Provider_DrawArc_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(entity.Point), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(float64), args[5].(*props.Line))
 	})
 	return _c
 }
 
-func (_c *Provider_GenerateBytes_Call) Return(_a0 []byte, _a1 error) *Provider_GenerateBytes_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Provider_DrawArc_Call) Return() *Provider_DrawArc_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *Provider_GenerateBytes_Call) RunAndReturn(run func() ([]byte, error)) *Provider_GenerateBytes_Call {
+func (_c *Provider_DrawArc_Call) RunAndReturn(run func(entity.Point, float64, float64, float64, float64, *props.Line)) *Provider_DrawArc_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTextHeight provides a mock function with given fields: prop
-func (_m *Provider) GetTextHeight(prop *props.Font) float64 {
-	ret := _m.Called(prop)
-
-	var r0 float64
-	if rf, ok := ret.Get(0).(func(*props.Font) float64); ok {
-		r0 = rf(prop)
-	} else {
-		r0 = ret.Get(0).(float64)
-	}
-
-	return r0
+// DrawBezier provides a mock function with given fields: p0, p1, p2, p3, prop
+func (_m *Provider) DrawBezier(p0 entity.Point, p1 entity.Point, p2 entity.Point, p3 entity.Point, prop *props.Line) {
+	_m.Called(p0, p1, p2, p3, prop)
 }
 
-// Provider_GetTextHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTextHeight'
-type Provider_GetTextHeight_Call struct {
+// Provider_DrawBezier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrawBezier'
+type Provider_DrawBezier_Call struct {
 	*mock.Call
 }
 
-// GetTextHeight is a helper method to define mock.On call
-//   - prop *props.Font
-func (_e *Provider_Expecter) GetTextHeight(prop interface{}) *Provider_GetTextHeight_Call {
-	return &Provider_GetTextHeight_Call{Call: _e.mock.On("GetTextHeight", prop)}
+// DrawBezier is a helper method to define mock.On call
+//   - p0 entity.Point
+//   - p1 entity.Point
+//   - p2 entity.Point
+//   - p3 entity.Point
+//   - prop *props.Line
+func (_e *Provider_Expecter) DrawBezier(p0 interface{}, p1 interface{}, p2 interface{}, p3 interface{}, prop interface{}) *Provider_DrawBezier_Call {
+	return &Provider_DrawBezier_Call{Call: _e.mock.On("DrawBezier", p0, p1, p2, p3, prop)}
 }
 
-func (_c *Provider_GetTextHeight_Call) Run(run func(prop *props.Font)) *Provider_GetTextHeight_Call {
+func (_c *Provider_DrawBezier_Call) Run(run func(p0 entity.Point, p1 entity.Point, p2 entity.Point, p3 entity.Point, prop *props.Line)) *Provider_DrawBezier_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*props.Font))
+		run(args[0].(entity.Point), args[1].(entity.Point), args[2].(entity.Point), args[3].(entity.Point), args[4].(*props.Line))
 	})
 	return _c
 }
 
-func (_c *Provider_GetTextHeight_Call) Return(_a0 float64) *Provider_GetTextHeight_Call {
-	_c.Call.Return(_a0)
+func (_c *Provider_DrawBezier_Call) Return() *Provider_DrawBezier_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *Provider_GetTextHeight_Call) RunAndReturn(run func(*props.Font) float64) *Provider_GetTextHeight_Call {
+func (_c *Provider_DrawBezier_Call) RunAndReturn(run func(entity.Point, entity.Point, entity.Point, entity.Point, *props.Line)) *Provider_DrawBezier_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetCache provides a mock function with given fields: _a0
-func (_m *Provider) SetCache(_a0 cache.Cache) {
-	_m.Called(_a0)
+// DrawImage provides a mock function with given fields: img, cell, prop
+func (_m *Provider) DrawImage(img image.Image, cell *entity.Cell, prop *props.Rect) {
+	_m.Called(img, cell, prop)
 }
 
-// Provider_SetCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCache'
-type Provider_SetCache_Call struct {
+// Provider_DrawImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrawImage'
+type Provider_DrawImage_Call struct {
 	*mock.Call
 }
 
-// SetCache is a helper method to define mock.On call
-//   - _a0 cache.Cache
-func (_e *Provider_Expecter) SetCache(_a0 interface{}) *Provider_SetCache_Call {
-	return &Provider_SetCache_Call{Call: _e.mock.On("SetCache", _a0)}
+// DrawImage is a helper method to define mock.On call
+//   - img image.Image
+//   - cell *entity.Cell
+//   - prop *props.Rect
+func (_e *Provider_Expecter) DrawImage(img interface{}, cell interface{}, prop interface{}) *Provider_DrawImage_Call {
+	return &Provider_DrawImage_Call{Call: _e.mock.On("DrawImage", img, cell, prop)}
 }
 
-func (_c *Provider_SetCache_Call) Run(run func(_a0 cache.Cache)) *Provider_SetCache_Call {
+func (_c *Provider_DrawImage_Call) Run(run func(img image.Image, cell *entity.Cell, prop *props.Rect)) *Provider_DrawImage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(cache.Cache))
+		run(args[0].(image.Image), args[1].(*entity.Cell), args[2].(*props.Rect))
 	})
 	return _c
 }
 
-func (_c *Provider_SetCache_Call) Return() *Provider_SetCache_Call {
+func (_c *Provider_DrawImage_Call) Return() *Provider_DrawImage_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *Provider_SetCache_Call) RunAndReturn(run func(cache.Cache)) *Provider_SetCache_Call {
+func (_c *Provider_DrawImage_Call) RunAndReturn(run func(image.Image, *entity.Cell, *props.Rect)) *Provider_DrawImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetCompression provides a mock function with given fields: compression
-func (_m *Provider) SetCompression(compression bool) {
-	_m.Called(compression)
+// DrawLine provides a mock function with given fields: p0, p1, prop
+func (_m *Provider) DrawLine(p0 entity.Point, p1 entity.Point, prop *props.Line) {
+	_m.Called(p0, p1, prop)
 }
 
-// Provider_SetCompression_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCompression'
-type Provider_SetCompression_Call struct {
+// Provider_DrawLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrawLine'
+type Provider_DrawLine_Call struct {
 	*mock.Call
 }
 
-// SetCompression is a helper method to define mock.On call
+// DrawLine is a helper method to define mock.On call
+//   - p0 entity.Point
+//   - p1 entity.Point
+//   - prop *props.Line
+func (_e *Provider_Expecter) DrawLine(p0 interface{}, p1 interface{}, prop interface{}) *Provider_DrawLine_Call {
+	return &Provider_DrawLine_Call{Call: _e.mock.On("DrawLine", p0, p1, prop)}
+}
+
+func (_c *Provider_DrawLine_Call) Run(run func(p0 entity.Point, p1 entity.Point, prop *props.Line)) *Provider_DrawLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.Point), args[1].(entity.Point), args[2].(*props.Line))
+	})
+	return _c
+}
+
+func (_c *Provider_DrawLine_Call) Return() *Provider_DrawLine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_DrawLine_Call) RunAndReturn(run func(entity.Point, entity.Point, *props.Line)) *Provider_DrawLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DrawPolygon provides a mock function with given fields: points, prop
+func (_m *Provider) DrawPolygon(points []entity.Point, prop *props.Line) {
+	_m.Called(points, prop)
+}
+
+// Provider_DrawPolygon_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrawPolygon'
+type Provider_DrawPolygon_Call struct {
+	*mock.Call
+}
+
+// DrawPolygon is a helper method to define mock.On call
+//   - points []entity.Point
+//   - prop *props.Line
+func (_e *Provider_Expecter) DrawPolygon(points interface{}, prop interface{}) *Provider_DrawPolygon_Call {
+	return &Provider_DrawPolygon_Call{Call: _e.mock.On("DrawPolygon", points, prop)}
+}
+
+func (_c *Provider_DrawPolygon_Call) Run(run func(points []entity.Point, prop *props.Line)) *Provider_DrawPolygon_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Point), args[1].(*props.Line))
+	})
+	return _c
+}
+
+func (_c *Provider_DrawPolygon_Call) Return() *Provider_DrawPolygon_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_DrawPolygon_Call) RunAndReturn(run func([]entity.Point, *props.Line)) *Provider_DrawPolygon_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DrawRect provides a mock function with given fields: cell, prop
+func (_m *Provider) DrawRect(cell *entity.Cell, prop *props.Cell) {
+	_m.Called(cell, prop)
+}
+
+// Provider_DrawRect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrawRect'
+type Provider_DrawRect_Call struct {
+	*mock.Call
+}
+
+// DrawRect is a helper method to define mock.On call
+//   - cell *entity.Cell
+//   - prop *props.Cell
+func (_e *Provider_Expecter) DrawRect(cell interface{}, prop interface{}) *Provider_DrawRect_Call {
+	return &Provider_DrawRect_Call{Call: _e.mock.On("DrawRect", cell, prop)}
+}
+
+func (_c *Provider_DrawRect_Call) Run(run func(cell *entity.Cell, prop *props.Cell)) *Provider_DrawRect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Cell), args[1].(*props.Cell))
+	})
+	return _c
+}
+
+func (_c *Provider_DrawRect_Call) Return() *Provider_DrawRect_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_DrawRect_Call) RunAndReturn(run func(*entity.Cell, *props.Cell)) *Provider_DrawRect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateBytes provides a mock function with given fields:
+func (_m *Provider) GenerateBytes() ([]byte, error) {
+	ret := _m.Called()
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]byte, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GenerateBytes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateBytes'
+type Provider_GenerateBytes_Call struct {
+	*mock.Call
+}
+
+// GenerateBytes is a helper method to define mock.On call
+func (_e *Provider_Expecter) GenerateBytes() *Provider_GenerateBytes_Call {
+	return &Provider_GenerateBytes_Call{Call: _e.mock.On("GenerateBytes")}
+}
+
+func (_c *Provider_GenerateBytes_Call) Run(run func()) *Provider_GenerateBytes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Provider_GenerateBytes_Call) Return(_a0 []byte, _a1 error) *Provider_GenerateBytes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GenerateBytes_Call) RunAndReturn(run func() ([]byte, error)) *Provider_GenerateBytes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateTo provides a mock function with given fields: w
+func (_m *Provider) GenerateTo(w io.Writer) error {
+	ret := _m.Called(w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer) error); ok {
+		r0 = rf(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Provider_GenerateTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateTo'
+type Provider_GenerateTo_Call struct {
+	*mock.Call
+}
+
+// GenerateTo is a helper method to define mock.On call
+//   - w io.Writer
+func (_e *Provider_Expecter) GenerateTo(w interface{}) *Provider_GenerateTo_Call {
+	return &Provider_GenerateTo_Call{Call: _e.mock.On("GenerateTo", w)}
+}
+
+func (_c *Provider_GenerateTo_Call) Run(run func(w io.Writer)) *Provider_GenerateTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *Provider_GenerateTo_Call) Return(_a0 error) *Provider_GenerateTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GenerateTo_Call) RunAndReturn(run func(io.Writer) error) *Provider_GenerateTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBookmarks provides a mock function with given fields:
+func (_m *Provider) GetBookmarks() []entity.Bookmark {
+	ret := _m.Called()
+
+	var r0 []entity.Bookmark
+	if rf, ok := ret.Get(0).(func() []entity.Bookmark); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Bookmark)
+		}
+	}
+
+	return r0
+}
+
+// Provider_GetBookmarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBookmarks'
+type Provider_GetBookmarks_Call struct {
+	*mock.Call
+}
+
+// GetBookmarks is a helper method to define mock.On call
+func (_e *Provider_Expecter) GetBookmarks() *Provider_GetBookmarks_Call {
+	return &Provider_GetBookmarks_Call{Call: _e.mock.On("GetBookmarks")}
+}
+
+func (_c *Provider_GetBookmarks_Call) Run(run func()) *Provider_GetBookmarks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Provider_GetBookmarks_Call) Return(_a0 []entity.Bookmark) *Provider_GetBookmarks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetBookmarks_Call) RunAndReturn(run func() []entity.Bookmark) *Provider_GetBookmarks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCursor provides a mock function with given fields:
+func (_m *Provider) GetCursor() (float64, float64) {
+	ret := _m.Called()
+
+	var r0 float64
+	var r1 float64
+	if rf, ok := ret.Get(0).(func() (float64, float64)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func() float64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(float64)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCursor'
+type Provider_GetCursor_Call struct {
+	*mock.Call
+}
+
+// GetCursor is a helper method to define mock.On call
+func (_e *Provider_Expecter) GetCursor() *Provider_GetCursor_Call {
+	return &Provider_GetCursor_Call{Call: _e.mock.On("GetCursor")}
+}
+
+func (_c *Provider_GetCursor_Call) Run(run func()) *Provider_GetCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Provider_GetCursor_Call) Return(x float64, y float64) *Provider_GetCursor_Call {
+	_c.Call.Return(x, y)
+	return _c
+}
+
+func (_c *Provider_GetCursor_Call) RunAndReturn(run func() (float64, float64)) *Provider_GetCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPageSize provides a mock function with given fields:
+func (_m *Provider) GetPageSize() (float64, float64) {
+	ret := _m.Called()
+
+	var r0 float64
+	var r1 float64
+	if rf, ok := ret.Get(0).(func() (float64, float64)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func() float64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(float64)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetPageSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPageSize'
+type Provider_GetPageSize_Call struct {
+	*mock.Call
+}
+
+// GetPageSize is a helper method to define mock.On call
+func (_e *Provider_Expecter) GetPageSize() *Provider_GetPageSize_Call {
+	return &Provider_GetPageSize_Call{Call: _e.mock.On("GetPageSize")}
+}
+
+func (_c *Provider_GetPageSize_Call) Run(run func()) *Provider_GetPageSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Provider_GetPageSize_Call) Return(width float64, height float64) *Provider_GetPageSize_Call {
+	_c.Call.Return(width, height)
+	return _c
+}
+
+func (_c *Provider_GetPageSize_Call) RunAndReturn(run func() (float64, float64)) *Provider_GetPageSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStringWidth provides a mock function with given fields: text, prop
+func (_m *Provider) GetStringWidth(text string, prop *props.Font) float64 {
+	ret := _m.Called(text, prop)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, *props.Font) float64); ok {
+		r0 = rf(text, prop)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Provider_GetStringWidth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringWidth'
+type Provider_GetStringWidth_Call struct {
+	*mock.Call
+}
+
+// GetStringWidth is a helper method to define mock.On call
+//   - text string
+//   - prop *props.Font
+func (_e *Provider_Expecter) GetStringWidth(text interface{}, prop interface{}) *Provider_GetStringWidth_Call {
+	return &Provider_GetStringWidth_Call{Call: _e.mock.On("GetStringWidth", text, prop)}
+}
+
+func (_c *Provider_GetStringWidth_Call) Run(run func(text string, prop *props.Font)) *Provider_GetStringWidth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Font))
+	})
+	return _c
+}
+
+func (_c *Provider_GetStringWidth_Call) Return(_a0 float64) *Provider_GetStringWidth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetStringWidth_Call) RunAndReturn(run func(string, *props.Font) float64) *Provider_GetStringWidth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTextHeight provides a mock function with given fields: prop
+func (_m *Provider) GetTextHeight(prop *props.Font) float64 {
+	ret := _m.Called(prop)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(*props.Font) float64); ok {
+		r0 = rf(prop)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Provider_GetTextHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTextHeight'
+type Provider_GetTextHeight_Call struct {
+	*mock.Call
+}
+
+// GetTextHeight is a helper method to define mock.On call
+//   - prop *props.Font
+func (_e *Provider_Expecter) GetTextHeight(prop interface{}) *Provider_GetTextHeight_Call {
+	return &Provider_GetTextHeight_Call{Call: _e.mock.On("GetTextHeight", prop)}
+}
+
+func (_c *Provider_GetTextHeight_Call) Run(run func(prop *props.Font)) *Provider_GetTextHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*props.Font))
+	})
+	return _c
+}
+
+func (_c *Provider_GetTextHeight_Call) Return(_a0 float64) *Provider_GetTextHeight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetTextHeight_Call) RunAndReturn(run func(*props.Font) float64) *Provider_GetTextHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetClip provides a mock function with given fields:
+func (_m *Provider) ResetClip() {
+	_m.Called()
+}
+
+// Provider_ResetClip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetClip'
+type Provider_ResetClip_Call struct {
+	*mock.Call
+}
+
+// ResetClip is a helper method to define mock.On call
+func (_e *Provider_Expecter) ResetClip() *Provider_ResetClip_Call {
+	return &Provider_ResetClip_Call{Call: _e.mock.On("ResetClip")}
+}
+
+func (_c *Provider_ResetClip_Call) Run(run func()) *Provider_ResetClip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Provider_ResetClip_Call) Return() *Provider_ResetClip_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_ResetClip_Call) RunAndReturn(run func()) *Provider_ResetClip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetClipCircle provides a mock function with given fields: x, y, radius
+func (_m *Provider) SetClipCircle(x float64, y float64, radius float64) {
+	_m.Called(x, y, radius)
+}
+
+// Provider_SetClipCircle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetClipCircle'
+type Provider_SetClipCircle_Call struct {
+	*mock.Call
+}
+
+// SetClipCircle is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+//   - radius float64
+func (_e *Provider_Expecter) SetClipCircle(x interface{}, y interface{}, radius interface{}) *Provider_SetClipCircle_Call {
+	return &Provider_SetClipCircle_Call{Call: _e.mock.On("SetClipCircle", x, y, radius)}
+}
+
+func (_c *Provider_SetClipCircle_Call) Run(run func(x float64, y float64, radius float64)) *Provider_SetClipCircle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Provider_SetClipCircle_Call) Return() *Provider_SetClipCircle_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_SetClipCircle_Call) RunAndReturn(run func(float64, float64, float64)) *Provider_SetClipCircle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetClipRect provides a mock function with given fields: x, y, width, height
+func (_m *Provider) SetClipRect(x float64, y float64, width float64, height float64) {
+	_m.Called(x, y, width, height)
+}
+
+// Provider_SetClipRect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetClipRect'
+type Provider_SetClipRect_Call struct {
+	*mock.Call
+}
+
+// SetClipRect is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+//   - width float64
+//   - height float64
+func (_e *Provider_Expecter) SetClipRect(x interface{}, y interface{}, width interface{}, height interface{}) *Provider_SetClipRect_Call {
+	return &Provider_SetClipRect_Call{Call: _e.mock.On("SetClipRect", x, y, width, height)}
+}
+
+func (_c *Provider_SetClipRect_Call) Run(run func(x float64, y float64, width float64, height float64)) *Provider_SetClipRect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *Provider_SetClipRect_Call) Return() *Provider_SetClipRect_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_SetClipRect_Call) RunAndReturn(run func(float64, float64, float64, float64)) *Provider_SetClipRect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCompression provides a mock function with given fields: compression
+func (_m *Provider) SetCompression(compression bool) {
+	_m.Called(compression)
+}
+
+// Provider_SetCompression_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCompression'
+type Provider_SetCompression_Call struct {
+	*mock.Call
+}
+
+// SetCompression is a helper method to define mock.On call
 //   - compression bool
 func (_e *Provider_Expecter) SetCompression(compression interface{}) *Provider_SetCompression_Call {
 	return &Provider_SetCompression_Call{Call: _e.mock.On("SetCompression", compression)}
@@ -538,6 +1331,73 @@ func (_c *Provider_SetCompression_Call) RunAndReturn(run func(bool)) *Provider_S
 	return _c
 }
 
+// SetCursor provides a mock function with given fields: x, y
+func (_m *Provider) SetCursor(x float64, y float64) {
+	_m.Called(x, y)
+}
+
+// Provider_SetCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCursor'
+type Provider_SetCursor_Call struct {
+	*mock.Call
+}
+
+// SetCursor is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+func (_e *Provider_Expecter) SetCursor(x interface{}, y interface{}) *Provider_SetCursor_Call {
+	return &Provider_SetCursor_Call{Call: _e.mock.On("SetCursor", x, y)}
+}
+
+func (_c *Provider_SetCursor_Call) Run(run func(x float64, y float64)) *Provider_SetCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64))
+	})
+	return _c
+}
+
+func (_c *Provider_SetCursor_Call) Return() *Provider_SetCursor_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_SetCursor_Call) RunAndReturn(run func(float64, float64)) *Provider_SetCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetGraphicsState provides a mock function with given fields: state
+func (_m *Provider) SetGraphicsState(state props.GraphicsState) {
+	_m.Called(state)
+}
+
+// Provider_SetGraphicsState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGraphicsState'
+type Provider_SetGraphicsState_Call struct {
+	*mock.Call
+}
+
+// SetGraphicsState is a helper method to define mock.On call
+//   - state props.GraphicsState
+func (_e *Provider_Expecter) SetGraphicsState(state interface{}) *Provider_SetGraphicsState_Call {
+	return &Provider_SetGraphicsState_Call{Call: _e.mock.On("SetGraphicsState", state)}
+}
+
+func (_c *Provider_SetGraphicsState_Call) Run(run func(state props.GraphicsState)) *Provider_SetGraphicsState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(props.GraphicsState))
+	})
+	return _c
+}
+
+func (_c *Provider_SetGraphicsState_Call) Return() *Provider_SetGraphicsState_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_SetGraphicsState_Call) RunAndReturn(run func(props.GraphicsState)) *Provider_SetGraphicsState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetMetadata provides a mock function with given fields: metadata
 func (_m *Provider) SetMetadata(metadata *entity.Metadata) {
 	_m.Called(metadata)
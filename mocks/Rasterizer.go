@@ -0,0 +1,93 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	exporter "github.com/johnfercher/maroto/v2/pkg/exporter"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Rasterizer is an autogenerated mock type for the Rasterizer type
+type Rasterizer struct {
+	mock.Mock
+}
+
+type Rasterizer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Rasterizer) EXPECT() *Rasterizer_Expecter {
+	return &Rasterizer_Expecter{mock: &_m.Mock}
+}
+
+// Rasterize provides a mock function with given fields: pdf, page, format, dpi
+func (_m *Rasterizer) Rasterize(pdf []byte, page int, format exporter.Format, dpi float64) ([]byte, error) {
+	ret := _m.Called(pdf, page, format, dpi)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte, int, exporter.Format, float64) ([]byte, error)); ok {
+		return rf(pdf, page, format, dpi)
+	}
+	if rf, ok := ret.Get(0).(func([]byte, int, exporter.Format, float64) []byte); ok {
+		r0 = rf(pdf, page, format, dpi)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte, int, exporter.Format, float64) error); ok {
+		r1 = rf(pdf, page, format, dpi)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rasterizer_Rasterize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rasterize'
+type Rasterizer_Rasterize_Call struct {
+	*mock.Call
+}
+
+// Rasterize is a helper method to define mock.On call
+//   - pdf []byte
+//   - page int
+//   - format exporter.Format
+//   - dpi float64
+func (_e *Rasterizer_Expecter) Rasterize(pdf interface{}, page interface{}, format interface{}, dpi interface{}) *Rasterizer_Rasterize_Call {
+	return &Rasterizer_Rasterize_Call{Call: _e.mock.On("Rasterize", pdf, page, format, dpi)}
+}
+
+func (_c *Rasterizer_Rasterize_Call) Run(run func(pdf []byte, page int, format exporter.Format, dpi float64)) *Rasterizer_Rasterize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(int), args[2].(exporter.Format), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *Rasterizer_Rasterize_Call) Return(_a0 []byte, _a1 error) *Rasterizer_Rasterize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Rasterizer_Rasterize_Call) RunAndReturn(run func([]byte, int, exporter.Format, float64) ([]byte, error)) *Rasterizer_Rasterize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRasterizer creates a new instance of Rasterizer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRasterizer(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *Rasterizer {
+	mock := &Rasterizer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
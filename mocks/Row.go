@@ -234,6 +234,51 @@ func (_c *Row_SetConfig_Call) RunAndReturn(run func(*entity.Config)) *Row_SetCon
 	return _c
 }
 
+// WithBookmark provides a mock function with given fields: title, level
+func (_m *Row) WithBookmark(title string, level int) core.Row {
+	ret := _m.Called(title, level)
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(string, int) core.Row); ok {
+		r0 = rf(title, level)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// Row_WithBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithBookmark'
+type Row_WithBookmark_Call struct {
+	*mock.Call
+}
+
+// WithBookmark is a helper method to define mock.On call
+//   - title string
+//   - level int
+func (_e *Row_Expecter) WithBookmark(title interface{}, level interface{}) *Row_WithBookmark_Call {
+	return &Row_WithBookmark_Call{Call: _e.mock.On("WithBookmark", title, level)}
+}
+
+func (_c *Row_WithBookmark_Call) Run(run func(title string, level int)) *Row_WithBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Row_WithBookmark_Call) Return(_a0 core.Row) *Row_WithBookmark_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_WithBookmark_Call) RunAndReturn(run func(string, int) core.Row) *Row_WithBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithStyle provides a mock function with given fields: style
 func (_m *Row) WithStyle(style *props.Cell) core.Row {
 	ret := _m.Called(style)
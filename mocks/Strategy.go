@@ -0,0 +1,152 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Strategy is an autogenerated mock type for the Strategy type
+type Strategy struct {
+	mock.Mock
+}
+
+type Strategy_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Strategy) EXPECT() *Strategy_Expecter {
+	return &Strategy_Expecter{mock: &_m.Mock}
+}
+
+// GetTaskSize provides a mock function with given fields: items, workers
+func (_m *Strategy) GetTaskSize(items int, workers int) int {
+	ret := _m.Called(items, workers)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(int, int) int); ok {
+		r0 = rf(items, workers)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Strategy_GetTaskSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskSize'
+type Strategy_GetTaskSize_Call struct {
+	*mock.Call
+}
+
+// GetTaskSize is a helper method to define mock.On call
+//   - items int
+//   - workers int
+func (_e *Strategy_Expecter) GetTaskSize(items interface{}, workers interface{}) *Strategy_GetTaskSize_Call {
+	return &Strategy_GetTaskSize_Call{Call: _e.mock.On("GetTaskSize", items, workers)}
+}
+
+func (_c *Strategy_GetTaskSize_Call) Run(run func(items int, workers int)) *Strategy_GetTaskSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Strategy_GetTaskSize_Call) Return(_a0 int) *Strategy_GetTaskSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Strategy_GetTaskSize_Call) RunAndReturn(run func(int, int) int) *Strategy_GetTaskSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: workers, tasks, process
+func (_m *Strategy) Run(workers int, tasks int, process func(int, int)) {
+	_m.Called(workers, tasks, process)
+}
+
+// Strategy_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type Strategy_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - workers int
+//   - tasks int
+//   - process func(int , int)
+func (_e *Strategy_Expecter) Run(workers interface{}, tasks interface{}, process interface{}) *Strategy_Run_Call {
+	return &Strategy_Run_Call{Call: _e.mock.On("Run", workers, tasks, process)}
+}
+
+func (_c *Strategy_Run_Call) Run(run func(workers int, tasks int, process func(int, int))) *Strategy_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(func(int, int)))
+	})
+	return _c
+}
+
+func (_c *Strategy_Run_Call) Return() *Strategy_Run_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Strategy_Run_Call) RunAndReturn(run func(int, int, func(int, int))) *Strategy_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// String provides a mock function with given fields:
+func (_m *Strategy) String() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Strategy_String_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'String'
+type Strategy_String_Call struct {
+	*mock.Call
+}
+
+// String is a helper method to define mock.On call
+func (_e *Strategy_Expecter) String() *Strategy_String_Call {
+	return &Strategy_String_Call{Call: _e.mock.On("String")}
+}
+
+func (_c *Strategy_String_Call) Run(run func()) *Strategy_String_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Strategy_String_Call) Return(_a0 string) *Strategy_String_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Strategy_String_Call) RunAndReturn(run func() string) *Strategy_String_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStrategy creates a new instance of Strategy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStrategy(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *Strategy {
+	mock := &Strategy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,198 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	core "github.com/johnfercher/maroto/v2/pkg/core"
+	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
+
+	http "net/http"
+
+	image "github.com/johnfercher/maroto/v2/pkg/components/image"
+
+	mock "github.com/stretchr/testify/mock"
+
+	node "github.com/johnfercher/go-tree/node"
+)
+
+// URLImage is an autogenerated mock type for the URLImage type
+type URLImage struct {
+	mock.Mock
+}
+
+type URLImage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *URLImage) EXPECT() *URLImage_Expecter {
+	return &URLImage_Expecter{mock: &_m.Mock}
+}
+
+// GetStructure provides a mock function with given fields:
+func (_m *URLImage) GetStructure() *node.Node[core.Structure] {
+	ret := _m.Called()
+
+	var r0 *node.Node[core.Structure]
+	if rf, ok := ret.Get(0).(func() *node.Node[core.Structure]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*node.Node[core.Structure])
+		}
+	}
+
+	return r0
+}
+
+// URLImage_GetStructure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStructure'
+type URLImage_GetStructure_Call struct {
+	*mock.Call
+}
+
+// GetStructure is a helper method to define mock.On call
+func (_e *URLImage_Expecter) GetStructure() *URLImage_GetStructure_Call {
+	return &URLImage_GetStructure_Call{Call: _e.mock.On("GetStructure")}
+}
+
+func (_c *URLImage_GetStructure_Call) Run(run func()) *URLImage_GetStructure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *URLImage_GetStructure_Call) Return(_a0 *node.Node[core.Structure]) *URLImage_GetStructure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *URLImage_GetStructure_Call) RunAndReturn(run func() *node.Node[core.Structure]) *URLImage_GetStructure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Render provides a mock function with given fields: provider, cell
+func (_m *URLImage) Render(provider core.Provider, cell *entity.Cell) {
+	_m.Called(provider, cell)
+}
+
+// URLImage_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type URLImage_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell *entity.Cell
+func (_e *URLImage_Expecter) Render(provider interface{}, cell interface{}) *URLImage_Render_Call {
+	return &URLImage_Render_Call{Call: _e.mock.On("Render", provider, cell)}
+}
+
+func (_c *URLImage_Render_Call) Run(run func(provider core.Provider, cell *entity.Cell)) *URLImage_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *URLImage_Render_Call) Return() *URLImage_Render_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *URLImage_Render_Call) RunAndReturn(run func(core.Provider, *entity.Cell)) *URLImage_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetConfig provides a mock function with given fields: config
+func (_m *URLImage) SetConfig(config *entity.Config) {
+	_m.Called(config)
+}
+
+// URLImage_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
+type URLImage_SetConfig_Call struct {
+	*mock.Call
+}
+
+// SetConfig is a helper method to define mock.On call
+//   - config *entity.Config
+func (_e *URLImage_Expecter) SetConfig(config interface{}) *URLImage_SetConfig_Call {
+	return &URLImage_SetConfig_Call{Call: _e.mock.On("SetConfig", config)}
+}
+
+func (_c *URLImage_SetConfig_Call) Run(run func(config *entity.Config)) *URLImage_SetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Config))
+	})
+	return _c
+}
+
+func (_c *URLImage_SetConfig_Call) Return() *URLImage_SetConfig_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *URLImage_SetConfig_Call) RunAndReturn(run func(*entity.Config)) *URLImage_SetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithHTTPClient provides a mock function with given fields: client
+func (_m *URLImage) WithHTTPClient(client *http.Client) image.URLImage {
+	ret := _m.Called(client)
+
+	var r0 image.URLImage
+	if rf, ok := ret.Get(0).(func(*http.Client) image.URLImage); ok {
+		r0 = rf(client)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(image.URLImage)
+		}
+	}
+
+	return r0
+}
+
+// URLImage_WithHTTPClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithHTTPClient'
+type URLImage_WithHTTPClient_Call struct {
+	*mock.Call
+}
+
+// WithHTTPClient is a helper method to define mock.On call
+//   - client *http.Client
+func (_e *URLImage_Expecter) WithHTTPClient(client interface{}) *URLImage_WithHTTPClient_Call {
+	return &URLImage_WithHTTPClient_Call{Call: _e.mock.On("WithHTTPClient", client)}
+}
+
+func (_c *URLImage_WithHTTPClient_Call) Run(run func(client *http.Client)) *URLImage_WithHTTPClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Client))
+	})
+	return _c
+}
+
+func (_c *URLImage_WithHTTPClient_Call) Return(_a0 image.URLImage) *URLImage_WithHTTPClient_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *URLImage_WithHTTPClient_Call) RunAndReturn(run func(*http.Client) image.URLImage) *URLImage_WithHTTPClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewURLImage creates a new instance of URLImage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewURLImage(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *URLImage {
+	mock := &URLImage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
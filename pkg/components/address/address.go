@@ -0,0 +1,131 @@
+// Package address implements creation of postal address blocks.
+package address
+
+import (
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Address is the representation of a postal address.
+type Address struct {
+	Name       string
+	Street     string
+	City       string
+	State      string
+	PostalCode string
+	Country    string
+	// CountryCode is the ISO 3166-1 alpha-2 code used to choose the address format, ex: US, GB and BR.
+	CountryCode string
+}
+
+type address struct {
+	lines  []string
+	prop   props.Address
+	config *entity.Config
+}
+
+// New is responsible to create an instance of an Address.
+func New(addr Address, ps ...props.Address) core.Component {
+	prop := props.Address{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid(fontfamily.Arial)
+
+	return &address{
+		lines: addr.Lines(),
+		prop:  prop,
+	}
+}
+
+// NewCol is responsible to create an instance of an Address wrapped in a Col.
+func NewCol(size int, addr Address, ps ...props.Address) core.Col {
+	a := New(addr, ps...)
+	return col.New(size).Add(a)
+}
+
+// NewRow is responsible to create an instance of an Address wrapped in a Row.
+func NewRow(height float64, addr Address, ps ...props.Address) core.Row {
+	a := New(addr, ps...)
+	c := col.New().Add(a)
+	return row.New(height).Add(c)
+}
+
+// Render renders an Address into a PDF context, one line below the other.
+func (a *address) Render(provider core.Provider, cell *entity.Cell) {
+	lineHeight := provider.GetTextHeight(a.prop.ToFontProp()) * a.prop.LineSpacing
+
+	for i, line := range a.lines {
+		provider.AddText(line, cell, a.prop.ToTextProp(float64(i)*lineHeight))
+	}
+}
+
+// GetStructure returns the Structure of an Address.
+func (a *address) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "address",
+		Value:   strings.Join(a.lines, "\n"),
+		Details: a.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (a *address) SetConfig(config *entity.Config) {
+	a.config = config
+}
+
+// Lines returns the address lines formatted according to the CountryCode,
+// unknown countries use a generic format with name, street, city line and country.
+// Empty lines are removed.
+func (a Address) Lines() []string {
+	var lines []string
+
+	switch strings.ToUpper(a.CountryCode) {
+	case "US", "CA":
+		lines = []string{a.Name, a.Street, join(", ", a.City, join(" ", a.State, a.PostalCode)), a.Country}
+	case "AU":
+		lines = []string{a.Name, a.Street, join(" ", a.City, a.State, a.PostalCode), a.Country}
+	case "GB":
+		lines = []string{a.Name, a.Street, a.City, a.State, a.PostalCode, a.Country}
+	case "BR":
+		lines = []string{a.Name, a.Street, join(" - ", a.City, a.State), a.PostalCode, a.Country}
+	case "IT":
+		lines = []string{a.Name, a.Street, join(" ", a.PostalCode, a.City, a.State), a.Country}
+	case "DE", "FR", "ES", "NL", "PT", "BE", "CH", "AT":
+		lines = []string{a.Name, a.Street, join(" ", a.PostalCode, a.City), a.State, a.Country}
+	case "JP":
+		lines = []string{a.PostalCode, join(" ", a.State, a.City), a.Street, a.Name, a.Country}
+	default:
+		lines = []string{a.Name, a.Street, join(" ", a.City, a.State, a.PostalCode), a.Country}
+	}
+
+	var filled []string
+	for _, line := range lines {
+		if line != "" {
+			filled = append(filled, line)
+		}
+	}
+
+	return filled
+}
+
+func join(separator string, values ...string) string {
+	var filled []string
+	for _, value := range values {
+		if value != "" {
+			filled = append(filled, value)
+		}
+	}
+
+	return strings.Join(filled, separator)
+}
@@ -0,0 +1,150 @@
+package address_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/address"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var addr = address.Address{
+	Name:        "John Doe",
+	Street:      "1600 Amphitheatre Pkwy",
+	City:        "Mountain View",
+	State:       "CA",
+	PostalCode:  "94043",
+	Country:     "United States",
+	CountryCode: "US",
+}
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := address.New(addr)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/addresses/new_address_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := address.New(addr, fixture.AddressProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/addresses/new_address_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := address.NewCol(12, addr)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/addresses/new_address_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := address.NewRow(10, addr)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/addresses/new_address_row.json")
+}
+
+func TestAddress_Render(t *testing.T) {
+	t.Run("should write one line below the other", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.AddressProp()
+		sut := address.New(addr, prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(prop.ToFontProp()).Return(4.0)
+		provider.EXPECT().AddText("John Doe", &cell, prop.ToTextProp(0))
+		provider.EXPECT().AddText("1600 Amphitheatre Pkwy", &cell, prop.ToTextProp(6))
+		provider.EXPECT().AddText("Mountain View, CA 94043", &cell, prop.ToTextProp(12))
+		provider.EXPECT().AddText("United States", &cell, prop.ToTextProp(18))
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "GetTextHeight", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 4)
+	})
+}
+
+func TestAddress_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := address.New(addr)
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
+
+func TestAddress_Lines(t *testing.T) {
+	t.Run("when country is US, should write city, state and postal code in the same line", func(t *testing.T) {
+		// Act
+		lines := addr.Lines()
+
+		// Assert
+		assert.Equal(t, []string{"John Doe", "1600 Amphitheatre Pkwy", "Mountain View, CA 94043", "United States"}, lines)
+	})
+	t.Run("when country is GB, should write postal code in its own line", func(t *testing.T) {
+		// Arrange
+		sut := address.Address{
+			Name: "Jane Doe", Street: "10 Downing Street", City: "London",
+			PostalCode: "SW1A 2AA", Country: "United Kingdom", CountryCode: "gb",
+		}
+
+		// Act
+		lines := sut.Lines()
+
+		// Assert
+		assert.Equal(t, []string{"Jane Doe", "10 Downing Street", "London", "SW1A 2AA", "United Kingdom"}, lines)
+	})
+	t.Run("when country is DE, should write postal code before city", func(t *testing.T) {
+		// Arrange
+		sut := address.Address{
+			Name: "Max Mustermann", Street: "Unter den Linden 1", City: "Berlin",
+			PostalCode: "10117", Country: "Deutschland", CountryCode: "DE",
+		}
+
+		// Act
+		lines := sut.Lines()
+
+		// Assert
+		assert.Equal(t, []string{"Max Mustermann", "Unter den Linden 1", "10117 Berlin", "Deutschland"}, lines)
+	})
+	t.Run("when country is BR, should write city and state in the same line", func(t *testing.T) {
+		// Arrange
+		sut := address.Address{
+			Name: "Fulano de Tal", Street: "Av. Paulista, 1000", City: "São Paulo", State: "SP",
+			PostalCode: "01310-100", Country: "Brasil", CountryCode: "BR",
+		}
+
+		// Act
+		lines := sut.Lines()
+
+		// Assert
+		assert.Equal(t, []string{"Fulano de Tal", "Av. Paulista, 1000", "São Paulo - SP", "01310-100", "Brasil"}, lines)
+	})
+	t.Run("when country is unknown, should use generic four lines format", func(t *testing.T) {
+		// Arrange
+		sut := address.Address{
+			Name: "Someone", Street: "Main Street 1", City: "Somewhere", State: "SW",
+			PostalCode: "12345", Country: "Nowhere", CountryCode: "ZZ",
+		}
+
+		// Act
+		lines := sut.Lines()
+
+		// Assert
+		assert.Equal(t, []string{"Someone", "Main Street 1", "Somewhere SW 12345", "Nowhere"}, lines)
+	})
+}
@@ -0,0 +1,99 @@
+// Package avatar implements creation of circular avatars.
+package avatar
+
+import (
+	"math"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type avatar struct {
+	bytes     []byte
+	extension extension.Type
+	prop      props.Rect
+	config    *entity.Config
+}
+
+// New is responsible to create an instance of an Avatar, an image clipped by a circle.
+// The diameter of the circle is the smallest side of the cell scaled by props.Rect.Percent,
+// the circle is centered when props.Rect.Center is true, otherwise it's placed by Left and Top.
+func New(bytes []byte, extension extension.Type, ps ...props.Rect) core.Component {
+	prop := props.Rect{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &avatar{
+		bytes:     bytes,
+		extension: extension,
+		prop:      prop,
+	}
+}
+
+// NewCol is responsible to create an instance of an Avatar wrapped in a Col.
+func NewCol(size int, bytes []byte, extension extension.Type, ps ...props.Rect) core.Col {
+	avatar := New(bytes, extension, ps...)
+	return col.New(size).Add(avatar)
+}
+
+// NewRow is responsible to create an instance of an Avatar wrapped in a Row.
+func NewRow(height float64, bytes []byte, extension extension.Type, ps ...props.Rect) core.Row {
+	avatar := New(bytes, extension, ps...)
+	c := col.New().Add(avatar)
+	return row.New(height).Add(c)
+}
+
+// Render renders an Avatar into a PDF context.
+func (a *avatar) Render(provider core.Provider, cell *entity.Cell) {
+	diameter := math.Min(cell.Width, cell.Height) * a.prop.Percent / 100.0
+
+	square := &entity.Cell{
+		X:      cell.X + a.prop.Left,
+		Y:      cell.Y + a.prop.Top,
+		Width:  diameter,
+		Height: diameter,
+	}
+
+	if a.prop.Center {
+		square.X = cell.X + (cell.Width-diameter)/2.0
+		square.Y = cell.Y + (cell.Height-diameter)/2.0
+	}
+
+	radius := diameter / 2.0
+
+	provider.SetClipCircle(square.X+radius, square.Y+radius, radius)
+	provider.AddImageFromBytes(a.bytes, square, &props.Rect{Center: true, Percent: 100}, a.extension)
+	provider.ResetClip()
+}
+
+// GetStructure returns the Structure of an Avatar.
+func (a *avatar) GetStructure() *node.Node[core.Structure] {
+	trimLength := 10
+	if len(a.bytes) < trimLength {
+		trimLength = len(a.bytes)
+	}
+
+	str := core.Structure{
+		Type:    "avatar",
+		Value:   a.bytes[:trimLength],
+		Details: a.prop.ToMap(),
+	}
+
+	str.Details["extension"] = a.extension
+	str.Details["bytes_size"] = len(a.bytes)
+
+	return node.New(str)
+}
+
+// SetConfig sets the pdf config.
+func (a *avatar) SetConfig(config *entity.Config) {
+	a.config = config
+}
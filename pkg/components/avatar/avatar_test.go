@@ -0,0 +1,80 @@
+package avatar_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/avatar"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := avatar.New([]byte{1, 2, 3}, extension.Png)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/avatars/new_avatar_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := avatar.New([]byte{1, 2, 3}, extension.Png, props.Rect{Center: true, Percent: 80})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/avatars/new_avatar_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := avatar.NewCol(12, []byte{1, 2, 3}, extension.Png)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/avatars/new_avatar_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := avatar.NewRow(10, []byte{1, 2, 3}, extension.Png)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/avatars/new_avatar_row.json")
+}
+
+func TestAvatar_Render(t *testing.T) {
+	t.Run("when centered, should clip a circle in the middle of the cell", func(t *testing.T) {
+		// Arrange
+		bytes := []byte{1, 2, 3}
+		cell := &entity.Cell{X: 10, Y: 20, Width: 40, Height: 20}
+		square := &entity.Cell{X: 22, Y: 22, Width: 16, Height: 16}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().SetClipCircle(30.0, 30.0, 8.0)
+		provider.EXPECT().AddImageFromBytes(bytes, square, &props.Rect{Center: true, Percent: 100}, extension.Png)
+		provider.EXPECT().ResetClip()
+
+		sut := avatar.New(bytes, extension.Png, props.Rect{Center: true, Percent: 80})
+
+		// Act
+		sut.Render(provider, cell)
+	})
+	t.Run("when not centered, should clip a circle placed by left and top", func(t *testing.T) {
+		// Arrange
+		bytes := []byte{1, 2, 3}
+		cell := &entity.Cell{X: 10, Y: 20, Width: 40, Height: 20}
+		square := &entity.Cell{X: 12, Y: 23, Width: 20, Height: 20}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().SetClipCircle(22.0, 33.0, 10.0)
+		provider.EXPECT().AddImageFromBytes(bytes, square, &props.Rect{Center: true, Percent: 100}, extension.Png)
+		provider.EXPECT().ResetClip()
+
+		sut := avatar.New(bytes, extension.Png, props.Rect{Left: 2, Top: 3})
+
+		// Act
+		sut.Render(provider, cell)
+	})
+}
@@ -0,0 +1,409 @@
+// Package bar implements creation of vertical and horizontal bar charts.
+package bar
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	// axisTicks is the approximate number of divisions of the value axis.
+	axisTicks = 5
+	// groupPercent is how much of the space of each category is filled by its bars.
+	groupPercent = 0.8
+)
+
+// defaultColors are applied, in order, to the series without color.
+var defaultColors = []props.Color{
+	{Red: 66, Green: 133, Blue: 244},
+	{Red: 219, Green: 68, Blue: 55},
+	{Red: 244, Green: 180, Blue: 0},
+	{Red: 15, Green: 157, Blue: 88},
+	{Red: 171, Green: 71, Blue: 188},
+	{Red: 0, Green: 172, Blue: 193},
+	{Red: 255, Green: 112, Blue: 67},
+	{Red: 158, Green: 157, Blue: 36},
+}
+
+// Series is a set of bars with the same color, its values are indexed by category.
+type Series struct {
+	Label  string
+	Values []float64
+	Color  *props.Color
+}
+
+type bar struct {
+	series []Series
+	prop   props.BarChart
+	config *entity.Config
+}
+
+// New is responsible to create an instance of a BarChart. The series are drawn side by side in each category,
+// or stacked when the props are Stacked, over an axis scaled from the values. The labels of the categories
+// come from the props and, when any series has a label, a legend is written on the right of the chart.
+func New(series []Series, ps ...props.BarChart) core.Component {
+	prop := props.BarChart{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid(fontfamily.Arial)
+
+	return &bar{
+		series: series,
+		prop:   prop,
+	}
+}
+
+// NewCol is responsible to create an instance of a BarChart wrapped in a Col.
+func NewCol(size int, series []Series, ps ...props.BarChart) core.Col {
+	bar := New(series, ps...)
+	return col.New(size).Add(bar)
+}
+
+// NewRow is responsible to create an instance of a BarChart wrapped in a Row.
+func NewRow(height float64, series []Series, ps ...props.BarChart) core.Row {
+	bar := New(series, ps...)
+	c := col.New().Add(bar)
+	return row.New(height).Add(c)
+}
+
+// Render renders a BarChart into a PDF context.
+func (b *bar) Render(provider core.Provider, cell *entity.Cell) {
+	count := b.getCategoriesCount()
+	if count == 0 || len(b.series) == 0 {
+		return
+	}
+
+	fontHeight := provider.GetTextHeight(&b.prop.Font)
+	rowHeight := fontHeight * 1.5
+
+	legendWidth := 0.0
+	if b.hasLabels() {
+		legendWidth = cell.Width * b.prop.LegendPercent / 100.0
+	}
+
+	labelWidth := cell.Width * b.prop.LabelPercent / 100.0
+	area := entity.Cell{
+		X:      cell.X + labelWidth,
+		Y:      cell.Y,
+		Width:  cell.Width - labelWidth - legendWidth,
+		Height: cell.Height - rowHeight,
+	}
+
+	if b.prop.Horizontal && b.prop.ShowValues {
+		area.Width -= rowHeight * 2
+	}
+
+	if !b.prop.Horizontal {
+		top := rowHeight / 2.0
+		if b.prop.ShowValues {
+			top = rowHeight
+		}
+
+		area.Y += top
+		area.Height -= top
+	}
+
+	if area.Width <= 0 || area.Height <= 0 {
+		return
+	}
+
+	low, high := b.getLimits()
+	s := newScale(low, high)
+
+	b.renderBars(provider, area, s, count, fontHeight, rowHeight)
+	b.renderAxis(provider, cell, area, s, count, fontHeight, rowHeight)
+
+	if legendWidth > 0 {
+		b.renderLegend(provider, cell, legendWidth, fontHeight, rowHeight)
+	}
+}
+
+// GetStructure returns the Structure of a BarChart.
+func (b *bar) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "bar",
+		Value:   b.getSeriesValue(),
+		Details: b.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (b *bar) SetConfig(config *entity.Config) {
+	b.config = config
+}
+
+func (b *bar) renderBars(provider core.Provider, area entity.Cell, s scale, count int, fontHeight, rowHeight float64) {
+	slot := b.getCategoryLength(area) / float64(count)
+	groupLength := slot * groupPercent
+	offset := (slot - groupLength) / 2.0
+
+	for i := 0; i < count; i++ {
+		start := float64(i)*slot + offset
+
+		if b.prop.Stacked {
+			positive, negative := 0.0, 0.0
+			for j := range b.series {
+				value := b.getValue(j, i)
+				if value > 0 {
+					b.drawBar(provider, area, s, start, groupLength, positive, positive+value, b.getColor(j))
+					positive += value
+				} else if value < 0 {
+					b.drawBar(provider, area, s, start, groupLength, negative, negative+value, b.getColor(j))
+					negative += value
+				}
+			}
+
+			if b.prop.ShowValues {
+				end := positive
+				if positive == 0 {
+					end = negative
+				}
+				b.writeValue(provider, area, s, start, groupLength, positive+negative, end, fontHeight, rowHeight)
+			}
+
+			continue
+		}
+
+		barLength := groupLength / float64(len(b.series))
+		for j := range b.series {
+			value := b.getValue(j, i)
+			b.drawBar(provider, area, s, start+float64(j)*barLength, barLength, 0, value, b.getColor(j))
+
+			if b.prop.ShowValues {
+				b.writeValue(provider, area, s, start+float64(j)*barLength, barLength, value, value, fontHeight, rowHeight)
+			}
+		}
+	}
+}
+
+func (b *bar) drawBar(provider core.Provider, area entity.Cell, s scale, start, length, from, to float64, color *props.Color) {
+	low, high := s.ratio(math.Min(from, to)), s.ratio(math.Max(from, to))
+	if high <= low {
+		return
+	}
+
+	rect := entity.Cell{
+		X:      area.X + start,
+		Y:      area.Y + area.Height*(1-high),
+		Width:  length,
+		Height: area.Height * (high - low),
+	}
+
+	if b.prop.Horizontal {
+		rect = entity.Cell{
+			X:      area.X + area.Width*low,
+			Y:      area.Y + start,
+			Width:  area.Width * (high - low),
+			Height: length,
+		}
+	}
+
+	provider.DrawRect(&rect, &props.Cell{BackgroundColor: color})
+}
+
+// writeValue writes the value after the end of the bar, above or below it when vertical and on its right or left when horizontal.
+func (b *bar) writeValue(provider core.Provider, area entity.Cell, s scale, start, length, value, end, fontHeight, rowHeight float64) {
+	label := strconv.FormatFloat(value, 'f', -1, 64)
+
+	if b.prop.Horizontal {
+		x := area.X + area.Width*s.ratio(end)
+		valueCell := &entity.Cell{X: x + fontHeight/4.0, Y: area.Y + start, Width: rowHeight * 2, Height: length}
+		valueAlign := align.Left
+		if end < 0 {
+			valueCell.X = x - fontHeight/4.0 - valueCell.Width
+			valueAlign = align.Right
+		}
+
+		provider.AddText(label, valueCell, b.prop.Font.ToTextProp(valueAlign, (length-fontHeight)/2.0, 0))
+		return
+	}
+
+	y := area.Y + area.Height*(1-s.ratio(end))
+	valueCell := &entity.Cell{X: area.X + start, Y: y - rowHeight, Width: length, Height: rowHeight}
+	if end < 0 {
+		valueCell.Y = y
+	}
+
+	provider.AddText(label, valueCell, b.prop.Font.ToTextProp(align.Center, (rowHeight-fontHeight)/2.0, 0))
+}
+
+func (b *bar) renderAxis(provider core.Provider, cell *entity.Cell, area entity.Cell, s scale, count int, fontHeight, rowHeight float64) {
+	axisProp := &props.Line{
+		Color:     b.prop.AxisColor,
+		Style:     linestyle.Solid,
+		Thickness: linestyle.DefaultLineThickness,
+	}
+
+	top := (rowHeight - fontHeight) / 2.0
+	labelWidth := area.X - cell.X - fontHeight/2.0
+	slot := b.getCategoryLength(area) / float64(count)
+	zero := s.ratio(0)
+
+	if b.prop.Horizontal {
+		bottom := area.Y + area.Height
+		provider.DrawLine(entity.Point{X: area.X, Y: bottom}, entity.Point{X: area.X + area.Width, Y: bottom}, axisProp)
+		x := area.X + area.Width*zero
+		provider.DrawLine(entity.Point{X: x, Y: area.Y}, entity.Point{X: x, Y: bottom}, axisProp)
+
+		tickWidth := area.Width / axisTicks
+		for _, tick := range s.getTicks() {
+			x := area.X + area.Width*s.ratio(tick)
+			tickCell := &entity.Cell{X: x - tickWidth/2.0, Y: bottom, Width: tickWidth, Height: rowHeight}
+			provider.AddText(s.format(tick), tickCell, b.prop.Font.ToTextProp(align.Center, top, 0))
+		}
+
+		for i, category := range b.prop.Categories {
+			if i >= count {
+				break
+			}
+
+			categoryCell := &entity.Cell{X: cell.X, Y: area.Y + float64(i)*slot, Width: labelWidth, Height: slot}
+			provider.AddText(category, categoryCell, b.prop.Font.ToTextProp(align.Right, (slot-fontHeight)/2.0, 0))
+		}
+
+		return
+	}
+
+	provider.DrawLine(entity.Point{X: area.X, Y: area.Y}, entity.Point{X: area.X, Y: area.Y + area.Height}, axisProp)
+	y := area.Y + area.Height*(1-zero)
+	provider.DrawLine(entity.Point{X: area.X, Y: y}, entity.Point{X: area.X + area.Width, Y: y}, axisProp)
+
+	for _, tick := range s.getTicks() {
+		y := area.Y + area.Height*(1-s.ratio(tick))
+		tickCell := &entity.Cell{X: cell.X, Y: y - rowHeight/2.0, Width: labelWidth, Height: rowHeight}
+		provider.AddText(s.format(tick), tickCell, b.prop.Font.ToTextProp(align.Right, top, 0))
+	}
+
+	for i, category := range b.prop.Categories {
+		if i >= count {
+			break
+		}
+
+		categoryCell := &entity.Cell{X: area.X + float64(i)*slot, Y: area.Y + area.Height, Width: slot, Height: rowHeight}
+		provider.AddText(category, categoryCell, b.prop.Font.ToTextProp(align.Center, top, 0))
+	}
+}
+
+func (b *bar) renderLegend(provider core.Provider, cell *entity.Cell, legendWidth, fontHeight, rowHeight float64) {
+	x := cell.X + cell.Width - legendWidth + fontHeight/2.0
+	y := cell.Y + math.Max(0, (cell.Height-float64(len(b.series))*rowHeight)/2.0)
+	top := (rowHeight - fontHeight) / 2.0
+
+	for i, series := range b.series {
+		provider.DrawRect(&entity.Cell{
+			X:      x,
+			Y:      y + top,
+			Width:  fontHeight,
+			Height: fontHeight,
+		}, &props.Cell{BackgroundColor: b.getColor(i)})
+
+		labelX := x + fontHeight*1.5
+		labelCell := &entity.Cell{
+			X:      labelX,
+			Y:      y,
+			Width:  cell.X + cell.Width - labelX,
+			Height: rowHeight,
+		}
+
+		provider.AddText(series.Label, labelCell, b.prop.Font.ToTextProp(align.Left, top, 0))
+
+		y += rowHeight
+	}
+}
+
+// getLimits returns the lowest and highest values of the bars, the sums by category when stacked.
+func (b *bar) getLimits() (float64, float64) {
+	low, high := 0.0, 0.0
+
+	for i := 0; i < b.getCategoriesCount(); i++ {
+		positive, negative := 0.0, 0.0
+		for j := range b.series {
+			value := b.getValue(j, i)
+			if !b.prop.Stacked {
+				low, high = math.Min(low, value), math.Max(high, value)
+				continue
+			}
+
+			if value > 0 {
+				positive += value
+			} else {
+				negative += value
+			}
+		}
+
+		low, high = math.Min(low, negative), math.Max(high, positive)
+	}
+
+	return low, high
+}
+
+func (b *bar) getCategoriesCount() int {
+	count := len(b.prop.Categories)
+	for _, series := range b.series {
+		count = max(count, len(series.Values))
+	}
+
+	return count
+}
+
+func (b *bar) getCategoryLength(area entity.Cell) float64 {
+	if b.prop.Horizontal {
+		return area.Height
+	}
+
+	return area.Width
+}
+
+func (b *bar) getValue(series, category int) float64 {
+	values := b.series[series].Values
+	if category >= len(values) || math.IsNaN(values[category]) || math.IsInf(values[category], 0) {
+		return 0
+	}
+
+	return values[category]
+}
+
+func (b *bar) hasLabels() bool {
+	for _, series := range b.series {
+		if series.Label != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *bar) getColor(index int) *props.Color {
+	if b.series[index].Color != nil {
+		return b.series[index].Color
+	}
+
+	return &defaultColors[index%len(defaultColors)]
+}
+
+func (b *bar) getSeriesValue() []map[string]interface{} {
+	value := make([]map[string]interface{}, 0, len(b.series))
+	for i, series := range b.series {
+		value = append(value, map[string]interface{}{
+			"label":  series.Label,
+			"values": series.Values,
+			"color":  b.getColor(i).ToString(),
+		})
+	}
+
+	return value
+}
@@ -0,0 +1,160 @@
+package bar_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart/bar"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var series = []bar.Series{
+	{Label: "2023", Values: []float64{10, 20}},
+	{Label: "2024", Values: []float64{15, 25}, Color: &props.RedColor},
+}
+
+var defaultColor = &props.Color{Red: 66, Green: 133, Blue: 244}
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := bar.New(series)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := bar.New(series, fixture.BarChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := bar.NewCol(12, series)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := bar.NewRow(10, series)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_row.json")
+}
+
+func TestBar_Render(t *testing.T) {
+	t.Run("when there are no values, should not call provider", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := bar.New([]bar.Series{{Label: "empty"}})
+
+		provider := &mocks.Provider{}
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "DrawRect")
+		provider.AssertNotCalled(t, "GetTextHeight")
+	})
+	t.Run("when vertical, should draw the bars over the scaled axis", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 50}
+		sut := bar.New([]bar.Series{{Values: []float64{10, 20}}})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().DrawRect(&entity.Cell{X: 19.25, Y: 23.5, Width: 34, Height: 20.5}, &props.Cell{BackgroundColor: defaultColor})
+		provider.EXPECT().DrawRect(&entity.Cell{X: 61.75, Y: 3, Width: 34, Height: 41}, &props.Cell{BackgroundColor: defaultColor})
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawRect", 2)
+		provider.AssertNumberOfCalls(t, "DrawLine", 2)
+		provider.AssertNumberOfCalls(t, "AddText", 5)
+		provider.AssertCalled(t, "AddText", "20", &entity.Cell{X: 0, Y: 0, Width: 13, Height: 6}, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Align == align.Right
+		}))
+	})
+	t.Run("when values are negative, should extend the axis below zero", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 50}
+		sut := bar.New([]bar.Series{{Values: []float64{-10, 20}}})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 4)
+		provider.AssertCalled(t, "AddText", "-10", mock.Anything, mock.Anything)
+		provider.AssertCalled(t, "AddText", "0", mock.Anything, mock.Anything)
+	})
+	t.Run("when stacked, horizontal and showing values, should draw a bar by category with the total", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 50}
+		prop := props.BarChart{Stacked: true, Horizontal: true, ShowValues: true, Categories: []string{"Q1", "Q2"}}
+		sut := bar.New([]bar.Series{{Values: []float64{10, 20}}, {Values: []float64{15, 25}, Color: &props.RedColor}}, prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawRect", 4)
+		provider.AssertCalled(t, "DrawRect", mock.Anything, &props.Cell{BackgroundColor: &props.RedColor})
+		provider.AssertCalled(t, "AddText", "25", mock.Anything, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Align == align.Left
+		}))
+		provider.AssertCalled(t, "AddText", "45", mock.Anything, mock.Anything)
+		provider.AssertCalled(t, "AddText", "Q2", mock.Anything, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Align == align.Right
+		}))
+		provider.AssertNotCalled(t, "AddText", "15", mock.Anything, mock.Anything)
+	})
+	t.Run("when series have labels, should write the legend", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 50}
+		sut := bar.New(series)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawRect", 6)
+		provider.AssertCalled(t, "DrawRect", &entity.Cell{X: 82, Y: 20, Width: 4, Height: 4}, &props.Cell{BackgroundColor: defaultColor})
+		provider.AssertCalled(t, "AddText", "2024", &entity.Cell{X: 88, Y: 25, Width: 12, Height: 6}, mock.Anything)
+	})
+}
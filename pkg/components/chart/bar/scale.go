@@ -0,0 +1,74 @@
+package bar
+
+import (
+	"math"
+	"strconv"
+)
+
+// scale maps the values of the chart to the value axis, its limits are multiples of the step and include zero.
+type scale struct {
+	min  float64
+	max  float64
+	step float64
+}
+
+func newScale(low, high float64) scale {
+	low, high = math.Min(low, 0), math.Max(high, 0)
+	if high == low {
+		high = 1
+	}
+
+	step := getNiceStep((high - low) / axisTicks)
+
+	// the epsilon avoids a whole step added by floating point errors, ex: 0.30000000000000004 / 0.1.
+	epsilon := 1e-9
+	return scale{
+		min:  math.Floor(low/step+epsilon) * step,
+		max:  math.Ceil(high/step-epsilon) * step,
+		step: step,
+	}
+}
+
+// ratio returns the position of the value in the axis, 0 at the min and 1 at the max.
+func (s scale) ratio(value float64) float64 {
+	return (value - s.min) / (s.max - s.min)
+}
+
+func (s scale) getTicks() []float64 {
+	count := int(math.Round((s.max - s.min) / s.step))
+
+	ticks := make([]float64, 0, count+1)
+	for i := 0; i <= count; i++ {
+		tick := s.min + float64(i)*s.step
+		if math.Abs(tick) < s.step/2 {
+			tick = 0
+		}
+
+		ticks = append(ticks, tick)
+	}
+
+	return ticks
+}
+
+// format writes the tick with the decimals of the step.
+func (s scale) format(tick float64) string {
+	decimals := max(0, int(-math.Floor(math.Log10(s.step))))
+	return strconv.FormatFloat(tick, 'f', decimals, 64)
+}
+
+// getNiceStep rounds the step up to 1, 2 or 5 times a power of ten.
+func getNiceStep(raw float64) float64 {
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	fraction := raw / magnitude
+
+	switch {
+	case fraction <= 1:
+		return magnitude
+	case fraction <= 2:
+		return 2 * magnitude
+	case fraction <= 5:
+		return 5 * magnitude
+	default:
+		return 10 * magnitude
+	}
+}
@@ -0,0 +1,354 @@
+// Package chart implements creation of charts rendered by go-chart, as sparklines, line and bar charts.
+package chart
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/johnfercher/go-tree/node"
+	gochart "github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/legendposition"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Type is a representation of how the series of a chart are drawn.
+type Type string
+
+const (
+	// Line draws each series as a line, over an axis scaled from the values.
+	Line Type = "line"
+	// Sparkline draws the series as lines filling the cell, without axes, title nor legend, to be
+	// written along with the text.
+	Sparkline Type = "sparkline"
+	// Bar draws a bar for each series, with its label and its first value.
+	Bar Type = "bar"
+)
+
+const (
+	// dpi is the resolution of the image of the chart.
+	dpi = 150.0
+	// mmPerInch converts the size of the cell to pixels.
+	mmPerInch = 25.4
+	// paddingMM is the space, in mm, around the chart.
+	paddingMM = 2.0
+	// strokeMM is the width, in mm, of the lines.
+	strokeMM = 0.4
+	// titleFontSize is the size, in points, of the title.
+	titleFontSize = 12.0
+	// legendFontSize is the size, in points, of the legend, as written by go-chart.
+	legendFontSize = 8.0
+	// barPercent is how much of the space of each bar is filled by it.
+	barPercent = 0.6
+)
+
+// Series is a set of values drawn with the same color.
+type Series struct {
+	Label string
+	// XValues are the positions of the values on the horizontal axis of line charts and sparklines,
+	// the indexes of the values when they don't have one for each value.
+	XValues []float64
+	Values  []float64
+	Color   *props.Color
+}
+
+type chart struct {
+	chartType Type
+	series    []Series
+	prop      props.Chart
+	config    *entity.Config
+}
+
+// New is responsible to create an instance of a Chart. The chart is rendered by go-chart in a png image of
+// the size of the cell, which is added like the image components.
+func New(chartType Type, data []Series, ps ...props.Chart) core.Component {
+	prop := props.Chart{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	return &chart{
+		chartType: chartType,
+		series:    data,
+		prop:      prop,
+	}
+}
+
+// NewCol is responsible to create an instance of a Chart wrapped in a Col.
+func NewCol(size int, chartType Type, data []Series, ps ...props.Chart) core.Col {
+	chart := New(chartType, data, ps...)
+	return col.New(size).Add(chart)
+}
+
+// NewRow is responsible to create an instance of a Chart wrapped in a Row.
+func NewRow(height float64, chartType Type, data []Series, ps ...props.Chart) core.Row {
+	chart := New(chartType, data, ps...)
+	c := col.New().Add(chart)
+	return row.New(height).Add(c)
+}
+
+// Render renders a Chart into a PDF context, nothing is written when there are no values to draw.
+func (c *chart) Render(provider core.Provider, cell *entity.Cell) {
+	width, height := toPixels(cell.Width), toPixels(cell.Height)
+	if width <= 0 || height <= 0 || !c.hasValues() {
+		return
+	}
+
+	png, err := c.toPNG(width, height)
+	if err != nil {
+		provider.AddText("could not render chart", cell, merror.DefaultErrorText)
+		return
+	}
+
+	provider.AddImageFromBytes(png, cell, &props.Rect{Percent: 100}, extension.Png)
+}
+
+// GetStructure returns the Structure of a Chart.
+func (c *chart) GetStructure() *node.Node[core.Structure] {
+	details := c.prop.ToMap()
+	details["prop_type"] = c.chartType
+
+	str := core.Structure{
+		Type:    "chart",
+		Value:   c.getSeriesValue(),
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (c *chart) SetConfig(config *entity.Config) {
+	c.config = config
+}
+
+func (c *chart) toPNG(width, height int) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if c.chartType == Bar {
+		if err := c.newBarChart(width, height).Render(gochart.PNG, &buf); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	if err := c.newLineChart(width, height).Render(gochart.PNG, &buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c *chart) newLineChart(width, height int) *gochart.Chart {
+	lineChart := &gochart.Chart{
+		Width:      width,
+		Height:     height,
+		DPI:        dpi,
+		Background: c.getBackgroundStyle(),
+		Canvas:     c.getCanvasStyle(),
+	}
+
+	for i, s := range c.series {
+		if len(s.Values) == 0 {
+			continue
+		}
+
+		lineChart.Series = append(lineChart.Series, gochart.ContinuousSeries{
+			Name:    s.Label,
+			XValues: getXValues(s),
+			YValues: s.Values,
+			Style:   c.getSeriesStyle(i, false),
+		})
+	}
+
+	if c.chartType == Sparkline {
+		lineChart.XAxis.Style.Hidden = true
+		lineChart.YAxis.Style.Hidden = true
+		return lineChart
+	}
+
+	lineChart.XAxis.Style.Hidden = c.prop.HideAxes
+	lineChart.YAxis.Style.Hidden = c.prop.HideAxes
+	lineChart.Title = c.prop.Title
+	lineChart.TitleStyle = c.getTitleStyle()
+
+	switch c.prop.LegendPosition {
+	case legendposition.Top:
+		lineChart.Elements = []gochart.Renderable{gochart.LegendThin(lineChart)}
+	case legendposition.Inside:
+		lineChart.Elements = []gochart.Renderable{gochart.Legend(lineChart)}
+	}
+
+	return lineChart
+}
+
+func (c *chart) newBarChart(width, height int) *gochart.BarChart {
+	barChart := &gochart.BarChart{
+		Width:      width,
+		Height:     height,
+		DPI:        dpi,
+		Title:      c.prop.Title,
+		TitleStyle: c.getTitleStyle(),
+		Background: c.getBackgroundStyle(),
+		Canvas:     c.getCanvasStyle(),
+	}
+
+	barChart.XAxis.Hidden = c.prop.HideAxes
+	barChart.YAxis.Style.Hidden = c.prop.HideAxes
+
+	// the bars grow from zero, go-chart scales the axis from the lowest value by default
+	barChart.UseBaseValue = true
+	yRange := &gochart.ContinuousRange{}
+	for i, s := range c.series {
+		if len(s.Values) == 0 {
+			continue
+		}
+
+		barChart.Bars = append(barChart.Bars, gochart.Value{
+			Label: s.Label,
+			Value: s.Values[0],
+			Style: c.getSeriesStyle(i, true),
+		})
+		yRange.Min = math.Min(yRange.Min, s.Values[0])
+		yRange.Max = math.Max(yRange.Max, s.Values[0])
+	}
+	barChart.YAxis.Range = yRange
+
+	slot := float64(width-barChart.Background.Padding.Left-barChart.Background.Padding.Right) / float64(len(barChart.Bars))
+	barChart.BarWidth = max(int(slot*barPercent), 1)
+	barChart.BarSpacing = max(int(slot*(1-barPercent)), 1)
+
+	return barChart
+}
+
+// getBackgroundStyle returns the background with the padding of the title and the legend written above the chart.
+func (c *chart) getBackgroundStyle() gochart.Style {
+	padding := toPixels(paddingMM)
+	style := gochart.Style{
+		Padding: gochart.Box{Top: padding, Left: padding, Right: padding, Bottom: padding, IsSet: true},
+	}
+
+	if c.prop.BackgroundColor != nil {
+		style.FillColor = toColor(c.prop.BackgroundColor)
+	}
+
+	if c.chartType == Sparkline {
+		// the lines are not clipped on the border of the image
+		stroke := toPixels(strokeMM)
+		style.Padding = gochart.Box{Top: stroke, Left: stroke, Right: stroke, Bottom: stroke, IsSet: true}
+		return style
+	}
+
+	if !c.prop.HideAxes {
+		// go-chart doesn't take the resolution into account when it reserves the space of the labels of the axis
+		style.Padding.Bottom += pointsToPixels(gochart.DefaultAxisFontSize)
+	}
+
+	if c.prop.Title != "" {
+		style.Padding.Top += padding + pointsToPixels(titleFontSize)
+	}
+
+	if c.prop.LegendPosition == legendposition.Top && c.chartType != Bar {
+		// go-chart centers the legend in the space above the chart, so it's the same below the title and the chart
+		style.Padding.Top = 2*style.Padding.Top + pointsToPixels(legendFontSize) + padding
+	}
+
+	return style
+}
+
+func (c *chart) getCanvasStyle() gochart.Style {
+	if c.prop.BackgroundColor == nil {
+		return gochart.Style{}
+	}
+
+	return gochart.Style{FillColor: toColor(c.prop.BackgroundColor)}
+}
+
+func (c *chart) getTitleStyle() gochart.Style {
+	return gochart.Style{
+		FontSize: titleFontSize,
+		Padding:  gochart.Box{Top: toPixels(paddingMM), IsSet: true},
+	}
+}
+
+// getSeriesStyle returns the style of the series i, go-chart applies its palette to the series without color.
+func (c *chart) getSeriesStyle(i int, fill bool) gochart.Style {
+	style := gochart.Style{StrokeWidth: float64(toPixels(strokeMM))}
+
+	color := c.series[i].Color
+	if color == nil {
+		return style
+	}
+
+	style.StrokeColor = toColor(color)
+	if fill {
+		style.FillColor = toColor(color)
+	}
+
+	return style
+}
+
+func (c *chart) hasValues() bool {
+	for _, s := range c.series {
+		if len(s.Values) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *chart) getSeriesValue() []map[string]interface{} {
+	value := make([]map[string]interface{}, 0, len(c.series))
+	for _, s := range c.series {
+		m := map[string]interface{}{
+			"label":  s.Label,
+			"values": s.Values,
+		}
+
+		if len(s.XValues) > 0 {
+			m["x_values"] = s.XValues
+		}
+
+		if s.Color != nil {
+			m["color"] = s.Color.ToString()
+		}
+
+		value = append(value, m)
+	}
+
+	return value
+}
+
+func getXValues(s Series) []float64 {
+	if len(s.XValues) == len(s.Values) {
+		return s.XValues
+	}
+
+	xValues := make([]float64, len(s.Values))
+	for i := range xValues {
+		xValues[i] = float64(i)
+	}
+
+	return xValues
+}
+
+func toColor(color *props.Color) drawing.Color {
+	return drawing.Color{R: uint8(color.Red), G: uint8(color.Green), B: uint8(color.Blue), A: 255}
+}
+
+func toPixels(mm float64) int {
+	return int(math.Round(mm / mmPerInch * dpi))
+}
+
+func pointsToPixels(points float64) int {
+	return int(math.Round(points / 72.0 * dpi))
+}
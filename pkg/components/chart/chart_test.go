@@ -0,0 +1,105 @@
+package chart_test
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/legendposition"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var series = []chart.Series{
+	{Label: "revenue", Values: []float64{3, 5, 4, 8}},
+	{Label: "cost", XValues: []float64{1, 2, 3, 4}, Values: []float64{2, 3, 3, 4}, Color: &props.RedColor},
+}
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.New(chart.Line, series)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_chart_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.New(chart.Bar, series, fixture.ChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_chart_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := chart.NewCol(12, chart.Sparkline, series)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_chart_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := chart.NewRow(10, chart.Sparkline, series)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_chart_row.json")
+}
+
+func TestChart_Render(t *testing.T) {
+	t.Run("when there are no values, should not call provider", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.New(chart.Line, []chart.Series{{Label: "empty"}})
+
+		provider := mocks.NewProvider(t)
+
+		// Act
+		sut.Render(provider, &cell)
+	})
+	t.Run("when chart cannot be rendered, should write the error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.New(chart.Line, []chart.Series{{Values: []float64{1}}})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("could not render chart", &cell, merror.DefaultErrorText)
+
+		// Act
+		sut.Render(provider, &cell)
+	})
+	for _, chartType := range []chart.Type{chart.Line, chart.Sparkline, chart.Bar} {
+		t.Run("when chart is "+string(chartType)+", should add a png of the size of the cell", func(t *testing.T) {
+			// Arrange
+			cell := entity.Cell{X: 10, Y: 20, Width: 50.8, Height: 25.4}
+			sut := chart.New(chartType, series, props.Chart{Title: "title", LegendPosition: legendposition.Top})
+
+			var image []byte
+			provider := mocks.NewProvider(t)
+			provider.EXPECT().AddImageFromBytes(mock.Anything, &cell, &props.Rect{Percent: 100}, extension.Png).
+				Run(func(bytes []byte, _ *entity.Cell, _ *props.Rect, _ extension.Type) {
+					image = bytes
+				})
+
+			// Act
+			sut.Render(provider, &cell)
+
+			// Assert
+			config, err := png.DecodeConfig(bytes.NewReader(image))
+			assert.Nil(t, err)
+			assert.Equal(t, 300, config.Width)
+			assert.Equal(t, 150, config.Height)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Package pie implements creation of pie and donut charts.
+package pie
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// defaultColors are applied, in order, to the slices without color.
+var defaultColors = []props.Color{
+	{Red: 66, Green: 133, Blue: 244},
+	{Red: 219, Green: 68, Blue: 55},
+	{Red: 244, Green: 180, Blue: 0},
+	{Red: 15, Green: 157, Blue: 88},
+	{Red: 171, Green: 71, Blue: 188},
+	{Red: 0, Green: 172, Blue: 193},
+	{Red: 255, Green: 112, Blue: 67},
+	{Red: 158, Green: 157, Blue: 36},
+}
+
+// Slice is a part of a pie chart, its angle is proportional to its Value.
+type Slice struct {
+	Label string
+	Value float64
+	Color *props.Color
+}
+
+type pie struct {
+	slices []Slice
+	prop   props.PieChart
+	config *entity.Config
+}
+
+// New is responsible to create an instance of a PieChart. The slices are drawn clockwise starting
+// at the top of the circle and the slices without positive values are ignored. When any slice has a
+// label, a legend with the labels and percentages is written on the right of the chart.
+func New(slices []Slice, ps ...props.PieChart) core.Component {
+	prop := props.PieChart{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid(fontfamily.Arial)
+
+	return &pie{
+		slices: slices,
+		prop:   prop,
+	}
+}
+
+// NewCol is responsible to create an instance of a PieChart wrapped in a Col.
+func NewCol(size int, slices []Slice, ps ...props.PieChart) core.Col {
+	pie := New(slices, ps...)
+	return col.New(size).Add(pie)
+}
+
+// NewRow is responsible to create an instance of a PieChart wrapped in a Row.
+func NewRow(height float64, slices []Slice, ps ...props.PieChart) core.Row {
+	pie := New(slices, ps...)
+	c := col.New().Add(pie)
+	return row.New(height).Add(c)
+}
+
+// Render renders a PieChart into a PDF context.
+func (p *pie) Render(provider core.Provider, cell *entity.Cell) {
+	total := p.getTotal()
+	if total <= 0 {
+		return
+	}
+
+	chartWidth := cell.Width
+	if p.hasLabels() {
+		chartWidth -= cell.Width * p.prop.LegendPercent / 100.0
+	}
+
+	radius := math.Min(chartWidth, cell.Height) / 2.0
+	innerRadius := math.Min(p.prop.DonutRadius, radius)
+	center := entity.Point{
+		X: cell.X + chartWidth/2.0,
+		Y: cell.Y + cell.Height/2.0,
+	}
+
+	angle := 0.0
+	for i, slice := range p.slices {
+		if !isDrawn(slice.Value) {
+			continue
+		}
+
+		sweep := slice.Value / total * 360.0
+		provider.DrawArc(center, radius, innerRadius, angle, angle+sweep, &props.Line{
+			Color:     p.prop.BorderColor,
+			Style:     linestyle.Solid,
+			Thickness: linestyle.DefaultLineThickness,
+			FillColor: p.getColor(i),
+		})
+		angle += sweep
+	}
+
+	if p.hasLabels() {
+		p.renderLegend(provider, cell, chartWidth, total)
+	}
+}
+
+// GetStructure returns the Structure of a PieChart.
+func (p *pie) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "pie",
+		Value:   p.getSlicesValue(),
+		Details: p.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (p *pie) SetConfig(config *entity.Config) {
+	p.config = config
+}
+
+func (p *pie) renderLegend(provider core.Provider, cell *entity.Cell, chartWidth, total float64) {
+	fontHeight := provider.GetTextHeight(&p.prop.LegendFont)
+	rowHeight := fontHeight * 1.5
+
+	count := 0
+	for _, slice := range p.slices {
+		if isDrawn(slice.Value) {
+			count++
+		}
+	}
+
+	x := cell.X + chartWidth + fontHeight/2.0
+	y := cell.Y + math.Max(0, (cell.Height-float64(count)*rowHeight)/2.0)
+	top := (rowHeight - fontHeight) / 2.0
+
+	for i, slice := range p.slices {
+		if !isDrawn(slice.Value) {
+			continue
+		}
+
+		provider.DrawRect(&entity.Cell{
+			X:      x,
+			Y:      y + top,
+			Width:  fontHeight,
+			Height: fontHeight,
+		}, &props.Cell{BackgroundColor: p.getColor(i)})
+
+		labelX := x + fontHeight*1.5
+		labelCell := &entity.Cell{
+			X:      labelX,
+			Y:      y,
+			Width:  cell.X + cell.Width - labelX,
+			Height: rowHeight,
+		}
+
+		label := fmt.Sprintf("%s %.1f%%", slice.Label, slice.Value/total*100.0)
+		provider.AddText(label, labelCell, p.prop.LegendFont.ToTextProp(align.Left, top, 0))
+
+		y += rowHeight
+	}
+}
+
+func (p *pie) getTotal() float64 {
+	total := 0.0
+	for _, slice := range p.slices {
+		if isDrawn(slice.Value) {
+			total += slice.Value
+		}
+	}
+
+	return total
+}
+
+// isDrawn returns if a slice of the value is drawn, the values which are not positive, NaN or infinite are skipped.
+func isDrawn(value float64) bool {
+	return value > 0 && !math.IsInf(value, 1)
+}
+
+func (p *pie) hasLabels() bool {
+	for _, slice := range p.slices {
+		if slice.Label != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *pie) getColor(index int) *props.Color {
+	if p.slices[index].Color != nil {
+		return p.slices[index].Color
+	}
+
+	return &defaultColors[index%len(defaultColors)]
+}
+
+func (p *pie) getSlicesValue() []map[string]interface{} {
+	value := make([]map[string]interface{}, 0, len(p.slices))
+	for i, slice := range p.slices {
+		value = append(value, map[string]interface{}{
+			"label": slice.Label,
+			"value": slice.Value,
+			"color": p.getColor(i).ToString(),
+		})
+	}
+
+	return value
+}
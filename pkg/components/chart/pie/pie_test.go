@@ -0,0 +1,155 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart/pie"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var slices = []pie.Slice{
+	{Label: "a", Value: 1},
+	{Label: "b", Value: 3, Color: &props.RedColor},
+}
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := pie.New(slices)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := pie.New(slices, fixture.PieChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := pie.NewCol(12, slices)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := pie.NewRow(10, slices)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_row.json")
+}
+
+func TestPie_Render(t *testing.T) {
+	t.Run("when there are no positive values, should not call provider", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := pie.New([]pie.Slice{{Value: 0}, {Value: -1}})
+
+		provider := &mocks.Provider{}
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "DrawArc")
+	})
+	t.Run("when values are NaN or infinite, should skip them", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 20, Height: 20}
+		sut := pie.New([]pie.Slice{{Value: math.NaN(), Label: "a"}, {Value: math.Inf(1), Label: "b"}, {Value: 1, Label: "c"}})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawArc(mock.Anything, mock.Anything, 0.0, 0.0, 360.0, mock.Anything)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().AddText("c 100.0%", mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawArc", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when there are no labels, should draw the slices using the whole cell", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 40, Height: 30}
+		sut := pie.New([]pie.Slice{{Value: 1}, {Value: 0}, {Value: 3, Color: &props.RedColor}})
+		center := entity.Point{X: 30, Y: 35}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().DrawArc(center, 15.0, 0.0, 0.0, 90.0, &props.Line{
+			Color:     &props.WhiteColor,
+			Style:     linestyle.Solid,
+			Thickness: linestyle.DefaultLineThickness,
+			FillColor: &props.Color{Red: 66, Green: 133, Blue: 244},
+		})
+		provider.EXPECT().DrawArc(center, 15.0, 0.0, 90.0, 360.0, &props.Line{
+			Color:     &props.WhiteColor,
+			Style:     linestyle.Solid,
+			Thickness: linestyle.DefaultLineThickness,
+			FillColor: &props.RedColor,
+		})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawArc", 2)
+		provider.AssertNotCalled(t, "AddText")
+	})
+	t.Run("when donut radius is defined, should draw the slices with the inner radius", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 20, Height: 20}
+		sut := pie.New([]pie.Slice{{Value: 1}}, props.PieChart{DonutRadius: 4})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().DrawArc(entity.Point{X: 10, Y: 10}, 10.0, 4.0, 0.0, 360.0, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawArc", 1)
+	})
+	t.Run("when there are labels, should reserve the legend width and write the percentages", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 50}
+		sut := pie.New(slices, props.PieChart{LegendPercent: 50})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().DrawArc(entity.Point{X: 25, Y: 25}, 25.0, 0.0, mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().DrawRect(&entity.Cell{X: 52, Y: 20, Width: 4, Height: 4},
+			&props.Cell{BackgroundColor: &props.Color{Red: 66, Green: 133, Blue: 244}})
+		provider.EXPECT().DrawRect(&entity.Cell{X: 52, Y: 26, Width: 4, Height: 4},
+			&props.Cell{BackgroundColor: &props.RedColor})
+		provider.EXPECT().AddText("a 25.0%", &entity.Cell{X: 58, Y: 19, Width: 42, Height: 6}, mock.Anything)
+		provider.EXPECT().AddText("b 75.0%", &entity.Cell{X: 58, Y: 25, Width: 42, Height: 6}, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Align == align.Left && p.Top == 1
+		}))
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawArc", 2)
+		provider.AssertNumberOfCalls(t, "DrawRect", 2)
+		provider.AssertNumberOfCalls(t, "AddText", 2)
+	})
+}
@@ -0,0 +1,78 @@
+// nolint:dupl
+package code
+
+import (
+	"errors"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ErrEmptyAztec is returned when the code of an Aztec code is empty.
+var ErrEmptyAztec = errors.New("aztec code cannot be empty")
+
+type aztecCode struct {
+	code   string
+	prop   props.Rect
+	config *entity.Config
+}
+
+// NewAztec is responsible to create an instance of an Aztec code, by default it is centered
+// and occupies the whole cell. When the code is empty, the error is rendered instead of the Aztec code.
+func NewAztec(code string, barcodeProps ...props.Rect) core.Component {
+	if code == "" {
+		return text.New(ErrEmptyAztec.Error(), *merror.DefaultErrorText)
+	}
+
+	prop := props.Rect{Center: true, Percent: 100}
+	if len(barcodeProps) > 0 {
+		prop = barcodeProps[0]
+	}
+	prop.MakeValid()
+
+	return &aztecCode{
+		code: code,
+		prop: prop,
+	}
+}
+
+// NewAztecCol is responsible to create an instance of an Aztec code wrapped in a Col.
+func NewAztecCol(size int, code string, ps ...props.Rect) core.Col {
+	aztecCode := NewAztec(code, ps...)
+	return col.New(size).Add(aztecCode)
+}
+
+// NewAztecRow is responsible to create an instance of an Aztec code wrapped in a Row.
+func NewAztecRow(height float64, code string, ps ...props.Rect) core.Row {
+	aztecCode := NewAztec(code, ps...)
+	c := col.New().Add(aztecCode)
+	return row.New(height).Add(c)
+}
+
+// Render renders an Aztec code into a PDF context.
+func (m *aztecCode) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddAztecCode(m.code, cell, &m.prop)
+}
+
+// GetStructure returns the Structure of an Aztec code.
+func (m *aztecCode) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "aztec",
+		Value:   m.code,
+		Details: m.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the configuration of an Aztec code.
+func (m *aztecCode) SetConfig(config *entity.Config) {
+	m.config = config
+}
@@ -0,0 +1,98 @@
+// nolint: dupl
+package code_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewAztec(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAztec("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAztec("code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_custom_prop.json")
+	})
+	t.Run("when code is empty, should render the error", func(t *testing.T) {
+		// Act
+		sut := code.NewAztec("")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_empty.json")
+	})
+}
+
+func TestNewAztecCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAztecCol(12, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAztecCol(12, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_col_custom_prop.json")
+	})
+}
+
+func TestNewAztecRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAztecRow(10, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAztecRow(10, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_row_custom_prop.json")
+	})
+}
+
+func TestAztecCode_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		codeValue := "code"
+		cell := fixture.CellEntity()
+		prop := fixture.RectProp()
+		sut := code.NewAztec(codeValue, prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddAztecCode(codeValue, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddAztecCode", 1)
+	})
+}
+
+func TestAztecCode_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := code.NewAztec("code")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
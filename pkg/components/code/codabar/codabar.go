@@ -0,0 +1,78 @@
+// Package codabar implements creation of Codabar barcodes used by libraries and blood banks.
+package codabar
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	startStopCharacters = "ABCD"
+	dataCharacters      = "0123456789-$:/.+"
+	minSize             = 3
+)
+
+var (
+	// ErrInvalidStartStop is returned when the code does not start and end with A, B, C or D.
+	ErrInvalidStartStop = errors.New("codabar code must start and end with A, B, C or D")
+	// ErrInvalidCharacter is returned when the data between start and stop has characters out of the Codabar set.
+	ErrInvalidCharacter = errors.New("codabar code must contain only 0-9, -, $, :, /, . and +")
+)
+
+// NewCodabar is responsible to create a Codabar Barcode, ex: "A40156B".
+// When the code is invalid, the error is rendered instead of the barcode.
+func NewCodabar(value string, ps ...props.Barcode) core.Component {
+	if err := Validate(value); err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	prop := props.Barcode{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.Type = barcode.Codabar
+
+	return code.NewBar(strings.ToUpper(value), prop)
+}
+
+// NewCodabarCol is responsible to create a Codabar Barcode wrapped in a Col.
+func NewCodabarCol(size int, value string, ps ...props.Barcode) core.Col {
+	bar := NewCodabar(value, ps...)
+	return col.New(size).Add(bar)
+}
+
+// NewCodabarRow is responsible to create a Codabar Barcode wrapped in a Row.
+func NewCodabarRow(height float64, value string, ps ...props.Barcode) core.Row {
+	bar := NewCodabar(value, ps...)
+	c := col.New().Add(bar)
+	return row.New(height).Add(c)
+}
+
+// Validate checks that the code starts and ends with a start/stop character
+// and that the data between them has only Codabar characters.
+func Validate(value string) error {
+	value = strings.ToUpper(value)
+
+	if len(value) < minSize ||
+		!strings.ContainsRune(startStopCharacters, rune(value[0])) ||
+		!strings.ContainsRune(startStopCharacters, rune(value[len(value)-1])) {
+		return ErrInvalidStartStop
+	}
+
+	for _, c := range value[1 : len(value)-1] {
+		if !strings.ContainsRune(dataCharacters, c) {
+			return ErrInvalidCharacter
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package codabar_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/codabar"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewCodabar(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := codabar.NewCodabar("A40156B")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_codabar_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := codabar.NewCodabar("a40156b", fixture.BarcodeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_codabar_custom_prop.json")
+	})
+	t.Run("when code is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := codabar.NewCodabar("40156")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_codabar_invalid.json")
+	})
+}
+
+func TestNewCodabarCol(t *testing.T) {
+	// Act
+	sut := codabar.NewCodabarCol(12, "A40156B")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_codabar_col.json")
+}
+
+func TestNewCodabarRow(t *testing.T) {
+	// Act
+	sut := codabar.NewCodabarRow(10, "A40156B")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_codabar_row.json")
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("when code is valid, should return nil", func(t *testing.T) {
+		// Act
+		err := codabar.Validate("C12-34$5:6/7.8+9D")
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when code is too short, should return error", func(t *testing.T) {
+		// Act
+		err := codabar.Validate("AB")
+
+		// Assert
+		assert.Equal(t, codabar.ErrInvalidStartStop, err)
+	})
+	t.Run("when code does not end with stop character, should return error", func(t *testing.T) {
+		// Act
+		err := codabar.Validate("A12345")
+
+		// Assert
+		assert.Equal(t, codabar.ErrInvalidStartStop, err)
+	})
+	t.Run("when code has invalid character, should return error", func(t *testing.T) {
+		// Act
+		err := codabar.Validate("A123X45B")
+
+		// Assert
+		assert.Equal(t, codabar.ErrInvalidCharacter, err)
+	})
+}
@@ -0,0 +1,113 @@
+// Package hibcc implements creation of HIBCC LIC barcodes used in healthcare product labeling.
+package hibcc
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	// charset is the HIBCC character set, the index of each character is its value on the check character calculation.
+	charset    = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ-. $/+%"
+	flag       = "+"
+	licSize    = 4
+	maxPCNSize = 18
+)
+
+var (
+	// ErrInvalidLIC is returned when the Labeler Identification Code is not 4 alphanumeric characters starting with a letter.
+	ErrInvalidLIC = errors.New("invalid HIBCC labeler identification code")
+	// ErrInvalidPCN is returned when the Product or Catalog Number is not 1 to 18 alphanumeric characters.
+	ErrInvalidPCN = errors.New("invalid HIBCC product or catalog number")
+	// ErrInvalidUnitOfMeasure is returned when the Unit of Measure is not a digit.
+	ErrInvalidUnitOfMeasure = errors.New("invalid HIBCC unit of measure")
+)
+
+// NewHIBCC is responsible to create a Barcode with the HIBCC LIC Primary Data Structure.
+// The data is the LIC (4 characters), followed by the PCN (1 to 18 characters) and the
+// Unit of Measure (1 digit), ex: "A999ABC1230". When the data is invalid, the error is
+// rendered instead of the barcode.
+func NewHIBCC(data string, ps ...props.Barcode) core.Component {
+	value, err := Encode(data)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return code.NewBar(value, ps...)
+}
+
+// NewHIBCCCol is responsible to create a HIBCC Barcode wrapped in a Col.
+func NewHIBCCCol(size int, data string, ps ...props.Barcode) core.Col {
+	bar := NewHIBCC(data, ps...)
+	return col.New(size).Add(bar)
+}
+
+// NewHIBCCRow is responsible to create a HIBCC Barcode wrapped in a Row.
+func NewHIBCCRow(height float64, data string, ps ...props.Barcode) core.Row {
+	bar := NewHIBCC(data, ps...)
+	c := col.New().Add(bar)
+	return row.New(height).Add(c)
+}
+
+// Encode validates the mandatory fields of the Primary Data Structure and returns it with
+// the "+" flag character and the modulo 43 check character, ex: "A999ABC1230" => "+A999ABC1230V".
+func Encode(data string) (string, error) {
+	data = strings.ToUpper(data)
+
+	if len(data) < licSize || !isLetter(data[0]) || !isAlphanumeric(data[:licSize]) {
+		return "", ErrInvalidLIC
+	}
+
+	// the LIC is followed by at least one character of the PCN and the unit of measure
+	if len(data) < licSize+2 {
+		return "", ErrInvalidPCN
+	}
+
+	unitOfMeasure := data[len(data)-1]
+	if !isDigit(unitOfMeasure) {
+		return "", ErrInvalidUnitOfMeasure
+	}
+
+	pcn := data[licSize : len(data)-1]
+	if pcn == "" || len(pcn) > maxPCNSize || !isAlphanumeric(pcn) {
+		return "", ErrInvalidPCN
+	}
+
+	value := flag + data
+	return value + string(checkCharacter(value)), nil
+}
+
+func checkCharacter(value string) byte {
+	sum := 0
+	for i := 0; i < len(value); i++ {
+		sum += strings.IndexByte(charset, value[i])
+	}
+
+	return charset[sum%len(charset)]
+}
+
+func isAlphanumeric(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if !isLetter(value[i]) && !isDigit(value[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
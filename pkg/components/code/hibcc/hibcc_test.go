@@ -0,0 +1,140 @@
+package hibcc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/hibcc"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewHIBCC(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := hibcc.NewHIBCC("A999ABC1230")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_hibcc_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := hibcc.NewHIBCC("A999ABC1230", fixture.BarcodeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_hibcc_custom_prop.json")
+	})
+	t.Run("when data is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := hibcc.NewHIBCC("1999ABC1230")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_hibcc_invalid.json")
+	})
+}
+
+func TestNewHIBCCCol(t *testing.T) {
+	// Act
+	sut := hibcc.NewHIBCCCol(12, "A999ABC1230")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_hibcc_col.json")
+}
+
+func TestNewHIBCCRow(t *testing.T) {
+	// Act
+	sut := hibcc.NewHIBCCRow(10, "A999ABC1230")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_hibcc_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when data is valid, should add flag and check character", func(t *testing.T) {
+		// Act
+		value, err := hibcc.Encode("A999ABC1230")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "+A999ABC1230V", value)
+	})
+	t.Run("when data is lowercase, should encode uppercase", func(t *testing.T) {
+		// Act
+		value, err := hibcc.Encode("a999abc1230")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "+A999ABC1230V", value)
+	})
+	t.Run("when check sum is 38, should use space as check character", func(t *testing.T) {
+		// Act
+		value, err := hibcc.Encode("A123PCN1231")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "+A123PCN1231 ", value)
+	})
+	t.Run("when lic does not start with a letter, should return error", func(t *testing.T) {
+		// Act
+		_, err := hibcc.Encode("1999ABC1230")
+
+		// Assert
+		assert.Equal(t, hibcc.ErrInvalidLIC, err)
+	})
+	t.Run("when lic is too short, should return error", func(t *testing.T) {
+		// Act
+		_, err := hibcc.Encode("A99")
+
+		// Assert
+		assert.Equal(t, hibcc.ErrInvalidLIC, err)
+	})
+	t.Run("when unit of measure is not a digit, should return error", func(t *testing.T) {
+		// Act
+		_, err := hibcc.Encode("A999ABC123X")
+
+		// Assert
+		assert.Equal(t, hibcc.ErrInvalidUnitOfMeasure, err)
+	})
+	t.Run("when data is shorter than lic, pcn and unit of measure, should return error", func(t *testing.T) {
+		cases := []struct {
+			data string
+			err  error
+		}{
+			{data: "", err: hibcc.ErrInvalidLIC},
+			{data: "A", err: hibcc.ErrInvalidLIC},
+			{data: "A99", err: hibcc.ErrInvalidLIC},
+			{data: "A999", err: hibcc.ErrInvalidPCN},
+			{data: "A9990", err: hibcc.ErrInvalidPCN},
+		}
+
+		for _, c := range cases {
+			// Act
+			_, err := hibcc.Encode(c.data)
+
+			// Assert
+			assert.Equal(t, c.err, err, c.data)
+		}
+	})
+	t.Run("when pcn is empty, should return error", func(t *testing.T) {
+		// Act
+		_, err := hibcc.Encode("A9990")
+
+		// Assert
+		assert.Equal(t, hibcc.ErrInvalidPCN, err)
+	})
+	t.Run("when pcn is too long, should return error", func(t *testing.T) {
+		// Act
+		_, err := hibcc.Encode("A99912345678901234567890")
+
+		// Assert
+		assert.Equal(t, hibcc.ErrInvalidPCN, err)
+	})
+	t.Run("when pcn has invalid characters, should return error", func(t *testing.T) {
+		// Act
+		_, err := hibcc.Encode("A999AB-C1230")
+
+		// Assert
+		assert.Equal(t, hibcc.ErrInvalidPCN, err)
+	})
+}
@@ -0,0 +1,67 @@
+// nolint:dupl
+package code
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type microQrCode struct {
+	code   string
+	prop   props.Rect
+	config *entity.Config
+}
+
+// NewMicroQr is responsible to create an instance of a Micro QrCode. The code is written in the byte mode
+// with the M error correction level, so it supports up to 13 bytes.
+func NewMicroQr(code string, barcodeProps ...props.Rect) core.Component {
+	prop := props.Rect{}
+	if len(barcodeProps) > 0 {
+		prop = barcodeProps[0]
+	}
+	prop.MakeValid()
+
+	return &microQrCode{
+		code: code,
+		prop: prop,
+	}
+}
+
+// NewMicroQrCol is responsible to create an instance of a Micro QrCode wrapped in a Col.
+func NewMicroQrCol(size int, code string, ps ...props.Rect) core.Col {
+	microQrCode := NewMicroQr(code, ps...)
+	return col.New(size).Add(microQrCode)
+}
+
+// NewMicroQrRow is responsible to create an instance of a Micro QrCode wrapped in a Row.
+func NewMicroQrRow(height float64, code string, ps ...props.Rect) core.Row {
+	microQrCode := NewMicroQr(code, ps...)
+	c := col.New().Add(microQrCode)
+	return row.New(height).Add(c)
+}
+
+// Render renders a Micro QrCode into a PDF context.
+func (m *microQrCode) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddMicroQrCode(m.code, cell, &m.prop)
+}
+
+// GetStructure returns the Structure of a Micro QrCode.
+func (m *microQrCode) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "microqrcode",
+		Value:   m.code,
+		Details: m.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig set the config for the component.
+func (m *microQrCode) SetConfig(config *entity.Config) {
+	m.config = config
+}
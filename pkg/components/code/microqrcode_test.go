@@ -0,0 +1,91 @@
+// nolint: dupl
+package code_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewMicroQr(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewMicroQr("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_micro_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewMicroQr("code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_micro_qr_custom_prop.json")
+	})
+}
+
+func TestNewMicroQrCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewMicroQrCol(12, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_micro_qr_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewMicroQrCol(12, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_micro_qr_col_custom_prop.json")
+	})
+}
+
+func TestNewMicroQrRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewMicroQrRow(10, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_micro_qr_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewMicroQrRow(10, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_micro_qr_row_custom_prop.json")
+	})
+}
+
+func TestMicroQrCode_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		codeValue := "code"
+		cell := fixture.CellEntity()
+		prop := fixture.RectProp()
+		sut := code.NewMicroQr(codeValue, prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddMicroQrCode(codeValue, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddMicroQrCode", 1)
+	})
+}
+
+func TestMicroQrCode_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := code.NewMicroQr("code")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
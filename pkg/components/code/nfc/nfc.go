@@ -0,0 +1,137 @@
+// Package nfc implements creation of QR codes with NFC smart poster records, used to label IoT devices.
+package nfc
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	// microQrCapacity is the amount of bytes supported by a M4 Micro QR code with the M error correction level.
+	microQrCapacity = 13
+	// shortRecordLimit is the biggest payload of a short record, which has a 1 byte payload length.
+	shortRecordLimit = 255
+
+	flagMessageBegin = 0x80
+	flagMessageEnd   = 0x40
+	flagShortRecord  = 0x10
+	tnfWellKnown     = 0x01
+)
+
+// Action is the recommended action of a smart poster, done by the device after reading the URI.
+type Action byte
+
+const (
+	// Default recommends that the device does the action of the URI, ex: opens a browser. As it is
+	// what the devices do without a recommendation, the action record is not written.
+	Default Action = 0x00
+	// SaveForLater recommends that the device saves the URI, ex: as a bookmark.
+	SaveForLater Action = 0x01
+	// OpenForEdit recommends that the device opens the URI to be edited.
+	OpenForEdit Action = 0x02
+)
+
+var (
+	// ErrEmptyURI is returned when the URI is empty.
+	ErrEmptyURI = errors.New("empty uri")
+	// ErrInvalidAction is returned when the action is not one of the defined actions.
+	ErrInvalidAction = errors.New("invalid nfc action")
+)
+
+// uriPrefixes are the abbreviations of the URI record type definition, the index is the identifier code.
+var uriPrefixes = []string{
+	"", "http://www.", "https://www.", "http://", "https://", "tel:", "mailto:", "ftp://anonymous:anonymous@",
+	"ftp://ftp.", "ftps://", "sftp://", "smb://", "nfs://", "ftp://", "dav://", "news:", "telnet://", "imap:",
+	"rtsp://", "urn:", "pop:", "sip:", "sips:", "tftp:", "btspp://", "btl2cap://", "btgoep://", "tcpobex://",
+	"irdaobex://", "file://", "urn:epc:id:", "urn:epc:tag:", "urn:epc:pat:", "urn:epc:raw:", "urn:epc:", "urn:nfc:",
+}
+
+// NewQR is responsible to create a code with a NFC smart poster record of the URI and the action. When the
+// record fits in a Micro QR code it is used, otherwise a QR code is created.
+// When the URI or the action are invalid, the error is rendered instead of the code.
+func NewQR(uri string, action Action, ps ...props.Rect) core.Component {
+	value, err := Encode(uri, action)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	if len(value) <= microQrCapacity {
+		return code.NewMicroQr(string(value), ps...)
+	}
+
+	return code.NewQr(string(value), ps...)
+}
+
+// NewQRCol is responsible to create a NFC code wrapped in a Col.
+func NewQRCol(size int, uri string, action Action, ps ...props.Rect) core.Col {
+	qr := NewQR(uri, action, ps...)
+	return col.New(size).Add(qr)
+}
+
+// NewQRRow is responsible to create a NFC code wrapped in a Row.
+func NewQRRow(height float64, uri string, action Action, ps ...props.Rect) core.Row {
+	qr := NewQR(uri, action, ps...)
+	c := col.New().Add(qr)
+	return row.New(height).Add(c)
+}
+
+// Encode returns the NDEF message of a smart poster with a URI record, the known URI prefix is abbreviated,
+// and an action record. The action record is not written with the Default action.
+func Encode(uri string, action Action) ([]byte, error) {
+	if uri == "" {
+		return nil, ErrEmptyURI
+	}
+
+	if action != Default && action != SaveForLater && action != OpenForEdit {
+		return nil, ErrInvalidAction
+	}
+
+	identifier := 0
+	for i, prefix := range uriPrefixes {
+		if strings.HasPrefix(uri, prefix) && len(prefix) > len(uriPrefixes[identifier]) {
+			identifier = i
+		}
+	}
+
+	uriPayload := append([]byte{byte(identifier)}, uri[len(uriPrefixes[identifier]):]...)
+	records := newRecord("U", uriPayload, true, action == Default)
+
+	if action != Default {
+		records = append(records, newRecord("act", []byte{byte(action)}, false, true)...)
+	}
+
+	return newRecord("Sp", records, true, true), nil
+}
+
+// newRecord returns a well known record, it is a short record when the payload fits in 255 bytes.
+func newRecord(recordType string, payload []byte, first, last bool) []byte {
+	header := byte(tnfWellKnown)
+	if first {
+		header |= flagMessageBegin
+	}
+
+	if last {
+		header |= flagMessageEnd
+	}
+
+	record := []byte{header, byte(len(recordType))}
+	if len(payload) <= shortRecordLimit {
+		record[0] |= flagShortRecord
+		record = append(record, byte(len(payload)))
+	} else {
+		size := len(payload)
+		record = append(record, byte(size>>24), byte(size>>16), byte(size>>8), byte(size))
+	}
+
+	record = append(record, recordType...)
+
+	return append(record, payload...)
+}
@@ -0,0 +1,114 @@
+package nfc_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/nfc"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewQR(t *testing.T) {
+	t.Run("when record fits in a micro qr code, should create a micro qr code", func(t *testing.T) {
+		// Act
+		sut := nfc.NewQR("https://a.b", nfc.Default)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_nfc_qr_micro.json")
+	})
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := nfc.NewQR("https://maroto.io/device/42", nfc.SaveForLater)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_nfc_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := nfc.NewQR("https://maroto.io/device/42", nfc.SaveForLater, fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_nfc_qr_custom_prop.json")
+	})
+	t.Run("when action is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := nfc.NewQR("https://maroto.io", nfc.Action(9))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_nfc_qr_invalid.json")
+	})
+}
+
+func TestNewQRCol(t *testing.T) {
+	// Act
+	sut := nfc.NewQRCol(12, "https://maroto.io/device/42", nfc.OpenForEdit)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_nfc_qr_col.json")
+}
+
+func TestNewQRRow(t *testing.T) {
+	// Act
+	sut := nfc.NewQRRow(10, "https://maroto.io/device/42", nfc.OpenForEdit)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_nfc_qr_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when uri is empty, should return error", func(t *testing.T) {
+		// Act
+		value, err := nfc.Encode("", nfc.Default)
+
+		// Assert
+		assert.ErrorIs(t, err, nfc.ErrEmptyURI)
+		assert.Nil(t, value)
+	})
+	t.Run("when action is invalid, should return error", func(t *testing.T) {
+		// Act
+		value, err := nfc.Encode("https://maroto.io", nfc.Action(3))
+
+		// Assert
+		assert.ErrorIs(t, err, nfc.ErrInvalidAction)
+		assert.Nil(t, value)
+	})
+	t.Run("when action is default, should write only the uri record with the longest prefix", func(t *testing.T) {
+		// Act
+		value, err := nfc.Encode("https://www.maroto.io", nfc.Default)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, append([]byte{0xD1, 0x02, 0x0E, 'S', 'p', 0xD1, 0x01, 0x0A, 'U', 0x02}, "maroto.io"...), value)
+	})
+	t.Run("when action is not default, should write the action record after the uri record", func(t *testing.T) {
+		// Act
+		value, err := nfc.Encode("tel:123", nfc.OpenForEdit)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{
+			0xD1, 0x02, 0x0F, 'S', 'p',
+			0x91, 0x01, 0x04, 'U', 0x05, '1', '2', '3',
+			0x51, 0x03, 0x01, 'a', 'c', 't', 0x02,
+		}, value)
+	})
+	t.Run("when uri has an unknown prefix, should write the uri without abbreviation", func(t *testing.T) {
+		// Act
+		value, err := nfc.Encode("geo:1,2", nfc.Default)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, append([]byte{0x00}, "geo:1,2"...), value[9:])
+	})
+	t.Run("when payload is longer than 255 bytes, should write long records", func(t *testing.T) {
+		// Act
+		value, err := nfc.Encode("https://"+strings.Repeat("a", 300), nfc.Default)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{0xC1, 0x02, 0x00, 0x00, 0x01, 0x34, 'S', 'p', 0xC1, 0x01, 0x00, 0x00, 0x01, 0x2D, 'U', 0x04}, value[:16])
+	})
+}
@@ -0,0 +1,78 @@
+// nolint:dupl
+package code
+
+import (
+	"errors"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ErrEmptyPDF417 is returned when the code of a PDF417 is empty.
+var ErrEmptyPDF417 = errors.New("pdf417 code cannot be empty")
+
+type pdf417Code struct {
+	code   string
+	prop   props.PDF417
+	config *entity.Config
+}
+
+// NewPDF417 is responsible to create an instance of a PDF417. When the code is empty,
+// the error is rendered instead of the PDF417.
+func NewPDF417(code string, ps ...props.PDF417) core.Component {
+	if code == "" {
+		return text.New(ErrEmptyPDF417.Error(), *merror.DefaultErrorText)
+	}
+
+	prop := props.PDF417{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &pdf417Code{
+		code: code,
+		prop: prop,
+	}
+}
+
+// NewPDF417Col is responsible to create an instance of a PDF417 wrapped in a Col.
+func NewPDF417Col(size int, code string, ps ...props.PDF417) core.Col {
+	pdf417Code := NewPDF417(code, ps...)
+	return col.New(size).Add(pdf417Code)
+}
+
+// NewPDF417Row is responsible to create an instance of a PDF417 wrapped in a Row.
+func NewPDF417Row(height float64, code string, ps ...props.PDF417) core.Row {
+	pdf417Code := NewPDF417(code, ps...)
+	c := col.New().Add(pdf417Code)
+	return row.New(height).Add(c)
+}
+
+// Render renders a PDF417 into a PDF context.
+func (p *pdf417Code) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddPDF417Code(p.code, cell, &p.prop)
+}
+
+// GetStructure returns the Structure of a PDF417.
+func (p *pdf417Code) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "pdf417",
+		Value:   p.code,
+		Details: p.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the configuration of a PDF417.
+func (p *pdf417Code) SetConfig(config *entity.Config) {
+	p.config = config
+}
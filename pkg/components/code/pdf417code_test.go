@@ -0,0 +1,98 @@
+// nolint: dupl
+package code_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewPDF417(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417("code", fixture.PDF417Prop())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_custom_prop.json")
+	})
+	t.Run("when code is empty, should render the error", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417("")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_empty.json")
+	})
+}
+
+func TestNewPDF417Col(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Col(12, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Col(12, "code", fixture.PDF417Prop())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_col_custom_prop.json")
+	})
+}
+
+func TestNewPDF417Row(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Row(10, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Row(10, "code", fixture.PDF417Prop())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_row_custom_prop.json")
+	})
+}
+
+func TestPDF417Code_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		codeValue := "code"
+		cell := fixture.CellEntity()
+		prop := fixture.PDF417Prop()
+		sut := code.NewPDF417(codeValue, prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddPDF417Code(codeValue, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPDF417Code", 1)
+	})
+}
+
+func TestPDF417Code_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := code.NewPDF417("code")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
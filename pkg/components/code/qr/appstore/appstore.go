@@ -0,0 +1,104 @@
+// Package appstore implements creation of QR codes which open the page of an app in the App Store or Google Play.
+package appstore
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Platform is the store in which the app is published.
+type Platform string
+
+const (
+	// IOS opens the app in the App Store.
+	IOS Platform = "ios"
+	// Android opens the app in Google Play.
+	Android Platform = "android"
+)
+
+const (
+	appStoreURL   = "https://apps.apple.com/app/id"
+	googlePlayURL = "https://play.google.com/store/apps/details?id="
+)
+
+var (
+	// ErrInvalidPlatform is returned when the platform is not IOS or Android.
+	ErrInvalidPlatform = errors.New("platform must be ios or android")
+	// ErrInvalidAppID is returned when the app ID does not follow the format of the platform.
+	ErrInvalidAppID = errors.New("invalid app id")
+)
+
+var (
+	iosAppID     = regexp.MustCompile(`^[0-9]+$`)
+	androidAppID = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*(\.[a-zA-Z][a-zA-Z0-9_]*)+$`)
+)
+
+// NewQR is responsible to create a QR code that opens the page of the app in the store of the platform.
+// The app ID is the numeric ID in the App Store, with or without the "id" prefix, or the package name
+// in Google Play, ex: "com.example.app". When the app ID is invalid, the error is rendered instead of the QR code.
+func NewQR(appID string, platform Platform, ps ...props.Rect) core.Component {
+	qr, err := NewQRErr(appID, platform, ps...)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return qr
+}
+
+// NewQRErr is responsible to create a QR code that opens the page of the app, like NewQR,
+// but returns the error when the app ID is invalid instead of rendering it.
+func NewQRErr(appID string, platform Platform, ps ...props.Rect) (core.Component, error) {
+	value, err := Encode(appID, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	return code.NewQr(value, ps...), nil
+}
+
+// NewQRCol is responsible to create an app store QR code wrapped in a Col.
+func NewQRCol(size int, appID string, platform Platform, ps ...props.Rect) core.Col {
+	qr := NewQR(appID, platform, ps...)
+	return col.New(size).Add(qr)
+}
+
+// NewQRRow is responsible to create an app store QR code wrapped in a Row.
+func NewQRRow(height float64, appID string, platform Platform, ps ...props.Rect) core.Row {
+	qr := NewQR(appID, platform, ps...)
+	c := col.New().Add(qr)
+	return row.New(height).Add(c)
+}
+
+// Encode validates the app ID and returns the URL of the app in the store of the platform,
+// ex: "https://apps.apple.com/app/id284882215" or "https://play.google.com/store/apps/details?id=com.example.app".
+func Encode(appID string, platform Platform) (string, error) {
+	appID = strings.TrimSpace(appID)
+
+	switch platform {
+	case IOS:
+		appID = strings.TrimPrefix(appID, "id")
+		if !iosAppID.MatchString(appID) {
+			return "", fmt.Errorf("%w, ios app id must be numeric, got '%s'", ErrInvalidAppID, appID)
+		}
+
+		return appStoreURL + appID, nil
+	case Android:
+		if !androidAppID.MatchString(appID) {
+			return "", fmt.Errorf("%w, android app id must be a package name, got '%s'", ErrInvalidAppID, appID)
+		}
+
+		return googlePlayURL + appID, nil
+	default:
+		return "", fmt.Errorf("%w, got '%s'", ErrInvalidPlatform, platform)
+	}
+}
@@ -0,0 +1,112 @@
+package appstore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/qr/appstore"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewQR(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := appstore.NewQR("284882215", appstore.IOS)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_app_store_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := appstore.NewQR("com.example.app", appstore.Android, fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_app_store_qr_custom_prop.json")
+	})
+	t.Run("when app id is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := appstore.NewQR("com.example.app", appstore.IOS)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_app_store_qr_invalid.json")
+	})
+}
+
+func TestNewQRErr(t *testing.T) {
+	t.Run("when app id is valid, should return the qr code", func(t *testing.T) {
+		// Act
+		sut, err := appstore.NewQRErr("284882215", appstore.IOS)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "https://apps.apple.com/app/id284882215", sut.GetStructure().GetData().Value)
+	})
+	t.Run("when platform is invalid, should return error", func(t *testing.T) {
+		// Act
+		sut, err := appstore.NewQRErr("284882215", "windows")
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorIs(t, err, appstore.ErrInvalidPlatform)
+		assert.Equal(t, "platform must be ios or android, got 'windows'", err.Error())
+	})
+}
+
+func TestNewQRCol(t *testing.T) {
+	// Act
+	sut := appstore.NewQRCol(12, "284882215", appstore.IOS)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_app_store_qr_col.json")
+}
+
+func TestNewQRRow(t *testing.T) {
+	// Act
+	sut := appstore.NewQRRow(10, "com.example.app", appstore.Android)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_app_store_qr_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when ios app id has the id prefix, should remove it", func(t *testing.T) {
+		// Act
+		value, err := appstore.Encode("id284882215", appstore.IOS)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "https://apps.apple.com/app/id284882215", value)
+	})
+	t.Run("when android app id is a package name, should return the google play url", func(t *testing.T) {
+		// Act
+		value, err := appstore.Encode("com.example.my_app2", appstore.Android)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "https://play.google.com/store/apps/details?id=com.example.my_app2", value)
+	})
+	t.Run("when ios app id is not numeric, should return error", func(t *testing.T) {
+		// Act
+		_, err := appstore.Encode("12ab", appstore.IOS)
+
+		// Assert
+		assert.ErrorIs(t, err, appstore.ErrInvalidAppID)
+		assert.Equal(t, "invalid app id, ios app id must be numeric, got '12ab'", err.Error())
+	})
+	t.Run("when android app id has a single segment, should return error", func(t *testing.T) {
+		// Act
+		_, err := appstore.Encode("example", appstore.Android)
+
+		// Assert
+		assert.ErrorIs(t, err, appstore.ErrInvalidAppID)
+	})
+	t.Run("when android app id segment starts with a digit, should return error", func(t *testing.T) {
+		// Act
+		_, err := appstore.Encode("com.1example", appstore.Android)
+
+		// Assert
+		assert.ErrorIs(t, err, appstore.ErrInvalidAppID)
+	})
+}
@@ -0,0 +1,254 @@
+// Package bitcoin implements creation of QR codes with BIP-21 URIs, which open the wallet with a payment request.
+package bitcoin
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	scheme = "bitcoin:"
+	// MaxDecimals is the number of decimals of an amount in BTC, 1 satoshi is 0.00000001 BTC.
+	MaxDecimals = 8
+	// MaxAmount is the limit of bitcoins which will ever exist, in BTC.
+	MaxAmount = 21_000_000
+)
+
+var (
+	// ErrInvalidAddress is returned when the address is not a valid Base58Check or Bech32 address.
+	ErrInvalidAddress = errors.New("invalid bitcoin address")
+	// ErrInvalidAmount is returned when the amount is not a number, is negative, is greater than MaxAmount
+	// or has more than MaxDecimals decimals.
+	ErrInvalidAmount = errors.New("invalid bitcoin amount")
+)
+
+const (
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	bech32Charset  = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	bech32Const    = 1
+	bech32mConst   = 0x2bc830a3
+)
+
+// base58Versions are the version bytes of legacy P2PKH and P2SH addresses, on mainnet and testnet.
+var base58Versions = map[byte]bool{0x00: true, 0x05: true, 0x6F: true, 0xC4: true}
+
+// bech32Prefixes are the human readable parts of SegWit addresses, on mainnet, testnet and regtest.
+var bech32Prefixes = map[string]bool{"bc": true, "tb": true, "bcrt": true}
+
+// NewQR is responsible to create a QR code with a BIP-21 payment request to the address. The amount, in BTC,
+// the label and the message are optional, they are not written when they are zero or empty.
+// When the address or the amount are invalid, the error is rendered instead of the QR code.
+func NewQR(address string, amount float64, label, message string, ps ...props.Rect) core.Component {
+	value, err := Encode(address, amount, label, message)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return code.NewQr(value, ps...)
+}
+
+// NewQRCol is responsible to create a Bitcoin QR code wrapped in a Col.
+func NewQRCol(size int, address string, amount float64, label, message string, ps ...props.Rect) core.Col {
+	qr := NewQR(address, amount, label, message, ps...)
+	return col.New(size).Add(qr)
+}
+
+// NewQRRow is responsible to create a Bitcoin QR code wrapped in a Row.
+func NewQRRow(height float64, address string, amount float64, label, message string, ps ...props.Rect) core.Row {
+	qr := NewQR(address, amount, label, message, ps...)
+	c := col.New().Add(qr)
+	return row.New(height).Add(c)
+}
+
+// Encode validates the address, legacy addresses with Base58Check and SegWit addresses with Bech32 or Bech32m,
+// and the amount, and returns the "bitcoin:address?amount=x&label=y&message=z" content of the QR code.
+func Encode(address string, amount float64, label, message string) (string, error) {
+	if !isBase58Address(address) && !isBech32Address(address) {
+		return "", ErrInvalidAddress
+	}
+
+	formattedAmount, err := formatAmount(amount)
+	if err != nil {
+		return "", err
+	}
+
+	var params []string
+	if formattedAmount != "" {
+		params = append(params, "amount="+formattedAmount)
+	}
+
+	if label != "" {
+		params = append(params, "label="+escape(label))
+	}
+
+	if message != "" {
+		params = append(params, "message="+escape(message))
+	}
+
+	if len(params) == 0 {
+		return scheme + address, nil
+	}
+
+	return scheme + address + "?" + strings.Join(params, "&"), nil
+}
+
+func formatAmount(amount float64) (string, error) {
+	if math.IsNaN(amount) || amount < 0 || amount > MaxAmount {
+		return "", ErrInvalidAmount
+	}
+
+	if amount == 0 {
+		return "", nil
+	}
+
+	formatted := strconv.FormatFloat(amount, 'f', -1, 64)
+	if dot := strings.IndexByte(formatted, '.'); dot >= 0 && len(formatted)-dot-1 > MaxDecimals {
+		return "", ErrInvalidAmount
+	}
+
+	return formatted, nil
+}
+
+// escape percent-encodes the value as defined by RFC 3986, spaces are written as %20 instead of +.
+func escape(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+// isBase58Address checks a legacy address: 25 bytes with a known version, the hash and a checksum
+// of the first 4 bytes of the double SHA-256.
+func isBase58Address(address string) bool {
+	decoded := big.NewInt(0)
+	base := big.NewInt(int64(len(base58Alphabet)))
+
+	for _, c := range address {
+		index := strings.IndexRune(base58Alphabet, c)
+		if index < 0 {
+			return false
+		}
+		decoded.Mul(decoded, base)
+		decoded.Add(decoded, big.NewInt(int64(index)))
+	}
+
+	// Each leading "1" is a leading zero byte, which is lost in the number.
+	zeros := len(address) - len(strings.TrimLeft(address, "1"))
+	payload := append(make([]byte, zeros), decoded.Bytes()...)
+
+	addressLength := 25
+	if len(payload) != addressLength || !base58Versions[payload[0]] {
+		return false
+	}
+
+	first := sha256.Sum256(payload[:21])
+	second := sha256.Sum256(first[:])
+
+	return string(second[:4]) == string(payload[21:])
+}
+
+// isBech32Address checks a SegWit address, version 0 uses the Bech32 checksum of BIP-173 and the
+// other versions the Bech32m checksum of BIP-350.
+func isBech32Address(address string) bool {
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return false
+	}
+	address = strings.ToLower(address)
+
+	separator := strings.LastIndexByte(address, '1')
+	checksumLength := 6
+	if separator < 1 || len(address)-separator-1 < checksumLength+1 || len(address) > 90 {
+		return false
+	}
+
+	hrp := address[:separator]
+	if !bech32Prefixes[hrp] {
+		return false
+	}
+
+	var data []int
+	for _, c := range address[separator+1:] {
+		index := strings.IndexRune(bech32Charset, c)
+		if index < 0 {
+			return false
+		}
+		data = append(data, index)
+	}
+
+	version := data[0]
+	checksum := bech32Polymod(append(expandHRP(hrp), data...))
+	if version == 0 && checksum != bech32Const || version != 0 && checksum != bech32mConst || version > 16 {
+		return false
+	}
+
+	program, ok := convertBits(data[1:len(data)-checksumLength], 5, 8)
+	if !ok || len(program) < 2 || len(program) > 40 {
+		return false
+	}
+
+	return version != 0 || len(program) == 20 || len(program) == 32
+}
+
+func bech32Polymod(values []int) int {
+	generator := []int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	checksum := 1
+
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ value
+		for i, g := range generator {
+			if top>>i&1 == 1 {
+				checksum ^= g
+			}
+		}
+	}
+
+	return checksum
+}
+
+func expandHRP(hrp string) []int {
+	expanded := make([]int, 0, len(hrp)*2+1)
+	for _, c := range hrp {
+		expanded = append(expanded, int(c)>>5)
+	}
+
+	expanded = append(expanded, 0)
+	for _, c := range hrp {
+		expanded = append(expanded, int(c)&31)
+	}
+
+	return expanded
+}
+
+// convertBits regroups the 5 bit words of the address in bytes, the padding must be zero and shorter than 5 bits.
+func convertBits(data []int, from, to uint) ([]byte, bool) {
+	accumulator, bits := 0, uint(0)
+	maxValue := 1<<to - 1
+	maxAccumulator := 1<<(from+to-1) - 1
+
+	var converted []byte
+	for _, value := range data {
+		accumulator = (accumulator<<from | value) & maxAccumulator
+		bits += from
+		for bits >= to {
+			bits -= to
+			converted = append(converted, byte(accumulator>>bits&maxValue))
+		}
+	}
+
+	if bits >= from || accumulator<<(to-bits)&maxValue != 0 {
+		return nil, false
+	}
+
+	return converted, true
+}
@@ -0,0 +1,163 @@
+package bitcoin_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/qr/bitcoin"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+const address = "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+
+func TestNewQR(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := bitcoin.NewQR(address, 0.01, "Luke-Jr", "Donation")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_bitcoin_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := bitcoin.NewQR(address, 0.01, "Luke-Jr", "Donation", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_bitcoin_qr_custom_prop.json")
+	})
+	t.Run("when address is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := bitcoin.NewQR("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3", 0.01, "", "")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_bitcoin_qr_invalid.json")
+	})
+}
+
+func TestNewQRCol(t *testing.T) {
+	// Act
+	sut := bitcoin.NewQRCol(12, address, 0, "", "")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_bitcoin_qr_col.json")
+}
+
+func TestNewQRRow(t *testing.T) {
+	// Act
+	sut := bitcoin.NewQRRow(10, address, 0, "", "")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_bitcoin_qr_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when only address is sent, should return the address uri", func(t *testing.T) {
+		// Act
+		value, err := bitcoin.Encode(address, 0, "", "")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "bitcoin:"+address, value)
+	})
+	t.Run("when all params are sent, should return escaped params", func(t *testing.T) {
+		// Act
+		value, err := bitcoin.Encode(address, 20.3, "Luke-Jr", "Donation for project xyz & co")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "bitcoin:"+address+"?amount=20.3&label=Luke-Jr&message=Donation%20for%20project%20xyz%20%26%20co", value)
+	})
+	t.Run("when amount has 8 decimals, should accept", func(t *testing.T) {
+		// Act
+		value, err := bitcoin.Encode(address, 0.00000001, "", "")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "bitcoin:"+address+"?amount=0.00000001", value)
+	})
+	t.Run("when amount has more than 8 decimals, should return error", func(t *testing.T) {
+		// Act
+		_, err := bitcoin.Encode(address, 0.000000001, "", "")
+
+		// Assert
+		assert.ErrorIs(t, err, bitcoin.ErrInvalidAmount)
+	})
+	t.Run("when amount is negative, should return error", func(t *testing.T) {
+		// Act
+		_, err := bitcoin.Encode(address, -1, "", "")
+
+		// Assert
+		assert.ErrorIs(t, err, bitcoin.ErrInvalidAmount)
+	})
+	t.Run("when amount is not a number, should return error", func(t *testing.T) {
+		// Act
+		_, err := bitcoin.Encode(address, math.NaN(), "", "")
+
+		// Assert
+		assert.ErrorIs(t, err, bitcoin.ErrInvalidAmount)
+	})
+	t.Run("when amount is infinite, should return error", func(t *testing.T) {
+		// Act
+		_, positiveErr := bitcoin.Encode(address, math.Inf(1), "", "")
+		_, negativeErr := bitcoin.Encode(address, math.Inf(-1), "", "")
+
+		// Assert
+		assert.ErrorIs(t, positiveErr, bitcoin.ErrInvalidAmount)
+		assert.ErrorIs(t, negativeErr, bitcoin.ErrInvalidAmount)
+	})
+	t.Run("when amount is greater than the bitcoin supply, should return error", func(t *testing.T) {
+		// Act
+		_, err := bitcoin.Encode(address, bitcoin.MaxAmount+1, "", "")
+
+		// Assert
+		assert.ErrorIs(t, err, bitcoin.ErrInvalidAmount)
+	})
+	t.Run("when amount is the bitcoin supply, should accept", func(t *testing.T) {
+		// Act
+		uri, err := bitcoin.Encode(address, bitcoin.MaxAmount, "", "")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "bitcoin:"+address+"?amount=21000000", uri)
+	})
+	t.Run("when address is valid, should accept", func(t *testing.T) {
+		addresses := []string{
+			"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+			"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+			"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+			"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7",
+			"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
+		}
+
+		for _, address := range addresses {
+			// Act
+			_, err := bitcoin.Encode(address, 0, "", "")
+
+			// Assert
+			assert.Nil(t, err, address)
+		}
+	})
+	t.Run("when address is invalid, should return error", func(t *testing.T) {
+		addresses := []string{
+			"",
+			"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb",
+			"0A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+			"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5",
+			"bc1qW508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			"ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9",
+			"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",
+		}
+
+		for _, address := range addresses {
+			// Act
+			_, err := bitcoin.Encode(address, 0, "", "")
+
+			// Assert
+			assert.ErrorIs(t, err, bitcoin.ErrInvalidAddress, address)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+// Package calendar implements creation of QR codes with iCalendar events, used on event posters and tickets.
+package calendar
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	dateLayout = "20060102T150405Z"
+	lineBreak  = "\r\n"
+)
+
+var (
+	// ErrEmptyUID is returned when the event has no UID.
+	ErrEmptyUID = errors.New("calendar event uid is empty")
+	// ErrInvalidPeriod is returned when the event does not start before its end.
+	ErrInvalidPeriod = errors.New("calendar event start must be before end")
+)
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// Event is the calendar event added when the QR code is read.
+type Event struct {
+	UID         string
+	Summary     string
+	Location    string
+	Description string
+	Start       time.Time
+	End         time.Time
+}
+
+// NewQR is responsible to create a QR code that adds the event to the calendar.
+// When the event is invalid, the error is rendered instead of the QR code.
+func NewQR(event Event, ps ...props.Rect) core.Component {
+	value, err := Encode(event)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return code.NewQr(value, ps...)
+}
+
+// NewQRCol is responsible to create a calendar QR code wrapped in a Col.
+func NewQRCol(size int, event Event, ps ...props.Rect) core.Col {
+	qr := NewQR(event, ps...)
+	return col.New(size).Add(qr)
+}
+
+// NewQRRow is responsible to create a calendar QR code wrapped in a Row.
+func NewQRRow(height float64, event Event, ps ...props.Rect) core.Row {
+	qr := NewQR(event, ps...)
+	c := col.New().Add(qr)
+	return row.New(height).Add(c)
+}
+
+// Encode validates the event and returns it as a minimal iCalendar VEVENT, as defined by RFC 5545.
+// The dates are written in UTC and the empty fields are omitted.
+func Encode(event Event) (string, error) {
+	if event.UID == "" {
+		return "", ErrEmptyUID
+	}
+
+	if !event.Start.Before(event.End) {
+		return "", ErrInvalidPeriod
+	}
+
+	lines := []string{
+		"BEGIN:VEVENT",
+		"UID:" + textEscaper.Replace(event.UID),
+		"DTSTART:" + event.Start.UTC().Format(dateLayout),
+		"DTEND:" + event.End.UTC().Format(dateLayout),
+	}
+
+	if event.Summary != "" {
+		lines = append(lines, "SUMMARY:"+textEscaper.Replace(event.Summary))
+	}
+
+	if event.Location != "" {
+		lines = append(lines, "LOCATION:"+textEscaper.Replace(event.Location))
+	}
+
+	if event.Description != "" {
+		lines = append(lines, "DESCRIPTION:"+textEscaper.Replace(event.Description))
+	}
+
+	lines = append(lines, "END:VEVENT")
+
+	return strings.Join(lines, lineBreak), nil
+}
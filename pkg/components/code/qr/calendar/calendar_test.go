@@ -0,0 +1,105 @@
+package calendar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/qr/calendar"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var event = calendar.Event{
+	UID:     "2024-gophercon@maroto",
+	Summary: "GopherCon",
+	Start:   time.Date(2024, time.June, 10, 9, 0, 0, 0, time.UTC),
+	End:     time.Date(2024, time.June, 10, 18, 0, 0, 0, time.UTC),
+}
+
+func TestNewQR(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := calendar.NewQR(event)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_calendar_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := calendar.NewQR(event, fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_calendar_qr_custom_prop.json")
+	})
+	t.Run("when event is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := calendar.NewQR(calendar.Event{Summary: "GopherCon"})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_calendar_qr_invalid.json")
+	})
+}
+
+func TestNewQRCol(t *testing.T) {
+	// Act
+	sut := calendar.NewQRCol(12, event)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_calendar_qr_col.json")
+}
+
+func TestNewQRRow(t *testing.T) {
+	// Act
+	sut := calendar.NewQRRow(10, event)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_calendar_qr_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when only required fields are sent, should omit the optional ones", func(t *testing.T) {
+		// Act
+		value, err := calendar.Encode(calendar.Event{UID: "1", Start: event.Start, End: event.End})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "BEGIN:VEVENT\r\nUID:1\r\nDTSTART:20240610T090000Z\r\nDTEND:20240610T180000Z\r\nEND:VEVENT", value)
+	})
+	t.Run("when every field is sent, should escape the texts and write dates in utc", func(t *testing.T) {
+		// Arrange
+		location := time.FixedZone("BRT", -3*60*60)
+
+		// Act
+		value, err := calendar.Encode(calendar.Event{
+			UID:         "1",
+			Summary:     "Meetup; Go",
+			Location:    "Room 1, Floor 2",
+			Description: "Talks\nPizza",
+			Start:       time.Date(2024, time.June, 10, 9, 0, 0, 0, location),
+			End:         time.Date(2024, time.June, 10, 11, 30, 0, 0, location),
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "BEGIN:VEVENT\r\nUID:1\r\nDTSTART:20240610T120000Z\r\nDTEND:20240610T143000Z\r\n"+
+			"SUMMARY:Meetup\\; Go\r\nLOCATION:Room 1\\, Floor 2\r\nDESCRIPTION:Talks\\nPizza\r\nEND:VEVENT", value)
+	})
+	t.Run("when uid is empty, should return error", func(t *testing.T) {
+		// Act
+		_, err := calendar.Encode(calendar.Event{Start: event.Start, End: event.End})
+
+		// Assert
+		assert.Equal(t, calendar.ErrEmptyUID, err)
+	})
+	t.Run("when start is not before end, should return error", func(t *testing.T) {
+		// Act
+		_, errAfter := calendar.Encode(calendar.Event{UID: "1", Start: event.End, End: event.Start})
+		_, errEqual := calendar.Encode(calendar.Event{UID: "1", Start: event.Start, End: event.Start})
+
+		// Assert
+		assert.Equal(t, calendar.ErrInvalidPeriod, errAfter)
+		assert.Equal(t, calendar.ErrInvalidPeriod, errEqual)
+	})
+}
@@ -0,0 +1,153 @@
+// Package email implements creation of QR codes with mailto: actions, used on business cards and posters.
+package email
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	scheme = "mailto:"
+	hexa   = "0123456789ABCDEF"
+)
+
+// ErrInvalidAddress is returned when a recipient is not an email address.
+var ErrInvalidAddress = errors.New("invalid email address")
+
+// Message is the content of the email opened when the QR code is read.
+type Message struct {
+	To      string
+	CC      []string
+	BCC     []string
+	Subject string
+	Body    string
+}
+
+// NewQR is responsible to create a QR code that opens an email to the recipient with the subject and the body.
+// When the recipient is invalid, the error is rendered instead of the QR code.
+func NewQR(to, subject, body string, ps ...props.Rect) core.Component {
+	return NewMessageQR(Message{To: to, Subject: subject, Body: body}, ps...)
+}
+
+// NewQRCol is responsible to create an email QR code wrapped in a Col.
+func NewQRCol(size int, to, subject, body string, ps ...props.Rect) core.Col {
+	qr := NewQR(to, subject, body, ps...)
+	return col.New(size).Add(qr)
+}
+
+// NewQRRow is responsible to create an email QR code wrapped in a Row.
+func NewQRRow(height float64, to, subject, body string, ps ...props.Rect) core.Row {
+	qr := NewQR(to, subject, body, ps...)
+	c := col.New().Add(qr)
+	return row.New(height).Add(c)
+}
+
+// NewMessageQR is responsible to create a QR code that opens an email with the Message, it supports CC and BCC.
+// When any recipient is invalid, the error is rendered instead of the QR code.
+func NewMessageQR(msg Message, ps ...props.Rect) core.Component {
+	value, err := Encode(msg)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return code.NewQr(value, ps...)
+}
+
+// Encode validates the recipients and returns the mailto: URI of the Message as defined by RFC 6068,
+// ex: "mailto:john@doe.com?subject=Hello%20World".
+func Encode(msg Message) (string, error) {
+	if err := validate(msg.To); err != nil {
+		return "", err
+	}
+
+	for _, addr := range append(append([]string{}, msg.CC...), msg.BCC...) {
+		if err := validate(addr); err != nil {
+			return "", err
+		}
+	}
+
+	var fields []string
+	if len(msg.CC) > 0 {
+		fields = append(fields, "cc="+escapeAddresses(msg.CC))
+	}
+
+	if len(msg.BCC) > 0 {
+		fields = append(fields, "bcc="+escapeAddresses(msg.BCC))
+	}
+
+	if msg.Subject != "" {
+		fields = append(fields, "subject="+escape(msg.Subject, ""))
+	}
+
+	if msg.Body != "" {
+		fields = append(fields, "body="+escape(normalizeLineBreaks(msg.Body), ""))
+	}
+
+	value := scheme + escape(msg.To, "@")
+	if len(fields) > 0 {
+		value += "?" + strings.Join(fields, "&")
+	}
+
+	return value, nil
+}
+
+// validate checks that the address has a local part and a domain with a dot, ex: john@doe.com.
+func validate(addr string) error {
+	local, domain, found := strings.Cut(addr, "@")
+	if !found || local == "" || strings.ContainsAny(addr, " \t\r\n,") || strings.Contains(domain, "@") {
+		return ErrInvalidAddress
+	}
+
+	dot := strings.LastIndexByte(domain, '.')
+	if dot <= 0 || dot == len(domain)-1 {
+		return ErrInvalidAddress
+	}
+
+	return nil
+}
+
+func escapeAddresses(addrs []string) string {
+	escaped := make([]string, len(addrs))
+	for i, addr := range addrs {
+		escaped[i] = escape(addr, "@")
+	}
+
+	return strings.Join(escaped, ",")
+}
+
+// escape percent-encodes every byte that is not unreserved or listed on keep, spaces become %20.
+func escape(value, keep string) string {
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if isUnreserved(c) || strings.IndexByte(keep, c) >= 0 {
+			sb.WriteByte(c)
+			continue
+		}
+
+		sb.WriteByte('%')
+		sb.WriteByte(hexa[c>>4])
+		sb.WriteByte(hexa[c&0x0F])
+	}
+
+	return sb.String()
+}
+
+func isUnreserved(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// normalizeLineBreaks converts the line breaks to CRLF, as required by RFC 6068 on the body.
+func normalizeLineBreaks(value string) string {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	return strings.ReplaceAll(value, "\n", "\r\n")
+}
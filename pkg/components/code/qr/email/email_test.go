@@ -0,0 +1,105 @@
+package email_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/qr/email"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewQR(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := email.NewQR("john@doe.com", "Hello", "World")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_email_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := email.NewQR("john@doe.com", "Hello", "World", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_email_qr_custom_prop.json")
+	})
+	t.Run("when recipient is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := email.NewQR("john.doe.com", "Hello", "World")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_email_qr_invalid.json")
+	})
+}
+
+func TestNewQRCol(t *testing.T) {
+	// Act
+	sut := email.NewQRCol(12, "john@doe.com", "Hello", "World")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_email_qr_col.json")
+}
+
+func TestNewQRRow(t *testing.T) {
+	// Act
+	sut := email.NewQRRow(10, "john@doe.com", "Hello", "World")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_email_qr_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when only recipient is sent, should not add fields", func(t *testing.T) {
+		// Act
+		value, err := email.Encode(email.Message{To: "john@doe.com"})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "mailto:john@doe.com", value)
+	})
+	t.Run("when subject and body are sent, should percent-encode them", func(t *testing.T) {
+		// Act
+		value, err := email.Encode(email.Message{
+			To:      "john@doe.com",
+			Subject: "Q&A = 100%",
+			Body:    "Hello,\nWorld",
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "mailto:john@doe.com?subject=Q%26A%20%3D%20100%25&body=Hello%2C%0D%0AWorld", value)
+	})
+	t.Run("when cc and bcc are sent, should add them before subject", func(t *testing.T) {
+		// Act
+		value, err := email.Encode(email.Message{
+			To:      "john@doe.com",
+			CC:      []string{"jane@doe.com", "jim@doe.com"},
+			BCC:     []string{"boss@doe.com"},
+			Subject: "Hi",
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "mailto:john@doe.com?cc=jane@doe.com,jim@doe.com&bcc=boss@doe.com&subject=Hi", value)
+	})
+	t.Run("when recipient has no domain, should return error", func(t *testing.T) {
+		// Act
+		_, errNoAt := email.Encode(email.Message{To: "john"})
+		_, errNoDot := email.Encode(email.Message{To: "john@doe"})
+		_, errNoLocal := email.Encode(email.Message{To: "@doe.com"})
+
+		// Assert
+		assert.Equal(t, email.ErrInvalidAddress, errNoAt)
+		assert.Equal(t, email.ErrInvalidAddress, errNoDot)
+		assert.Equal(t, email.ErrInvalidAddress, errNoLocal)
+	})
+	t.Run("when cc is invalid, should return error", func(t *testing.T) {
+		// Act
+		_, err := email.Encode(email.Message{To: "john@doe.com", CC: []string{"jane doe@doe.com"}})
+
+		// Assert
+		assert.Equal(t, email.ErrInvalidAddress, err)
+	})
+}
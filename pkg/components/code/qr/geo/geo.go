@@ -0,0 +1,90 @@
+// Package geo implements creation of QR codes with geo: URIs, which open a map at the coordinates.
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	scheme       = "geo:"
+	maxLatitude  = 90.0
+	maxLongitude = 180.0
+)
+
+var (
+	// ErrInvalidLatitude is returned when the latitude is not between -90 and 90.
+	ErrInvalidLatitude = errors.New("latitude must be between -90 and 90")
+	// ErrInvalidLongitude is returned when the longitude is not between -180 and 180.
+	ErrInvalidLongitude = errors.New("longitude must be between -180 and 180")
+)
+
+// NewQR is responsible to create a QR code that opens a map at the coordinates, the query is an optional
+// label or search of the place. When the coordinates are invalid, the error is rendered instead of the QR code.
+func NewQR(lat, lon float64, query string, ps ...props.Rect) core.Component {
+	qr, err := NewQRErr(lat, lon, query, ps...)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return qr
+}
+
+// NewQRErr is responsible to create a QR code that opens a map at the coordinates, like NewQR,
+// but returns the error when the coordinates are invalid instead of rendering it.
+func NewQRErr(lat, lon float64, query string, ps ...props.Rect) (core.Component, error) {
+	value, err := Encode(lat, lon, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return code.NewQr(value, ps...), nil
+}
+
+// NewQRCol is responsible to create a geo QR code wrapped in a Col.
+func NewQRCol(size int, lat, lon float64, query string, ps ...props.Rect) core.Col {
+	qr := NewQR(lat, lon, query, ps...)
+	return col.New(size).Add(qr)
+}
+
+// NewQRRow is responsible to create a geo QR code wrapped in a Row.
+func NewQRRow(height float64, lat, lon float64, query string, ps ...props.Rect) core.Row {
+	qr := NewQR(lat, lon, query, ps...)
+	c := col.New().Add(qr)
+	return row.New(height).Add(c)
+}
+
+// Encode validates the coordinates and returns the geo: URI as defined by RFC 5870,
+// ex: "geo:-23.5505,-46.6333?q=S%C3%A3o%20Paulo".
+func Encode(lat, lon float64, query string) (string, error) {
+	if math.IsNaN(lat) || math.Abs(lat) > maxLatitude {
+		return "", fmt.Errorf("%w, got %v", ErrInvalidLatitude, lat)
+	}
+
+	if math.IsNaN(lon) || math.Abs(lon) > maxLongitude {
+		return "", fmt.Errorf("%w, got %v", ErrInvalidLongitude, lon)
+	}
+
+	value := scheme + formatCoordinate(lat) + "," + formatCoordinate(lon)
+	if query != "" {
+		value += "?q=" + strings.ReplaceAll(url.QueryEscape(query), "+", "%20")
+	}
+
+	return value, nil
+}
+
+func formatCoordinate(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,105 @@
+package geo_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/qr/geo"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewQR(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := geo.NewQR(-23.5505, -46.6333, "Praça da Sé")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_geo_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := geo.NewQR(-23.5505, -46.6333, "Praça da Sé", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_geo_qr_custom_prop.json")
+	})
+	t.Run("when latitude is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := geo.NewQR(91, -46.6333, "")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_geo_qr_invalid.json")
+	})
+}
+
+func TestNewQRErr(t *testing.T) {
+	t.Run("when coordinates are valid, should return the qr code", func(t *testing.T) {
+		// Act
+		sut, err := geo.NewQRErr(-23.5505, -46.6333, "")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "geo:-23.5505,-46.6333", sut.GetStructure().GetData().Value)
+	})
+	t.Run("when longitude is invalid, should return error", func(t *testing.T) {
+		// Act
+		sut, err := geo.NewQRErr(-23.5505, -180.5, "")
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorIs(t, err, geo.ErrInvalidLongitude)
+		assert.Equal(t, "longitude must be between -180 and 180, got -180.5", err.Error())
+	})
+}
+
+func TestNewQRCol(t *testing.T) {
+	// Act
+	sut := geo.NewQRCol(12, -23.5505, -46.6333, "")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_geo_qr_col.json")
+}
+
+func TestNewQRRow(t *testing.T) {
+	// Act
+	sut := geo.NewQRRow(10, -23.5505, -46.6333, "")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_geo_qr_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when query is sent, should escape it", func(t *testing.T) {
+		// Act
+		value, err := geo.Encode(48.2010, 16.3695, "Café & Bar")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "geo:48.201,16.3695?q=Caf%C3%A9%20%26%20Bar", value)
+	})
+	t.Run("when coordinates are on the limits, should accept", func(t *testing.T) {
+		// Act
+		value, err := geo.Encode(-90, 180, "")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "geo:-90,180", value)
+	})
+	t.Run("when latitude is out of range, should return error", func(t *testing.T) {
+		// Act
+		_, err := geo.Encode(-90.1, 0, "")
+
+		// Assert
+		assert.ErrorIs(t, err, geo.ErrInvalidLatitude)
+	})
+	t.Run("when latitude is not a number, should return error", func(t *testing.T) {
+		// Act
+		_, err := geo.Encode(math.NaN(), 0, "")
+
+		// Assert
+		assert.ErrorIs(t, err, geo.ErrInvalidLatitude)
+	})
+}
@@ -0,0 +1,113 @@
+// Package mecard implements creation of QR codes with MECARD: contacts, the contact exchange format
+// commonly used in Japan, China and Korea.
+package mecard
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const scheme = "MECARD:"
+
+var (
+	// ErrMissingName is returned when the contact has neither a last name nor a first name.
+	ErrMissingName = errors.New("mecard contact must have a name")
+	// ErrInvalidBirthday is returned when the birthday is not a date in the YYYYMMDD format.
+	ErrInvalidBirthday = errors.New("mecard birthday must be in the YYYYMMDD format")
+)
+
+var birthday = regexp.MustCompile(`^\d{4}(0[1-9]|1[0-2])(0[1-9]|[12]\d|3[01])$`)
+
+var escaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, `:`, `\:`)
+
+// Contact is the content of the contact added when the QR code is read, only the name is required.
+type Contact struct {
+	LastName  string
+	FirstName string
+	Phone     string
+	Email     string
+	Address   string
+	URL       string
+	Memo      string
+	// Birthday is the date of birth in the YYYYMMDD format, ex: "19700131".
+	Birthday string
+}
+
+// NewQR is responsible to create a QR code that adds the contact to the address book.
+// When the contact is invalid, the error is rendered instead of the QR code.
+func NewQR(contact Contact, ps ...props.Rect) core.Component {
+	value, err := Encode(contact)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return code.NewQr(value, ps...)
+}
+
+// NewQRCol is responsible to create a MeCard QR code wrapped in a Col.
+func NewQRCol(size int, contact Contact, ps ...props.Rect) core.Col {
+	qr := NewQR(contact, ps...)
+	return col.New(size).Add(qr)
+}
+
+// NewQRRow is responsible to create a MeCard QR code wrapped in a Row.
+func NewQRRow(height float64, contact Contact, ps ...props.Rect) core.Row {
+	qr := NewQR(contact, ps...)
+	c := col.New().Add(qr)
+	return row.New(height).Add(c)
+}
+
+// Encode validates the contact and returns the MeCard content of the QR code, ex:
+// "MECARD:N:Doe,John;TEL:+5511912345678;EMAIL:john@doe.com;;". The empty fields are omitted
+// and the reserved characters of the values, like ";" and ":", are escaped with a backslash.
+func Encode(contact Contact) (string, error) {
+	if contact.LastName == "" && contact.FirstName == "" {
+		return "", ErrMissingName
+	}
+
+	if contact.Birthday != "" && !birthday.MatchString(contact.Birthday) {
+		return "", ErrInvalidBirthday
+	}
+
+	name := escaper.Replace(contact.LastName)
+	if contact.FirstName != "" {
+		name += "," + escaper.Replace(contact.FirstName)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(scheme)
+	sb.WriteString("N:" + name + ";")
+
+	fields := []struct {
+		key   string
+		value string
+	}{
+		{"TEL", contact.Phone},
+		{"EMAIL", contact.Email},
+		{"ADR", contact.Address},
+		{"URL", contact.URL},
+		{"NOTE", contact.Memo},
+		{"BDAY", contact.Birthday},
+	}
+
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+
+		sb.WriteString(field.key + ":" + escaper.Replace(field.value) + ";")
+	}
+
+	sb.WriteString(";")
+
+	return sb.String(), nil
+}
@@ -0,0 +1,115 @@
+package mecard_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/qr/mecard"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var contact = mecard.Contact{
+	LastName:  "Doe",
+	FirstName: "John",
+	Phone:     "+5511912345678",
+	Email:     "john@doe.com",
+}
+
+func TestNewQR(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := mecard.NewQR(contact)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_mecard_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := mecard.NewQR(contact, fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_mecard_qr_custom_prop.json")
+	})
+	t.Run("when contact has no name, should create error text", func(t *testing.T) {
+		// Act
+		sut := mecard.NewQR(mecard.Contact{Phone: "+5511912345678"})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_mecard_qr_invalid.json")
+	})
+}
+
+func TestNewQRCol(t *testing.T) {
+	// Act
+	sut := mecard.NewQRCol(12, contact)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_mecard_qr_col.json")
+}
+
+func TestNewQRRow(t *testing.T) {
+	// Act
+	sut := mecard.NewQRRow(10, contact)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_mecard_qr_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when only name is sent, should not add other fields", func(t *testing.T) {
+		// Act
+		value, err := mecard.Encode(mecard.Contact{LastName: "Doe"})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "MECARD:N:Doe;;", value)
+	})
+	t.Run("when all fields are sent, should write them in order", func(t *testing.T) {
+		// Act
+		value, err := mecard.Encode(mecard.Contact{
+			LastName:  "Yamada",
+			FirstName: "Taro",
+			Phone:     "+81312345678",
+			Email:     "taro@example.jp",
+			Address:   "Tokyo",
+			URL:       "example.jp",
+			Memo:      "Sales",
+			Birthday:  "19700131",
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "MECARD:N:Yamada,Taro;TEL:+81312345678;EMAIL:taro@example.jp;ADR:Tokyo;"+
+			"URL:example.jp;NOTE:Sales;BDAY:19700131;;", value)
+	})
+	t.Run("when values have reserved characters, should escape them", func(t *testing.T) {
+		// Act
+		value, err := mecard.Encode(mecard.Contact{
+			LastName: "Doe; Jr",
+			URL:      "https://doe.com",
+			Memo:     `a,b\c`,
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, `MECARD:N:Doe\; Jr;URL:https\://doe.com;NOTE:a\,b\\c;;`, value)
+	})
+	t.Run("when name is not sent, should return error", func(t *testing.T) {
+		// Act
+		_, err := mecard.Encode(mecard.Contact{Email: "john@doe.com"})
+
+		// Assert
+		assert.Equal(t, mecard.ErrMissingName, err)
+	})
+	t.Run("when birthday is not in YYYYMMDD format, should return error", func(t *testing.T) {
+		// Act
+		_, errDashes := mecard.Encode(mecard.Contact{LastName: "Doe", Birthday: "1970-01-31"})
+		_, errMonth := mecard.Encode(mecard.Contact{LastName: "Doe", Birthday: "19701331"})
+
+		// Assert
+		assert.Equal(t, mecard.ErrInvalidBirthday, errDashes)
+		assert.Equal(t, mecard.ErrInvalidBirthday, errMonth)
+	})
+}
@@ -0,0 +1,69 @@
+// Package sms implements creation of QR codes with SMSTO: actions, which open the SMS app with a message.
+package sms
+
+import (
+	"errors"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	scheme = "SMSTO:"
+	// MaxBodyLength is the length of a single SMS, longer bodies are not accepted.
+	MaxBodyLength = 160
+)
+
+var (
+	// ErrInvalidPhone is returned when the recipient is not a phone number in the E.164 format.
+	ErrInvalidPhone = errors.New("invalid E.164 phone number")
+	// ErrBodyTooLong is returned when the body has more than MaxBodyLength characters.
+	ErrBodyTooLong = errors.New("sms body longer than 160 characters")
+)
+
+var e164 = regexp.MustCompile(`^\+\d{7,15}$`)
+
+// NewQR is responsible to create a QR code that opens the SMS app with the body written to the recipient.
+// When the recipient or the body are invalid, the error is rendered instead of the QR code.
+func NewQR(to, body string, ps ...props.Rect) core.Component {
+	value, err := Encode(to, body)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return code.NewQr(value, ps...)
+}
+
+// NewQRCol is responsible to create a SMS QR code wrapped in a Col.
+func NewQRCol(size int, to, body string, ps ...props.Rect) core.Col {
+	qr := NewQR(to, body, ps...)
+	return col.New(size).Add(qr)
+}
+
+// NewQRRow is responsible to create a SMS QR code wrapped in a Row.
+func NewQRRow(height float64, to, body string, ps ...props.Rect) core.Row {
+	qr := NewQR(to, body, ps...)
+	c := col.New().Add(qr)
+	return row.New(height).Add(c)
+}
+
+// Encode validates the recipient, which must follow the E.164 format, ex: "+5511912345678", and the body,
+// which must have up to MaxBodyLength characters, and returns the "SMSTO:to:body" content of the QR code.
+func Encode(to, body string) (string, error) {
+	if !e164.MatchString(to) {
+		return "", ErrInvalidPhone
+	}
+
+	if utf8.RuneCountInString(body) > MaxBodyLength {
+		return "", ErrBodyTooLong
+	}
+
+	return scheme + to + ":" + body, nil
+}
@@ -0,0 +1,96 @@
+package sms_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/qr/sms"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewQR(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := sms.NewQR("+5511912345678", "Hello")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_sms_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := sms.NewQR("+5511912345678", "Hello", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_sms_qr_custom_prop.json")
+	})
+	t.Run("when recipient is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := sms.NewQR("11912345678", "Hello")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_sms_qr_invalid.json")
+	})
+}
+
+func TestNewQRCol(t *testing.T) {
+	// Act
+	sut := sms.NewQRCol(12, "+5511912345678", "Hello")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_sms_qr_col.json")
+}
+
+func TestNewQRRow(t *testing.T) {
+	// Act
+	sut := sms.NewQRRow(10, "+5511912345678", "Hello")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_sms_qr_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when recipient and body are valid, should return smsto content", func(t *testing.T) {
+		// Act
+		value, err := sms.Encode("+5511912345678", "Hello: World")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "SMSTO:+5511912345678:Hello: World", value)
+	})
+	t.Run("when body is empty, should keep the separator", func(t *testing.T) {
+		// Act
+		value, err := sms.Encode("+1234567", "")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "SMSTO:+1234567:", value)
+	})
+	t.Run("when recipient is not e164, should return error", func(t *testing.T) {
+		for _, to := range []string{"", "5511912345678", "+123456", "+1234567890123456", "+55 11 91234-5678"} {
+			// Act
+			value, err := sms.Encode(to, "Hello")
+
+			// Assert
+			assert.ErrorIs(t, err, sms.ErrInvalidPhone, to)
+			assert.Empty(t, value)
+		}
+	})
+	t.Run("when body has 160 characters, should accept it", func(t *testing.T) {
+		// Act
+		_, err := sms.Encode("+5511912345678", strings.Repeat("á", 160))
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when body has more than 160 characters, should return error", func(t *testing.T) {
+		// Act
+		value, err := sms.Encode("+5511912345678", strings.Repeat("a", 161))
+
+		// Assert
+		assert.ErrorIs(t, err, sms.ErrBodyTooLong)
+		assert.Empty(t, value)
+	})
+}
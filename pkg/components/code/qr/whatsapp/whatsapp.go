@@ -0,0 +1,65 @@
+// Package whatsapp implements creation of QR codes with wa.me links, which open a WhatsApp chat with a message.
+package whatsapp
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const baseURL = "https://wa.me/"
+
+// ErrInvalidPhone is returned when the phone is not a phone number in the E.164 format.
+var ErrInvalidPhone = errors.New("invalid E.164 phone number")
+
+var e164 = regexp.MustCompile(`^\+\d{7,15}$`)
+
+// NewQR is responsible to create a QR code that opens a WhatsApp chat with the phone and the message written.
+// When the phone is invalid, the error is rendered instead of the QR code.
+func NewQR(phone, message string, ps ...props.Rect) core.Component {
+	value, err := Encode(phone, message)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return code.NewQr(value, ps...)
+}
+
+// NewQRCol is responsible to create a WhatsApp QR code wrapped in a Col.
+func NewQRCol(size int, phone, message string, ps ...props.Rect) core.Col {
+	qr := NewQR(phone, message, ps...)
+	return col.New(size).Add(qr)
+}
+
+// NewQRRow is responsible to create a WhatsApp QR code wrapped in a Row.
+func NewQRRow(height float64, phone, message string, ps ...props.Rect) core.Row {
+	qr := NewQR(phone, message, ps...)
+	c := col.New().Add(qr)
+	return row.New(height).Add(c)
+}
+
+// Encode validates the phone, which must follow the E.164 format, ex: "+5511912345678", and returns the
+// "https://wa.me/5511912345678?text=message" content of the QR code. The plus sign is removed from the phone,
+// as wa.me expects only the digits, and the message is URL encoded with the spaces as %20. When the message is
+// empty, the text parameter is omitted.
+func Encode(phone, message string) (string, error) {
+	if !e164.MatchString(phone) {
+		return "", ErrInvalidPhone
+	}
+
+	link := baseURL + strings.TrimPrefix(phone, "+")
+	if message == "" {
+		return link, nil
+	}
+
+	return link + "?text=" + strings.ReplaceAll(url.QueryEscape(message), "+", "%20"), nil
+}
@@ -0,0 +1,88 @@
+package whatsapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/qr/whatsapp"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewQR(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := whatsapp.NewQR("+5511912345678", "Hello")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_whatsapp_qr_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := whatsapp.NewQR("+5511912345678", "Hello", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_whatsapp_qr_custom_prop.json")
+	})
+	t.Run("when phone is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := whatsapp.NewQR("11912345678", "Hello")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_whatsapp_qr_invalid.json")
+	})
+}
+
+func TestNewQRCol(t *testing.T) {
+	// Act
+	sut := whatsapp.NewQRCol(12, "+5511912345678", "Hello")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_whatsapp_qr_col.json")
+}
+
+func TestNewQRRow(t *testing.T) {
+	// Act
+	sut := whatsapp.NewQRRow(10, "+5511912345678", "Hello")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_whatsapp_qr_row.json")
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("when phone and message are valid, should return wa.me link with the message encoded", func(t *testing.T) {
+		// Act
+		value, err := whatsapp.Encode("+5511912345678", "Hi! Order #12 & 50% off?")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "https://wa.me/5511912345678?text=Hi%21%20Order%20%2312%20%26%2050%25%20off%3F", value)
+	})
+	t.Run("when message has non ascii characters, should encode them as utf8", func(t *testing.T) {
+		// Act
+		value, err := whatsapp.Encode("+5511912345678", "olá")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "https://wa.me/5511912345678?text=ol%C3%A1", value)
+	})
+	t.Run("when message is empty, should omit the text", func(t *testing.T) {
+		// Act
+		value, err := whatsapp.Encode("+1234567", "")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "https://wa.me/1234567", value)
+	})
+	t.Run("when phone is not e164, should return error", func(t *testing.T) {
+		for _, phone := range []string{"", "5511912345678", "+123456", "+1234567890123456", "+55 11 91234-5678"} {
+			// Act
+			value, err := whatsapp.Encode(phone, "Hello")
+
+			// Assert
+			assert.ErrorIs(t, err, whatsapp.ErrInvalidPhone, phone)
+			assert.Empty(t, value)
+		}
+	})
+}
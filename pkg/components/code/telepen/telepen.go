@@ -0,0 +1,79 @@
+// Package telepen implements creation of Telepen barcodes used by UK libraries and the NHS.
+package telepen
+
+import (
+	"errors"
+	"unicode"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+var (
+	// ErrEmptyCode is returned when the code has no characters.
+	ErrEmptyCode = errors.New("telepen code must not be empty")
+	// ErrInvalidCharacter is returned when the code has characters out of the ASCII range.
+	ErrInvalidCharacter = errors.New("telepen code must contain only ASCII characters")
+	// ErrInvalidDigit is returned when a numeric code has characters which are not digits.
+	ErrInvalidDigit = errors.New("telepen numeric code must contain only digits")
+)
+
+// NewTelepen is responsible to create a Telepen Barcode, ex: "ABC-123". When numeric is true the
+// Telepen Numeric mode is used, it encodes each pair of digits in one character, and codes with an odd
+// number of digits are padded with a leading zero. When the code is invalid, the error is rendered
+// instead of the barcode.
+func NewTelepen(value string, numeric bool, ps ...props.Barcode) core.Component {
+	if err := Validate(value, numeric); err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	prop := props.Barcode{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	prop.Type = barcode.Telepen
+	if numeric {
+		prop.Type = barcode.TelepenNumeric
+	}
+
+	return code.NewBar(value, prop)
+}
+
+// NewTelepenCol is responsible to create a Telepen Barcode wrapped in a Col.
+func NewTelepenCol(size int, value string, numeric bool, ps ...props.Barcode) core.Col {
+	bar := NewTelepen(value, numeric, ps...)
+	return col.New(size).Add(bar)
+}
+
+// NewTelepenRow is responsible to create a Telepen Barcode wrapped in a Row.
+func NewTelepenRow(height float64, value string, numeric bool, ps ...props.Barcode) core.Row {
+	bar := NewTelepen(value, numeric, ps...)
+	c := col.New().Add(bar)
+	return row.New(height).Add(c)
+}
+
+// Validate checks that the code has only ASCII characters or, in the numeric mode, only digits.
+func Validate(value string, numeric bool) error {
+	if value == "" {
+		return ErrEmptyCode
+	}
+
+	for _, c := range value {
+		if numeric && (c < '0' || c > '9') {
+			return ErrInvalidDigit
+		}
+
+		if c > unicode.MaxASCII {
+			return ErrInvalidCharacter
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package telepen_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/code/telepen"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewTelepen(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := telepen.NewTelepen("ABC-123", false)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_telepen_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := telepen.NewTelepen("ABC-123", false, fixture.BarcodeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_telepen_custom_prop.json")
+	})
+	t.Run("when numeric, should use telepen numeric", func(t *testing.T) {
+		// Act
+		sut := telepen.NewTelepen("12345", true)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_telepen_numeric.json")
+	})
+	t.Run("when code is invalid, should create error text", func(t *testing.T) {
+		// Act
+		sut := telepen.NewTelepen("código", false)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_telepen_invalid.json")
+	})
+}
+
+func TestNewTelepenCol(t *testing.T) {
+	// Act
+	sut := telepen.NewTelepenCol(12, "ABC-123", false)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_telepen_col.json")
+}
+
+func TestNewTelepenRow(t *testing.T) {
+	// Act
+	sut := telepen.NewTelepenRow(10, "ABC-123", false)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_telepen_row.json")
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("when code is empty, should return error", func(t *testing.T) {
+		// Act
+		err := telepen.Validate("", false)
+
+		// Assert
+		assert.Equal(t, telepen.ErrEmptyCode, err)
+	})
+	t.Run("when code has characters out of ascii, should return error", func(t *testing.T) {
+		// Act
+		err := telepen.Validate("ABCÇ", false)
+
+		// Assert
+		assert.Equal(t, telepen.ErrInvalidCharacter, err)
+	})
+	t.Run("when numeric code has letters, should return error", func(t *testing.T) {
+		// Act
+		err := telepen.Validate("12A4", true)
+
+		// Assert
+		assert.Equal(t, telepen.ErrInvalidDigit, err)
+	})
+	t.Run("when code is valid, should not return error", func(t *testing.T) {
+		// Act
+		errAlpha := telepen.Validate("Ab c~\x01", false)
+		errNumeric := telepen.Validate("0123456789", true)
+
+		// Assert
+		assert.Nil(t, errAlpha)
+		assert.Nil(t, errNumeric)
+	})
+}
@@ -15,6 +15,9 @@ type col struct {
 	components []core.Component
 	config     *entity.Config
 	style      *props.Cell
+	id         string
+	tooltip    string
+	span       int
 }
 
 // New is responsible to create an instance of core.Col.
@@ -56,6 +59,27 @@ func (c *col) GetStructure() *node.Node[core.Structure] {
 		str.Details["is_max"] = true
 	}
 
+	if c.id != "" {
+		if len(str.Details) == 0 {
+			str.Details = make(map[string]interface{})
+		}
+		str.Details["id"] = c.id
+	}
+
+	if c.tooltip != "" {
+		if len(str.Details) == 0 {
+			str.Details = make(map[string]interface{})
+		}
+		str.Details["tooltip"] = c.tooltip
+	}
+
+	if c.span > 1 {
+		if len(str.Details) == 0 {
+			str.Details = make(map[string]interface{})
+		}
+		str.Details["span"] = c.span
+	}
+
 	node := node.New(str)
 
 	for _, c := range c.components {
@@ -72,6 +96,14 @@ func (c *col) Render(provider core.Provider, cell entity.Cell, createCell bool)
 		provider.CreateCol(cell.Width, cell.Height, c.config, c.style)
 	}
 
+	if c.hasHover() {
+		provider.AddHoverArea(c.id, &cell, c.style.HoverColor)
+	}
+
+	if tooltip := c.getTooltip(); tooltip != "" {
+		provider.AddTooltipArea(tooltip, &cell)
+	}
+
 	for _, component := range c.components {
 		component.Render(provider, &cell)
 	}
@@ -90,3 +122,48 @@ func (c *col) WithStyle(style *props.Cell) core.Col {
 	c.style = style
 	return c
 }
+
+// WithID sets the id of the column, it's used as the field name of interactive features like the hover color.
+func (c *col) WithID(id string) core.Col {
+	c.id = id
+	return c
+}
+
+// WithTooltip sets a text shown when the mouse is over the column, it's used instead of the Tooltip of the style.
+func (c *col) WithTooltip(tooltip string) core.Col {
+	c.tooltip = tooltip
+	return c
+}
+
+// Span sets how many col slots of the row, starting from this one, the column occupies. The column is rendered
+// with the width of all of them and the next n-1 cols of the row are not rendered. Values lower than 2 disable it.
+func (c *col) Span(n int) core.Col {
+	c.span = n
+	return c
+}
+
+// GetSpan returns how many col slots of the row the column occupies, at least 1.
+func (c *col) GetSpan() int {
+	return max(c.span, 1)
+}
+
+func (c *col) hasHover() bool {
+	if c.id == "" || c.style == nil || c.style.HoverColor == nil {
+		return false
+	}
+
+	return c.config != nil && c.config.JavaScriptEnabled
+}
+
+// getTooltip returns the tooltip of the column, the one sent to WithTooltip has priority over the style.
+func (c *col) getTooltip() string {
+	if c.tooltip != "" {
+		return c.tooltip
+	}
+
+	if c.style == nil {
+		return ""
+	}
+
+	return c.style.Tooltip
+}
@@ -44,6 +44,51 @@ func TestNew(t *testing.T) {
 		// Assert
 		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_with_props.json")
 	})
+	t.Run("when has id, should retrieve id", func(t *testing.T) {
+		// Act
+		c := col.New(12).WithID("total")
+
+		// Assert
+		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_with_id.json")
+	})
+	t.Run("when has span, should retrieve span", func(t *testing.T) {
+		// Act
+		c := col.New(2).Span(3)
+
+		// Assert
+		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_with_span.json")
+	})
+	t.Run("when has tooltip, should retrieve tooltip", func(t *testing.T) {
+		// Act
+		c := col.New(12).WithTooltip("total with taxes")
+
+		// Assert
+		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_with_tooltip.json")
+	})
+}
+
+func TestCol_GetSpan(t *testing.T) {
+	t.Run("when span is not defined, should return one", func(t *testing.T) {
+		// Arrange
+		c := col.New(12)
+
+		// Act & Assert
+		assert.Equal(t, 1, c.GetSpan())
+	})
+	t.Run("when span is defined, should return it", func(t *testing.T) {
+		// Arrange
+		c := col.New(2).Span(3)
+
+		// Act & Assert
+		assert.Equal(t, 3, c.GetSpan())
+	})
+	t.Run("when span is invalid, should return one", func(t *testing.T) {
+		// Arrange
+		c := col.New(2).Span(-1)
+
+		// Act & Assert
+		assert.Equal(t, 1, c.GetSpan())
+	})
 }
 
 func TestCol_GetSize(t *testing.T) {
@@ -119,4 +164,97 @@ func TestCol_Render(t *testing.T) {
 		component.AssertNumberOfCalls(t, "Render", 1)
 		component.AssertNumberOfCalls(t, "SetConfig", 1)
 	})
+	t.Run("when has hover color and javascript is enabled, should add hover area", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{JavaScriptEnabled: true}
+		cell := fixture.CellEntity()
+		style := &props.Cell{HoverColor: &props.Color{Red: 10, Green: 20, Blue: 30}}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().CreateCol(cell.Width, cell.Height, cfg, style)
+		provider.EXPECT().AddHoverArea("total", &cell, style.HoverColor)
+
+		sut := col.New(12).WithID("total").WithStyle(style)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, true)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddHoverArea", 1)
+	})
+	t.Run("when has hover color and javascript is disabled, should not add hover area", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := fixture.CellEntity()
+		style := &props.Cell{HoverColor: &props.Color{Red: 10, Green: 20, Blue: 30}}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().CreateCol(cell.Width, cell.Height, cfg, style)
+
+		sut := col.New(12).WithID("total").WithStyle(style)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, true)
+
+		// Assert
+		provider.AssertNotCalled(t, "AddHoverArea")
+	})
+	t.Run("when has hover color without id, should not add hover area", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{JavaScriptEnabled: true}
+		cell := fixture.CellEntity()
+		style := &props.Cell{HoverColor: &props.Color{Red: 10, Green: 20, Blue: 30}}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().CreateCol(cell.Width, cell.Height, cfg, style)
+
+		sut := col.New(12).WithStyle(style)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, true)
+
+		// Assert
+		provider.AssertNotCalled(t, "AddHoverArea")
+	})
+	t.Run("when style has tooltip, should add tooltip area", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := fixture.CellEntity()
+		style := &props.Cell{Tooltip: "style tooltip"}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().CreateCol(cell.Width, cell.Height, cfg, style)
+		provider.EXPECT().AddTooltipArea("style tooltip", &cell)
+
+		sut := col.New(12).WithStyle(style)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, true)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddTooltipArea", 1)
+	})
+	t.Run("when has tooltip and style has tooltip, should add the col tooltip", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := fixture.CellEntity()
+		style := &props.Cell{Tooltip: "style tooltip"}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().CreateCol(cell.Width, cell.Height, cfg, style)
+		provider.EXPECT().AddTooltipArea("col tooltip", &cell)
+
+		sut := col.New(12).WithStyle(style).WithTooltip("col tooltip")
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, true)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddTooltipArea", 1)
+	})
 }
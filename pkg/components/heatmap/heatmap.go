@@ -0,0 +1,190 @@
+// Package heatmap implements creation of heatmaps.
+package heatmap
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type heatmap struct {
+	data   [][]float64
+	prop   props.Heatmap
+	config *entity.Config
+}
+
+// New is responsible to create an instance of a Heatmap. When the family of the label font is
+// not defined, the labels are written with the family of the default font of the document.
+func New(data [][]float64, ps ...props.Heatmap) core.Component {
+	prop := props.Heatmap{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid("")
+
+	return &heatmap{
+		data: data,
+		prop: prop,
+	}
+}
+
+// NewCol is responsible to create an instance of a Heatmap wrapped in a Col.
+func NewCol(size int, data [][]float64, ps ...props.Heatmap) core.Col {
+	heatmap := New(data, ps...)
+	return col.New(size).Add(heatmap)
+}
+
+// NewRow is responsible to create an instance of a Heatmap wrapped in a Row.
+func NewRow(height float64, data [][]float64, ps ...props.Heatmap) core.Row {
+	heatmap := New(data, ps...)
+	c := col.New().Add(heatmap)
+	return row.New(height).Add(c)
+}
+
+// Render renders a Heatmap into a PDF context.
+func (h *heatmap) Render(provider core.Provider, cell *entity.Cell) {
+	rows, cols := h.getGridSize()
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	grid := cell.Copy()
+
+	if len(h.prop.ColLabels) > 0 {
+		labelHeight := provider.GetTextHeight(&h.prop.LabelFont) * 1.5
+		grid.Y += labelHeight
+		grid.Height -= labelHeight
+	}
+
+	if len(h.prop.RowLabels) > 0 {
+		labelWidth := cell.Width * h.prop.LabelPercent / 100.0
+		grid.X += labelWidth
+		grid.Width -= labelWidth
+	}
+
+	cellWidth := grid.Width / float64(cols)
+	cellHeight := grid.Height / float64(rows)
+	minValue, maxValue := h.getRange()
+
+	for i, values := range h.data {
+		for j, value := range values {
+			valueCell := &entity.Cell{
+				X:      grid.X + float64(j)*cellWidth,
+				Y:      grid.Y + float64(i)*cellHeight,
+				Width:  cellWidth,
+				Height: cellHeight,
+			}
+
+			provider.DrawRect(valueCell, &props.Cell{
+				BackgroundColor: h.interpolate(value, minValue, maxValue),
+			})
+		}
+	}
+
+	h.renderLabels(provider, cell, &grid, cellWidth, cellHeight)
+}
+
+// GetStructure returns the Structure of a Heatmap.
+func (h *heatmap) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "heatmap",
+		Value:   h.data,
+		Details: h.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (h *heatmap) SetConfig(config *entity.Config) {
+	h.config = config
+
+	if h.prop.LabelFont.Family != "" {
+		return
+	}
+
+	h.prop.LabelFont.Family = fontfamily.Arial
+	if config != nil && config.DefaultFont != nil && config.DefaultFont.Family != "" {
+		h.prop.LabelFont.Family = config.DefaultFont.Family
+	}
+}
+
+func (h *heatmap) renderLabels(provider core.Provider, cell *entity.Cell, grid *entity.Cell, cellWidth, cellHeight float64) {
+	fontHeight := provider.GetTextHeight(&h.prop.LabelFont)
+
+	for j, label := range h.prop.ColLabels {
+		labelCell := &entity.Cell{
+			X:      grid.X + float64(j)*cellWidth,
+			Y:      cell.Y,
+			Width:  cellWidth,
+			Height: grid.Y - cell.Y,
+		}
+		provider.AddText(label, labelCell, h.prop.LabelFont.ToTextProp(align.Center, 0, 0))
+	}
+
+	for i, label := range h.prop.RowLabels {
+		labelCell := &entity.Cell{
+			X:      cell.X,
+			Y:      grid.Y + float64(i)*cellHeight,
+			Width:  grid.X - cell.X,
+			Height: cellHeight,
+		}
+		top := (cellHeight - fontHeight) / 2.0
+		provider.AddText(label, labelCell, h.prop.LabelFont.ToTextProp(align.Left, top, 0))
+	}
+}
+
+func (h *heatmap) getGridSize() (int, int) {
+	cols := 0
+	for _, values := range h.data {
+		if len(values) > cols {
+			cols = len(values)
+		}
+	}
+
+	return len(h.data), cols
+}
+
+func (h *heatmap) getRange() (float64, float64) {
+	initialized := false
+	var minValue, maxValue float64
+
+	for _, values := range h.data {
+		for _, value := range values {
+			if !initialized {
+				minValue, maxValue = value, value
+				initialized = true
+				continue
+			}
+
+			if value < minValue {
+				minValue = value
+			}
+
+			if value > maxValue {
+				maxValue = value
+			}
+		}
+	}
+
+	return minValue, maxValue
+}
+
+func (h *heatmap) interpolate(value, minValue, maxValue float64) *props.Color {
+	ratio := 0.0
+	if maxValue > minValue {
+		ratio = (value - minValue) / (maxValue - minValue)
+	}
+
+	return &props.Color{
+		Red:   h.prop.MinColor.Red + int(float64(h.prop.MaxColor.Red-h.prop.MinColor.Red)*ratio),
+		Green: h.prop.MinColor.Green + int(float64(h.prop.MaxColor.Green-h.prop.MinColor.Green)*ratio),
+		Blue:  h.prop.MinColor.Blue + int(float64(h.prop.MaxColor.Blue-h.prop.MinColor.Blue)*ratio),
+	}
+}
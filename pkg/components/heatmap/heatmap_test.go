@@ -0,0 +1,152 @@
+package heatmap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/heatmap"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var data = [][]float64{
+	{1, 2, 3},
+	{4, 5, 6},
+}
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := heatmap.New(data)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/heatmaps/new_heatmap_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := heatmap.New(data, fixture.HeatmapProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/heatmaps/new_heatmap_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := heatmap.NewCol(12, data)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/heatmaps/new_heatmap_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := heatmap.NewRow(10, data)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/heatmaps/new_heatmap_row.json")
+}
+
+func TestHeatmap_Render(t *testing.T) {
+	t.Run("when data is empty, should not call provider", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := heatmap.New(nil)
+
+		provider := &mocks.Provider{}
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "DrawRect")
+	})
+	t.Run("when there are no labels, should draw the cells interpolating colors", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 30, Height: 20}
+		sut := heatmap.New(data, props.Heatmap{
+			MinColor: &props.Color{Red: 0, Green: 0, Blue: 0},
+			MaxColor: &props.Color{Red: 250, Green: 100, Blue: 50},
+		})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(5)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawRect", 6)
+		provider.AssertCalled(t, "DrawRect", &entity.Cell{X: 0, Y: 0, Width: 10, Height: 10},
+			&props.Cell{BackgroundColor: &props.Color{Red: 0, Green: 0, Blue: 0}})
+		provider.AssertCalled(t, "DrawRect", &entity.Cell{X: 20, Y: 10, Width: 10, Height: 10},
+			&props.Cell{BackgroundColor: &props.Color{Red: 250, Green: 100, Blue: 50}})
+	})
+	t.Run("when there are labels, should reserve space and write them", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 100}
+		sut := heatmap.New(data, fixture.HeatmapProp())
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(10)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawRect", 6)
+		provider.AssertNumberOfCalls(t, "AddText", 5)
+		provider.AssertCalled(t, "DrawRect", &entity.Cell{X: 20, Y: 15, Width: 80.0 / 3.0, Height: 42.5}, mock.Anything)
+	})
+}
+
+func TestHeatmap_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := heatmap.New(data)
+
+		// Act
+		sut.SetConfig(nil)
+	})
+	t.Run("when label family is not defined, should write labels with the default family", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 100}
+		sut := heatmap.New(data, props.Heatmap{ColLabels: []string{"a"}})
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{Family: fontfamily.Courier}})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().GetTextHeight(&props.Font{Family: fontfamily.Courier, Style: fontstyle.Normal, Size: 8}).Return(4)
+		provider.EXPECT().AddText("a", mock.Anything, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Family == fontfamily.Courier
+		}))
+
+		// Act
+		sut.Render(provider, &cell)
+	})
+	t.Run("when label family is defined, should keep it", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 100}
+		sut := heatmap.New(data, props.Heatmap{ColLabels: []string{"a"}, LabelFont: props.Font{Family: fontfamily.Helvetica}})
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{Family: fontfamily.Courier}})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4)
+		provider.EXPECT().AddText("a", mock.Anything, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Family == fontfamily.Helvetica
+		}))
+
+		// Act
+		sut.Render(provider, &cell)
+	})
+}
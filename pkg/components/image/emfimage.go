@@ -0,0 +1,337 @@
+package image
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+var (
+	// ErrInvalidEMF is returned when the bytes are not an Enhanced Metafile.
+	ErrInvalidEMF = errors.New("invalid emf")
+	// ErrEMFNotSupported is returned when the EMF has drawing records which cannot be converted,
+	// only the records of bitmaps are drawn.
+	ErrEMFNotSupported = errors.New("emf record not supported")
+)
+
+const (
+	emfSignature     = 0x464D4520
+	emfHeaderSize    = 88
+	emfRecordMinSize = 8
+	dibHeaderMinSize = 40
+	dibRGB           = 0
+	dibBitFields     = 3
+	// emfMaxPixels limits the size of the image of an EMF and of its bitmaps, as their sizes come from the EMF,
+	// 4096x4096 pixels use 64MB.
+	emfMaxPixels = 4096 * 4096
+)
+
+// emfRecord is the type of an EMF record, as defined by MS-EMF.
+type emfRecord uint32
+
+const (
+	emrHeader        emfRecord = 1
+	emrEOF           emfRecord = 14
+	emrGDIComment    emfRecord = 70
+	emrBitBlt        emfRecord = 76
+	emrStretchDIBits emfRecord = 81
+)
+
+// emfStateRecords are the records which change the state of the device without drawing, they are ignored.
+var emfStateRecords = map[emfRecord]bool{
+	9: true, 10: true, 11: true, 12: true, 13: true, 17: true, 18: true, 19: true, 20: true, 21: true,
+	22: true, 24: true, 25: true, 30: true, 33: true, 34: true, 35: true, 36: true, 37: true, 38: true,
+	39: true, 40: true, 58: true, 75: true, 82: true, 98: true, 115: true, emrGDIComment: true,
+}
+
+// NewFromEMF is responsible to create an instance of an Image from a Windows Enhanced Metafile.
+// The EMF is converted to PNG with its bitmap records, which is how most legacy applications export
+// their images. When the EMF cannot be converted, the error is rendered instead of the image.
+func NewFromEMF(bytes []byte, ps ...props.Rect) core.Component {
+	pngBytes, err := EMFToPNG(bytes)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return NewFromBytes(pngBytes, extension.Png, ps...)
+}
+
+// NewFromEMFCol is responsible to create an instance of an Image from an EMF wrapped in a Col.
+func NewFromEMFCol(size int, bytes []byte, ps ...props.Rect) core.Col {
+	image := NewFromEMF(bytes, ps...)
+	return col.New(size).Add(image)
+}
+
+// NewFromEMFRow is responsible to create an instance of an Image from an EMF wrapped in a Row.
+func NewFromEMFRow(height float64, bytes []byte, ps ...props.Rect) core.Row {
+	image := NewFromEMF(bytes, ps...)
+	c := col.New().Add(image)
+	return row.New(height).Add(c)
+}
+
+// EMFToPNG converts an EMF to PNG, the image has the size of the bounds of the EMF header. The bitmaps of the
+// EMR_STRETCHDIBITS and EMR_BITBLT records are drawn and the records which only change the state of the device
+// are ignored, any other drawing record returns ErrEMFNotSupported.
+func EMFToPNG(emfBytes []byte) ([]byte, error) {
+	if len(emfBytes) < emfHeaderSize || readUint32(emfBytes, 0) != uint32(emrHeader) ||
+		readUint32(emfBytes, 40) != emfSignature {
+		return nil, ErrInvalidEMF
+	}
+
+	left, top := int(readInt32(emfBytes, 8)), int(readInt32(emfBytes, 12))
+	right, bottom := int(readInt32(emfBytes, 16)), int(readInt32(emfBytes, 20))
+	if right <= left || bottom <= top {
+		return nil, fmt.Errorf("%w: empty bounds", ErrInvalidEMF)
+	}
+
+	if !isValidSize(right-left+1, bottom-top+1) {
+		return nil, fmt.Errorf("%w: bounds larger than %d pixels", ErrEMFNotSupported, emfMaxPixels)
+	}
+
+	canvas := image.NewRGBA(image.Rect(0, 0, right-left+1, bottom-top+1))
+	origin := image.Pt(left, top)
+
+	for offset := 0; offset < len(emfBytes); {
+		if len(emfBytes)-offset < emfRecordMinSize {
+			return nil, fmt.Errorf("%w: truncated record", ErrInvalidEMF)
+		}
+
+		recordType := emfRecord(readUint32(emfBytes, offset))
+		size := int(readUint32(emfBytes, offset+4))
+		if size < emfRecordMinSize || size%4 != 0 || offset+size > len(emfBytes) {
+			return nil, fmt.Errorf("%w: invalid record size", ErrInvalidEMF)
+		}
+
+		record := emfBytes[offset : offset+size]
+
+		var err error
+		switch {
+		case recordType == emrEOF:
+			return encodePNG(canvas)
+		case recordType == emrHeader:
+		case recordType == emrStretchDIBits:
+			err = drawStretchDIBits(canvas, record, origin)
+		case recordType == emrBitBlt:
+			err = drawBitBlt(canvas, record, origin)
+		case emfStateRecords[recordType]:
+		default:
+			err = fmt.Errorf("%w: record type %d", ErrEMFNotSupported, recordType)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		offset += size
+	}
+
+	return nil, fmt.Errorf("%w: missing EMR_EOF", ErrInvalidEMF)
+}
+
+func drawStretchDIBits(canvas *image.RGBA, record []byte, origin image.Point) error {
+	minSize := 80
+	if len(record) < minSize {
+		return fmt.Errorf("%w: truncated EMR_STRETCHDIBITS", ErrInvalidEMF)
+	}
+
+	dest := image.Rect(0, 0, int(readInt32(record, 72)), int(readInt32(record, 76))).
+		Add(image.Pt(int(readInt32(record, 24)), int(readInt32(record, 28))))
+	src := image.Rect(0, 0, int(readInt32(record, 40)), int(readInt32(record, 44))).
+		Add(image.Pt(int(readInt32(record, 32)), int(readInt32(record, 36))))
+
+	return drawDIB(canvas, record, 48, dest.Sub(origin), src, true)
+}
+
+func drawBitBlt(canvas *image.RGBA, record []byte, origin image.Point) error {
+	minSize := 100
+	if len(record) < minSize {
+		return fmt.Errorf("%w: truncated EMR_BITBLT", ErrInvalidEMF)
+	}
+
+	// Without a bitmap, the record fills the destination with the brush, which is not supported.
+	if readUint32(record, 88) == 0 {
+		return fmt.Errorf("%w: EMR_BITBLT without bitmap", ErrEMFNotSupported)
+	}
+
+	dest := image.Rect(0, 0, int(readInt32(record, 32)), int(readInt32(record, 36))).
+		Add(image.Pt(int(readInt32(record, 24)), int(readInt32(record, 28))))
+	src := dest.Sub(dest.Min).Add(image.Pt(int(readInt32(record, 44)), int(readInt32(record, 48))))
+
+	return drawDIB(canvas, record, 84, dest.Sub(origin), src, false)
+}
+
+// drawDIB decodes the bitmap referenced by the offsets at bmiField and draws the src rectangle of it
+// scaled to the dest rectangle of the canvas. When bottomUpSource is true, the src rectangle of
+// bottom-up bitmaps starts from their bottom line, as on StretchDIBits.
+func drawDIB(canvas *image.RGBA, record []byte, bmiField int, dest, src image.Rectangle, bottomUpSource bool) error {
+	offBmi, cbBmi := int(readUint32(record, bmiField)), int(readUint32(record, bmiField+4))
+	offBits, cbBits := int(readUint32(record, bmiField+8)), int(readUint32(record, bmiField+12))
+
+	if offBmi+cbBmi > len(record) || offBits+cbBits > len(record) {
+		return fmt.Errorf("%w: bitmap out of record", ErrInvalidEMF)
+	}
+
+	dib, bottomUp, err := decodeDIB(record[offBmi:offBmi+cbBmi], record[offBits:offBits+cbBits])
+	if err != nil {
+		return err
+	}
+
+	if bottomUp && bottomUpSource {
+		height := dib.Bounds().Dy()
+		src = image.Rect(src.Min.X, height-src.Max.Y, src.Max.X, height-src.Min.Y)
+	}
+
+	if dest.Empty() || src.Empty() {
+		return nil
+	}
+
+	if !src.In(dib.Bounds()) {
+		return fmt.Errorf("%w: source out of bitmap", ErrInvalidEMF)
+	}
+
+	// only the pixels of the destination inside the canvas are drawn, the scale is still the one of the whole destination
+	visible := dest.Intersect(canvas.Bounds())
+	for y := visible.Min.Y; y < visible.Max.Y; y++ {
+		for x := visible.Min.X; x < visible.Max.X; x++ {
+			sx := src.Min.X + (x-dest.Min.X)*src.Dx()/dest.Dx()
+			sy := src.Min.Y + (y-dest.Min.Y)*src.Dy()/dest.Dy()
+			canvas.Set(x, y, dib.At(sx, sy))
+		}
+	}
+
+	return nil
+}
+
+// decodeDIB decodes an uncompressed device independent bitmap of 1, 4, 8, 24 or 32 bits per pixel,
+// it also returns if the lines of the bitmap are stored from the bottom.
+func decodeDIB(bmi, bits []byte) (image.Image, bool, error) {
+	if len(bmi) < dibHeaderMinSize {
+		return nil, false, fmt.Errorf("%w: invalid bitmap header", ErrInvalidEMF)
+	}
+
+	width, height := int(readInt32(bmi, 4)), int(readInt32(bmi, 8))
+	bitCount := int(binary.LittleEndian.Uint16(bmi[14:]))
+	compression := readUint32(bmi, 16)
+
+	if compression != dibRGB && !(compression == dibBitFields && bitCount == 32) {
+		return nil, false, fmt.Errorf("%w: compressed bitmap", ErrEMFNotSupported)
+	}
+
+	bottomUp := height > 0
+	if !bottomUp {
+		height = -height
+	}
+
+	palette, err := getDIBPalette(bmi, bitCount)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if width <= 0 || height <= 0 {
+		return nil, false, fmt.Errorf("%w: empty bitmap", ErrInvalidEMF)
+	}
+
+	if !isValidSize(width, height) {
+		return nil, false, fmt.Errorf("%w: bitmap larger than %d pixels", ErrEMFNotSupported, emfMaxPixels)
+	}
+
+	stride := (width*bitCount + 31) / 32 * 4
+	if stride*height > len(bits) {
+		return nil, false, fmt.Errorf("%w: truncated bitmap", ErrInvalidEMF)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		line := bits[y*stride : (y+1)*stride]
+		row := y
+		if bottomUp {
+			row = height - 1 - y
+		}
+
+		for x := 0; x < width; x++ {
+			img.Set(x, row, getDIBPixel(line, x, bitCount, palette))
+		}
+	}
+
+	return img, bottomUp, nil
+}
+
+func getDIBPalette(bmi []byte, bitCount int) ([]color.RGBA, error) {
+	switch bitCount {
+	case 24, 32:
+		return nil, nil
+	case 1, 4, 8:
+	default:
+		return nil, fmt.Errorf("%w: %d bits per pixel", ErrEMFNotSupported, bitCount)
+	}
+
+	headerSize := int(readUint32(bmi, 0))
+	colors := int(readUint32(bmi, 32))
+	if colors == 0 {
+		colors = 1 << bitCount
+	}
+
+	if headerSize+colors*4 > len(bmi) {
+		return nil, fmt.Errorf("%w: truncated palette", ErrInvalidEMF)
+	}
+
+	palette := make([]color.RGBA, colors)
+	for i := range palette {
+		entry := bmi[headerSize+i*4:]
+		palette[i] = color.RGBA{R: entry[2], G: entry[1], B: entry[0], A: 255}
+	}
+
+	return palette, nil
+}
+
+func getDIBPixel(line []byte, x, bitCount int, palette []color.RGBA) color.RGBA {
+	switch bitCount {
+	case 32:
+		return color.RGBA{R: line[x*4+2], G: line[x*4+1], B: line[x*4], A: 255}
+	case 24:
+		return color.RGBA{R: line[x*3+2], G: line[x*3+1], B: line[x*3], A: 255}
+	}
+
+	perByte := 8 / bitCount
+	shift := 8 - bitCount*(x%perByte+1)
+	index := int(line[x/perByte]>>shift) & (1<<bitCount - 1)
+	if index >= len(palette) {
+		return color.RGBA{A: 255}
+	}
+
+	return palette[index]
+}
+
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func readUint32(b []byte, offset int) uint32 {
+	return binary.LittleEndian.Uint32(b[offset:])
+}
+
+func readInt32(b []byte, offset int) int32 {
+	return int32(binary.LittleEndian.Uint32(b[offset:]))
+}
+
+// isValidSize returns true when an image of the width and height has at most emfMaxPixels.
+func isValidSize(width, height int) bool {
+	return width > 0 && height > 0 && width <= emfMaxPixels/height
+}
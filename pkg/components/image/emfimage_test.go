@@ -0,0 +1,219 @@
+package image_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	mimage "github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewFromEMF(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := mimage.NewFromEMF(buildEMF(stretchDIBitsRecord()))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_emf_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := mimage.NewFromEMF(buildEMF(stretchDIBitsRecord()), fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_emf_custom_prop.json")
+	})
+	t.Run("when emf cannot be converted, should create text with error", func(t *testing.T) {
+		// Act
+		sut := mimage.NewFromEMF(buildEMF(emfRecord(43, make([]byte, 16))))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_emf_not_supported.json")
+	})
+}
+
+func TestNewFromEMFCol(t *testing.T) {
+	// Act
+	sut := mimage.NewFromEMFCol(12, buildEMF(stretchDIBitsRecord()))
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_emf_col.json")
+}
+
+func TestNewFromEMFRow(t *testing.T) {
+	// Act
+	sut := mimage.NewFromEMFRow(10, buildEMF(stretchDIBitsRecord()))
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_emf_row.json")
+}
+
+func TestEMFToPNG(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	black := color.RGBA{A: 255}
+
+	t.Run("when emf has stretched bitmap, should scale it to the destination", func(t *testing.T) {
+		// Act
+		pngBytes, err := mimage.EMFToPNG(buildEMF(emfRecord(17, le(1)), stretchDIBitsRecord()))
+
+		// Assert
+		assert.Nil(t, err)
+		img := decodePNG(t, pngBytes)
+		assert.Equal(t, image.Rect(0, 0, 4, 2), img.Bounds())
+		assert.Equal(t, red, color.RGBAModel.Convert(img.At(0, 0)))
+		assert.Equal(t, red, color.RGBAModel.Convert(img.At(1, 1)))
+		assert.Equal(t, blue, color.RGBAModel.Convert(img.At(2, 0)))
+		assert.Equal(t, blue, color.RGBAModel.Convert(img.At(3, 1)))
+	})
+	t.Run("when emf has bit block transfer of palette bitmap, should draw it", func(t *testing.T) {
+		// Act
+		pngBytes, err := mimage.EMFToPNG(buildEMF(bitBltRecord()))
+
+		// Assert
+		assert.Nil(t, err)
+		img := decodePNG(t, pngBytes)
+		assert.Equal(t, white, color.RGBAModel.Convert(img.At(0, 0)))
+		assert.Equal(t, black, color.RGBAModel.Convert(img.At(1, 0)))
+		assert.Equal(t, black, color.RGBAModel.Convert(img.At(0, 1)))
+		assert.Equal(t, white, color.RGBAModel.Convert(img.At(1, 1)))
+	})
+	t.Run("when bytes are not emf, should return error", func(t *testing.T) {
+		// Act
+		pngBytes, err := mimage.EMFToPNG([]byte{1, 2, 3})
+
+		// Assert
+		assert.ErrorIs(t, err, mimage.ErrInvalidEMF)
+		assert.Nil(t, pngBytes)
+	})
+	t.Run("when emf has no end of file, should return error", func(t *testing.T) {
+		// Arrange
+		emf := buildEMF()
+
+		// Act
+		_, err := mimage.EMFToPNG(emf[:len(emf)-20])
+
+		// Assert
+		assert.ErrorIs(t, err, mimage.ErrInvalidEMF)
+	})
+	t.Run("when emf has vector records, should return error", func(t *testing.T) {
+		// Act
+		_, err := mimage.EMFToPNG(buildEMF(emfRecord(43, make([]byte, 16))))
+
+		// Assert
+		assert.ErrorIs(t, err, mimage.ErrEMFNotSupported)
+	})
+	t.Run("when emf bounds are empty or inverted, should return error", func(t *testing.T) {
+		for _, bounds := range [][4]int32{{10, 20, 10, 21}, {10, 20, 13, 20}, {math.MaxInt32, math.MaxInt32, math.MinInt32, math.MinInt32}} {
+			// Act
+			_, err := mimage.EMFToPNG(buildEMFWithBounds(bounds))
+
+			// Assert
+			assert.ErrorIs(t, err, mimage.ErrInvalidEMF)
+		}
+	})
+	t.Run("when emf bounds are too large, should return error", func(t *testing.T) {
+		for _, bounds := range [][4]int32{{0, 0, 20000, 20000}, {math.MinInt32, math.MinInt32, math.MaxInt32, math.MaxInt32}} {
+			// Act
+			_, err := mimage.EMFToPNG(buildEMFWithBounds(bounds))
+
+			// Assert
+			assert.ErrorIs(t, err, mimage.ErrEMFNotSupported)
+		}
+	})
+	t.Run("when destination is out of the bounds, should draw only the visible pixels", func(t *testing.T) {
+		// Arrange
+		record := stretchDIBitsRecord()
+		copy(record[24:], le(8, 20))
+
+		// Act
+		pngBytes, err := mimage.EMFToPNG(buildEMF(record))
+
+		// Assert
+		assert.Nil(t, err)
+		img := decodePNG(t, pngBytes)
+		assert.Equal(t, blue, color.RGBAModel.Convert(img.At(0, 0)))
+		assert.Equal(t, color.RGBA{}, color.RGBAModel.Convert(img.At(2, 0)))
+	})
+	t.Run("when source is out of the bitmap, should return error", func(t *testing.T) {
+		// Arrange
+		record := stretchDIBitsRecord()
+		copy(record[40:], le(3, 1))
+
+		// Act
+		_, err := mimage.EMFToPNG(buildEMF(record))
+
+		// Assert
+		assert.ErrorIs(t, err, mimage.ErrInvalidEMF)
+	})
+}
+
+// buildEMF creates an EMF with bounds from (10, 20) to (13, 21) with the records between the header and the EOF.
+func buildEMF(records ...[]byte) []byte {
+	return buildEMFWithBounds([4]int32{10, 20, 13, 21}, records...)
+}
+
+// buildEMFWithBounds creates an EMF with the left, top, right and bottom bounds with the records between
+// the header and the EOF.
+func buildEMFWithBounds(bounds [4]int32, records ...[]byte) []byte {
+	header := make([]byte, 80)
+	copy(header, le(bounds[0], bounds[1], bounds[2], bounds[3]))
+	binary.LittleEndian.PutUint32(header[32:], 0x464D4520)
+
+	emf := emfRecord(1, header)
+	for _, record := range records {
+		emf = append(emf, record...)
+	}
+
+	return append(emf, emfRecord(14, make([]byte, 12))...)
+}
+
+// stretchDIBitsRecord stretches a bottom-up bitmap of 2x1 pixels, red and blue, on the whole bounds.
+func stretchDIBitsRecord() []byte {
+	fields := le(10, 20, 13, 21, 10, 20, 0, 0, 2, 1, 80, 40, 120, 8, 0, 0xCC0020, 4, 2)
+	bmi := append(le(40, 2, 1), 1, 0, 24, 0)
+	bmi = append(bmi, make([]byte, 24)...)
+	bits := []byte{0, 0, 255, 255, 0, 0, 0, 0}
+
+	return emfRecord(81, append(append(fields, bmi...), bits...))
+}
+
+// bitBltRecord copies a top-down 1 bit bitmap of 2x2 pixels, white and black, to the top left of the bounds.
+func bitBltRecord() []byte {
+	fields := le(10, 20, 13, 21, 10, 20, 2, 2, 0xCC0020, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 100, 48, 148, 8)
+	bmi := append(le(40, 2, -2), 1, 0, 1, 0)
+	bmi = append(bmi, le(0, 0, 0, 0, 2, 0)...)
+	bmi = append(bmi, 0, 0, 0, 0, 255, 255, 255, 0)
+	bits := []byte{0b10000000, 0, 0, 0, 0b01000000, 0, 0, 0}
+
+	return emfRecord(76, append(append(fields, bmi...), bits...))
+}
+
+func emfRecord(recordType uint32, data []byte) []byte {
+	return append(le(int32(recordType), int32(len(data)+8)), data...)
+}
+
+func le(values ...int32) []byte {
+	b := make([]byte, 4*len(values))
+	for i, value := range values {
+		binary.LittleEndian.PutUint32(b[i*4:], uint32(value))
+	}
+
+	return b
+}
+
+func decodePNG(t *testing.T, b []byte) image.Image {
+	img, err := png.Decode(bytes.NewReader(b))
+	assert.Nil(t, err)
+
+	return img
+}
@@ -0,0 +1,99 @@
+package image
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/png"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ErrGIFFrameOutOfRange is returned when the requested frame does not exist in the GIF.
+var ErrGIFFrameOutOfRange = errors.New("gif frame index is out of range")
+
+// NewGIF is responsible to create an instance of an Image from a frame of a GIF.
+// The frame is composed over the previous ones, so partial frames are rendered as they appear in the animation.
+// When the GIF cannot be decoded or the frame does not exist, the error is rendered instead of the image.
+func NewGIF(bytes []byte, frameIndex int, ps ...props.Rect) core.Component {
+	frame, err := GIFFrame(bytes, frameIndex)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return NewFromBytes(frame, extension.Png, ps...)
+}
+
+// NewGIFCol is responsible to create an instance of an Image from a frame of a GIF wrapped in a Col.
+func NewGIFCol(size int, bytes []byte, frameIndex int, ps ...props.Rect) core.Col {
+	image := NewGIF(bytes, frameIndex, ps...)
+	return col.New(size).Add(image)
+}
+
+// NewGIFRow is responsible to create an instance of an Image from a frame of a GIF wrapped in a Row.
+func NewGIFRow(height float64, bytes []byte, frameIndex int, ps ...props.Rect) core.Row {
+	image := NewGIF(bytes, frameIndex, ps...)
+	c := col.New().Add(image)
+	return row.New(height).Add(c)
+}
+
+// GIFFrame decodes a GIF and returns the frame at frameIndex encoded as PNG.
+func GIFFrame(gifBytes []byte, frameIndex int) ([]byte, error) {
+	g, err := gif.DecodeAll(bytes.NewReader(gifBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	if frameIndex < 0 || frameIndex >= len(g.Image) {
+		return nil, fmt.Errorf("%w: index %d, gif has %d frames", ErrGIFFrameOutOfRange, frameIndex, len(g.Image))
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, composeGIFFrame(g, frameIndex)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// composeGIFFrame draws the frames until frameIndex over a canvas, respecting the disposal of each one.
+func composeGIFFrame(g *gif.GIF, frameIndex int) image.Image {
+	bounds := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	if bounds.Empty() {
+		bounds = g.Image[0].Bounds()
+	}
+
+	canvas := image.NewRGBA(bounds)
+
+	for i := 0; i <= frameIndex; i++ {
+		frame := g.Image[i]
+		if i == frameIndex {
+			draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+			break
+		}
+
+		disposal := byte(0)
+		if i < len(g.Disposal) {
+			disposal = g.Disposal[i]
+		}
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, frame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+		default:
+			draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+		}
+	}
+
+	return canvas
+}
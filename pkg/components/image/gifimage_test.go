@@ -0,0 +1,110 @@
+package image_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	mimage "github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewGIF(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := mimage.NewGIF(buildGIF(t), 1)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_gif_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := mimage.NewGIF(buildGIF(t), 1, fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_gif_custom_prop.json")
+	})
+	t.Run("when frame is out of range, should create text with error", func(t *testing.T) {
+		// Act
+		sut := mimage.NewGIF(buildGIF(t), 5)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_gif_frame_out_of_range.json")
+	})
+}
+
+func TestNewGIFCol(t *testing.T) {
+	// Act
+	sut := mimage.NewGIFCol(12, buildGIF(t), 0)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_gif_col.json")
+}
+
+func TestNewGIFRow(t *testing.T) {
+	// Act
+	sut := mimage.NewGIFRow(10, buildGIF(t), 0)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_gif_row.json")
+}
+
+func TestGIFFrame(t *testing.T) {
+	t.Run("when frame exists, should return the frame composed as png", func(t *testing.T) {
+		// Act
+		bytesFrame, err := mimage.GIFFrame(buildGIF(t), 1)
+
+		// Assert
+		assert.Nil(t, err)
+		frame, err := png.Decode(bytes.NewReader(bytesFrame))
+		assert.Nil(t, err)
+		assert.Equal(t, image.Rect(0, 0, 4, 4), frame.Bounds())
+		assert.Equal(t, color.RGBAModel.Convert(color.RGBA{R: 255, A: 255}), color.RGBAModel.Convert(frame.At(0, 0)))
+		assert.Equal(t, color.RGBAModel.Convert(color.RGBA{B: 255, A: 255}), color.RGBAModel.Convert(frame.At(3, 3)))
+	})
+	t.Run("when frame index is negative, should return error", func(t *testing.T) {
+		// Act
+		bytesFrame, err := mimage.GIFFrame(buildGIF(t), -1)
+
+		// Assert
+		assert.True(t, errors.Is(err, mimage.ErrGIFFrameOutOfRange))
+		assert.Nil(t, bytesFrame)
+	})
+	t.Run("when bytes are not a gif, should return error", func(t *testing.T) {
+		// Act
+		_, err := mimage.GIFFrame([]byte{1, 2, 3}, 0)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+}
+
+// buildGIF creates a red 4x4 GIF with a second frame painting only the bottom right pixel in blue.
+func buildGIF(t *testing.T) []byte {
+	palette := color.Palette{color.RGBA{R: 255, A: 255}, color.RGBA{B: 255, A: 255}}
+
+	first := image.NewPaletted(image.Rect(0, 0, 4, 4), palette)
+	second := image.NewPaletted(image.Rect(3, 3, 4, 4), palette)
+	second.SetColorIndex(3, 3, 1)
+
+	var buf bytes.Buffer
+	err := gif.EncodeAll(&buf, &gif.GIF{
+		Image: []*image.Paletted{first, second},
+		Delay: []int{10, 10},
+		Config: image.Config{
+			ColorModel: palette,
+			Width:      4,
+			Height:     4,
+		},
+	})
+	assert.Nil(t, err)
+
+	return buf.Bytes()
+}
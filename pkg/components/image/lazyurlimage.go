@@ -0,0 +1,187 @@
+package image
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	defaultURLTimeout = 10 * time.Second
+	fallbackLabel     = "could not load image"
+	// URLImageCacheSize is how many images fetched by NewFromURL are kept, the least recently used is
+	// removed when another url is fetched.
+	URLImageCacheSize = 64
+)
+
+// urlImages are the images already fetched by NewFromURL, by url.
+var urlImages = newImageCache(URLImageCacheSize)
+
+// cachedImage is an image fetched from an url.
+type cachedImage struct {
+	url       string
+	bytes     []byte
+	extension extension.Type
+}
+
+// imageCache is a least recently used cache of the images fetched from urls.
+type imageCache struct {
+	mutex    sync.Mutex
+	size     int
+	images   map[string]*list.Element
+	lastUsed *list.List
+}
+
+func newImageCache(size int) *imageCache {
+	return &imageCache{
+		size:     size,
+		images:   make(map[string]*list.Element),
+		lastUsed: list.New(),
+	}
+}
+
+func (c *imageCache) get(url string) (*cachedImage, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.images[url]
+	if !ok {
+		return nil, false
+	}
+
+	c.lastUsed.MoveToFront(element)
+	return element.Value.(*cachedImage), true
+}
+
+// add stores the image, unless another one was stored for the url while it was fetched, and returns the stored one.
+func (c *imageCache) add(image *cachedImage) *cachedImage {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.images[image.url]; ok {
+		c.lastUsed.MoveToFront(element)
+		return element.Value.(*cachedImage)
+	}
+
+	c.images[image.url] = c.lastUsed.PushFront(image)
+	if c.lastUsed.Len() > c.size {
+		oldest := c.lastUsed.Back()
+		c.lastUsed.Remove(oldest)
+		delete(c.images, oldest.Value.(*cachedImage).url)
+	}
+
+	return image
+}
+
+// URLImage is an Image fetched from an url when it's rendered.
+type URLImage interface {
+	core.Component
+	WithHTTPClient(client *http.Client) URLImage
+}
+
+type urlImage struct {
+	url    string
+	client *http.Client
+	prop   props.Rect
+	config *entity.Config
+}
+
+// NewFromURL is responsible to create an instance of an Image fetched from an url. Unlike NewFromHTTPURL, the image
+// is only downloaded on the first render and the bytes are cached by url, so every image with the same url, in any
+// document, uses the same download while it's one of the URLImageCacheSize urls used last. The extension is detected
+// from the Content-Type header of the response and images bigger than MaxURLImageSize are not loaded.
+// The request is made with a 10 seconds timeout, WithHTTPClient defines another client. When the image cannot
+// be loaded, the error is rendered, or a gray placeholder when props.Rect.FallbackOnError is true.
+func NewFromURL(url string, ps ...props.Rect) URLImage {
+	prop := props.Rect{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &urlImage{
+		url:  url,
+		prop: prop,
+	}
+}
+
+// NewFromURLCol is responsible to create an instance of an Image fetched from an url wrapped in a Col.
+func NewFromURLCol(size int, url string, ps ...props.Rect) core.Col {
+	image := NewFromURL(url, ps...)
+	return col.New(size).Add(image)
+}
+
+// NewFromURLRow is responsible to create an instance of an Image fetched from an url wrapped in a Row.
+func NewFromURLRow(height float64, url string, ps ...props.Rect) core.Row {
+	image := NewFromURL(url, ps...)
+	c := col.New().Add(image)
+	return row.New(height).Add(c)
+}
+
+// WithHTTPClient defines the client used to fetch the image, ex: to define other timeouts.
+func (u *urlImage) WithHTTPClient(client *http.Client) URLImage {
+	u.client = client
+	return u
+}
+
+// Render renders an Image into a PDF context, fetching it on the first render of the url.
+func (u *urlImage) Render(provider core.Provider, cell *entity.Cell) {
+	image, err := u.load()
+	if err == nil {
+		provider.AddImageFromBytes(image.bytes, cell, &u.prop, image.extension)
+		return
+	}
+
+	if u.prop.FallbackOnError {
+		NewPlaceholder(0, 0, fallbackLabel).Render(provider, cell)
+		return
+	}
+
+	text.New(err.Error(), *merror.DefaultErrorText).Render(provider, cell)
+}
+
+// GetStructure returns the Structure of an Image.
+func (u *urlImage) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "urlImage",
+		Value:   u.url,
+		Details: u.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the pdf config.
+func (u *urlImage) SetConfig(config *entity.Config) {
+	u.config = config
+}
+
+func (u *urlImage) load() (*cachedImage, error) {
+	if cached, ok := urlImages.get(u.url); ok {
+		return cached, nil
+	}
+
+	client := u.client
+	if client == nil {
+		client = newHTTPClient(defaultURLTimeout)
+	}
+
+	bytes, ext, err := fetch(client, u.url)
+	if err != nil {
+		return nil, err
+	}
+
+	return urlImages.add(&cachedImage{url: u.url, bytes: bytes, extension: ext}), nil
+}
@@ -0,0 +1,185 @@
+package image_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+const logoURL = "https://example.com/logo.png"
+
+// roundTripperFunc is a http.RoundTripper defined by a function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestNewFromURL(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := image.NewFromURL(logoURL)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_lazy_url_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := image.NewFromURL(logoURL, props.Rect{Percent: 50, Center: true, FallbackOnError: true})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_lazy_url_custom_prop.json")
+	})
+}
+
+func TestNewFromURLCol(t *testing.T) {
+	// Act
+	sut := image.NewFromURLCol(12, logoURL)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_lazy_url_col.json")
+}
+
+func TestNewFromURLRow(t *testing.T) {
+	// Act
+	sut := image.NewFromURLRow(10, logoURL)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_lazy_url_row.json")
+}
+
+func TestURLImage_Render(t *testing.T) {
+	t.Run("when url is rendered many times, should fetch it once", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte{1, 2, 3})
+		}))
+		t.Cleanup(server.Close)
+
+		cell := &entity.Cell{Width: 10, Height: 10}
+		first := image.NewFromURL(server.URL + "/logo.png")
+		second := image.NewFromURL(server.URL + "/logo.png")
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddImageFromBytes([]byte{1, 2, 3}, cell, mock.Anything, extension.Png)
+
+		// Act
+		first.Render(provider, cell)
+		first.Render(provider, cell)
+		second.Render(provider, cell)
+
+		// Assert
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+		provider.AssertNumberOfCalls(t, "AddImageFromBytes", 3)
+	})
+	t.Run("when more urls than the cache size are rendered, should fetch the least recently used again", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte{1, 2, 3})
+		}))
+		t.Cleanup(server.Close)
+
+		cell := &entity.Cell{Width: 10, Height: 10}
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddImageFromBytes([]byte{1, 2, 3}, cell, mock.Anything, extension.Png)
+
+		// Act
+		image.NewFromURL(server.URL+"/first.png").Render(provider, cell)
+		for i := 0; i < image.URLImageCacheSize; i++ {
+			image.NewFromURL(server.URL+"/"+strconv.Itoa(i)+".png").Render(provider, cell)
+		}
+		image.NewFromURL(server.URL+"/first.png").Render(provider, cell)
+
+		// Assert
+		assert.Equal(t, int32(image.URLImageCacheSize+2), atomic.LoadInt32(&requests))
+	})
+	t.Run("when http client is sent, should fetch with the client", func(t *testing.T) {
+		// Arrange
+		var used bool
+		client := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			used = true
+			recorder := httptest.NewRecorder()
+			recorder.Header().Set("Content-Type", "image/jpeg")
+			_, _ = recorder.Write([]byte{4, 5, 6})
+			return recorder.Result(), nil
+		})}
+
+		cell := &entity.Cell{Width: 10, Height: 10}
+		sut := image.NewFromURL("https://client.example.com/photo.jpg").WithHTTPClient(client)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddImageFromBytes([]byte{4, 5, 6}, cell, mock.Anything, extension.Jpeg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		assert.True(t, used)
+	})
+	t.Run("when fetch fails, should write the error and fetch again on next render", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		t.Cleanup(server.Close)
+
+		cell := &entity.Cell{Width: 10, Height: 10}
+		sut := image.NewFromURL(server.URL + "/missing.png")
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText(mock.MatchedBy(func(value string) bool {
+			return assert.Contains(t, value, image.ErrHTTPStatus.Error())
+		}), cell, mock.Anything)
+
+		// Act
+		sut.Render(provider, cell)
+		sut.Render(provider, cell)
+
+		// Assert
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+		provider.AssertNotCalled(t, "AddImageFromBytes", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("when fetch fails and fallback on error is true, should draw a placeholder", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+		}))
+		t.Cleanup(server.Close)
+
+		cell := &entity.Cell{Width: 10, Height: 10}
+		sut := image.NewFromURL(server.URL+"/page", props.Rect{FallbackOnError: true})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(2)
+		provider.EXPECT().AddText("could not load image", mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawRect", 1)
+		provider.AssertNumberOfCalls(t, "DrawLine", 2)
+	})
+}
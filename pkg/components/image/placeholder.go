@@ -0,0 +1,128 @@
+package image
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/border"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+var (
+	placeholderBackgroundColor = props.Color{Red: 220, Green: 220, Blue: 220}
+	placeholderStrokeColor     = props.Color{Red: 150, Green: 150, Blue: 150}
+	placeholderFont            = props.Font{
+		Family: fontfamily.Arial,
+		Style:  fontstyle.Normal,
+		Size:   10,
+		Color:  &props.Color{Red: 90, Green: 90, Blue: 90},
+	}
+)
+
+type placeholder struct {
+	width  float64
+	height float64
+	label  string
+	config *entity.Config
+}
+
+// NewPlaceholder is responsible to create a gray rectangle crossed by its diagonals, used as an image in wireframes.
+// The rectangle is centered in the cell and limited to the cell size, width or height equal to zero fills the cell.
+// The label is optional and written in the center of the rectangle.
+func NewPlaceholder(width, height float64, label string) core.Component {
+	return &placeholder{
+		width:  width,
+		height: height,
+		label:  label,
+	}
+}
+
+// NewPlaceholderCol is responsible to create a placeholder image wrapped in a Col.
+func NewPlaceholderCol(size int, width, height float64, label string) core.Col {
+	placeholder := NewPlaceholder(width, height, label)
+	return col.New(size).Add(placeholder)
+}
+
+// NewPlaceholderRow is responsible to create a placeholder image wrapped in a Row.
+func NewPlaceholderRow(rowHeight, width, height float64, label string) core.Row {
+	placeholder := NewPlaceholder(width, height, label)
+	c := col.New().Add(placeholder)
+	return row.New(rowHeight).Add(c)
+}
+
+// Render renders a placeholder image into a PDF context.
+func (p *placeholder) Render(provider core.Provider, cell *entity.Cell) {
+	box := p.getBox(cell)
+
+	provider.DrawRect(&box, &props.Cell{
+		BackgroundColor: &placeholderBackgroundColor,
+		BorderType:      border.Full,
+		BorderColor:     &placeholderStrokeColor,
+	})
+
+	lineProp := &props.Line{
+		Color:     &placeholderStrokeColor,
+		Style:     linestyle.Solid,
+		Thickness: linestyle.DefaultLineThickness,
+	}
+
+	provider.DrawLine(entity.Point{X: box.X, Y: box.Y}, entity.Point{X: box.X + box.Width, Y: box.Y + box.Height}, lineProp)
+	provider.DrawLine(entity.Point{X: box.X + box.Width, Y: box.Y}, entity.Point{X: box.X, Y: box.Y + box.Height}, lineProp)
+
+	if p.label == "" {
+		return
+	}
+
+	labelProp := &props.Text{
+		Top:   (box.Height - provider.GetTextHeight(&placeholderFont)) / 2.0,
+		Align: align.Center,
+	}
+	labelProp.MakeValid(&placeholderFont)
+
+	provider.AddText(p.label, &box, labelProp)
+}
+
+// GetStructure returns the Structure of a placeholder image.
+func (p *placeholder) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:  "placeholder",
+		Value: p.label,
+		Details: map[string]interface{}{
+			"prop_width":  p.width,
+			"prop_height": p.height,
+		},
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the pdf config.
+func (p *placeholder) SetConfig(config *entity.Config) {
+	p.config = config
+}
+
+func (p *placeholder) getBox(cell *entity.Cell) entity.Cell {
+	width := p.width
+	if width <= 0 || width > cell.Width {
+		width = cell.Width
+	}
+
+	height := p.height
+	if height <= 0 || height > cell.Height {
+		height = cell.Height
+	}
+
+	return entity.Cell{
+		X:      cell.X + (cell.Width-width)/2.0,
+		Y:      cell.Y + (cell.Height-height)/2.0,
+		Width:  width,
+		Height: height,
+	}
+}
@@ -0,0 +1,87 @@
+package image_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewPlaceholder(t *testing.T) {
+	// Act
+	sut := image.NewPlaceholder(40, 30, "Logo")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_placeholder.json")
+}
+
+func TestNewPlaceholderCol(t *testing.T) {
+	// Act
+	sut := image.NewPlaceholderCol(12, 40, 30, "Logo")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_placeholder_col.json")
+}
+
+func TestNewPlaceholderRow(t *testing.T) {
+	// Act
+	sut := image.NewPlaceholderRow(10, 40, 30, "Logo")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_placeholder_row.json")
+}
+
+func TestPlaceholder_Render(t *testing.T) {
+	t.Run("when size fits the cell, should draw centered rectangle, diagonals and label", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		box := &entity.Cell{X: 40, Y: 75, Width: 40, Height: 30}
+		sut := image.NewPlaceholder(40, 30, "Logo")
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawRect(box, mock.Anything)
+		provider.EXPECT().DrawLine(entity.Point{X: 40, Y: 75}, entity.Point{X: 80, Y: 105}, mock.Anything)
+		provider.EXPECT().DrawLine(entity.Point{X: 80, Y: 75}, entity.Point{X: 40, Y: 105}, mock.Anything)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText("Logo", box, mock.MatchedBy(func(prop *props.Text) bool {
+			return prop.Top == 13 && prop.Align == align.Center
+		}))
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawLine", 2)
+	})
+	t.Run("when size is zero and label is empty, should fill the cell without label", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := image.NewPlaceholder(0, 0, "")
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawRect(&cell, mock.Anything)
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "AddText", mock.Anything, mock.Anything, mock.Anything)
+		provider.AssertNumberOfCalls(t, "DrawLine", 2)
+	})
+}
+
+func TestPlaceholder_SetConfig(t *testing.T) {
+	// Arrange
+	sut := image.NewPlaceholder(40, 30, "Logo")
+
+	// Act
+	sut.SetConfig(&entity.Config{})
+}
@@ -0,0 +1,58 @@
+package image
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// NewFromReader is responsible to create an instance of an Image read from a reader.
+// All the bytes are read at construction time and the extension is detected from the content,
+// it returns ErrUnsupportedContentType when the content is not a png or jpeg image.
+func NewFromReader(r io.Reader, ps ...props.Rect) (core.Component, error) {
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading image: %w", err)
+	}
+
+	contentType := http.DetectContentType(bytes)
+	ext, ok := contentTypes[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedContentType, contentType)
+	}
+
+	return NewFromBytes(bytes, ext, ps...), nil
+}
+
+// NewFromReaderCol is responsible to create an instance of an Image read from a reader wrapped in a Col.
+func NewFromReaderCol(size int, r io.Reader, ps ...props.Rect) (core.Col, error) {
+	image, err := NewFromReader(r, ps...)
+	if err != nil {
+		return nil, err
+	}
+
+	return col.New(size).Add(image), nil
+}
+
+// NewFromReaderRow is responsible to create an instance of an Image read from a reader wrapped in a Row.
+func NewFromReaderRow(height float64, r io.Reader, ps ...props.Rect) (core.Row, error) {
+	image, err := NewFromReader(r, ps...)
+	if err != nil {
+		return nil, err
+	}
+
+	c := col.New().Add(image)
+	return row.New(height).Add(c), nil
+}
+
+// NewFromStdin is responsible to create an instance of an Image read from the standard input,
+// like NewFromReader, so images can be piped to a command.
+func NewFromStdin(ps ...props.Rect) (core.Component, error) {
+	return NewFromReader(os.Stdin, ps...)
+}
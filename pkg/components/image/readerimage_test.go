@@ -0,0 +1,99 @@
+package image_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var (
+	pngHeader  = []byte("\x89PNG\r\n\x1a\n")
+	jpegHeader = []byte("\xff\xd8\xff\xe0")
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func TestNewFromReader(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromReader(bytes.NewReader(pngHeader))
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_reader_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromReader(bytes.NewReader(jpegHeader), fixture.RectProp())
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_reader_custom_prop.json")
+	})
+	t.Run("when content is not an image, should return error", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromReader(strings.NewReader("text"))
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorIs(t, err, image.ErrUnsupportedContentType)
+	})
+	t.Run("when reader fails, should return error", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromReader(failingReader{})
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorContains(t, err, "broken pipe")
+	})
+}
+
+func TestNewFromReaderCol(t *testing.T) {
+	// Act
+	sut, err := image.NewFromReaderCol(12, bytes.NewReader(pngHeader))
+
+	// Assert
+	assert.Nil(t, err)
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_reader_col.json")
+}
+
+func TestNewFromReaderRow(t *testing.T) {
+	// Act
+	sut, err := image.NewFromReaderRow(10, bytes.NewReader(pngHeader))
+
+	// Assert
+	assert.Nil(t, err)
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_reader_row.json")
+}
+
+func TestNewFromStdin(t *testing.T) {
+	// Arrange
+	r, w, err := os.Pipe()
+	assert.Nil(t, err)
+
+	stdin := os.Stdin
+	os.Stdin = r
+	t.Cleanup(func() { os.Stdin = stdin })
+
+	_, _ = w.Write(pngHeader)
+	_ = w.Close()
+
+	// Act
+	sut, err := image.NewFromStdin()
+
+	// Assert
+	assert.Nil(t, err)
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_reader_default_prop.json")
+}
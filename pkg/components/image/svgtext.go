@@ -0,0 +1,154 @@
+package image
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/gobolditalic"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/gomonobold"
+	"golang.org/x/image/font/gofont/gomonobolditalic"
+	"golang.org/x/image/font/gofont/gomonoitalic"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ErrEmptyText is returned when the text to be drawn as an image is empty.
+var ErrEmptyText = errors.New("text image cannot be empty")
+
+const (
+	// TextImageDPI is the resolution in which the texts are drawn as images.
+	TextImageDPI         = 300.0
+	defaultTextImageSize = 10.0
+)
+
+// textImageFonts are the outlines of the Go fonts by style, the sans-serif ones are used
+// for all families except courier, which uses the monospaced ones.
+var textImageFonts = map[bool]map[fontstyle.Type][]byte{
+	false: {
+		fontstyle.Normal:     goregular.TTF,
+		fontstyle.Bold:       gobold.TTF,
+		fontstyle.Italic:     goitalic.TTF,
+		fontstyle.BoldItalic: gobolditalic.TTF,
+	},
+	true: {
+		fontstyle.Normal:     gomono.TTF,
+		fontstyle.Bold:       gomonobold.TTF,
+		fontstyle.Italic:     gomonoitalic.TTF,
+		fontstyle.BoldItalic: gomonobolditalic.TTF,
+	},
+}
+
+// NewSVGText is responsible to create an instance of an Image with the text drawn with vector outlines,
+// so it does not depend on the fonts of the PDF. The text is drawn at TextImageDPI and embedded as PNG,
+// the font family only selects between the sans-serif and the monospaced (courier) Go fonts. When bg is nil
+// the background is transparent and when fg is nil the text is black. When the text cannot be drawn,
+// the error is rendered instead of the image.
+func NewSVGText(value string, textFont props.Font, bg, fg *props.Color, ps ...props.Rect) core.Component {
+	pngBytes, err := TextToPNG(value, textFont, bg, fg)
+	if err != nil {
+		return text.New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return NewFromBytes(pngBytes, extension.Png, ps...)
+}
+
+// NewSVGTextCol is responsible to create an instance of an Image with a text wrapped in a Col.
+func NewSVGTextCol(size int, value string, textFont props.Font, bg, fg *props.Color, ps ...props.Rect) core.Col {
+	image := NewSVGText(value, textFont, bg, fg, ps...)
+	return col.New(size).Add(image)
+}
+
+// NewSVGTextRow is responsible to create an instance of an Image with a text wrapped in a Row.
+func NewSVGTextRow(height float64, value string, textFont props.Font, bg, fg *props.Color, ps ...props.Rect) core.Row {
+	image := NewSVGText(value, textFont, bg, fg, ps...)
+	c := col.New().Add(image)
+	return row.New(height).Add(c)
+}
+
+// TextToPNG draws the text, with a line for each "\n", in a PNG with the size of the text and a padding
+// of a quarter of the line height. The font size is in points, by default 10.
+func TextToPNG(value string, textFont props.Font, bg, fg *props.Color) ([]byte, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, ErrEmptyText
+	}
+
+	face, err := newTextImageFace(textFont)
+	if err != nil {
+		return nil, err
+	}
+	defer face.Close()
+
+	metrics := face.Metrics()
+	lineHeight := metrics.Height.Ceil()
+	padding := lineHeight / 4
+	lines := strings.Split(value, "\n")
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, font.MeasureString(face, line).Ceil())
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width+2*padding, lineHeight*len(lines)+2*padding))
+	if bg != nil {
+		draw.Draw(img, img.Bounds(), image.NewUniform(toRGBA(bg)), image.Point{}, draw.Src)
+	}
+
+	foreground := color.RGBA{A: 255}
+	if fg != nil {
+		foreground = toRGBA(fg)
+	}
+
+	drawer := &font.Drawer{Dst: img, Src: image.NewUniform(foreground), Face: face}
+	for i, line := range lines {
+		drawer.Dot = fixed.P(padding, padding+i*lineHeight+metrics.Ascent.Ceil())
+		drawer.DrawString(line)
+	}
+
+	return encodePNG(img)
+}
+
+func newTextImageFace(textFont props.Font) (font.Face, error) {
+	fonts := textImageFonts[textFont.Family == fontfamily.Courier]
+	ttf, ok := fonts[textFont.Style]
+	if !ok {
+		ttf = fonts[fontstyle.Normal]
+	}
+
+	parsed, err := opentype.Parse(ttf)
+	if err != nil {
+		return nil, err
+	}
+
+	size := textFont.Size
+	if size <= 0 {
+		size = defaultTextImageSize
+	}
+
+	return opentype.NewFace(parsed, &opentype.FaceOptions{
+		Size:    size,
+		DPI:     TextImageDPI,
+		Hinting: font.HintingFull,
+	})
+}
+
+func toRGBA(c *props.Color) color.RGBA {
+	return color.RGBA{R: uint8(c.Red), G: uint8(c.Green), B: uint8(c.Blue), A: 255}
+}
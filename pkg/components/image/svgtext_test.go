@@ -0,0 +1,114 @@
+package image_test
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	mimage "github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewSVGText(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := mimage.NewSVGText("Logo", props.Font{Size: 12}, nil, nil)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_svg_text_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := mimage.NewSVGText("Logo", props.Font{Size: 12}, nil, nil, fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_svg_text_custom_prop.json")
+	})
+	t.Run("when text is empty, should create text with error", func(t *testing.T) {
+		// Act
+		sut := mimage.NewSVGText(" ", props.Font{}, nil, nil)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_svg_text_empty.json")
+	})
+}
+
+func TestNewSVGTextCol(t *testing.T) {
+	// Act
+	sut := mimage.NewSVGTextCol(12, "Logo", props.Font{Size: 12}, nil, nil)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_svg_text_col.json")
+}
+
+func TestNewSVGTextRow(t *testing.T) {
+	// Act
+	sut := mimage.NewSVGTextRow(10, "Logo", props.Font{Size: 12}, nil, nil)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_svg_text_row.json")
+}
+
+func TestTextToPNG(t *testing.T) {
+	t.Run("when colors are sent, should draw the text over the background", func(t *testing.T) {
+		// Arrange
+		bg := &props.Color{Red: 0, Green: 0, Blue: 255}
+		fg := &props.Color{Red: 255, Green: 255, Blue: 255}
+
+		// Act
+		pngBytes, err := mimage.TextToPNG("Logo", props.Font{Size: 10, Style: fontstyle.Bold}, bg, fg)
+
+		// Assert
+		assert.Nil(t, err)
+		img, err := png.Decode(bytes.NewReader(pngBytes))
+		assert.Nil(t, err)
+		assert.Equal(t, color.RGBA{B: 255, A: 255}, color.RGBAModel.Convert(img.At(0, 0)))
+
+		hasText := false
+		bounds := img.Bounds()
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				if color.RGBAModel.Convert(img.At(x, y)) == (color.RGBA{R: 255, G: 255, B: 255, A: 255}) {
+					hasText = true
+				}
+			}
+		}
+		assert.True(t, hasText)
+	})
+	t.Run("when background is nil, should be transparent", func(t *testing.T) {
+		// Act
+		pngBytes, err := mimage.TextToPNG("Logo", props.Font{}, nil, nil)
+
+		// Assert
+		assert.Nil(t, err)
+		img, err := png.Decode(bytes.NewReader(pngBytes))
+		assert.Nil(t, err)
+		_, _, _, alpha := img.At(0, 0).RGBA()
+		assert.Equal(t, uint32(0), alpha)
+	})
+	t.Run("when text has lines, should be taller than a single line", func(t *testing.T) {
+		// Act
+		single, _ := mimage.TextToPNG("Logo", props.Font{Family: fontfamily.Courier}, nil, nil)
+		multiple, _ := mimage.TextToPNG("Logo\nLogo", props.Font{Family: fontfamily.Courier}, nil, nil)
+
+		// Assert
+		singleImg, _ := png.Decode(bytes.NewReader(single))
+		multipleImg, _ := png.Decode(bytes.NewReader(multiple))
+		assert.Equal(t, singleImg.Bounds().Dx(), multipleImg.Bounds().Dx())
+		assert.Greater(t, multipleImg.Bounds().Dy(), singleImg.Bounds().Dy())
+	})
+	t.Run("when text is empty, should return error", func(t *testing.T) {
+		// Act
+		_, err := mimage.TextToPNG("", props.Font{}, nil, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, mimage.ErrEmptyText)
+	})
+}
@@ -0,0 +1,77 @@
+package image
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type tiledImage struct {
+	bytes     []byte
+	extension extension.Type
+	prop      props.Rect
+	config    *entity.Config
+}
+
+// NewTiled is responsible to create an instance of an Image repeated to fill the cell, used on patterned backgrounds.
+// Each tile has the image size scaled by props.Rect.Percent, and props.Rect.TileOffsetX and TileOffsetY shift the tiles.
+func NewTiled(bytes []byte, extension extension.Type, ps ...props.Rect) core.Component {
+	prop := props.Rect{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &tiledImage{
+		bytes:     bytes,
+		prop:      prop,
+		extension: extension,
+	}
+}
+
+// NewTiledCol is responsible to create an instance of a tiled Image wrapped in a Col.
+func NewTiledCol(size int, bytes []byte, extension extension.Type, ps ...props.Rect) core.Col {
+	image := NewTiled(bytes, extension, ps...)
+	return col.New(size).Add(image)
+}
+
+// NewTiledRow is responsible to create an instance of a tiled Image wrapped in a Row.
+func NewTiledRow(height float64, bytes []byte, extension extension.Type, ps ...props.Rect) core.Row {
+	image := NewTiled(bytes, extension, ps...)
+	c := col.New().Add(image)
+	return row.New(height).Add(c)
+}
+
+// Render renders a tiled Image into a PDF context.
+func (t *tiledImage) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddTiledImageFromBytes(t.bytes, cell, &t.prop, t.extension)
+}
+
+// GetStructure returns the Structure of a tiled Image.
+func (t *tiledImage) GetStructure() *node.Node[core.Structure] {
+	trimLength := 10
+	if len(t.bytes) < trimLength {
+		trimLength = len(t.bytes)
+	}
+
+	str := core.Structure{
+		Type:    "tiledImage",
+		Value:   t.bytes[:trimLength],
+		Details: t.prop.ToMap(),
+	}
+
+	str.Details["extension"] = t.extension
+	str.Details["bytes_size"] = len(t.bytes)
+
+	return node.New(str)
+}
+
+// SetConfig sets the pdf config.
+func (t *tiledImage) SetConfig(config *entity.Config) {
+	t.config = config
+}
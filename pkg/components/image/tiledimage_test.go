@@ -0,0 +1,75 @@
+package image_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewTiled(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := image.NewTiled([]byte{1, 2, 3}, extension.Png)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_tiled_image_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := image.NewTiled([]byte{1, 2, 3}, extension.Png, props.Rect{Percent: 50, TileOffsetX: 2, TileOffsetY: 3})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_tiled_image_custom_prop.json")
+	})
+}
+
+func TestNewTiledCol(t *testing.T) {
+	// Act
+	sut := image.NewTiledCol(12, []byte{1, 2, 3}, extension.Png)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_tiled_image_col.json")
+}
+
+func TestNewTiledRow(t *testing.T) {
+	// Act
+	sut := image.NewTiledRow(10, []byte{1, 2, 3}, extension.Png)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_tiled_image_row.json")
+}
+
+func TestTiledImage_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		bytes := []byte{1, 2, 3}
+		ext := extension.Png
+		cell := fixture.CellEntity()
+		prop := fixture.RectProp()
+		sut := image.NewTiled(bytes, ext, prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddTiledImageFromBytes(bytes, &cell, &prop, ext)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddTiledImageFromBytes", 1)
+	})
+}
+
+func TestTiledImage_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := image.NewTiled([]byte{1, 2, 3}, extension.Png)
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
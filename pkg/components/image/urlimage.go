@@ -0,0 +1,121 @@
+package image
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	maxRedirects = 5
+	// MaxURLImageSize is the biggest image, in bytes, downloaded from an url, a bigger response is not read
+	// and returns ErrImageTooLarge.
+	MaxURLImageSize = 32 << 20
+)
+
+var (
+	// ErrHTTPStatus is returned when the server answers the image request with a 4xx or 5xx status.
+	ErrHTTPStatus = errors.New("unexpected http status fetching image")
+	// ErrUnsupportedContentType is returned when the Content-Type of the response, or of the content read, is not a supported image.
+	ErrUnsupportedContentType = errors.New("unsupported image content type")
+	// ErrTooManyRedirects is returned when the image request is redirected more than 5 times.
+	ErrTooManyRedirects = errors.New("too many redirects fetching image")
+	// ErrImageTooLarge is returned when the image is bigger than MaxURLImageSize.
+	ErrImageTooLarge = errors.New("image too large")
+)
+
+var contentTypes = map[string]extension.Type{
+	"image/png":  extension.Png,
+	"image/jpeg": extension.Jpeg,
+	"image/jpg":  extension.Jpg,
+}
+
+// NewFromHTTPURL is responsible to create an instance of an Image fetched from an url.
+// The image is downloaded at construction time within the timeout and the extension is
+// detected from the Content-Type header of the response. Redirects are followed up to 5 times
+// and images bigger than MaxURLImageSize return ErrImageTooLarge.
+func NewFromHTTPURL(url string, timeout time.Duration, ps ...props.Rect) (core.Component, error) {
+	bytes, ext, err := fetch(newHTTPClient(timeout), url)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFromBytes(bytes, ext, ps...), nil
+}
+
+// NewFromHTTPURLCol is responsible to create an instance of an Image fetched from an url wrapped in a Col.
+func NewFromHTTPURLCol(size int, url string, timeout time.Duration, ps ...props.Rect) (core.Col, error) {
+	image, err := NewFromHTTPURL(url, timeout, ps...)
+	if err != nil {
+		return nil, err
+	}
+
+	return col.New(size).Add(image), nil
+}
+
+// NewFromHTTPURLRow is responsible to create an instance of an Image fetched from an url wrapped in a Row.
+func NewFromHTTPURLRow(height float64, url string, timeout time.Duration, ps ...props.Rect) (core.Row, error) {
+	image, err := NewFromHTTPURL(url, timeout, ps...)
+	if err != nil {
+		return nil, err
+	}
+
+	c := col.New().Add(image)
+	return row.New(height).Add(c), nil
+}
+
+// newHTTPClient returns a client which follows redirects up to 5 times.
+func newHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(_ *http.Request, via []*http.Request) error {
+			if len(via) > maxRedirects {
+				return ErrTooManyRedirects
+			}
+			return nil
+		},
+	}
+}
+
+func fetch(client *http.Client, url string) ([]byte, extension.Type, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, "", fmt.Errorf("fetching image %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, "", fmt.Errorf("%w: %s answered %s", ErrHTTPStatus, url, resp.Status)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	ext, ok := contentTypes[mediaType]
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %q from %s", ErrUnsupportedContentType, mediaType, url)
+	}
+
+	if resp.ContentLength > MaxURLImageSize {
+		return nil, "", fmt.Errorf("%w: %s has %d bytes", ErrImageTooLarge, url, resp.ContentLength)
+	}
+
+	// one more byte is read to know when the body is bigger than the limit
+	bytes, err := io.ReadAll(io.LimitReader(resp.Body, MaxURLImageSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("reading image %s: %w", url, err)
+	}
+
+	if len(bytes) > MaxURLImageSize {
+		return nil, "", fmt.Errorf("%w: %s has more than %d bytes", ErrImageTooLarge, url, MaxURLImageSize)
+	}
+
+	return bytes, ext, nil
+}
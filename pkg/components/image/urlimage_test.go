@@ -0,0 +1,158 @@
+package image_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func newImageServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/image.png", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte{1, 2, 3})
+	})
+	mux.HandleFunc("/image.jpg", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg; charset=binary")
+		_, _ = w.Write([]byte{4, 5, 6})
+	})
+	mux.HandleFunc("/text", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("text"))
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/image.png", http.StatusFound)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(make([]byte, image.MaxURLImageSize+1))
+	})
+	mux.HandleFunc("/large-chunked", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.(http.Flusher).Flush()
+		_, _ = w.Write(make([]byte, image.MaxURLImageSize+1))
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNewFromHTTPURL(t *testing.T) {
+	server := newImageServer(t)
+
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromHTTPURL(server.URL+"/image.png", time.Second)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_url_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromHTTPURL(server.URL+"/image.jpg", time.Second, fixture.RectProp())
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_url_custom_prop.json")
+	})
+	t.Run("when url redirects, should follow the redirect", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromHTTPURL(server.URL+"/redirect", time.Second)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_url_default_prop.json")
+	})
+	t.Run("when url redirects more than 5 times, should return error", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromHTTPURL(server.URL+"/loop", time.Second)
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorIs(t, err, image.ErrTooManyRedirects)
+	})
+	t.Run("when server answers with error status, should return error", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromHTTPURL(server.URL+"/missing", time.Second)
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorIs(t, err, image.ErrHTTPStatus)
+		assert.Contains(t, err.Error(), "404 Not Found")
+	})
+	t.Run("when content type is not an image, should return error", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromHTTPURL(server.URL+"/text", time.Second)
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorIs(t, err, image.ErrUnsupportedContentType)
+	})
+	t.Run("when image is bigger than the limit, should return error", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromHTTPURL(server.URL+"/large", 5*time.Second)
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorIs(t, err, image.ErrImageTooLarge)
+	})
+	t.Run("when image without content length is bigger than the limit, should return error", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromHTTPURL(server.URL+"/large-chunked", 5*time.Second)
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorIs(t, err, image.ErrImageTooLarge)
+	})
+	t.Run("when server is slower than timeout, should return error", func(t *testing.T) {
+		// Act
+		sut, err := image.NewFromHTTPURL(server.URL+"/slow", 50*time.Millisecond)
+
+		// Assert
+		assert.Nil(t, sut)
+		assert.ErrorContains(t, err, "Timeout")
+	})
+}
+
+func TestNewFromHTTPURLCol(t *testing.T) {
+	// Arrange
+	server := newImageServer(t)
+
+	// Act
+	sut, err := image.NewFromHTTPURLCol(12, server.URL+"/image.png", time.Second)
+
+	// Assert
+	assert.Nil(t, err)
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_url_col.json")
+}
+
+func TestNewFromHTTPURLRow(t *testing.T) {
+	// Arrange
+	server := newImageServer(t)
+
+	// Act
+	sut, err := image.NewFromHTTPURLRow(10, server.URL+"/image.png", time.Second)
+
+	// Assert
+	assert.Nil(t, err)
+	test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_url_row.json")
+}
@@ -0,0 +1,400 @@
+// Package invoice implements creation of invoices composing texts, addresses, images, tables and signatures.
+package invoice
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/address"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/signature"
+	"github.com/johnfercher/maroto/v2/pkg/components/tablelist"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	titleScale     = 2.0
+	headerHeight   = 30.0
+	labelHeight    = 6.0
+	addressHeight  = 24.0
+	totalHeight    = 6.0
+	textHeight     = 12.0
+	signatureSpace = 20.0
+	spaceHeight    = 5.0
+	// lineScale is the height, in mm, of each point of the size of the font of the header details.
+	lineScale = 0.5
+)
+
+// Item is a line item of an Invoice.
+type Item struct {
+	Description string
+	Quantity    float64
+	UnitPrice   float64
+}
+
+// Total returns the quantity multiplied by the unit price.
+func (i Item) Total() float64 {
+	return i.Quantity * i.UnitPrice
+}
+
+// Invoice is the content of an invoice.
+type Invoice struct {
+	Number        string
+	IssueDate     time.Time
+	DueDate       time.Time
+	Logo          []byte
+	LogoExtension extension.Type
+	From          address.Address
+	BillTo        address.Address
+	// ShipTo is optional, when nil only the bill to address is written.
+	ShipTo *address.Address
+	Items  []Item
+	// TaxPercent is applied over the subtotal, ex: 10 means 10%.
+	TaxPercent     float64
+	PaymentTerms   string
+	SignatureLabel string
+	Footer         string
+}
+
+// Subtotal returns the sum of the items totals.
+func (inv Invoice) Subtotal() float64 {
+	subtotal := 0.0
+	for _, item := range inv.Items {
+		subtotal += item.Total()
+	}
+
+	return subtotal
+}
+
+// Tax returns the tax applied over the subtotal.
+func (inv Invoice) Tax() float64 {
+	return inv.Subtotal() * inv.TaxPercent / 100.0
+}
+
+// Total returns the subtotal plus the tax.
+func (inv Invoice) Total() float64 {
+	return inv.Subtotal() + inv.Tax()
+}
+
+// New is responsible to create an Invoice: the header with logo and sender address, the bill to and ship to
+// addresses, the line items table with subtotal, tax and total, the payment terms, the signature and the footer.
+// The invoice is a core.FlowRow split between its sections, and between the line items, so it breaks pages as
+// any other content added to maroto.
+func New(inv Invoice, ps ...props.Invoice) core.Row {
+	prop := props.Invoice{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid(fontfamily.Arial)
+
+	b := &builder{inv: inv, prop: prop}
+
+	var sections []core.Row
+	sections = append(sections, b.header())
+	sections = append(sections, b.addresses()...)
+	sections = append(sections, row.New(spaceHeight))
+	sections = append(sections, b.items(inv.Items))
+	sections = append(sections, b.totals()...)
+
+	if inv.PaymentTerms != "" {
+		sections = append(sections, row.New(textHeight).Add(
+			text.NewCol(12, "Payment terms: "+inv.PaymentTerms, b.textProp(fontstyle.Normal, align.Left, spaceHeight)),
+		))
+	}
+
+	if inv.SignatureLabel != "" {
+		sections = append(sections, row.New(signatureSpace).Add(
+			col.New(8),
+			signature.NewCol(4, inv.SignatureLabel, props.Signature{FontFamily: prop.Family, FontColor: prop.Color}),
+		))
+	}
+
+	if inv.Footer != "" {
+		sections = append(sections, row.New(textHeight).Add(
+			text.NewCol(12, inv.Footer, b.textProp(fontstyle.Italic, align.Center, spaceHeight)),
+		))
+	}
+
+	return &invoiceRow{sections: sections, prop: prop}
+}
+
+// invoiceRow is the core.FlowRow of an Invoice, it renders its sections one below the other.
+type invoiceRow struct {
+	sections []core.Row
+	prop     props.Invoice
+	config   *entity.Config
+}
+
+// Add does nothing, as the row has only the sections of the invoice.
+func (r *invoiceRow) Add(...core.Col) core.Row {
+	return r
+}
+
+// GetHeight returns the sum of the heights of the sections.
+func (r *invoiceRow) GetHeight() float64 {
+	height := 0.0
+	for _, section := range r.sections {
+		height += section.GetHeight()
+	}
+
+	return height
+}
+
+// WithStyle does nothing, as each section of the invoice has its own style.
+func (r *invoiceRow) WithStyle(*props.Cell) core.Row {
+	return r
+}
+
+// WithBookmark adds an entry to the outline of the document pointing to the first section of the invoice.
+func (r *invoiceRow) WithBookmark(title string, level int) core.Row {
+	if len(r.sections) > 0 {
+		r.sections[0].WithBookmark(title, level)
+	}
+
+	return r
+}
+
+// GetStructure returns the Structure of an Invoice, with a node for each section.
+func (r *invoiceRow) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "invoice",
+		Details: r.prop.ToMap(),
+	}
+
+	n := node.New(str)
+	for _, section := range r.sections {
+		n.AddNext(section.GetStructure())
+	}
+
+	return n
+}
+
+// SetConfig sets the config.
+func (r *invoiceRow) SetConfig(config *entity.Config) {
+	r.config = config
+	for _, section := range r.sections {
+		section.SetConfig(config)
+	}
+}
+
+// Render renders the sections of an Invoice one below the other.
+func (r *invoiceRow) Render(provider core.Provider, cell entity.Cell) {
+	for _, section := range r.sections {
+		section.Render(provider, cell)
+		cell.Y += section.GetHeight()
+	}
+}
+
+// Split returns a row with the sections which fit in the height, at least the first one, and a row with the
+// rest of the sections. The line items table is split between its items, with the header on both parts.
+func (r *invoiceRow) Split(height, _ float64, config *entity.Config) (core.Row, core.FlowRow) {
+	r.SetConfig(config)
+
+	part := &invoiceRow{prop: r.prop, config: config}
+	used := 0.0
+
+	for i, section := range r.sections {
+		if used+section.GetHeight() > height {
+			if items, ok := section.(*itemsRow); ok {
+				if first, second := items.split(height - used); first != nil {
+					part.sections = append(part.sections, first)
+					return part, r.rest(append([]core.Row{second}, r.sections[i+1:]...))
+				}
+			}
+
+			if len(part.sections) > 0 {
+				return part, r.rest(r.sections[i:])
+			}
+		}
+
+		part.sections = append(part.sections, section)
+		used += section.GetHeight()
+	}
+
+	return part, nil
+}
+
+func (r *invoiceRow) rest(sections []core.Row) core.FlowRow {
+	return &invoiceRow{sections: sections, prop: r.prop, config: r.config}
+}
+
+// itemsRow is the row of the line items table, which is split between its items.
+type itemsRow struct {
+	core.Row
+	b     *builder
+	items []Item
+}
+
+// split returns a row with the items which fit in the height and a row with the rest, the first row is nil when
+// not even one item fits and the second is nil when all the items fit.
+func (r *itemsRow) split(height float64) (core.Row, *itemsRow) {
+	quantity := int(height/r.b.prop.ItemHeight) - 1
+	if quantity < 1 {
+		return nil, r
+	}
+
+	if quantity >= len(r.items) {
+		return r, nil
+	}
+
+	first := r.b.items(r.items[:quantity])
+	second := r.b.items(r.items[quantity:])
+	if r.b.config != nil {
+		first.SetConfig(r.b.config)
+		second.SetConfig(r.b.config)
+	}
+
+	return first, second
+}
+
+// SetConfig sets the config of the table, which is also used by the rows it's split in.
+func (r *itemsRow) SetConfig(config *entity.Config) {
+	r.b.config = config
+	r.Row.SetConfig(config)
+}
+
+type builder struct {
+	inv    Invoice
+	prop   props.Invoice
+	config *entity.Config
+}
+
+func (b *builder) header() core.Row {
+	title := b.textProp(fontstyle.Bold, align.Right, 0)
+	title.Size = b.prop.Size * titleScale
+
+	details := []core.Component{text.New("INVOICE", title)}
+	for i, line := range b.headerDetails() {
+		top := b.prop.Size + float64(i)*b.prop.Size*lineScale
+		details = append(details, text.New(line, b.textProp(fontstyle.Normal, align.Right, top)))
+	}
+
+	if len(b.inv.Logo) == 0 {
+		return row.New(headerHeight).Add(
+			address.NewCol(6, b.inv.From, b.addressProp()),
+			col.New(6).Add(details...),
+		)
+	}
+
+	return row.New(headerHeight).Add(
+		image.NewFromBytesCol(3, b.inv.Logo, b.inv.LogoExtension, props.Rect{Percent: 90}),
+		address.NewCol(5, b.inv.From, b.addressProp()),
+		col.New(4).Add(details...),
+	)
+}
+
+// headerDetails returns the lines written below the title: the number and the dates which are set.
+func (b *builder) headerDetails() []string {
+	details := []string{"Invoice #" + b.inv.Number}
+	if !b.inv.IssueDate.IsZero() {
+		details = append(details, "Issued: "+b.inv.IssueDate.Format(b.prop.DateLayout))
+	}
+
+	if !b.inv.DueDate.IsZero() {
+		details = append(details, "Due: "+b.inv.DueDate.Format(b.prop.DateLayout))
+	}
+
+	return details
+}
+
+func (b *builder) addresses() []core.Row {
+	label := b.textProp(fontstyle.Bold, align.Left, 0)
+	addressProp := b.addressProp()
+
+	if b.inv.ShipTo == nil {
+		return []core.Row{
+			row.New(labelHeight).Add(text.NewCol(6, "Bill To", label)),
+			row.New(addressHeight).Add(address.NewCol(6, b.inv.BillTo, addressProp)),
+		}
+	}
+
+	return []core.Row{
+		row.New(labelHeight).Add(text.NewCol(6, "Bill To", label), text.NewCol(6, "Ship To", label)),
+		row.New(addressHeight).Add(
+			address.NewCol(6, b.inv.BillTo, addressProp),
+			address.NewCol(6, *b.inv.ShipTo, addressProp),
+		),
+	}
+}
+
+// items returns the line items table, with a line for the header and one for each item.
+func (b *builder) items(items []Item) *itemsRow {
+	values := make([][]string, 0, len(items))
+	for _, item := range items {
+		values = append(values, []string{
+			item.Description,
+			strconv.FormatFloat(item.Quantity, 'f', -1, 64),
+			b.money(item.UnitPrice),
+			b.money(item.Total()),
+		})
+	}
+
+	table := tablelist.New([]string{"Description", "Quantity", "Unit Price", "Amount"}, values, props.TableList{
+		HeaderColor:       b.prop.HeaderColor,
+		ColumnProportions: []float64{6, 2, 2, 2},
+		ColumnAligns:      []align.Type{align.Left, align.Right, align.Right, align.Right},
+		FontFamily:        b.prop.Family,
+		FontSize:          b.prop.Size,
+		FontColor:         b.prop.Color,
+	})
+
+	height := b.prop.ItemHeight * float64(len(items)+1)
+	return &itemsRow{Row: row.New(height).Add(col.New().Add(table)), b: b, items: items}
+}
+
+func (b *builder) totals() []core.Row {
+	value := b.textProp(fontstyle.Normal, align.Right, 1)
+	total := b.textProp(fontstyle.Bold, align.Right, 1)
+
+	rows := []core.Row{
+		row.New(totalHeight).Add(col.New(8), text.NewCol(2, "Subtotal", value), text.NewCol(2, b.money(b.inv.Subtotal()), value)),
+	}
+
+	if b.inv.TaxPercent != 0 {
+		taxLabel := "Tax (" + strconv.FormatFloat(b.inv.TaxPercent, 'f', -1, 64) + "%)"
+		rows = append(rows, row.New(totalHeight).Add(
+			col.New(8), text.NewCol(2, taxLabel, value), text.NewCol(2, b.money(b.inv.Tax()), value),
+		))
+	}
+
+	rows = append(rows, row.New(totalHeight).Add(
+		col.New(8), text.NewCol(2, "Total", total), text.NewCol(2, b.money(b.inv.Total()), total),
+	))
+
+	return rows
+}
+
+func (b *builder) money(value float64) string {
+	return fmt.Sprintf("%s%.2f", b.prop.Currency, value)
+}
+
+func (b *builder) textProp(style fontstyle.Type, textAlign align.Type, top float64) props.Text {
+	return props.Text{
+		Family: b.prop.Family,
+		Style:  style,
+		Size:   b.prop.Size,
+		Color:  b.prop.Color,
+		Align:  textAlign,
+		Top:    top,
+	}
+}
+
+func (b *builder) addressProp() props.Address {
+	return props.Address{
+		Family: b.prop.Family,
+		Size:   b.prop.Size,
+		Color:  b.prop.Color,
+	}
+}
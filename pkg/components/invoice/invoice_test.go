@@ -0,0 +1,144 @@
+package invoice_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/address"
+	"github.com/johnfercher/maroto/v2/pkg/components/invoice"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+	mtesting "github.com/johnfercher/maroto/v2/pkg/testing"
+)
+
+func newInvoice() invoice.Invoice {
+	return invoice.Invoice{
+		Number:    "2024-001",
+		IssueDate: time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+		DueDate:   time.Date(2024, time.February, 15, 0, 0, 0, 0, time.UTC),
+		From: address.Address{
+			Name:   "Maroto Inc",
+			Street: "1600 Amphitheatre Pkwy",
+			City:   "Mountain View",
+			State:  "CA",
+		},
+		BillTo: address.Address{
+			Name:   "John Doe",
+			Street: "42 Main St",
+			City:   "Springfield",
+		},
+		Items: []invoice.Item{
+			{Description: "Consulting", Quantity: 10, UnitPrice: 150},
+			{Description: "Support", Quantity: 2.5, UnitPrice: 40},
+		},
+		TaxPercent: 10,
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("when only required fields are sent, should create the default sections", func(t *testing.T) {
+		// Act
+		sut := invoice.New(newInvoice())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/invoices/new_invoice_default_prop.json")
+	})
+	t.Run("when every field is sent, should create every section", func(t *testing.T) {
+		// Arrange
+		inv := newInvoice()
+		inv.Logo = []byte{1, 2, 3}
+		inv.LogoExtension = extension.Png
+		inv.ShipTo = &address.Address{Name: "Jane Doe", City: "Shelbyville"}
+		inv.PaymentTerms = "Net 30"
+		inv.SignatureLabel = "Authorized signature"
+		inv.Footer = "Thank you for your business"
+
+		// Act
+		sut := invoice.New(inv, fixture.InvoiceProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/invoices/new_invoice_custom_prop.json")
+	})
+	t.Run("when items do not fit in a page, should continue the items table on the next page", func(t *testing.T) {
+		// Arrange
+		inv := newInvoice()
+		for i := 0; i < 40; i++ {
+			inv.Items = append(inv.Items, invoice.Item{Description: "Item " + strconv.Itoa(i), Quantity: 1, UnitPrice: 1})
+		}
+
+		m := maroto.New()
+		m.AddRows(invoice.New(inv))
+
+		// Act
+		doc, err := m.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		mtesting.AssertPageCount(t, doc, 2)
+		mtesting.AssertContainsText(t, doc, "Item 39")
+		mtesting.AssertContainsText(t, doc, "Total")
+	})
+}
+
+func TestInvoice_Split(t *testing.T) {
+	cfg := config.NewBuilder().Build()
+
+	t.Run("when every section fits, should return all of them", func(t *testing.T) {
+		// Arrange
+		sut := invoice.New(newInvoice()).(core.FlowRow)
+
+		// Act
+		part, rest := sut.Split(sut.GetHeight(), 190, cfg)
+
+		// Assert
+		assert.Equal(t, sut.GetHeight(), part.GetHeight())
+		assert.Nil(t, rest)
+	})
+	t.Run("when the items table does not fit, should split it between the items", func(t *testing.T) {
+		// Arrange
+		sut := invoice.New(newInvoice()).(core.FlowRow)
+		// header, labels, addresses, space and the items header with one item
+		height := 30.0 + 6 + 24 + 5 + 7*2
+
+		// Act
+		part, rest := sut.Split(height, 190, cfg)
+
+		// Assert
+		assert.Equal(t, height, part.GetHeight())
+		assert.NotNil(t, rest)
+		assert.Equal(t, sut.GetHeight()+7, part.GetHeight()+rest.GetHeight())
+	})
+	t.Run("when not even the first section fits, should return it", func(t *testing.T) {
+		// Arrange
+		sut := invoice.New(newInvoice()).(core.FlowRow)
+
+		// Act
+		part, rest := sut.Split(1, 190, cfg)
+
+		// Assert
+		assert.Equal(t, 30.0, part.GetHeight())
+		assert.NotNil(t, rest)
+	})
+}
+
+func TestInvoice_Totals(t *testing.T) {
+	// Arrange
+	sut := newInvoice()
+
+	// Act
+	subtotal := sut.Subtotal()
+	tax := sut.Tax()
+	total := sut.Total()
+
+	// Assert
+	assert.Equal(t, 1600.0, subtotal)
+	assert.Equal(t, 160.0, tax)
+	assert.Equal(t, 1760.0, total)
+}
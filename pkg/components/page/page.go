@@ -4,6 +4,7 @@ package page
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
@@ -48,6 +49,66 @@ func (p *page) Render(provider core.Provider, cell entity.Cell) {
 	if p.prop.Pattern != "" {
 		provider.AddText(p.prop.GetPageString(p.number, p.total), &cell, p.prop.GetNumberTextProp(cell.Height))
 	}
+
+	hasWatermark := p.renderTemplate(provider, cell)
+
+	if p.config.Watermark != nil && !hasWatermark {
+		provider.AddWatermark(p.config.Watermark.Text, &p.config.Watermark.Prop)
+	}
+}
+
+// renderTemplate renders the rows of the page template over the content from the top of the page, the cursor
+// is restored after them, so the rows of the next page are created as if the template was not rendered.
+// It returns whether the rows have a watermark, which replaces the watermark of the config.
+func (p *page) renderTemplate(provider core.Provider, cell entity.Cell) bool {
+	if p.config.PageTemplate == nil {
+		return false
+	}
+
+	var rows []core.Row
+	for _, r := range p.config.PageTemplate(p.number, p.total) {
+		if templateRow, ok := r.(core.Row); ok {
+			rows = append(rows, templateRow)
+		}
+	}
+
+	if len(rows) == 0 {
+		return false
+	}
+
+	x, y := provider.GetCursor()
+	provider.SetCursor(cell.X, cell.Y)
+
+	innerCell := cell.Copy()
+	for _, row := range rows {
+		row.SetConfig(p.config)
+		row.Render(provider, innerCell)
+		innerCell.Y += row.GetHeight()
+	}
+
+	provider.SetCursor(x, y)
+
+	for _, row := range rows {
+		if hasWatermark(row.GetStructure()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasWatermark(structure *node.Node[core.Structure]) bool {
+	if structure.GetData().Type == text.WatermarkType {
+		return true
+	}
+
+	for _, next := range structure.GetNexts() {
+		if hasWatermark(next) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // SetConfig sets the page configuration.
@@ -4,8 +4,15 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/johnfercher/maroto/v2/pkg/components/page"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
 func TestNew(t *testing.T) {
@@ -16,3 +23,54 @@ func TestNew(t *testing.T) {
 	assert.NotNil(t, sut)
 	assert.Equal(t, "*page.page", fmt.Sprintf("%T", sut))
 }
+
+func TestPage_Render(t *testing.T) {
+	t.Run("when config has a watermark, should add the watermark", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{Width: 190, Height: 277}
+		cfg := &entity.Config{Watermark: &entity.Watermark{Text: "DRAFT", Prop: fixture.WatermarkProp()}}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddWatermark("DRAFT", &cfg.Watermark.Prop)
+
+		sut := page.New()
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddWatermark", 1)
+	})
+	t.Run("when page template has a watermark, should add it instead of the watermark of the config", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{Width: 190, Height: 277}
+		fontProp := fixture.FontProp()
+		templateProp := props.Watermark{Angle: 30}
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+			DefaultFont: &fontProp,
+			Watermark:   &entity.Watermark{Text: "DRAFT", Prop: fixture.WatermarkProp()},
+			PageTemplate: func(int, int) []entity.PageRow {
+				return []entity.PageRow{text.NewWatermarkRow(0, "COPY", templateProp)}
+			},
+		}
+		templateProp.MakeValid(&fontProp)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetCursor().Return(0.0, 277.0)
+		provider.EXPECT().SetCursor(mock.Anything, mock.Anything)
+		provider.EXPECT().CreateCol(190.0, 0.0, cfg, (*props.Cell)(nil))
+		provider.EXPECT().CreateRow(0.0)
+		provider.EXPECT().AddWatermark("COPY", &templateProp)
+
+		sut := page.New()
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddWatermark", 1)
+	})
+}
@@ -0,0 +1,140 @@
+// Package progressbar implements creation of progress bars.
+package progressbar
+
+import (
+	"math"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// cornerSegments is the quantity of segments used to draw each rounded corner.
+const cornerSegments = 8
+
+type progressBar struct {
+	percent float64
+	prop    props.ProgressBar
+	config  *entity.Config
+}
+
+// New is responsible to create an instance of a ProgressBar filled in the percent, from 0 to 100.
+// Percents out of this range are clamped. The bar fills the whole cell.
+func New(percent float64, ps ...props.ProgressBar) core.Component {
+	prop := props.ProgressBar{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &progressBar{
+		percent: math.Max(0, math.Min(100, percent)),
+		prop:    prop,
+	}
+}
+
+// NewCol is responsible to create an instance of a ProgressBar wrapped in a Col.
+func NewCol(size int, percent float64, ps ...props.ProgressBar) core.Col {
+	progressBar := New(percent, ps...)
+	return col.New(size).Add(progressBar)
+}
+
+// NewRow is responsible to create an instance of a ProgressBar wrapped in a Row.
+func NewRow(height float64, percent float64, ps ...props.ProgressBar) core.Row {
+	progressBar := New(percent, ps...)
+	c := col.New().Add(progressBar)
+	return row.New(height).Add(c)
+}
+
+// Render renders a ProgressBar into a PDF context. The empty bar is drawn in the whole cell and the filled
+// bar is drawn over it, clipped to the percent of the width, so both parts keep the rounded corners.
+func (p *progressBar) Render(provider core.Provider, cell *entity.Cell) {
+	if cell.Width <= 0 || cell.Height <= 0 {
+		return
+	}
+
+	points := getRoundedRectPoints(cell, p.prop.CornerRadius)
+
+	provider.DrawPolygon(points, getFillProp(p.prop.EmptyColor))
+
+	if filled := cell.Width * p.percent / 100.0; filled > 0 {
+		provider.SetClipRect(cell.X, cell.Y, filled, cell.Height)
+		provider.DrawPolygon(points, getFillProp(p.prop.FilledColor))
+		provider.ResetClip()
+	}
+
+	if p.prop.BorderColor != nil {
+		provider.DrawPolygon(points, &props.Line{
+			Color:     p.prop.BorderColor,
+			Style:     linestyle.Solid,
+			Thickness: p.prop.BorderThickness,
+		})
+	}
+}
+
+// GetStructure returns the Structure of a ProgressBar.
+func (p *progressBar) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "progressbar",
+		Value:   p.percent,
+		Details: p.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (p *progressBar) SetConfig(config *entity.Config) {
+	p.config = config
+}
+
+// getFillProp returns the props.Line of a filled part, the outline has the same color, so it's not seen.
+func getFillProp(color *props.Color) *props.Line {
+	return &props.Line{
+		Color:     color,
+		FillColor: color,
+		Style:     linestyle.Solid,
+	}
+}
+
+// getRoundedRectPoints returns the outline of the cell with the corners rounded by the radius, limited to
+// half of the smaller side. When the radius is 0, the outline is the rectangle.
+func getRoundedRectPoints(cell *entity.Cell, radius float64) []entity.Point {
+	radius = math.Min(radius, math.Min(cell.Width, cell.Height)/2.0)
+	if radius <= 0 {
+		return []entity.Point{
+			{X: cell.X, Y: cell.Y},
+			{X: cell.X + cell.Width, Y: cell.Y},
+			{X: cell.X + cell.Width, Y: cell.Y + cell.Height},
+			{X: cell.X, Y: cell.Y + cell.Height},
+		}
+	}
+
+	// Centers of the corners, clockwise from the top right, with the angle in which each corner starts.
+	corners := []struct {
+		x, y, angle float64
+	}{
+		{cell.X + cell.Width - radius, cell.Y + radius, -math.Pi / 2},
+		{cell.X + cell.Width - radius, cell.Y + cell.Height - radius, 0},
+		{cell.X + radius, cell.Y + cell.Height - radius, math.Pi / 2},
+		{cell.X + radius, cell.Y + radius, math.Pi},
+	}
+
+	points := make([]entity.Point, 0, len(corners)*(cornerSegments+1))
+	for _, corner := range corners {
+		for i := 0; i <= cornerSegments; i++ {
+			angle := corner.angle + float64(i)*(math.Pi/2)/cornerSegments
+			points = append(points, entity.Point{
+				X: corner.x + radius*math.Cos(angle),
+				Y: corner.y + radius*math.Sin(angle),
+			})
+		}
+	}
+
+	return points
+}
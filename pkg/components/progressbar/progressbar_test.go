@@ -0,0 +1,132 @@
+package progressbar_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/progressbar"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := progressbar.New(40)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/progressbars/new_progressbar_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := progressbar.New(40, fixture.ProgressBarProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/progressbars/new_progressbar_custom_prop.json")
+	})
+	t.Run("when percent is out of range, should clamp it", func(t *testing.T) {
+		// Act
+		lower := progressbar.New(-10)
+		greater := progressbar.New(130)
+
+		// Assert
+		assert.Equal(t, 0.0, lower.GetStructure().GetData().Value)
+		assert.Equal(t, 100.0, greater.GetStructure().GetData().Value)
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := progressbar.NewCol(12, 40)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/progressbars/new_progressbar_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := progressbar.NewRow(10, 40)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/progressbars/new_progressbar_row.json")
+}
+
+func TestProgressBar_Render(t *testing.T) {
+	t.Run("when corners are square, should draw the empty bar and clip the filled bar", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 10, Y: 20, Width: 100, Height: 5}
+		sut := progressbar.New(40)
+
+		var polygons [][]entity.Point
+		var lineProps []*props.Line
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawPolygon(mock.Anything, mock.Anything).Run(func(p []entity.Point, l *props.Line) {
+			polygons = append(polygons, p)
+			lineProps = append(lineProps, l)
+		})
+		provider.EXPECT().SetClipRect(10.0, 20.0, 40.0, 5.0)
+		provider.EXPECT().ResetClip()
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		assert.Len(t, polygons, 2)
+		assert.Equal(t, []entity.Point{{X: 10, Y: 20}, {X: 110, Y: 20}, {X: 110, Y: 25}, {X: 10, Y: 25}}, polygons[0])
+		assert.Equal(t, &props.Color{Red: 224, Green: 224, Blue: 224}, lineProps[0].FillColor)
+		assert.Equal(t, &props.Color{Red: 66, Green: 133, Blue: 244}, lineProps[1].FillColor)
+	})
+	t.Run("when percent is 0, should draw only the empty bar", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 10, Y: 20, Width: 100, Height: 5}
+		sut := progressbar.New(0)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawPolygon(mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawPolygon", 1)
+		provider.AssertNotCalled(t, "SetClipRect", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("when border and radius are defined, should draw rounded outline", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 0, Y: 0, Width: 100, Height: 2}
+		prop := fixture.ProgressBarProp()
+		sut := progressbar.New(100, prop)
+
+		var polygons [][]entity.Point
+		var lineProps []*props.Line
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawPolygon(mock.Anything, mock.Anything).Run(func(p []entity.Point, l *props.Line) {
+			polygons = append(polygons, p)
+			lineProps = append(lineProps, l)
+		})
+		provider.EXPECT().SetClipRect(0.0, 0.0, 100.0, 2.0)
+		provider.EXPECT().ResetClip()
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		assert.Len(t, polygons, 3)
+		border := lineProps[2]
+		assert.Nil(t, border.FillColor)
+		assert.Equal(t, prop.BorderColor, border.Color)
+		assert.Equal(t, 0.5, border.Thickness)
+		for _, point := range polygons[2] {
+			assert.True(t, point.Y >= -0.0001 && point.Y <= 2.0001)
+			if point.X < 1 {
+				assert.InDelta(t, 1.0, math.Hypot(point.X-1, point.Y-1), 0.0001)
+			}
+		}
+	})
+}
@@ -11,10 +11,17 @@ import (
 )
 
 type row struct {
-	height float64
-	cols   []core.Col
-	style  *props.Cell
-	config *entity.Config
+	height    float64
+	cols      []core.Col
+	style     *props.Cell
+	bookmarks []bookmark
+	config    *entity.Config
+}
+
+// bookmark is an outline entry pointing to the row.
+type bookmark struct {
+	title string
+	level int
 }
 
 // New is responsible to create a core.Row.
@@ -46,6 +53,12 @@ func (r *row) GetHeight() float64 {
 // GetStructure returns the Structure of a core.Row.
 func (r *row) GetStructure() *node.Node[core.Structure] {
 	detailsMap := r.style.ToMap()
+	if len(r.bookmarks) > 0 {
+		if detailsMap == nil {
+			detailsMap = make(map[string]interface{})
+		}
+		detailsMap["bookmarks"] = r.getBookmarksValue()
+	}
 
 	str := core.Structure{
 		Type:    "row",
@@ -72,24 +85,79 @@ func (r *row) Render(provider core.Provider, cell entity.Cell) {
 		provider.CreateCol(cell.Width, cell.Height, r.config, r.style)
 	}
 
-	for _, col := range r.cols {
-		size := col.GetSize()
+	for _, slot := range r.getOrderedSlots() {
 		parentWidth := cell.Width
 
-		percent := float64(size) / float64(r.config.MaxGridSize)
+		percent := float64(slot.size) / float64(r.config.MaxGridSize)
 
 		colDimension := parentWidth * percent
 		innerCell.Width = colDimension
 
-		col.Render(provider, innerCell, r.style == nil)
+		slot.col.Render(provider, innerCell, r.style == nil)
 		innerCell.X += colDimension
 	}
 
+	// The bookmarks are added after the cols, as they start the page when the row is the first one.
+	for _, b := range r.bookmarks {
+		provider.AddBookmark(b.title, b.level, &cell)
+	}
+
 	provider.CreateRow(cell.Height)
 }
 
+// colSlot is a col rendered with the sum of the sizes of the col slots it spans.
+type colSlot struct {
+	col  core.Col
+	size int
+}
+
+// getOrderedSlots returns the cols in the order they are written, on RTL layouts the first col is the rightmost.
+// A col which spans many slots has the size of all of them and the spanned cols are skipped, the span is limited
+// to the cols remaining in the row.
+func (r *row) getOrderedSlots() []colSlot {
+	var slots []colSlot
+	for i := 0; i < len(r.cols); {
+		span := min(r.cols[i].GetSpan(), len(r.cols)-i)
+
+		size := 0
+		for _, col := range r.cols[i : i+span] {
+			size += col.GetSize()
+		}
+
+		slots = append(slots, colSlot{col: r.cols[i], size: size})
+		i += span
+	}
+
+	if r.config == nil || !r.config.RTL {
+		return slots
+	}
+
+	ordered := make([]colSlot, len(slots))
+	for i, slot := range slots {
+		ordered[len(slots)-1-i] = slot
+	}
+
+	return ordered
+}
+
 // WithStyle sets the style of a Row.
 func (r *row) WithStyle(style *props.Cell) core.Row {
 	r.style = style
 	return r
 }
+
+// WithBookmark adds an entry to the outline of the document pointing to the top of the Row,
+// level 0 is a root entry and higher levels are children of the previous lower level.
+func (r *row) WithBookmark(title string, level int) core.Row {
+	r.bookmarks = append(r.bookmarks, bookmark{title: title, level: max(level, 0)})
+	return r
+}
+
+func (r *row) getBookmarksValue() []map[string]interface{} {
+	value := make([]map[string]interface{}, len(r.bookmarks))
+	for i, b := range r.bookmarks {
+		value[i] = map[string]interface{}{"title": b.title, "level": b.level}
+	}
+
+	return value
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,13 @@ func TestNew(t *testing.T) {
 		// Assert
 		test.New(t).Assert(r.GetStructure()).Equals("components/rows/new_col_with_prop.json")
 	})
+	t.Run("when has bookmark, should apply correctly", func(t *testing.T) {
+		// Act
+		r := row.New(12).WithBookmark("Chapter 1", 0).WithBookmark("Section 1.1", 1)
+
+		// Assert
+		test.New(t).Assert(r.GetStructure()).Equals("components/rows/new_row_with_bookmark.json")
+	})
 }
 
 func TestRow_GetHeight(t *testing.T) {
@@ -62,6 +70,7 @@ func TestRow_GetStructure(t *testing.T) {
 		col.EXPECT().Render(provider, cell, true)
 		col.EXPECT().SetConfig(cfg)
 		col.EXPECT().GetSize().Return(12)
+		col.EXPECT().GetSpan().Return(1)
 
 		sut := row.New(cell.Height).Add(col)
 		sut.SetConfig(cfg)
@@ -90,6 +99,7 @@ func TestRow_GetStructure(t *testing.T) {
 		col.EXPECT().Render(provider, cell, false)
 		col.EXPECT().SetConfig(cfg)
 		col.EXPECT().GetSize().Return(12)
+		col.EXPECT().GetSpan().Return(1)
 
 		sut := row.New(cell.Height).Add(col).WithStyle(&prop)
 		sut.SetConfig(cfg)
@@ -103,6 +113,115 @@ func TestRow_GetStructure(t *testing.T) {
 		col.AssertNumberOfCalls(t, "Render", 1)
 		col.AssertNumberOfCalls(t, "SetConfig", 1)
 	})
+	t.Run("when there is bookmark, should add it after rendering the cols", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+		}
+		cell := fixture.CellEntity()
+
+		var calls []string
+		provider := &mocks.Provider{}
+		provider.EXPECT().CreateRow(cell.Height)
+		provider.EXPECT().AddBookmark("Chapter 1", 0, &cell).Run(func(string, int, *entity.Cell) {
+			calls = append(calls, "bookmark")
+		})
+
+		col := &mocks.Col{}
+		col.EXPECT().Render(provider, cell, true).Run(func(core.Provider, entity.Cell, bool) {
+			calls = append(calls, "col")
+		})
+		col.EXPECT().SetConfig(cfg)
+		col.EXPECT().GetSize().Return(12)
+		col.EXPECT().GetSpan().Return(1)
+
+		sut := row.New(cell.Height).Add(col).WithBookmark("Chapter 1", -1)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBookmark", 1)
+		assert.Equal(t, []string{"col", "bookmark"}, calls)
+	})
+	t.Run("when layout is rtl, should render the first col on the right", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+			RTL:         true,
+		}
+		cell := entity.Cell{X: 0, Y: 0, Width: 120, Height: 10}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().CreateRow(cell.Height)
+
+		first := &mocks.Col{}
+		first.EXPECT().Render(provider, entity.Cell{X: 40, Y: 0, Width: 80, Height: 10}, true)
+		first.EXPECT().SetConfig(cfg)
+		first.EXPECT().GetSize().Return(8)
+		first.EXPECT().GetSpan().Return(1)
+
+		second := &mocks.Col{}
+		second.EXPECT().Render(provider, entity.Cell{X: 0, Y: 0, Width: 40, Height: 10}, true)
+		second.EXPECT().SetConfig(cfg)
+		second.EXPECT().GetSize().Return(4)
+		second.EXPECT().GetSpan().Return(1)
+
+		sut := row.New(cell.Height).Add(first, second)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		first.AssertNumberOfCalls(t, "Render", 1)
+		second.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when col spans, should render it with the width of the spanned cols", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+		}
+		cell := entity.Cell{X: 0, Y: 0, Width: 120, Height: 10}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().CreateRow(cell.Height)
+
+		first := &mocks.Col{}
+		first.EXPECT().Render(provider, entity.Cell{X: 0, Y: 0, Width: 20, Height: 10}, true)
+		first.EXPECT().SetConfig(cfg)
+		first.EXPECT().GetSize().Return(2)
+		first.EXPECT().GetSpan().Return(1)
+
+		spanning := &mocks.Col{}
+		spanning.EXPECT().Render(provider, entity.Cell{X: 20, Y: 0, Width: 60, Height: 10}, true)
+		spanning.EXPECT().SetConfig(cfg)
+		spanning.EXPECT().GetSize().Return(2)
+		spanning.EXPECT().GetSpan().Return(2)
+
+		spanned := &mocks.Col{}
+		spanned.EXPECT().SetConfig(cfg)
+		spanned.EXPECT().GetSize().Return(4)
+
+		last := &mocks.Col{}
+		last.EXPECT().Render(provider, entity.Cell{X: 80, Y: 0, Width: 40, Height: 10}, true)
+		last.EXPECT().SetConfig(cfg)
+		last.EXPECT().GetSize().Return(4)
+		last.EXPECT().GetSpan().Return(3)
+
+		sut := row.New(cell.Height).Add(first, spanning, spanned, last)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		first.AssertNumberOfCalls(t, "Render", 1)
+		spanning.AssertNumberOfCalls(t, "Render", 1)
+		spanned.AssertNumberOfCalls(t, "Render", 0)
+		last.AssertNumberOfCalls(t, "Render", 1)
+	})
 }
 
 func TestRow_SetConfig(t *testing.T) {
@@ -0,0 +1,50 @@
+package shapes
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	// arrowShaftLength is the length of the shaft relative to the arrow width.
+	arrowShaftLength = 0.6
+	// arrowShaftThickness is the thickness of the shaft relative to the arrow height.
+	arrowShaftThickness = 0.4
+)
+
+// NewArrow is responsible to create an Arrow pointing to the right, it stretches to the whole cell.
+func NewArrow(ps ...props.Shape) core.Component {
+	return newShape("arrow", nil, false, arrowPoints, ps...)
+}
+
+// NewArrowCol is responsible to create an Arrow wrapped in a Col.
+func NewArrowCol(size int, ps ...props.Shape) core.Col {
+	arrow := NewArrow(ps...)
+	return col.New(size).Add(arrow)
+}
+
+// NewArrowRow is responsible to create an Arrow wrapped in a Row.
+func NewArrowRow(height float64, ps ...props.Shape) core.Row {
+	arrow := NewArrow(ps...)
+	c := col.New().Add(arrow)
+	return row.New(height).Add(c)
+}
+
+func arrowPoints(box *entity.Cell) []entity.Point {
+	shaftEnd := box.X + box.Width*arrowShaftLength
+	centerY := box.Y + box.Height/2.0
+	halfShaft := box.Height * arrowShaftThickness / 2.0
+
+	return []entity.Point{
+		{X: box.X, Y: centerY - halfShaft},
+		{X: shaftEnd, Y: centerY - halfShaft},
+		{X: shaftEnd, Y: box.Y},
+		{X: box.X + box.Width, Y: centerY},
+		{X: shaftEnd, Y: box.Y + box.Height},
+		{X: shaftEnd, Y: centerY + halfShaft},
+		{X: box.X, Y: centerY + halfShaft},
+	}
+}
@@ -0,0 +1,71 @@
+package shapes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/shapes"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewArrow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := shapes.NewArrow()
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_arrow_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := shapes.NewArrow(fixture.ShapeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_arrow_custom_prop.json")
+	})
+}
+
+func TestNewArrowCol(t *testing.T) {
+	// Act
+	sut := shapes.NewArrowCol(12)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_arrow_col.json")
+}
+
+func TestNewArrowRow(t *testing.T) {
+	// Act
+	sut := shapes.NewArrowRow(10)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_arrow_row.json")
+}
+
+func TestArrow_Render(t *testing.T) {
+	t.Run("should draw an arrow pointing to the right over the whole cell", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 0, Y: 0, Width: 50, Height: 10}
+		sut := shapes.NewArrow()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawPolygon([]entity.Point{
+			{X: 0, Y: 3},
+			{X: 30, Y: 3},
+			{X: 30, Y: 0},
+			{X: 50, Y: 5},
+			{X: 30, Y: 10},
+			{X: 30, Y: 7},
+			{X: 0, Y: 7},
+		}, mock.Anything)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawPolygon", 1)
+	})
+}
@@ -0,0 +1,45 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const hexagonSides = 6
+
+// NewHexagon is responsible to create a regular Hexagon with a vertex on the top.
+func NewHexagon(ps ...props.Shape) core.Component {
+	return newShape("hexagon", nil, true, hexagonPoints, ps...)
+}
+
+// NewHexagonCol is responsible to create a Hexagon wrapped in a Col.
+func NewHexagonCol(size int, ps ...props.Shape) core.Col {
+	hexagon := NewHexagon(ps...)
+	return col.New(size).Add(hexagon)
+}
+
+// NewHexagonRow is responsible to create a Hexagon wrapped in a Row.
+func NewHexagonRow(height float64, ps ...props.Shape) core.Row {
+	hexagon := NewHexagon(ps...)
+	c := col.New().Add(hexagon)
+	return row.New(height).Add(c)
+}
+
+func hexagonPoints(box *entity.Cell) []entity.Point {
+	radius := box.Width / 2.0
+	cx, cy := box.X+radius, box.Y+radius
+	step := 2 * math.Pi / hexagonSides
+
+	vertices := make([]entity.Point, 0, hexagonSides)
+	for i := 0; i < hexagonSides; i++ {
+		angle := -math.Pi/2 + float64(i)*step
+		vertices = append(vertices, entity.Point{X: cx + radius*math.Cos(angle), Y: cy + radius*math.Sin(angle)})
+	}
+
+	return vertices
+}
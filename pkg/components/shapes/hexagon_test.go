@@ -0,0 +1,75 @@
+package shapes_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/shapes"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewHexagon(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := shapes.NewHexagon()
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_hexagon_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := shapes.NewHexagon(fixture.ShapeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_hexagon_custom_prop.json")
+	})
+}
+
+func TestNewHexagonCol(t *testing.T) {
+	// Act
+	sut := shapes.NewHexagonCol(12)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_hexagon_col.json")
+}
+
+func TestNewHexagonRow(t *testing.T) {
+	// Act
+	sut := shapes.NewHexagonRow(10)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_hexagon_row.json")
+}
+
+func TestHexagon_Render(t *testing.T) {
+	t.Run("should draw six vertices with the same distance to the center", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 10, Y: 10, Width: 20, Height: 40}
+		prop := fixture.ShapeProp()
+		sut := shapes.NewHexagon(prop)
+
+		var points []entity.Point
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawPolygon(mock.Anything, prop.ToLineProp()).Run(func(p []entity.Point, _ *props.Line) {
+			points = p
+		})
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		assert.Len(t, points, 6)
+		assert.InDelta(t, 20.0, points[0].X, 0.0001)
+		assert.InDelta(t, 25.0, points[0].Y, 0.0001)
+		for _, point := range points {
+			assert.InDelta(t, 5.0, math.Hypot(point.X-20, point.Y-30), 0.0001)
+		}
+	})
+}
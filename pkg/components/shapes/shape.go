@@ -0,0 +1,77 @@
+// Package shapes implements creation of polygon shapes, like stars, arrows and hexagons.
+package shapes
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type shape struct {
+	name   string
+	value  interface{}
+	points func(box *entity.Cell) []entity.Point
+	square bool
+	prop   props.Shape
+	config *entity.Config
+}
+
+func newShape(name string, value interface{}, square bool, points func(box *entity.Cell) []entity.Point,
+	ps ...props.Shape,
+) *shape {
+	prop := props.Shape{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &shape{
+		name:   name,
+		value:  value,
+		points: points,
+		square: square,
+		prop:   prop,
+	}
+}
+
+// Render renders a Shape into a PDF context.
+func (s *shape) Render(provider core.Provider, cell *entity.Cell) {
+	box := s.getBox(cell)
+	provider.DrawPolygon(s.points(&box), s.prop.ToLineProp())
+}
+
+// GetStructure returns the Structure of a Shape.
+func (s *shape) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    s.name,
+		Value:   s.value,
+		Details: s.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (s *shape) SetConfig(config *entity.Config) {
+	s.config = config
+}
+
+// getBox returns the area centered in the cell where the shape is drawn, regular shapes use a square area.
+func (s *shape) getBox(cell *entity.Cell) entity.Cell {
+	width := cell.Width * s.prop.Percent / 100.0
+	height := cell.Height * s.prop.Percent / 100.0
+
+	if s.square {
+		width = min(width, height)
+		height = width
+	}
+
+	return entity.Cell{
+		X:      cell.X + (cell.Width-width)/2.0,
+		Y:      cell.Y + (cell.Height-height)/2.0,
+		Width:  width,
+		Height: height,
+	}
+}
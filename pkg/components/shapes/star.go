@@ -0,0 +1,62 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	minStarPoints     = 3
+	defaultStarPoints = 5
+	// starInnerRadius is the inner radius of the star relative to the outer radius.
+	starInnerRadius = 0.4
+)
+
+// NewStar is responsible to create a Star with the given quantity of points, with less than 3 points a 5 point star is created.
+func NewStar(points int, ps ...props.Shape) core.Component {
+	if points < minStarPoints {
+		points = defaultStarPoints
+	}
+
+	return newShape("star", points, true, func(box *entity.Cell) []entity.Point {
+		return starPoints(box, points)
+	}, ps...)
+}
+
+// NewStarCol is responsible to create a Star wrapped in a Col.
+func NewStarCol(size int, points int, ps ...props.Shape) core.Col {
+	star := NewStar(points, ps...)
+	return col.New(size).Add(star)
+}
+
+// NewStarRow is responsible to create a Star wrapped in a Row.
+func NewStarRow(height float64, points int, ps ...props.Shape) core.Row {
+	star := NewStar(points, ps...)
+	c := col.New().Add(star)
+	return row.New(height).Add(c)
+}
+
+// starPoints alternates outer and inner vertices, starting from the top of the box.
+func starPoints(box *entity.Cell, points int) []entity.Point {
+	radius := box.Width / 2.0
+	cx, cy := box.X+radius, box.Y+radius
+	step := math.Pi / float64(points)
+
+	vertices := make([]entity.Point, 0, points*2)
+	for i := 0; i < points*2; i++ {
+		r := radius
+		if i%2 == 1 {
+			r = radius * starInnerRadius
+		}
+
+		angle := -math.Pi/2 + float64(i)*step
+		vertices = append(vertices, entity.Point{X: cx + r*math.Cos(angle), Y: cy + r*math.Sin(angle)})
+	}
+
+	return vertices
+}
@@ -0,0 +1,94 @@
+package shapes_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/shapes"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewStar(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := shapes.NewStar(5)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_star_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := shapes.NewStar(6, fixture.ShapeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_star_custom_prop.json")
+	})
+	t.Run("when points are less than 3, should use 5 points", func(t *testing.T) {
+		// Act
+		sut := shapes.NewStar(2)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_star_default_prop.json")
+	})
+}
+
+func TestNewStarCol(t *testing.T) {
+	// Act
+	sut := shapes.NewStarCol(12, 5)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_star_col.json")
+}
+
+func TestNewStarRow(t *testing.T) {
+	// Act
+	sut := shapes.NewStarRow(10, 5)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_star_row.json")
+}
+
+func TestStar_Render(t *testing.T) {
+	t.Run("should draw a polygon alternating outer and inner vertices inside a centered square", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 0, Y: 0, Width: 40, Height: 20}
+		sut := shapes.NewStar(5)
+
+		var points []entity.Point
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawPolygon(mock.Anything, mock.Anything).Run(func(p []entity.Point, _ *props.Line) {
+			points = p
+		})
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		assert.Len(t, points, 10)
+		assert.InDelta(t, 20.0, points[0].X, 0.0001)
+		assert.InDelta(t, 0.0, points[0].Y, 0.0001)
+		for i, point := range points {
+			distance := math.Hypot(point.X-20, point.Y-10)
+			if i%2 == 0 {
+				assert.InDelta(t, 10.0, distance, 0.0001)
+			} else {
+				assert.InDelta(t, 4.0, distance, 0.0001)
+			}
+		}
+	})
+}
+
+func TestStar_SetConfig(t *testing.T) {
+	// Arrange
+	sut := shapes.NewStar(5)
+
+	// Act
+	sut.SetConfig(&entity.Config{})
+}
@@ -56,8 +56,26 @@ func (s *signature) Render(provider core.Provider, cell *entity.Cell) {
 
 	offsetPercent := (cell.Height - fontSize) / cell.Height * 100.0
 
+	if s.prop.HasBox() {
+		provider.DrawRect(cell, s.prop.ToCellProp())
+	}
+
 	provider.AddText(s.value, cell, textProp)
 	provider.AddLine(cell, s.prop.ToLineProp(offsetPercent))
+
+	if s.prop.DrawX {
+		s.drawX(provider, cell, cell.Height-fontSize)
+	}
+}
+
+// drawX draws the diagonals of the area above the line, in the same width of the line.
+func (s *signature) drawX(provider core.Provider, cell *entity.Cell, height float64) {
+	lineProp := s.prop.ToLineProp(0)
+	width := cell.Width * lineProp.SizePercent / 100.0
+	left := cell.X + (cell.Width-width)/2.0
+
+	provider.DrawLine(entity.Point{X: left, Y: cell.Y}, entity.Point{X: left + width, Y: cell.Y + height}, lineProp)
+	provider.DrawLine(entity.Point{X: left + width, Y: cell.Y}, entity.Point{X: left, Y: cell.Y + height}, lineProp)
 }
 
 // GetStructure returns the Structure of a Signature.
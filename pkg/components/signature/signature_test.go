@@ -6,7 +6,11 @@ import (
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/components/signature"
+	"github.com/johnfercher/maroto/v2/pkg/consts/border"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -25,6 +29,19 @@ func TestNew(t *testing.T) {
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("components/signatures/new_signature_custom_prop.json")
 	})
+	t.Run("when box prop is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		prop := fixture.SignatureProp()
+		prop.BorderThickness = 0.5
+		prop.BackgroundColor = &props.Color{Red: 240, Green: 240, Blue: 240}
+		prop.DrawX = true
+
+		// Act
+		sut := signature.New("signature", prop)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/signatures/new_signature_box_prop.json")
+	})
 }
 
 func TestNewCol(t *testing.T) {
@@ -81,6 +98,42 @@ func TestSignature_Render(t *testing.T) {
 		provider.AssertNumberOfCalls(t, "AddText", 1)
 		provider.AssertNumberOfCalls(t, "GetTextHeight", 1)
 		provider.AssertNumberOfCalls(t, "AddLine", 1)
+		provider.AssertNotCalled(t, "DrawRect", mock.Anything, mock.Anything)
+	})
+	t.Run("when box and x are defined, should draw them", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 30}
+		prop := props.Signature{
+			BorderThickness: 0.5,
+			BackgroundColor: &props.Color{Red: 240, Green: 240, Blue: 240},
+			DrawX:           true,
+		}
+		sut := signature.New("signature", prop)
+
+		var points []entity.Point
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().DrawRect(&cell, &props.Cell{
+			BackgroundColor: prop.BackgroundColor,
+			BorderType:      border.Full,
+			BorderThickness: 0.5,
+		})
+		provider.EXPECT().AddText("signature", &cell, mock.Anything)
+		provider.EXPECT().AddLine(&cell, mock.Anything)
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything).Run(func(p0, p1 entity.Point, _ *props.Line) {
+			points = append(points, p0, p1)
+		})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, []entity.Point{
+			{X: 15, Y: 20},
+			{X: 105, Y: 44},
+			{X: 105, Y: 20},
+			{X: 15, Y: 44},
+		}, points)
 	})
 }
 
@@ -0,0 +1,198 @@
+// Package tablelist implements creation of tables with a header and zebra-striped rows.
+package tablelist
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const cellPadding = 1.0
+
+type tableList struct {
+	header  []core.Component
+	rows    [][]core.Component
+	columns int
+	prop    props.TableList
+	config  *entity.Config
+}
+
+// New is responsible to create an instance of a TableList, it fills the cell with the header and the rows,
+// all with the same height. The header is not written when it's empty and the rows are filled with the
+// EvenRowColor and the OddRowColor alternately, starting from the odd row, so the colors don't need to
+// be set on each row.
+func New(header []string, rows [][]string, ps ...props.TableList) core.Component {
+	prop := props.TableList{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	t := &tableList{
+		header: newTexts(header, prop, prop.HeaderFontStyle),
+		prop:   prop,
+	}
+
+	t.columns = len(header)
+	for _, values := range rows {
+		t.rows = append(t.rows, newTexts(values, prop, prop.ContentFontStyle))
+		t.columns = max(t.columns, len(values))
+	}
+
+	return t
+}
+
+// NewCol is responsible to create an instance of a TableList wrapped in a Col.
+func NewCol(size int, header []string, rows [][]string, ps ...props.TableList) core.Col {
+	tableList := New(header, rows, ps...)
+	return col.New(size).Add(tableList)
+}
+
+// NewRow is responsible to create an instance of a TableList wrapped in a Row.
+func NewRow(height float64, header []string, rows [][]string, ps ...props.TableList) core.Row {
+	tableList := New(header, rows, ps...)
+	c := col.New().Add(tableList)
+	return row.New(height).Add(c)
+}
+
+// Render renders a TableList into a PDF context.
+func (t *tableList) Render(provider core.Provider, cell *entity.Cell) {
+	lines := len(t.rows)
+	if len(t.header) > 0 {
+		lines++
+	}
+
+	if lines == 0 || t.columns == 0 {
+		return
+	}
+
+	widths := t.getColumnWidths(cell.Width)
+	line := entity.Cell{X: cell.X, Y: cell.Y, Width: cell.Width, Height: cell.Height / float64(lines)}
+
+	if len(t.header) > 0 {
+		t.renderLine(provider, t.header, line, widths, t.prop.HeaderColor)
+		line.Y += line.Height
+	}
+
+	for i, texts := range t.rows {
+		color := t.prop.OddRowColor
+		if i%2 == 1 {
+			color = t.prop.EvenRowColor
+		}
+
+		t.renderLine(provider, texts, line, widths, color)
+		line.Y += line.Height
+	}
+}
+
+// GetStructure returns the Structure of a TableList.
+func (t *tableList) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "tablelist",
+		Details: t.prop.ToMap(),
+	}
+
+	n := node.New(str)
+
+	if len(t.header) > 0 {
+		n.AddNext(getLineStructure("tablelist_header", t.header))
+	}
+
+	for _, texts := range t.rows {
+		n.AddNext(getLineStructure("tablelist_row", texts))
+	}
+
+	return n
+}
+
+// SetConfig sets the config.
+func (t *tableList) SetConfig(config *entity.Config) {
+	t.config = config
+	for _, component := range t.header {
+		component.SetConfig(config)
+	}
+
+	for _, texts := range t.rows {
+		for _, component := range texts {
+			component.SetConfig(config)
+		}
+	}
+}
+
+func (t *tableList) renderLine(provider core.Provider, texts []core.Component, line entity.Cell, widths []float64,
+	color *props.Color,
+) {
+	if color != nil {
+		provider.DrawRect(&line, &props.Cell{BackgroundColor: color})
+	}
+
+	x := line.X
+	for i, component := range texts {
+		component.Render(provider, &entity.Cell{X: x, Y: line.Y, Width: widths[i], Height: line.Height})
+		x += widths[i]
+	}
+}
+
+// getColumnWidths splits the width by the ColumnProportions, or equally when there isn't a proportion for
+// each column.
+func (t *tableList) getColumnWidths(width float64) []float64 {
+	proportions := t.prop.ColumnProportions
+	if len(proportions) != t.columns {
+		proportions = make([]float64, t.columns)
+		for i := range proportions {
+			proportions[i] = 1
+		}
+	}
+
+	total := 0.0
+	for _, proportion := range proportions {
+		total += proportion
+	}
+
+	widths := make([]float64, t.columns)
+	for i, proportion := range proportions {
+		widths[i] = width * proportion / total
+	}
+
+	return widths
+}
+
+// newTexts returns the texts of a line, with the font of the table and the alignment of each column.
+func newTexts(values []string, prop props.TableList, style fontstyle.Type) []core.Component {
+	textProp := props.Text{
+		Family: prop.FontFamily,
+		Style:  style,
+		Size:   prop.FontSize,
+		Color:  prop.FontColor,
+		Top:    cellPadding,
+		Left:   cellPadding,
+		Right:  cellPadding,
+	}
+
+	texts := make([]core.Component, len(values))
+	for i, value := range values {
+		textProp.Align = ""
+		if i < len(prop.ColumnAligns) {
+			textProp.Align = prop.ColumnAligns[i]
+		}
+
+		texts[i] = text.New(value, textProp)
+	}
+
+	return texts
+}
+
+func getLineStructure(lineType string, texts []core.Component) *node.Node[core.Structure] {
+	n := node.New(core.Structure{Type: lineType})
+	for _, component := range texts {
+		n.AddNext(component.GetStructure())
+	}
+
+	return n
+}
@@ -0,0 +1,135 @@
+package tablelist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/tablelist"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var (
+	header = []string{"Product", "Price"}
+	rows   = [][]string{
+		{"Apple", "1.00"},
+		{"Banana", "0.50"},
+		{"Cherry", "3.00"},
+	}
+)
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := tablelist.New(header, rows)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/tablelists/new_tablelist_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := tablelist.New(header, rows, fixture.TableListProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/tablelists/new_tablelist_custom_prop.json")
+	})
+	t.Run("when header is empty, should only have the rows", func(t *testing.T) {
+		// Act
+		sut := tablelist.New(nil, rows)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/tablelists/new_tablelist_without_header.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := tablelist.NewCol(12, header, rows)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/tablelists/new_tablelist_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := tablelist.NewRow(40, header, rows)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/tablelists/new_tablelist_row.json")
+}
+
+func TestTableList_Render(t *testing.T) {
+	t.Run("when prop is not sent, should fill the header and the even rows", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 40}
+		sut := tablelist.New(header, rows)
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{}})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawRect", 2)
+		provider.AssertCalled(t, "DrawRect", &entity.Cell{X: 10, Y: 20, Width: 100, Height: 10},
+			&props.Cell{BackgroundColor: &props.Color{Red: 200, Green: 200, Blue: 200}})
+		provider.AssertCalled(t, "DrawRect", &entity.Cell{X: 10, Y: 40, Width: 100, Height: 10},
+			&props.Cell{BackgroundColor: &props.Color{Red: 240, Green: 240, Blue: 240}})
+		provider.AssertNumberOfCalls(t, "AddText", 8)
+		provider.AssertCalled(t, "AddText", "Banana", &entity.Cell{X: 10, Y: 40, Width: 50, Height: 10}, mock.Anything)
+		provider.AssertCalled(t, "AddText", "0.50", &entity.Cell{X: 60, Y: 40, Width: 50, Height: 10}, mock.Anything)
+	})
+	t.Run("when prop is sent, should alternate the colors and apply the proportions", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 90, Height: 40}
+		prop := fixture.TableListProp()
+		sut := tablelist.New(header, rows, prop)
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{}})
+
+		var colors []props.AnyColor
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything).Run(func(_ *entity.Cell, prop *props.Cell) {
+			colors = append(colors, prop.BackgroundColor)
+		})
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, []props.AnyColor{prop.HeaderColor, prop.OddRowColor, prop.EvenRowColor, prop.OddRowColor}, colors)
+		provider.AssertCalled(t, "AddText", "Product", &entity.Cell{X: 0, Y: 0, Width: 60, Height: 10}, mock.Anything)
+		provider.AssertCalled(t, "AddText", "Price", &entity.Cell{X: 60, Y: 0, Width: 30, Height: 10}, mock.Anything)
+	})
+	t.Run("when there is no header and no rows, should not render", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 90, Height: 40}
+		sut := tablelist.New(nil, nil)
+
+		provider := mocks.NewProvider(t)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "DrawRect", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTableList_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := tablelist.New(header, rows)
+
+		// Act
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{}})
+	})
+}
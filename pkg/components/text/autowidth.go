@@ -0,0 +1,36 @@
+package text
+
+import (
+	"math"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/internal/fontmetrics"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// NewAutoWidth is responsible to create an instance of a Text and to return the minimum width, in mm,
+// of a column that writes it without breaking lines, including the left and right spaces.
+// The width is measured with the props font, when not defined arial 10 is used. Custom fonts are not
+// known before the document is built, so they are measured with arial.
+func NewAutoWidth(value string, ps ...props.Text) (core.Component, float64) {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	return New(value, textProp), MeasureWidth(value, textProp)
+}
+
+// MeasureWidth returns the width, in mm, of the widest line of the text, including the left and right spaces.
+// It's rounded up to the next tenth of mm, so the text fits in a column of this width. It's an estimate made
+// before the document is built, custom fonts are measured with arial, the components measure their texts
+// with core.Provider.GetStringWidth when they are rendered.
+func MeasureWidth(value string, textProp props.Text) float64 {
+	width := 0.0
+	for _, line := range strings.Split(value, "\n") {
+		width = math.Max(width, fontmetrics.GetStringWidth(line, textProp.Family, textProp.Style, textProp.Size))
+	}
+
+	return math.Floor(width*10)/10 + 0.1 + textProp.Left + textProp.Right
+}
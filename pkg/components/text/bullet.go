@@ -0,0 +1,106 @@
+package text
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const defaultBulletChar = "•"
+
+type bullet struct {
+	value  string
+	indent int
+	prop   props.Text
+	config *entity.Config
+}
+
+// NewBullet is responsible to create an instance of a Text with a bullet character written indent mm
+// from the left of the cell. The text follows the bullet on the same baseline and, when broken in lines,
+// every line starts after the bullet. The character is defined by props.Text.BulletChar, by default it is "•".
+func NewBullet(value string, indent int, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	if textProp.BulletChar == "" {
+		textProp.BulletChar = defaultBulletChar
+	}
+
+	if indent < 0 {
+		indent = 0
+	}
+
+	return &bullet{
+		value:  value,
+		indent: indent,
+		prop:   textProp,
+	}
+}
+
+// NewBulletCol is responsible to create an instance of a Bullet wrapped in a Col.
+func NewBulletCol(size int, value string, indent int, ps ...props.Text) core.Col {
+	bullet := NewBullet(value, indent, ps...)
+	return col.New(size).Add(bullet)
+}
+
+// NewBulletRow is responsible to create an instance of a Bullet wrapped in a Row.
+func NewBulletRow(height float64, value string, indent int, ps ...props.Text) core.Row {
+	bullet := NewBullet(value, indent, ps...)
+	c := col.New().Add(bullet)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of a Bullet.
+func (b *bullet) GetStructure() *node.Node[core.Structure] {
+	details := b.prop.ToMap()
+	details["indent"] = b.indent
+
+	str := core.Structure{
+		Type:    "bullet",
+		Value:   b.value,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (b *bullet) SetConfig(config *entity.Config) {
+	b.config = config
+	b.prop.MakeValid(b.config.DefaultFont)
+}
+
+// Render renders a Bullet into a PDF context.
+func (b *bullet) Render(provider core.Provider, cell *entity.Cell) {
+	gap := provider.GetStringWidth(b.prop.BulletChar+" ", &props.Font{
+		Family: b.prop.Family,
+		Style:  b.prop.Style,
+		Size:   b.prop.Size,
+	})
+	offset := float64(b.indent) + gap
+
+	bulletCell := cell.Copy()
+	bulletCell.X += float64(b.indent)
+	bulletCell.Width = gap
+
+	bulletProp := b.prop
+	bulletProp.Left = 0
+	bulletProp.Right = 0
+	bulletProp.Align = align.Left
+	bulletProp.Hyperlink = nil
+
+	provider.AddText(b.prop.BulletChar, &bulletCell, &bulletProp)
+
+	textCell := cell.Copy()
+	textCell.X += offset
+	textCell.Width -= offset
+
+	provider.AddText(b.value, &textCell, &b.prop)
+}
@@ -0,0 +1,100 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewBullet(t *testing.T) {
+	t.Run("when prop is not sent, should use default bullet char", func(t *testing.T) {
+		// Act
+		sut := text.NewBullet("item", 5)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_bullet_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the bullet char sent", func(t *testing.T) {
+		// Act
+		sut := text.NewBullet("item", 5, props.Text{BulletChar: "-", Size: 12})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_bullet_custom_prop.json")
+	})
+}
+
+func TestNewBulletCol(t *testing.T) {
+	// Act
+	sut := text.NewBulletCol(12, "item", 5)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_bullet_col.json")
+}
+
+func TestNewBulletRow(t *testing.T) {
+	// Act
+	sut := text.NewBulletRow(10, "item", 5)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_bullet_row.json")
+}
+
+func TestBullet_Render(t *testing.T) {
+	t.Run("should write bullet at indent and text after the bullet", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 10}
+		fontProp := fixture.FontProp()
+		gap := 4.0
+
+		var bulletProp, textProp *props.Text
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetStringWidth("• ", &props.Font{Family: fontProp.Family, Style: fontProp.Style, Size: fontProp.Size}).
+			Return(gap)
+		provider.EXPECT().AddText("•", &entity.Cell{X: 15, Y: 20, Width: gap, Height: 10}, mock.Anything).
+			Run(func(_ string, _ *entity.Cell, p *props.Text) {
+				bulletProp = p
+			})
+		provider.EXPECT().AddText("item", &entity.Cell{X: 15 + gap, Y: 20, Width: 95 - gap, Height: 10}, mock.Anything).
+			Run(func(_ string, _ *entity.Cell, p *props.Text) {
+				textProp = p
+			})
+
+		sut := text.NewBullet("item", 5, props.Text{Left: 2})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 0.0, bulletProp.Left)
+		assert.Equal(t, 2.0, textProp.Left)
+		assert.Equal(t, bulletProp.Top, textProp.Top)
+		assert.Equal(t, bulletProp.Size, textProp.Size)
+	})
+	t.Run("when indent is negative, should write bullet at the left of the cell", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 10}
+		fontProp := fixture.FontProp()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetStringWidth("- ", mock.Anything).Return(2.0)
+		provider.EXPECT().AddText("-", mock.Anything, mock.Anything).Run(func(_ string, c *entity.Cell, _ *props.Text) {
+			assert.Equal(t, 10.0, c.X)
+		})
+		provider.EXPECT().AddText("item", mock.Anything, mock.Anything)
+
+		sut := text.NewBullet("item", -5, props.Text{BulletChar: "-"})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+	})
+}
@@ -0,0 +1,302 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/theme"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type tokenKind int
+
+const (
+	plainToken tokenKind = iota
+	keywordToken
+	stringToken
+	numberToken
+	commentToken
+)
+
+// codePalette is the set of colors of a theme, one for each kind of token and one for the background.
+type codePalette struct {
+	background *props.Color
+	tokens     map[tokenKind]*props.Color
+}
+
+var (
+	codePalettes = map[theme.Name]codePalette{
+		theme.Light: {
+			background: &props.Color{Red: 246, Green: 248, Blue: 250},
+			tokens: map[tokenKind]*props.Color{
+				plainToken:   {Red: 36, Green: 41, Blue: 46},
+				keywordToken: {Red: 215, Green: 58, Blue: 73},
+				stringToken:  {Red: 3, Green: 47, Blue: 98},
+				numberToken:  {Red: 0, Green: 92, Blue: 197},
+				commentToken: {Red: 106, Green: 115, Blue: 125},
+			},
+		},
+		theme.Dark: {
+			background: &props.Color{Red: 40, Green: 44, Blue: 52},
+			tokens: map[tokenKind]*props.Color{
+				plainToken:   {Red: 171, Green: 178, Blue: 191},
+				keywordToken: {Red: 198, Green: 120, Blue: 221},
+				stringToken:  {Red: 152, Green: 195, Blue: 121},
+				numberToken:  {Red: 209, Green: 154, Blue: 102},
+				commentToken: {Red: 92, Green: 99, Blue: 112},
+			},
+		},
+	}
+	codeLanguages = map[string]codeLanguage{
+		"go": {
+			comment: "//",
+			quotes:  "\"'`",
+			keywords: newKeywords("break", "case", "chan", "const", "continue", "default", "defer", "else",
+				"fallthrough", "for", "func", "go", "goto", "if", "import", "interface", "map", "package", "range",
+				"return", "select", "struct", "switch", "type", "var", "nil", "true", "false"),
+		},
+		"json": {
+			quotes:   "\"",
+			keywords: newKeywords("true", "false", "null"),
+			keyColon: true,
+		},
+		"yaml": {
+			comment:  "#",
+			quotes:   "\"'",
+			keywords: newKeywords("true", "false", "null", "yes", "no", "on", "off"),
+			keyColon: true,
+		},
+		"sql": {
+			comment:    "--",
+			quotes:     "'\"",
+			ignoreCase: true,
+			keywords: newKeywords("select", "from", "where", "and", "or", "not", "insert", "into", "values", "update",
+				"set", "delete", "create", "table", "drop", "alter", "join", "left", "right", "inner", "outer", "on",
+				"group", "by", "order", "having", "limit", "as", "distinct", "null", "is", "in", "like", "asc", "desc"),
+		},
+	}
+)
+
+// codeLanguage is the minimal definition of a language used to split a code in tokens.
+type codeLanguage struct {
+	comment    string
+	quotes     string
+	keywords   map[string]bool
+	ignoreCase bool
+	// keyColon define that a word followed by a colon is a key, highlighted as a keyword.
+	keyColon bool
+}
+
+type code struct {
+	value  string
+	lang   string
+	inline *inline
+	prop   props.Text
+	config *entity.Config
+}
+
+// NewCode is responsible to create an instance of a Text with a syntax highlighted code block.
+// The tokens of the languages go, json, yaml and sql are colored by keyword lists, other languages
+// are written without highlight. The colors come from props.Text.CodeTheme, by default theme.Light,
+// and the font is courier when the family is not defined.
+func NewCode(value string, lang string, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	if textProp.Family == "" {
+		textProp.Family = fontfamily.Courier
+	}
+
+	if _, ok := codePalettes[textProp.CodeTheme]; !ok {
+		textProp.CodeTheme = theme.Light
+	}
+
+	lang = strings.ToLower(lang)
+
+	return &code{
+		value:  value,
+		lang:   lang,
+		inline: newInline(Highlight(value, lang, textProp.CodeTheme), textProp),
+		prop:   textProp,
+	}
+}
+
+// NewCodeCol is responsible to create an instance of a code Text wrapped in a Col.
+func NewCodeCol(size int, value string, lang string, ps ...props.Text) core.Col {
+	code := NewCode(value, lang, ps...)
+	return col.New(size).Add(code)
+}
+
+// NewCodeRow is responsible to create an instance of a code Text wrapped in a Row.
+func NewCodeRow(height float64, value string, lang string, ps ...props.Text) core.Row {
+	code := NewCode(value, lang, ps...)
+	c := col.New().Add(code)
+	return row.New(height).Add(c)
+}
+
+// Highlight splits a code in parts colored by the kind of each token, ex: keywords, strings and comments.
+func Highlight(value string, lang string, name theme.Name) []InlinePart {
+	palette, ok := codePalettes[name]
+	if !ok {
+		palette = codePalettes[theme.Light]
+	}
+
+	language, ok := codeLanguages[strings.ToLower(lang)]
+
+	var parts []InlinePart
+	for _, line := range strings.SplitAfter(value, "\n") {
+		tokens := []codeToken{{value: line}}
+		if ok {
+			tokens = language.tokenize(line)
+		}
+
+		for _, token := range tokens {
+			parts = append(parts, InlinePart{
+				Value: token.value,
+				Color: palette.tokens[token.kind],
+			})
+		}
+	}
+
+	return parts
+}
+
+// GetStructure returns the Structure of a code Text.
+func (c *code) GetStructure() *node.Node[core.Structure] {
+	details := c.prop.ToMap()
+	details["lang"] = c.lang
+
+	str := core.Structure{
+		Type:    "code",
+		Value:   c.value,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (c *code) SetConfig(config *entity.Config) {
+	c.config = config
+	c.inline.SetConfig(config)
+}
+
+// Render renders a code Text into a PDF context.
+func (c *code) Render(provider core.Provider, cell *entity.Cell) {
+	provider.DrawRect(cell, &props.Cell{BackgroundColor: codePalettes[c.prop.CodeTheme].background})
+	c.inline.Render(provider, cell)
+}
+
+// getHeight returns the height of a code Text written in a cell of the width, the top padding is repeated below it.
+func (c *code) getHeight(provider core.Provider, width float64) float64 {
+	return c.inline.getHeight(provider, width) + c.prop.Top
+}
+
+type codeToken struct {
+	value string
+	kind  tokenKind
+}
+
+// tokenize splits a line in tokens, the whitespaces are kept as plain tokens.
+func (l codeLanguage) tokenize(line string) []codeToken {
+	var tokens []codeToken
+	runes := []rune(line)
+
+	for i := 0; i < len(runes); {
+		rest := string(runes[i:])
+		c := runes[i]
+
+		switch {
+		case l.comment != "" && strings.HasPrefix(rest, l.comment):
+			tokens = append(tokens, codeToken{value: strings.TrimRight(rest, "\n"), kind: commentToken})
+			if strings.HasSuffix(rest, "\n") {
+				tokens = append(tokens, codeToken{value: "\n"})
+			}
+			return tokens
+		case strings.ContainsRune(l.quotes, c):
+			end := i + 1
+			for end < len(runes) && runes[end] != c && runes[end] != '\n' {
+				if runes[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end = min(end+1, len(runes))
+			tokens = append(tokens, codeToken{value: string(runes[i:end]), kind: l.getStringKind(runes, end)})
+			i = end
+		case unicode.IsDigit(c):
+			end := l.scan(runes, i, func(r rune) bool { return unicode.IsDigit(r) || r == '.' || unicode.IsLetter(r) })
+			tokens = append(tokens, codeToken{value: string(runes[i:end]), kind: numberToken})
+			i = end
+		case unicode.IsLetter(c) || c == '_':
+			end := l.scan(runes, i, func(r rune) bool {
+				return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' && l.keyColon
+			})
+			tokens = append(tokens, codeToken{value: string(runes[i:end]), kind: l.getWordKind(runes, i, end)})
+			i = end
+		default:
+			end := l.scan(runes, i, func(r rune) bool { return unicode.IsSpace(r) && r != '\n' })
+			if end == i {
+				end++
+			}
+			tokens = append(tokens, codeToken{value: string(runes[i:end])})
+			i = end
+		}
+	}
+
+	return tokens
+}
+
+func (l codeLanguage) scan(runes []rune, start int, accept func(r rune) bool) int {
+	end := start
+	for end < len(runes) && accept(runes[end]) {
+		end++
+	}
+
+	return end
+}
+
+func (l codeLanguage) getWordKind(runes []rune, start, end int) tokenKind {
+	word := string(runes[start:end])
+	if l.ignoreCase {
+		word = strings.ToLower(word)
+	}
+
+	if l.keywords[word] || l.keyColon && l.isKey(runes, end) {
+		return keywordToken
+	}
+
+	return plainToken
+}
+
+// getStringKind returns the kind of a quoted token, on languages with keys the quoted keys are keywords.
+func (l codeLanguage) getStringKind(runes []rune, end int) tokenKind {
+	if l.keyColon && l.isKey(runes, end) {
+		return keywordToken
+	}
+
+	return stringToken
+}
+
+func (l codeLanguage) isKey(runes []rune, end int) bool {
+	next := l.scan(runes, end, func(r rune) bool { return r == ' ' || r == '\t' })
+	return next < len(runes) && runes[next] == ':'
+}
+
+func newKeywords(words ...string) map[string]bool {
+	keywords := make(map[string]bool, len(words))
+	for _, word := range words {
+		keywords[word] = true
+	}
+
+	return keywords
+}
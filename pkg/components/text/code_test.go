@@ -0,0 +1,154 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/theme"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewCode(t *testing.T) {
+	t.Run("when prop is not sent, should use courier and light theme", func(t *testing.T) {
+		// Act
+		sut := text.NewCode("return nil", "go")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_code_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := text.NewCode("SELECT 1", "SQL", props.Text{Size: 8, CodeTheme: theme.Dark})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_code_custom_prop.json")
+	})
+}
+
+func TestNewCodeCol(t *testing.T) {
+	// Act
+	sut := text.NewCodeCol(12, "return nil", "go")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_code_col.json")
+}
+
+func TestNewCodeRow(t *testing.T) {
+	// Act
+	sut := text.NewCodeRow(10, "return nil", "go")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_code_row.json")
+}
+
+func TestHighlight(t *testing.T) {
+	light := text.Highlight("", "go", theme.Light)
+	keyword := &props.Color{Red: 215, Green: 58, Blue: 73}
+	str := &props.Color{Red: 3, Green: 47, Blue: 98}
+	number := &props.Color{Red: 0, Green: 92, Blue: 197}
+	comment := &props.Color{Red: 106, Green: 115, Blue: 125}
+	plain := &props.Color{Red: 36, Green: 41, Blue: 46}
+
+	t.Run("when language is go, should highlight keywords, strings, numbers and comments", func(t *testing.T) {
+		// Act
+		parts := text.Highlight("return \"a\", 10 // done", "go", theme.Light)
+
+		// Assert
+		assert.Equal(t, []text.InlinePart{
+			{Value: "return", Color: keyword},
+			{Value: " ", Color: plain},
+			{Value: "\"a\"", Color: str},
+			{Value: ",", Color: plain},
+			{Value: " ", Color: plain},
+			{Value: "10", Color: number},
+			{Value: " ", Color: plain},
+			{Value: "// done", Color: comment},
+		}, parts)
+	})
+	t.Run("when language is json, should highlight keys and literals", func(t *testing.T) {
+		// Act
+		parts := text.Highlight("{\"a\": \"b\", \"c\": null}", "json", theme.Light)
+
+		// Assert
+		assert.Equal(t, keyword, parts[1].Color)
+		assert.Equal(t, "\"a\"", parts[1].Value)
+		assert.Equal(t, str, parts[4].Color)
+		assert.Equal(t, "null", parts[10].Value)
+		assert.Equal(t, keyword, parts[10].Color)
+	})
+	t.Run("when language is yaml, should highlight keys and comments", func(t *testing.T) {
+		// Act
+		parts := text.Highlight("name: maroto # lib\n", "yaml", theme.Light)
+
+		// Assert
+		assert.Equal(t, []text.InlinePart{
+			{Value: "name", Color: keyword},
+			{Value: ":", Color: plain},
+			{Value: " ", Color: plain},
+			{Value: "maroto", Color: plain},
+			{Value: " ", Color: plain},
+			{Value: "# lib", Color: comment},
+			{Value: "\n", Color: plain},
+		}, parts)
+	})
+	t.Run("when language is sql, should ignore the case of keywords", func(t *testing.T) {
+		// Act
+		parts := text.Highlight("SELECT id from users", "sql", theme.Light)
+
+		// Assert
+		assert.Equal(t, keyword, parts[0].Color)
+		assert.Equal(t, plain, parts[2].Color)
+		assert.Equal(t, keyword, parts[4].Color)
+	})
+	t.Run("when language is unknown, should not highlight", func(t *testing.T) {
+		// Act
+		parts := text.Highlight("return 1\nreturn 2", "cobol", theme.Dark)
+
+		// Assert
+		assert.Equal(t, []text.InlinePart{
+			{Value: "return 1\n", Color: &props.Color{Red: 171, Green: 178, Blue: 191}},
+			{Value: "return 2", Color: &props.Color{Red: 171, Green: 178, Blue: 191}},
+		}, parts)
+	})
+	t.Run("when theme is unknown, should use light theme", func(t *testing.T) {
+		// Act
+		parts := text.Highlight("return", "go", "unknown")
+
+		// Assert
+		assert.Empty(t, light)
+		assert.Equal(t, keyword, parts[0].Color)
+	})
+}
+
+func TestCode_Render(t *testing.T) {
+	t.Run("should draw the theme background and write the tokens", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		fontProp := fixture.FontProp()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().DrawRect(&cell, &props.Cell{BackgroundColor: &props.Color{Red: 40, Green: 44, Blue: 52}})
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		})
+		provider.EXPECT().AddText("return", mock.Anything, mock.Anything)
+		provider.EXPECT().AddText("nil", mock.Anything, mock.Anything)
+
+		sut := text.NewCode("return nil", "go", props.Text{CodeTheme: theme.Dark})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 2)
+	})
+}
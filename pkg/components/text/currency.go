@@ -0,0 +1,122 @@
+package text
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ErrUnknownCurrency is returned when a currency is not an ISO 4217 code.
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// currencyPattern is the position of the symbol and the negation of the amounts of a locale.
+type currencyPattern struct {
+	suffix      bool
+	space       bool
+	parentheses bool
+}
+
+var (
+	defaultCurrencyPattern = currencyPattern{}
+	// currencyPatterns are searched by the locale language and region, ex: "en-US", and then by the language, ex: "en".
+	currencyPatterns = map[string]currencyPattern{
+		"en":    {parentheses: true},
+		"en-GB": {},
+		"en-IN": {},
+		"de":    {suffix: true, space: true},
+		"fr":    {suffix: true, space: true},
+		"es":    {suffix: true, space: true},
+		"it":    {suffix: true, space: true},
+		"pt":    {space: true},
+		"pt-PT": {suffix: true, space: true},
+		"nl":    {space: true},
+		"ru":    {suffix: true, space: true},
+		"pl":    {suffix: true, space: true},
+		"sv":    {suffix: true, space: true},
+	}
+)
+
+// NewCurrency is responsible to create an instance of a Text with an amount formatted as an ISO 4217 currency
+// according to a locale, ex: "$1,234.50" in en-US and "1.234,50 €" in de-DE. The amount is rounded to the digits
+// of the currency and negative amounts are written with a minus or, on locales like en-US, between parentheses.
+// When the currency or the locale are not recognised, the error is rendered instead of the amount.
+func NewCurrency(amount float64, currency, locale string, ps ...props.Text) core.Component {
+	value, err := FormatCurrency(amount, currency, locale)
+	if err != nil {
+		return New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return New(value, ps...)
+}
+
+// FormatCurrency formats an amount of an ISO 4217 currency according to a locale. It returns ErrUnknownCurrency
+// when the currency is not recognised and ErrUnknownLocale when the locale is not recognised.
+func FormatCurrency(amount float64, code, locale string) (string, error) {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return "", ErrUnknownCurrency
+	}
+
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", ErrUnknownLocale
+	}
+
+	base, confidence := tag.Base()
+	if confidence == language.No {
+		return "", ErrUnknownLocale
+	}
+
+	pattern := getCurrencyPattern(tag, base)
+	printer := message.NewPrinter(tag)
+	scale, _ := currency.Standard.Rounding(unit)
+
+	digits := printer.Sprint(number.Decimal(math.Abs(amount), number.Scale(scale)))
+	symbol := printer.Sprint(currency.Symbol(unit))
+
+	// The spaces used as group separators by some locales are not available on the standard fonts.
+	digits = strings.NewReplacer("\u00a0", " ", "\u202f", " ").Replace(digits)
+
+	separator := ""
+	if pattern.space {
+		separator = " "
+	}
+
+	value := symbol + separator + digits
+	if pattern.suffix {
+		value = digits + separator + symbol
+	}
+
+	if amount >= 0 || digits == printer.Sprint(number.Decimal(0.0, number.Scale(scale))) {
+		return value, nil
+	}
+
+	if pattern.parentheses {
+		return "(" + value + ")", nil
+	}
+
+	return "-" + value, nil
+}
+
+func getCurrencyPattern(tag language.Tag, base language.Base) currencyPattern {
+	if region, confidence := tag.Region(); confidence == language.Exact {
+		if pattern, ok := currencyPatterns[base.String()+"-"+region.String()]; ok {
+			return pattern
+		}
+	}
+
+	if pattern, ok := currencyPatterns[base.String()]; ok {
+		return pattern
+	}
+
+	return defaultCurrencyPattern
+}
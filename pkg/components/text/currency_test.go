@@ -0,0 +1,94 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewCurrency(t *testing.T) {
+	t.Run("when currency and locale are known, should create text with formatted amount", func(t *testing.T) {
+		// Act
+		sut := text.NewCurrency(1234.5, "EUR", "de-DE")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_currency_de.json")
+	})
+	t.Run("when currency is unknown, should create text with error", func(t *testing.T) {
+		// Act
+		sut := text.NewCurrency(1234.5, "ABC", "de-DE")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_currency_unknown_currency.json")
+	})
+}
+
+func TestFormatCurrency(t *testing.T) {
+	t.Run("when amount is positive, should place symbol according to locale", func(t *testing.T) {
+		// Act
+		us, errUs := text.FormatCurrency(1234.5, "USD", "en-US")
+		de, errDe := text.FormatCurrency(1234.5, "EUR", "de-DE")
+		fr, errFr := text.FormatCurrency(1234.5, "EUR", "fr")
+		br, errBr := text.FormatCurrency(1234.5, "BRL", "pt-BR")
+
+		// Assert
+		assert.Nil(t, errUs)
+		assert.Equal(t, "$1,234.50", us)
+		assert.Nil(t, errDe)
+		assert.Equal(t, "1.234,50 €", de)
+		assert.Nil(t, errFr)
+		assert.Equal(t, "1 234,50 €", fr)
+		assert.Nil(t, errBr)
+		assert.Equal(t, "R$ 1.234,50", br)
+	})
+	t.Run("when amount is negative, should negate according to locale", func(t *testing.T) {
+		// Act
+		us, errUs := text.FormatCurrency(-1234.5, "USD", "en-US")
+		gb, errGb := text.FormatCurrency(-1234.5, "GBP", "en-GB")
+		de, errDe := text.FormatCurrency(-1234.5, "EUR", "de")
+
+		// Assert
+		assert.Nil(t, errUs)
+		assert.Equal(t, "($1,234.50)", us)
+		assert.Nil(t, errGb)
+		assert.Equal(t, "-£1,234.50", gb)
+		assert.Nil(t, errDe)
+		assert.Equal(t, "-1.234,50 €", de)
+	})
+	t.Run("when currency has no minor unit, should round to integer", func(t *testing.T) {
+		// Act
+		value, err := text.FormatCurrency(1234.6, "JPY", "en-US")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "¥1,235", value)
+	})
+	t.Run("when negative amount rounds to zero, should not negate", func(t *testing.T) {
+		// Act
+		value, err := text.FormatCurrency(-0.001, "USD", "en-US")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "$0.00", value)
+	})
+	t.Run("when currency is unknown, should return error", func(t *testing.T) {
+		// Act
+		value, err := text.FormatCurrency(1, "ABC", "en-US")
+
+		// Assert
+		assert.Equal(t, text.ErrUnknownCurrency, err)
+		assert.Empty(t, value)
+	})
+	t.Run("when locale is unknown, should return error", func(t *testing.T) {
+		// Act
+		_, errUnknown := text.FormatCurrency(1, "USD", "xx")
+		_, errMalformed := text.FormatCurrency(1, "USD", "!!")
+
+		// Assert
+		assert.Equal(t, text.ErrUnknownLocale, errUnknown)
+		assert.Equal(t, text.ErrUnknownLocale, errMalformed)
+	})
+}
@@ -0,0 +1,402 @@
+package text
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ErrUnknownLocale is returned when a locale is not recognised.
+var ErrUnknownLocale = errors.New("unknown locale")
+
+var (
+	months = []string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	}
+	weekdays = []string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	}
+	dateTranslations = map[language.Tag]dateTranslation{
+		language.French: {
+			layout: "2 January 2006",
+			months: []string{
+				"janvier",
+				"février",
+				"mars",
+				"avril",
+				"mai",
+				"juin",
+				"juillet",
+				"août",
+				"septembre",
+				"octobre",
+				"novembre",
+				"décembre",
+			},
+			shortMonths: []string{
+				"janv.",
+				"févr.",
+				"mars",
+				"avr.",
+				"mai",
+				"juin",
+				"juil.",
+				"août",
+				"sept.",
+				"oct.",
+				"nov.",
+				"déc.",
+			},
+			days: []string{
+				"dimanche",
+				"lundi",
+				"mardi",
+				"mercredi",
+				"jeudi",
+				"vendredi",
+				"samedi",
+			},
+			shortDays: []string{
+				"dim.",
+				"lun.",
+				"mar.",
+				"mer.",
+				"jeu.",
+				"ven.",
+				"sam.",
+			},
+		},
+		language.German: {
+			layout: "2. January 2006",
+			months: []string{
+				"Januar",
+				"Februar",
+				"März",
+				"April",
+				"Mai",
+				"Juni",
+				"Juli",
+				"August",
+				"September",
+				"Oktober",
+				"November",
+				"Dezember",
+			},
+			shortMonths: []string{
+				"Jan.",
+				"Feb.",
+				"März",
+				"Apr.",
+				"Mai",
+				"Juni",
+				"Juli",
+				"Aug.",
+				"Sept.",
+				"Okt.",
+				"Nov.",
+				"Dez.",
+			},
+			days: []string{
+				"Sonntag",
+				"Montag",
+				"Dienstag",
+				"Mittwoch",
+				"Donnerstag",
+				"Freitag",
+				"Samstag",
+			},
+			shortDays: []string{
+				"So.",
+				"Mo.",
+				"Di.",
+				"Mi.",
+				"Do.",
+				"Fr.",
+				"Sa.",
+			},
+		},
+		language.Spanish: {
+			layout: "2 de January de 2006",
+			months: []string{
+				"enero",
+				"febrero",
+				"marzo",
+				"abril",
+				"mayo",
+				"junio",
+				"julio",
+				"agosto",
+				"septiembre",
+				"octubre",
+				"noviembre",
+				"diciembre",
+			},
+			shortMonths: []string{
+				"ene.",
+				"feb.",
+				"mar.",
+				"abr.",
+				"may.",
+				"jun.",
+				"jul.",
+				"ago.",
+				"sept.",
+				"oct.",
+				"nov.",
+				"dic.",
+			},
+			days: []string{
+				"domingo",
+				"lunes",
+				"martes",
+				"miércoles",
+				"jueves",
+				"viernes",
+				"sábado",
+			},
+			shortDays: []string{
+				"dom.",
+				"lun.",
+				"mar.",
+				"mié.",
+				"jue.",
+				"vie.",
+				"sáb.",
+			},
+		},
+		language.Portuguese: {
+			layout: "2 de January de 2006",
+			months: []string{
+				"janeiro",
+				"fevereiro",
+				"março",
+				"abril",
+				"maio",
+				"junho",
+				"julho",
+				"agosto",
+				"setembro",
+				"outubro",
+				"novembro",
+				"dezembro",
+			},
+			shortMonths: []string{
+				"jan.",
+				"fev.",
+				"mar.",
+				"abr.",
+				"mai.",
+				"jun.",
+				"jul.",
+				"ago.",
+				"set.",
+				"out.",
+				"nov.",
+				"dez.",
+			},
+			days: []string{
+				"domingo",
+				"segunda-feira",
+				"terça-feira",
+				"quarta-feira",
+				"quinta-feira",
+				"sexta-feira",
+				"sábado",
+			},
+			shortDays: []string{
+				"dom.",
+				"seg.",
+				"ter.",
+				"qua.",
+				"qui.",
+				"sex.",
+				"sáb.",
+			},
+		},
+		language.Italian: {
+			layout: "2 January 2006",
+			months: []string{
+				"gennaio",
+				"febbraio",
+				"marzo",
+				"aprile",
+				"maggio",
+				"giugno",
+				"luglio",
+				"agosto",
+				"settembre",
+				"ottobre",
+				"novembre",
+				"dicembre",
+			},
+			shortMonths: []string{
+				"gen",
+				"feb",
+				"mar",
+				"apr",
+				"mag",
+				"giu",
+				"lug",
+				"ago",
+				"set",
+				"ott",
+				"nov",
+				"dic",
+			},
+			days: []string{
+				"domenica",
+				"lunedì",
+				"martedì",
+				"mercoledì",
+				"giovedì",
+				"venerdì",
+				"sabato",
+			},
+			shortDays: []string{
+				"dom",
+				"lun",
+				"mar",
+				"mer",
+				"gio",
+				"ven",
+				"sab",
+			},
+		},
+	}
+	dateLayouts   = map[language.Tag]string{language.English: "January 2, 2006"}
+	dateCatalog   = buildDateCatalog()
+	dateLanguages = dateCatalog.Languages()
+	dateMatcher   = language.NewMatcher(dateLanguages)
+	dateKeyShort  = "short:"
+)
+
+type dateTranslation struct {
+	layout      string
+	months      []string
+	shortMonths []string
+	days        []string
+	shortDays   []string
+}
+
+// NewDate is responsible to create an instance of a Text with a date formatted according to a locale.
+// The format follows the time.Format layout, when empty the default layout of the locale is used.
+// When the locale is not recognised, the error is rendered instead of the date.
+func NewDate(t time.Time, format, locale string, ps ...props.Text) core.Component {
+	value, err := FormatDate(t, format, locale)
+	if err != nil {
+		return New(err.Error(), *merror.DefaultErrorText)
+	}
+
+	return New(value, ps...)
+}
+
+// FormatDate formats a date according to a locale, ex: "15 janvier 2024" in fr and "January 15, 2024" in en.
+// It returns ErrUnknownLocale when the locale is not recognised.
+func FormatDate(t time.Time, format, locale string) (string, error) {
+	tag, err := matchDateLocale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	if format == "" {
+		format = dateLayouts[tag]
+	}
+
+	printer := message.NewPrinter(tag, message.Catalog(dateCatalog))
+
+	var sb strings.Builder
+	for format != "" {
+		prefix, name, suffix := nextDateName(format)
+		if prefix != "" {
+			sb.WriteString(t.Format(prefix))
+		}
+
+		if name != "" {
+			sb.WriteString(translateDateName(printer, t, name))
+		}
+
+		format = suffix
+	}
+
+	return sb.String(), nil
+}
+
+func matchDateLocale(locale string) (language.Tag, error) {
+	requested, err := language.Parse(locale)
+	if err != nil {
+		return language.Und, ErrUnknownLocale
+	}
+
+	_, index, confidence := dateMatcher.Match(requested)
+	if confidence == language.No {
+		return language.Und, ErrUnknownLocale
+	}
+
+	return dateLanguages[index], nil
+}
+
+// nextDateName splits a layout in the part before the first month or weekday name,
+// the name itself and the remaining layout.
+func nextDateName(layout string) (string, string, string) {
+	for i := 0; i < len(layout); i++ {
+		for _, name := range []string{"January", "Monday", "Jan", "Mon"} {
+			if strings.HasPrefix(layout[i:], name) {
+				return layout[:i], name, layout[i+len(name):]
+			}
+		}
+	}
+
+	return layout, "", ""
+}
+
+func translateDateName(printer *message.Printer, t time.Time, name string) string {
+	switch name {
+	case "January":
+		return printer.Sprintf(t.Month().String())
+	case "Jan":
+		return printer.Sprintf(dateKeyShort + t.Month().String())
+	case "Monday":
+		return printer.Sprintf(t.Weekday().String())
+	default:
+		return printer.Sprintf(dateKeyShort + t.Weekday().String())
+	}
+}
+
+func buildDateCatalog() *catalog.Builder {
+	builder := catalog.NewBuilder(catalog.Fallback(language.English))
+
+	for _, month := range months {
+		_ = builder.SetString(language.English, month, month)
+		_ = builder.SetString(language.English, dateKeyShort+month, month[:3])
+	}
+
+	for _, day := range weekdays {
+		_ = builder.SetString(language.English, day, day)
+		_ = builder.SetString(language.English, dateKeyShort+day, day[:3])
+	}
+
+	for tag, translation := range dateTranslations {
+		dateLayouts[tag] = translation.layout
+
+		for i, month := range months {
+			_ = builder.SetString(tag, month, translation.months[i])
+			_ = builder.SetString(tag, dateKeyShort+month, translation.shortMonths[i])
+		}
+
+		for i, day := range weekdays {
+			_ = builder.SetString(tag, day, translation.days[i])
+			_ = builder.SetString(tag, dateKeyShort+day, translation.shortDays[i])
+		}
+	}
+
+	return builder
+}
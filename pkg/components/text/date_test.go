@@ -0,0 +1,80 @@
+package text_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewDate(t *testing.T) {
+	date := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("when locale is known, should create text with formatted date", func(t *testing.T) {
+		// Act
+		sut := text.NewDate(date, "", "fr")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_date_fr.json")
+	})
+	t.Run("when locale is unknown, should create text with error", func(t *testing.T) {
+		// Act
+		sut := text.NewDate(date, "", "xx")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_date_unknown_locale.json")
+	})
+}
+
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("when format is empty, should use locale default layout", func(t *testing.T) {
+		// Act
+		fr, errFr := text.FormatDate(date, "", "fr")
+		en, errEn := text.FormatDate(date, "", "en")
+		de, errDe := text.FormatDate(date, "", "de-DE")
+
+		// Assert
+		assert.Nil(t, errFr)
+		assert.Equal(t, "15 janvier 2024", fr)
+		assert.Nil(t, errEn)
+		assert.Equal(t, "January 15, 2024", en)
+		assert.Nil(t, errDe)
+		assert.Equal(t, "15. Januar 2024", de)
+	})
+	t.Run("when format has short names, should translate short names", func(t *testing.T) {
+		// Act
+		value, err := text.FormatDate(date, "Mon 02 Jan 2006", "es")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "lun. 15 ene. 2024", value)
+	})
+	t.Run("when format has long names, should translate long names", func(t *testing.T) {
+		// Act
+		value, err := text.FormatDate(date, "Monday, 02/01/2006", "pt-BR")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "segunda-feira, 15/01/2024", value)
+	})
+	t.Run("when locale is unknown, should return error", func(t *testing.T) {
+		// Act
+		value, err := text.FormatDate(date, "", "xx")
+
+		// Assert
+		assert.Equal(t, text.ErrUnknownLocale, err)
+		assert.Empty(t, value)
+	})
+	t.Run("when locale is malformed, should return error", func(t *testing.T) {
+		// Act
+		_, err := text.FormatDate(date, "", "!!")
+
+		// Assert
+		assert.Equal(t, text.ErrUnknownLocale, err)
+	})
+}
@@ -0,0 +1,212 @@
+package text
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/whitespace"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	defaultDropCapLines = 2
+	// dropCapGap is the space, in mm, between the capital and the text alongside it.
+	dropCapGap = 1.0
+)
+
+type dropCap struct {
+	value    string
+	capLines int
+	capFont  props.Font
+	prop     props.Text
+	config   *entity.Config
+}
+
+// NewDropCap is responsible to create an instance of a Text which starts with a drop capital. The first
+// character is written with the capFont in the height of capLines lines, the remaining text is written alongside
+// it in the first capLines lines and then in the whole width. When the size of capFont is not defined, the capital
+// has the height of the capLines lines, and its family, style and color default to the ones of the props.
+// The lines are aligned to the left and measured with the props font when rendered, including the custom fonts.
+// The cell must be high enough to the text, the lines are not broken between pages.
+func NewDropCap(value string, capLines int, capFont props.Font, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	if capLines < 1 {
+		capLines = defaultDropCapLines
+	}
+
+	return &dropCap{
+		value:    value,
+		capLines: capLines,
+		capFont:  capFont,
+		prop:     textProp,
+	}
+}
+
+// NewDropCapCol is responsible to create an instance of a drop capital Text wrapped in a Col.
+func NewDropCapCol(size int, value string, capLines int, capFont props.Font, ps ...props.Text) core.Col {
+	dropCap := NewDropCap(value, capLines, capFont, ps...)
+	return col.New(size).Add(dropCap)
+}
+
+// NewDropCapRow is responsible to create an instance of a drop capital Text wrapped in a Row.
+func NewDropCapRow(height float64, value string, capLines int, capFont props.Font, ps ...props.Text) core.Row {
+	dropCap := NewDropCap(value, capLines, capFont, ps...)
+	c := col.New().Add(dropCap)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of a drop capital Text.
+func (d *dropCap) GetStructure() *node.Node[core.Structure] {
+	details := d.prop.ToMap()
+	details["cap_lines"] = d.capLines
+
+	for key, value := range d.capFont.AppendMap(make(map[string]interface{})) {
+		details["cap_"+strings.TrimPrefix(key, "prop_")] = value
+	}
+
+	str := core.Structure{
+		Type:    "dropcap",
+		Value:   d.value,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (d *dropCap) SetConfig(config *entity.Config) {
+	d.config = config
+	d.prop.MakeValid(d.config.DefaultFont)
+}
+
+// Render renders a drop capital Text into a PDF context. The capital is measured first, so the lines
+// alongside it are broken in the width left by it.
+func (d *dropCap) Render(provider core.Provider, cell *entity.Cell) {
+	value := strings.TrimLeft(d.value, " \t\n")
+	if value == "" {
+		return
+	}
+
+	capital, size := utf8.DecodeRuneInString(value)
+	rest := strings.TrimLeft(value[size:], " \t")
+
+	lineHeight := d.prop.GetLineHeight(provider.GetTextHeight(&props.Font{
+		Family: d.prop.Family,
+		Style:  d.prop.Style,
+		Size:   d.prop.Size,
+	})) + d.prop.VerticalPadding
+
+	capProp := d.getCapProp(provider, lineHeight)
+	capWidth := provider.GetStringWidth(string(capital), &props.Font{
+		Family: capProp.Family,
+		Style:  capProp.Style,
+		Size:   capProp.Size,
+	}) + dropCapGap
+	width := cell.Width - d.prop.Left - d.prop.Right
+
+	provider.AddText(string(capital), &entity.Cell{
+		X:      cell.X + d.prop.Left,
+		Y:      cell.Y,
+		Width:  capWidth,
+		Height: cell.Height,
+	}, &capProp)
+
+	lineProp := d.prop
+	lineProp.Left = 0
+	lineProp.Right = 0
+	lineProp.Align = align.Left
+	lineProp.TabStops = nil
+	lineProp.AutoFontSize = false
+	lineProp.WhiteSpace = whitespace.NoWrap
+
+	lineFont := &props.Font{Family: lineProp.Family, Style: lineProp.Style, Size: lineProp.Size}
+	measure := func(value string) float64 {
+		return provider.GetStringWidth(value, lineFont)
+	}
+
+	lines := breakDropCapLines(rest, d.capLines, width-capWidth, width, measure)
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		x := 0.0
+		if i < d.capLines {
+			x = capWidth
+		}
+
+		textProp := lineProp
+		textProp.Top = d.prop.Top + float64(i)*lineHeight
+		provider.AddText(line, &entity.Cell{
+			X:      cell.X + d.prop.Left + x,
+			Y:      cell.Y,
+			Width:  width - x,
+			Height: cell.Height,
+		}, &textProp)
+	}
+}
+
+// getCapProp returns the props of the capital, its size fills the height of the capLines lines when not defined.
+func (d *dropCap) getCapProp(provider core.Provider, lineHeight float64) props.Text {
+	capProp := props.Text{
+		Family:     d.capFont.Family,
+		Style:      d.capFont.Style,
+		Size:       d.capFont.Size,
+		Color:      d.capFont.Color,
+		Top:        d.prop.Top,
+		Align:      align.Left,
+		WhiteSpace: whitespace.NoWrap,
+	}
+	capProp.MakeValid(&props.Font{Family: d.prop.Family, Style: d.prop.Style, Size: d.prop.Size, Color: d.prop.Color})
+
+	if d.capFont.Size <= 0 {
+		height := provider.GetTextHeight(&props.Font{Family: capProp.Family, Style: capProp.Style, Size: d.prop.Size})
+		if height > 0 {
+			capProp.Size = d.prop.Size * lineHeight * float64(d.capLines) / height
+		}
+	}
+
+	return capProp
+}
+
+// breakDropCapLines breaks the text in lines, the first capLines lines are broken in the capWidth and the others
+// in the width. The line breaks of the text are kept and a word wider than the line is written alone.
+func breakDropCapLines(value string, capLines int, capWidth, width float64, measure func(string) float64) []string {
+	var lines []string
+
+	for _, paragraph := range strings.Split(value, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			lineWidth := width
+			if len(lines) < capLines {
+				lineWidth = capWidth
+			}
+
+			if line != "" && measure(line+" "+word) > lineWidth {
+				lines = append(lines, line)
+				line = ""
+			}
+
+			if line == "" {
+				line = word
+			} else {
+				line += " " + word
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
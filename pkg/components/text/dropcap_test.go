@@ -0,0 +1,113 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewDropCap(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := text.NewDropCap("Once upon a time", 3, props.Font{})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_drop_cap_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := text.NewDropCap("Once upon a time", 3, props.Font{Style: fontstyle.Bold, Size: 30}, fixture.TextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_drop_cap_custom_prop.json")
+	})
+	t.Run("when cap lines is invalid, should use two lines", func(t *testing.T) {
+		// Act
+		sut := text.NewDropCap("Once upon a time", 0, props.Font{})
+
+		// Assert
+		assert.Equal(t, 2, sut.GetStructure().GetData().Details["cap_lines"])
+	})
+}
+
+func TestNewDropCapCol(t *testing.T) {
+	// Act
+	sut := text.NewDropCapCol(12, "Once upon a time", 3, props.Font{})
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_drop_cap_col.json")
+}
+
+func TestNewDropCapRow(t *testing.T) {
+	// Act
+	sut := text.NewDropCapRow(10, "Once upon a time", 3, props.Font{})
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_drop_cap_row.json")
+}
+
+func TestDropCap_Render(t *testing.T) {
+	t.Run("should write the capital and wrap the first lines alongside it", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 40, Height: 60}
+		fontProp := fixture.FontProp()
+
+		var values []string
+		var cells []*entity.Cell
+		var textProps []*props.Text
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		})
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything).Run(func(v string, c *entity.Cell, p *props.Text) {
+			values = append(values, v)
+			cells = append(cells, c)
+			textProps = append(textProps, p)
+		})
+
+		sut := text.NewDropCap("Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor", 2,
+			props.Font{}, props.Text{Family: "arial", Size: 10})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, "L", values[0])
+		assert.Equal(t, 20.0, textProps[0].Size)
+		assert.Equal(t, 10.0, cells[0].X)
+
+		capWidth := cells[1].X - cell.X
+		assert.Greater(t, capWidth, 0.0)
+		assert.Equal(t, 0.0, textProps[1].Top)
+		assert.Equal(t, cell.X+capWidth, cells[2].X)
+		assert.Equal(t, 4.0, textProps[2].Top)
+		assert.Equal(t, cell.X, cells[3].X)
+		assert.Equal(t, 8.0, textProps[3].Top)
+		assert.Equal(t, cell.Width, cells[3].Width)
+	})
+	t.Run("when text is empty, should not write", func(t *testing.T) {
+		// Arrange
+		fontProp := fixture.FontProp()
+		provider := mocks.NewProvider(t)
+
+		sut := text.NewDropCap(" ", 2, props.Font{})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &entity.Cell{Width: 40, Height: 60})
+
+		// Assert
+		provider.AssertNotCalled(t, "AddText")
+	})
+}
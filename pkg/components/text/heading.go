@@ -0,0 +1,85 @@
+package text
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	minHeadingLevel = 1
+	maxHeadingLevel = 6
+)
+
+type heading struct {
+	value    string
+	level    int
+	prop     props.Heading
+	textProp *props.Text
+	config   *entity.Config
+}
+
+// NewHeading is responsible to create an instance of a Heading, a bold text scaled from the default font size
+// according to the level, from 1 to 6 like the html headings, which adds an entry to the outline of the document
+// pointing to it. The level 1 is the top level of the outline, the levels out of the range are moved into it.
+// When the prop is not sent, props.NewHeading is used.
+func NewHeading(value string, level int, ps ...props.Heading) core.Component {
+	headingProp := props.NewHeading()
+	if len(ps) > 0 {
+		headingProp = ps[0]
+	}
+
+	return &heading{
+		value: value,
+		level: min(max(level, minHeadingLevel), maxHeadingLevel),
+		prop:  headingProp,
+	}
+}
+
+// NewHeadingCol is responsible to create an instance of a Heading wrapped in a Col.
+func NewHeadingCol(size int, value string, level int, ps ...props.Heading) core.Col {
+	heading := NewHeading(value, level, ps...)
+	return col.New(size).Add(heading)
+}
+
+// NewHeadingRow is responsible to create an instance of a Heading wrapped in a Row.
+func NewHeadingRow(height float64, value string, level int, ps ...props.Heading) core.Row {
+	heading := NewHeading(value, level, ps...)
+	c := col.New().Add(heading)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of a Heading.
+func (h *heading) GetStructure() *node.Node[core.Structure] {
+	details := h.prop.ToMap()
+	details["prop_level"] = h.level
+
+	str := core.Structure{
+		Type:    "heading",
+		Value:   h.value,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config and the text props, as the font size depends on the default font.
+func (h *heading) SetConfig(config *entity.Config) {
+	h.config = config
+	if config.RTL && h.prop.Align == "" {
+		h.prop.Align = align.Right
+	}
+	h.prop.MakeValid(config.DefaultFont)
+	h.textProp = h.prop.ToTextProp(h.level)
+}
+
+// Render renders a Heading into a PDF context, the bookmark points to the top of the cell.
+func (h *heading) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddText(h.value, cell, h.textProp)
+	provider.AddBookmark(h.value, h.level-minHeadingLevel, cell)
+}
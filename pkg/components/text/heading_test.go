@@ -0,0 +1,123 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewHeading(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := text.NewHeading("Introduction", 1)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_heading_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := text.NewHeading("Introduction", 2, fixture.HeadingProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_heading_custom_prop.json")
+	})
+	t.Run("when level is out of range, should move it into the range", func(t *testing.T) {
+		// Act
+		sut := text.NewHeading("Introduction", 9)
+
+		// Assert
+		assert.Equal(t, 6, sut.GetStructure().GetData().Details["prop_level"])
+	})
+}
+
+func TestNewHeadingCol(t *testing.T) {
+	// Act
+	sut := text.NewHeadingCol(12, "Introduction", 1)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_heading_col.json")
+}
+
+func TestNewHeadingRow(t *testing.T) {
+	// Act
+	sut := text.NewHeadingRow(10, "Introduction", 1)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_heading_row.json")
+}
+
+func TestHeading_Render(t *testing.T) {
+	t.Run("when level is 1, should write a bold text scaled from the default font and add a top level bookmark", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 15}
+		fontProp := fixture.FontProp()
+
+		var calls []string
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("Introduction", &cell, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Size == 28 && p.Style == fontstyle.Bold && p.Family == fontProp.Family
+		})).Run(func(string, *entity.Cell, *props.Text) { calls = append(calls, "AddText") })
+		provider.EXPECT().AddBookmark("Introduction", 0, &cell).Run(func(string, int, *entity.Cell) {
+			calls = append(calls, "AddBookmark")
+		})
+
+		sut := text.NewHeading("Introduction", 1)
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, []string{"AddText", "AddBookmark"}, calls)
+	})
+	t.Run("when level is 3 and scale is sent, should use the scale and the level below", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 15}
+		fontProp := fixture.FontProp()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("Scope", &cell, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Size == 15
+		}))
+		provider.EXPECT().AddBookmark("Scope", 2, &cell)
+
+		sut := text.NewHeading("Scope", 3, props.Heading{Size: 10, Scale: []float64{3, 2, 1.5}})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBookmark", 1)
+	})
+	t.Run("when config is rtl and align is not sent, should align right", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 15}
+		fontProp := fixture.FontProp()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("Introduction", &cell, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Align == align.Right
+		}))
+		provider.EXPECT().AddBookmark("Introduction", 0, &cell)
+
+		sut := text.NewHeading("Introduction", 1)
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp, RTL: true})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+}
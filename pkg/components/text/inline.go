@@ -0,0 +1,219 @@
+package text
+
+import (
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/whitespace"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// InlinePart is a piece of an inline text written with its own color, style and font family.
+// When Color, Style or Family are not defined, the ones of the props.Text are used.
+type InlinePart struct {
+	Value     string
+	Color     *props.Color
+	Style     fontstyle.Type
+	Family    string
+	Hyperlink *string
+}
+
+type inline struct {
+	parts  []InlinePart
+	prop   props.Text
+	config *entity.Config
+}
+
+// NewInline is responsible to create an instance of a Text composed by parts with different colors and styles,
+// written one after the other. The line breaks of the parts are kept and, when a part does not fit in the
+// remaining width of the line, it is written on the next line. The parts are measured with their font when
+// rendered.
+func NewInline(parts []InlinePart, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	return newInline(parts, textProp)
+}
+
+func newInline(parts []InlinePart, textProp props.Text) *inline {
+	return &inline{
+		parts: parts,
+		prop:  textProp,
+	}
+}
+
+// NewInlineCol is responsible to create an instance of an inline Text wrapped in a Col.
+func NewInlineCol(size int, parts []InlinePart, ps ...props.Text) core.Col {
+	inline := NewInline(parts, ps...)
+	return col.New(size).Add(inline)
+}
+
+// NewInlineRow is responsible to create an instance of an inline Text wrapped in a Row.
+func NewInlineRow(height float64, parts []InlinePart, ps ...props.Text) core.Row {
+	inline := NewInline(parts, ps...)
+	c := col.New().Add(inline)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of an inline Text.
+func (i *inline) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "inline",
+		Value:   getPartsValue(i.parts),
+		Details: i.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (i *inline) SetConfig(config *entity.Config) {
+	i.config = config
+	i.prop.MakeValid(i.config.DefaultFont)
+}
+
+// Render renders an inline Text into a PDF context.
+func (i *inline) Render(provider core.Provider, cell *entity.Cell) {
+	width := cell.Width - i.prop.Left - i.prop.Right
+
+	i.layout(provider, i.getLineHeight(provider), width, func(value string, x, y float64, partProp props.Text) {
+		lineCell := &entity.Cell{
+			X:      cell.X + i.prop.Left + x,
+			Y:      cell.Y,
+			Width:  width - x,
+			Height: cell.Height,
+		}
+
+		partProp.Top = i.prop.Top + y
+		provider.AddText(value, lineCell, &partProp)
+	})
+}
+
+// getHeight returns the height used by the parts when written in a cell of the width.
+func (i *inline) getHeight(provider core.Provider, width float64) float64 {
+	return i.prop.Top + i.layout(provider, i.getLineHeight(provider), width-i.prop.Left-i.prop.Right, nil)
+}
+
+func (i *inline) getLineHeight(provider core.Provider) float64 {
+	return i.prop.GetLineHeight(provider.GetTextHeight(&props.Font{
+		Family: i.prop.Family,
+		Style:  i.prop.Style,
+		Size:   i.prop.Size,
+	})) + i.prop.VerticalPadding
+}
+
+// layout places the parts one after the other in lines of the width, calling write, when defined,
+// with the position of each non blank piece. It returns the height of the lines.
+func (i *inline) layout(provider core.Provider, lineHeight, width float64,
+	write func(value string, x, y float64, partProp props.Text),
+) float64 {
+	x, y := 0.0, 0.0
+	wrapped := false
+
+	for _, part := range i.parts {
+		partProp := i.getPartProp(part)
+		partFont := &props.Font{Family: partProp.Family, Style: partProp.Style, Size: partProp.Size}
+
+		for index, line := range strings.Split(part.Value, "\n") {
+			if index > 0 {
+				x = 0
+				y += lineHeight
+				wrapped = false
+			}
+
+			blank := strings.TrimSpace(line) == ""
+			if line == "" || wrapped && x == 0 && blank {
+				continue
+			}
+
+			lineWidth := provider.GetStringWidth(line, partFont)
+			if x > 0 && x+lineWidth > width {
+				x = 0
+				y += lineHeight
+				wrapped = true
+
+				if blank {
+					continue
+				}
+			}
+
+			if !blank && write != nil {
+				write(line, x, y, partProp)
+			}
+
+			x += lineWidth
+		}
+	}
+
+	if len(i.parts) == 0 {
+		return 0
+	}
+
+	return y + lineHeight
+}
+
+func (i *inline) getPartProp(part InlinePart) props.Text {
+	partProp := i.prop
+	partProp.Left = 0
+	partProp.Right = 0
+	partProp.Align = align.Left
+	partProp.TabStops = nil
+	partProp.AutoFontSize = false
+	partProp.WhiteSpace = whitespace.NoWrap
+
+	if part.Color != nil {
+		partProp.Color = part.Color
+	}
+
+	if part.Style != "" {
+		partProp.Style = part.Style
+	}
+
+	if part.Family != "" {
+		partProp.Family = part.Family
+	}
+
+	if part.Hyperlink != nil {
+		partProp.Hyperlink = part.Hyperlink
+	}
+
+	return partProp
+}
+
+func getPartsValue(parts []InlinePart) []map[string]interface{} {
+	value := make([]map[string]interface{}, 0, len(parts))
+	for _, part := range parts {
+		m := map[string]interface{}{
+			"value": part.Value,
+		}
+
+		if part.Color != nil {
+			m["color"] = part.Color.ToString()
+		}
+
+		if part.Style != "" {
+			m["style"] = part.Style
+		}
+
+		if part.Family != "" {
+			m["family"] = part.Family
+		}
+
+		if part.Hyperlink != nil {
+			m["hyperlink"] = *part.Hyperlink
+		}
+
+		value = append(value, m)
+	}
+
+	return value
+}
@@ -0,0 +1,137 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewInline(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := text.NewInline([]text.InlinePart{{Value: "plain "}, {Value: "bold", Style: fontstyle.Bold}})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_inline_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		color := fixture.ColorProp()
+
+		// Act
+		sut := text.NewInline([]text.InlinePart{{Value: "red", Color: &color}}, fixture.TextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_inline_custom_prop.json")
+	})
+}
+
+func TestNewInlineCol(t *testing.T) {
+	// Act
+	sut := text.NewInlineCol(12, []text.InlinePart{{Value: "plain"}})
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_inline_col.json")
+}
+
+func TestNewInlineRow(t *testing.T) {
+	// Act
+	sut := text.NewInlineRow(10, []text.InlinePart{{Value: "plain"}})
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_inline_row.json")
+}
+
+func TestInline_Render(t *testing.T) {
+	t.Run("should write the parts one after the other with their own style", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 30}
+		fontProp := fixture.FontProp()
+
+		var cells []*entity.Cell
+		var textProps []*props.Text
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		})
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything).Run(func(_ string, c *entity.Cell, p *props.Text) {
+			cells = append(cells, c)
+			textProps = append(textProps, p)
+		})
+
+		sut := text.NewInline([]text.InlinePart{{Value: "plain"}, {Value: " "}, {Value: "bold", Style: fontstyle.Bold}})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Len(t, cells, 2)
+		assert.Equal(t, 10.0, cells[0].X)
+		assert.Equal(t, 22.0, cells[1].X)
+		assert.Equal(t, fontProp.Style, textProps[0].Style)
+		assert.Equal(t, fontstyle.Bold, textProps[1].Style)
+		assert.Equal(t, textProps[0].Top, textProps[1].Top)
+	})
+	t.Run("when part has line break, should write the next line below", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 30}
+		fontProp := fixture.FontProp()
+
+		var tops []float64
+		var xs []float64
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		})
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything).Run(func(_ string, c *entity.Cell, p *props.Text) {
+			tops = append(tops, p.Top)
+			xs = append(xs, c.X)
+		})
+
+		sut := text.NewInline([]text.InlinePart{{Value: "first\nsecond"}}, props.Text{Top: 1, VerticalPadding: 1})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, []float64{1, 6}, tops)
+		assert.Equal(t, []float64{10, 10}, xs)
+	})
+	t.Run("when part does not fit in the line, should write it on the next line", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 15, Height: 30}
+		fontProp := fixture.FontProp()
+
+		var tops []float64
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		})
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything).Run(func(_ string, _ *entity.Cell, p *props.Text) {
+			tops = append(tops, p.Top)
+		})
+
+		sut := text.NewInline([]text.InlinePart{{Value: "first"}, {Value: "second"}})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, []float64{0, 4}, tops)
+	})
+}
@@ -0,0 +1,20 @@
+package text
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// NewJustified is responsible to create an instance of a Text where each line is spaced to fill the whole width.
+// The last line is not stretched, it is aligned by props.Text.AlignLastLine, which is left by default.
+func NewJustified(value string, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	textProp.Align = align.Justify
+
+	return New(value, textProp)
+}
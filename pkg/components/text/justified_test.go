@@ -0,0 +1,27 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewJustified(t *testing.T) {
+	t.Run("when prop is not sent, should justify the text", func(t *testing.T) {
+		// Act
+		sut := text.NewJustified("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_text_justified_default_prop.json")
+	})
+	t.Run("when prop is sent, should keep the last line align", func(t *testing.T) {
+		// Act
+		sut := text.NewJustified("code", props.Text{Align: align.Left, AlignLastLine: align.Right})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_text_justified_custom_prop.json")
+	})
+}
@@ -0,0 +1,437 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	gmtext "github.com/yuin/goldmark/text"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/line"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	markdownCodePadding   = 1.5
+	markdownQuoteBarWidth = 0.8
+)
+
+var markdownQuoteColor = &props.Color{Red: 200, Green: 200, Blue: 200}
+
+// markdownBlock is a block of a Markdown, written one below the other.
+type markdownBlock interface {
+	Render(provider core.Provider, cell *entity.Cell)
+	getHeight(provider core.Provider, width float64) float64
+}
+
+// markdownStyle is the style inherited by the inline nodes, ex: the text inside an emphasis inside a link.
+type markdownStyle struct {
+	bold      bool
+	italic    bool
+	family    string
+	color     *props.Color
+	hyperlink *string
+}
+
+type markdown struct {
+	value  string
+	source []byte
+	doc    ast.Node
+	blocks []markdownBlock
+	prop   props.Markdown
+	config *entity.Config
+}
+
+// NewMarkdown is responsible to create an instance of a Text from a CommonMark Markdown. The headings,
+// paragraphs, emphasis, code spans, code blocks, blockquotes, lists, horizontal rules and links are converted
+// to texts, inline texts, code blocks and lines written one below the other from the top of the cell.
+// The cell must be high enough to the content, the blocks are not broken between pages.
+func NewMarkdown(md string, ps ...props.Markdown) core.Component {
+	prop := props.Markdown{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	source := []byte(md)
+
+	return &markdown{
+		value:  md,
+		source: source,
+		doc:    goldmark.DefaultParser().Parse(gmtext.NewReader(source)),
+		prop:   prop,
+	}
+}
+
+// NewMarkdownCol is responsible to create an instance of a Markdown wrapped in a Col.
+func NewMarkdownCol(size int, md string, ps ...props.Markdown) core.Col {
+	markdown := NewMarkdown(md, ps...)
+	return col.New(size).Add(markdown)
+}
+
+// NewMarkdownRow is responsible to create an instance of a Markdown wrapped in a Row.
+func NewMarkdownRow(height float64, md string, ps ...props.Markdown) core.Row {
+	markdown := NewMarkdown(md, ps...)
+	c := col.New().Add(markdown)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of a Markdown.
+func (m *markdown) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "markdown",
+		Value:   m.value,
+		Details: m.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config and converts the Markdown nodes to blocks, as the font sizes depend on the default font.
+func (m *markdown) SetConfig(config *entity.Config) {
+	m.config = config
+	m.prop.MakeValid(config.DefaultFont)
+	m.blocks = m.getBlocks(m.doc)
+}
+
+// Render renders a Markdown into a PDF context.
+func (m *markdown) Render(provider core.Provider, cell *entity.Cell) {
+	renderBlocks(provider, m.blocks, cell, m.prop.BlockSpacing)
+}
+
+func (m *markdown) getBlocks(parent ast.Node) []markdownBlock {
+	var blocks []markdownBlock
+
+	for n := parent.FirstChild(); n != nil; n = n.NextSibling() {
+		switch block := n.(type) {
+		case *ast.Heading:
+			textProp := m.getTextProp()
+			textProp.Size *= m.prop.HeadingScales[min(block.Level, len(m.prop.HeadingScales))-1]
+			textProp.Style = fontstyle.Bold
+			blocks = append(blocks, m.newInline(m.getParts(block, markdownStyle{bold: true}), textProp))
+		case *ast.Paragraph, *ast.TextBlock:
+			blocks = append(blocks, m.newInline(m.getParts(block, markdownStyle{}), m.getTextProp()))
+		case *ast.FencedCodeBlock:
+			blocks = append(blocks, m.newCode(block, string(block.Language(m.source))))
+		case *ast.CodeBlock:
+			blocks = append(blocks, m.newCode(block, ""))
+		case *ast.HTMLBlock:
+			parts := []InlinePart{{Value: strings.TrimRight(m.getLines(block), "\n")}}
+			blocks = append(blocks, m.newInline(parts, m.getTextProp()))
+		case *ast.Blockquote:
+			blocks = append(blocks, &markdownQuote{
+				blocks:  m.getBlocks(block),
+				indent:  m.prop.Indent,
+				spacing: m.prop.BlockSpacing,
+			})
+		case *ast.List:
+			blocks = append(blocks, m.newList(block))
+		case *ast.ThematicBreak:
+			blocks = append(blocks, &markdownDivider{
+				line:   line.New(props.Line{Color: markdownQuoteColor, SizePercent: 100, OffsetPercent: 50}),
+				height: m.prop.BlockSpacing * 2,
+			})
+		}
+	}
+
+	return blocks
+}
+
+func (m *markdown) newInline(parts []InlinePart, textProp props.Text) *inline {
+	inline := newInline(parts, textProp)
+	inline.SetConfig(m.config)
+
+	return inline
+}
+
+func (m *markdown) newCode(block ast.Node, lang string) *code {
+	textProp := m.getTextProp()
+	textProp.Family = m.prop.CodeFamily
+	textProp.CodeTheme = m.prop.CodeTheme
+	textProp.Top = markdownCodePadding
+	textProp.Left = markdownCodePadding
+	textProp.Right = markdownCodePadding
+
+	code := NewCode(strings.TrimRight(m.getLines(block), "\n"), lang, textProp).(*code)
+	code.SetConfig(m.config)
+
+	return code
+}
+
+func (m *markdown) newList(list *ast.List) *markdownList {
+	markerProp := m.getTextProp()
+	markerProp.Align = align.Left
+
+	l := &markdownList{
+		indent:  m.prop.Indent,
+		spacing: m.prop.BlockSpacing,
+		prop:    markerProp,
+	}
+
+	number := list.Start
+	for n := list.FirstChild(); n != nil; n = n.NextSibling() {
+		marker := defaultBulletChar
+		if list.IsOrdered() {
+			marker = fmt.Sprintf("%d.", number)
+			number++
+		}
+
+		l.items = append(l.items, markdownListItem{
+			marker: marker,
+			blocks: m.getBlocks(n),
+		})
+	}
+
+	return l
+}
+
+func (m *markdown) getTextProp() props.Text {
+	return props.Text{
+		Family: m.prop.Family,
+		Size:   m.prop.Size,
+		Color:  m.prop.Color,
+	}
+}
+
+func (m *markdown) getLines(block ast.Node) string {
+	var builder strings.Builder
+
+	lines := block.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		builder.Write(segment.Value(m.source))
+	}
+
+	return builder.String()
+}
+
+// getParts converts the inline nodes to parts, the text is split in words to be wrapped by the inline Text.
+func (m *markdown) getParts(parent ast.Node, style markdownStyle) []InlinePart {
+	var parts []InlinePart
+
+	for n := parent.FirstChild(); n != nil; n = n.NextSibling() {
+		switch inline := n.(type) {
+		case *ast.Text:
+			parts = append(parts, style.newParts(string(inline.Segment.Value(m.source)))...)
+			if inline.HardLineBreak() {
+				parts = append(parts, style.newParts("\n")...)
+			} else if inline.SoftLineBreak() {
+				parts = append(parts, style.newParts(" ")...)
+			}
+		case *ast.String:
+			parts = append(parts, style.newParts(string(inline.Value))...)
+		case *ast.Emphasis:
+			emphasis := style
+			if inline.Level > 1 {
+				emphasis.bold = true
+			} else {
+				emphasis.italic = true
+			}
+			parts = append(parts, m.getParts(inline, emphasis)...)
+		case *ast.CodeSpan:
+			code := style
+			code.family = m.prop.CodeFamily
+			parts = append(parts, m.getParts(inline, code)...)
+		case *ast.Link:
+			link := style
+			destination := string(inline.Destination)
+			link.color = m.prop.LinkColor
+			link.hyperlink = &destination
+			parts = append(parts, m.getParts(inline, link)...)
+		case *ast.AutoLink:
+			link := style
+			destination := string(inline.URL(m.source))
+			link.color = m.prop.LinkColor
+			link.hyperlink = &destination
+			parts = append(parts, link.newParts(string(inline.Label(m.source)))...)
+		case *ast.RawHTML:
+			for i := 0; i < inline.Segments.Len(); i++ {
+				segment := inline.Segments.At(i)
+				parts = append(parts, style.newParts(string(segment.Value(m.source)))...)
+			}
+		default:
+			parts = append(parts, m.getParts(inline, style)...)
+		}
+	}
+
+	return parts
+}
+
+// newParts splits the value in words and whitespaces, all of them with the style.
+func (s markdownStyle) newParts(value string) []InlinePart {
+	var parts []InlinePart
+
+	for _, word := range splitWords(value) {
+		parts = append(parts, InlinePart{
+			Value:     word,
+			Color:     s.color,
+			Style:     s.getFontStyle(),
+			Family:    s.family,
+			Hyperlink: s.hyperlink,
+		})
+	}
+
+	return parts
+}
+
+func (s markdownStyle) getFontStyle() fontstyle.Type {
+	switch {
+	case s.bold && s.italic:
+		return fontstyle.BoldItalic
+	case s.bold:
+		return fontstyle.Bold
+	case s.italic:
+		return fontstyle.Italic
+	default:
+		return fontstyle.Normal
+	}
+}
+
+// splitWords splits a value in runs of whitespaces and runs of other characters.
+func splitWords(value string) []string {
+	var words []string
+
+	start := 0
+	runes := []rune(value)
+	for i := 1; i <= len(runes); i++ {
+		if i == len(runes) || unicode.IsSpace(runes[i]) != unicode.IsSpace(runes[i-1]) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return words
+}
+
+type markdownQuote struct {
+	blocks  []markdownBlock
+	indent  float64
+	spacing float64
+}
+
+// Render renders the blocks of a blockquote indented by a vertical bar.
+func (q *markdownQuote) Render(provider core.Provider, cell *entity.Cell) {
+	height := q.getHeight(provider, cell.Width)
+
+	provider.DrawLine(entity.Point{X: cell.X + markdownQuoteBarWidth, Y: cell.Y},
+		entity.Point{X: cell.X + markdownQuoteBarWidth, Y: cell.Y + height},
+		&props.Line{Color: markdownQuoteColor, Style: linestyle.Solid, Thickness: markdownQuoteBarWidth})
+
+	renderBlocks(provider, q.blocks, &entity.Cell{
+		X:      cell.X + q.indent,
+		Y:      cell.Y,
+		Width:  cell.Width - q.indent,
+		Height: height,
+	}, q.spacing)
+}
+
+func (q *markdownQuote) getHeight(provider core.Provider, width float64) float64 {
+	return getBlocksHeight(provider, q.blocks, width-q.indent, q.spacing)
+}
+
+type markdownListItem struct {
+	marker string
+	blocks []markdownBlock
+}
+
+type markdownList struct {
+	items   []markdownListItem
+	indent  float64
+	spacing float64
+	prop    props.Text
+}
+
+// Render renders the items of a list, the marker is written in the indentation of each item.
+func (l *markdownList) Render(provider core.Provider, cell *entity.Cell) {
+	y := 0.0
+
+	for _, item := range l.items {
+		height := getBlocksHeight(provider, item.blocks, cell.Width-l.indent, l.spacing)
+
+		markerProp := l.prop
+		provider.AddText(item.marker, &entity.Cell{
+			X:      cell.X,
+			Y:      cell.Y + y,
+			Width:  l.indent,
+			Height: height,
+		}, &markerProp)
+
+		renderBlocks(provider, item.blocks, &entity.Cell{
+			X:      cell.X + l.indent,
+			Y:      cell.Y + y,
+			Width:  cell.Width - l.indent,
+			Height: height,
+		}, l.spacing)
+
+		y += height
+	}
+}
+
+func (l *markdownList) getHeight(provider core.Provider, width float64) float64 {
+	height := 0.0
+	for _, item := range l.items {
+		height += getBlocksHeight(provider, item.blocks, width-l.indent, l.spacing)
+	}
+
+	return height
+}
+
+type markdownDivider struct {
+	line   core.Component
+	height float64
+}
+
+// Render renders a horizontal rule in the middle of its height.
+func (d *markdownDivider) Render(provider core.Provider, cell *entity.Cell) {
+	lineCell := cell.Copy()
+	lineCell.Height = d.height
+	d.line.Render(provider, &lineCell)
+}
+
+func (d *markdownDivider) getHeight(core.Provider, float64) float64 {
+	return d.height
+}
+
+// renderBlocks renders the blocks one below the other, with the spacing between them.
+func renderBlocks(provider core.Provider, blocks []markdownBlock, cell *entity.Cell, spacing float64) {
+	y := 0.0
+
+	for i, block := range blocks {
+		if i > 0 {
+			y += spacing
+		}
+
+		height := block.getHeight(provider, cell.Width)
+		block.Render(provider, &entity.Cell{
+			X:      cell.X,
+			Y:      cell.Y + y,
+			Width:  cell.Width,
+			Height: height,
+		})
+
+		y += height
+	}
+}
+
+func getBlocksHeight(provider core.Provider, blocks []markdownBlock, width float64, spacing float64) float64 {
+	height := 0.0
+
+	for i, block := range blocks {
+		if i > 0 {
+			height += spacing
+		}
+
+		height += block.getHeight(provider, width)
+	}
+
+	return height
+}
@@ -0,0 +1,171 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+const markdownValue = "# Title\n\nHello **world**"
+
+type writtenText struct {
+	value string
+	cell  entity.Cell
+	prop  props.Text
+}
+
+func TestNewMarkdown(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := text.NewMarkdown(markdownValue)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_markdown_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := text.NewMarkdown(markdownValue, fixture.MarkdownProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_markdown_custom_prop.json")
+	})
+}
+
+func TestNewMarkdownCol(t *testing.T) {
+	// Act
+	sut := text.NewMarkdownCol(12, markdownValue)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_markdown_col.json")
+}
+
+func TestNewMarkdownRow(t *testing.T) {
+	// Act
+	sut := text.NewMarkdownRow(10, markdownValue)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_markdown_row.json")
+}
+
+func TestMarkdown_Render(t *testing.T) {
+	config := &entity.Config{DefaultFont: &props.Font{Family: fontfamily.Arial, Size: 10}}
+	cell := entity.Cell{X: 10, Y: 20, Width: 150, Height: 100}
+
+	newProvider := func(written *[]writtenText) *mocks.Provider {
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0).Maybe()
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		}).Maybe()
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything).Run(func(value string, c *entity.Cell, p *props.Text) {
+			*written = append(*written, writtenText{value: value, cell: *c, prop: *p})
+		}).Maybe()
+		return provider
+	}
+
+	t.Run("when there are heading and paragraph, should write them one below the other", func(t *testing.T) {
+		// Arrange
+		var written []writtenText
+		sut := text.NewMarkdown(markdownValue)
+		sut.SetConfig(config)
+
+		// Act
+		sut.Render(newProvider(&written), &cell)
+
+		// Assert
+		assert.Len(t, written, 3)
+		assert.Equal(t, "Title", written[0].value)
+		assert.Equal(t, 20.0, written[0].prop.Size)
+		assert.Equal(t, fontstyle.Bold, written[0].prop.Style)
+		assert.Equal(t, 20.0, written[0].cell.Y)
+		assert.Equal(t, "Hello", written[1].value)
+		assert.Equal(t, 10.0, written[1].prop.Size)
+		assert.Equal(t, fontstyle.Normal, written[1].prop.Style)
+		assert.Equal(t, 26.0, written[1].cell.Y)
+		assert.Equal(t, "world", written[2].value)
+		assert.Equal(t, fontstyle.Bold, written[2].prop.Style)
+	})
+	t.Run("when there are italic, code and link, should apply their styles", func(t *testing.T) {
+		// Arrange
+		var written []writtenText
+		sut := text.NewMarkdown("*a* `b` [c](https://maroto.io)")
+		sut.SetConfig(config)
+
+		// Act
+		sut.Render(newProvider(&written), &cell)
+
+		// Assert
+		assert.Len(t, written, 3)
+		assert.Equal(t, fontstyle.Italic, written[0].prop.Style)
+		assert.Equal(t, fontfamily.Courier, written[1].prop.Family)
+		assert.Equal(t, &props.BlueColor, written[2].prop.Color)
+		assert.Equal(t, "https://maroto.io", *written[2].prop.Hyperlink)
+	})
+	t.Run("when there are lists, should write the markers in the indentation", func(t *testing.T) {
+		// Arrange
+		var written []writtenText
+		sut := text.NewMarkdown("- a\n- b\n\n3. c")
+		sut.SetConfig(config)
+
+		// Act
+		sut.Render(newProvider(&written), &cell)
+
+		// Assert
+		var values []string
+		for _, w := range written {
+			values = append(values, w.value)
+		}
+		assert.Equal(t, []string{"•", "a", "•", "b", "3.", "c"}, values)
+		assert.Equal(t, 10.0, written[0].cell.X)
+		assert.Equal(t, 15.0, written[1].cell.X)
+		assert.Equal(t, 24.0, written[2].cell.Y)
+		assert.Equal(t, 30.0, written[4].cell.Y)
+	})
+	t.Run("when there is a code block, should draw the background with the padding", func(t *testing.T) {
+		// Arrange
+		var written []writtenText
+		sut := text.NewMarkdown("```go\nreturn nil\n```")
+		sut.SetConfig(config)
+
+		provider := newProvider(&written)
+		provider.EXPECT().DrawRect(&entity.Cell{X: 10, Y: 20, Width: 150, Height: 7},
+			&props.Cell{BackgroundColor: &props.Color{Red: 246, Green: 248, Blue: 250}})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Len(t, written, 2)
+		assert.Equal(t, fontfamily.Courier, written[0].prop.Family)
+		assert.Equal(t, 1.5, written[0].prop.Top)
+	})
+	t.Run("when there are blockquote and horizontal rule, should draw the bar and the line", func(t *testing.T) {
+		// Arrange
+		var written []writtenText
+		sut := text.NewMarkdown("> quote\n\n---")
+		sut.SetConfig(config)
+
+		provider := newProvider(&written)
+		provider.EXPECT().DrawLine(entity.Point{X: 10.8, Y: 20}, entity.Point{X: 10.8, Y: 24},
+			&props.Line{Color: &props.Color{Red: 200, Green: 200, Blue: 200}, Style: linestyle.Solid, Thickness: 0.8})
+		provider.EXPECT().AddLine(&entity.Cell{X: 10, Y: 26, Width: 150, Height: 4}, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Len(t, written, 1)
+		assert.Equal(t, 15.0, written[0].cell.X)
+	})
+}
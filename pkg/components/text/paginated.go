@@ -0,0 +1,289 @@
+package text
+
+import (
+	"math"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/internal/fontmetrics"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/whitespace"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// pointsPerMM converts the font size to the font height in mm, as done by gofpdf.
+const pointsPerMM = 72.0 / 25.4
+
+// lineEpsilon avoids losing the last line which fits in a cell because of rounding errors.
+const lineEpsilon = 1e-9
+
+// FlowState is the position of a Paginated text where the rendering continues.
+type FlowState struct {
+	// Offset is the number of bytes of the text which were already rendered.
+	Offset int
+}
+
+// Paginated is a long text, ex: terms and conditions, which continues on the next pages when it doesn't fit in
+// the space left on the page.
+type Paginated struct {
+	// FlowState is where the text starts, it's set with the state returned by Next to resume a text rendered
+	// in another document.
+	FlowState FlowState
+	value     string
+	prop      props.Text
+	config    *entity.Config
+}
+
+// paginatedLine is a line of a Paginated, next is the offset of the text after it.
+type paginatedLine struct {
+	value string
+	next  int
+}
+
+// NewPaginated is responsible to create an instance of a Paginated text. Added to a row, it writes the lines
+// which fit in the cell, while added with NewPaginatedRow the lines which don't fit in the space left on the
+// page are written on the next pages, until the text is exhausted. The lines are broken between the words, the
+// line breaks of the value are kept and the lines are not justified. The lines are measured with the props font,
+// custom fonts are not known before the document is built, so they are measured with arial.
+func NewPaginated(value string, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	return &Paginated{
+		value: strings.ReplaceAll(value, "\r\n", "\n"),
+		prop:  textProp,
+	}
+}
+
+// NewPaginatedCol is responsible to create an instance of a Paginated wrapped in a Col.
+func NewPaginatedCol(size int, value string, ps ...props.Text) core.Col {
+	paginated := NewPaginated(value, ps...)
+	return col.New(size).Add(paginated)
+}
+
+// NewPaginatedRow is responsible to create an instance of a Paginated wrapped in a core.FlowRow, which is split by
+// maroto in rows of the space left on each page.
+func NewPaginatedRow(value string, ps ...props.Text) core.Row {
+	return NewPaginatedRowFromState(FlowState{}, value, ps...)
+}
+
+// NewPaginatedRowFromState is responsible to create an instance of a Paginated wrapped in a core.FlowRow, which
+// starts from the state, ex: the one returned by Next for the text written in another document.
+func NewPaginatedRowFromState(state FlowState, value string, ps ...props.Text) core.Row {
+	paginated := NewPaginated(value, ps...).(*Paginated)
+	paginated.FlowState = state
+
+	return &paginatedRow{
+		paginated: paginated,
+	}
+}
+
+// GetStructure returns the Structure of a Paginated.
+func (p *Paginated) GetStructure() *node.Node[core.Structure] {
+	details := p.prop.ToMap()
+	if p.FlowState.Offset != 0 {
+		details["prop_flow_offset"] = p.FlowState.Offset
+	}
+
+	str := core.Structure{
+		Type:    "paginated",
+		Value:   p.value,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (p *Paginated) SetConfig(config *entity.Config) {
+	p.config = config
+	if config.RTL && p.prop.Align == "" {
+		p.prop.Align = align.Right
+	}
+	p.prop.MakeValid(config.DefaultFont)
+	p.prop.WhiteSpace = whitespace.Pre
+}
+
+// Render renders the lines of a Paginated which fit in the cell into a PDF context, from the FlowState.
+func (p *Paginated) Render(provider core.Provider, cell *entity.Cell) {
+	lines := p.getLines(cell.Width)
+	lines = lines[:min(p.getLinesQuantity(cell.Height), len(lines))]
+	if len(lines) == 0 {
+		return
+	}
+
+	values := make([]string, len(lines))
+	for i, line := range lines {
+		values[i] = line.value
+	}
+
+	provider.AddText(strings.Join(values, "\n"), cell, &p.prop)
+}
+
+// Next returns the state after the lines written in a cell of the width and height, it's used to resume the
+// text in another document. The props depend on the config, so it's called after the document is generated.
+func (p *Paginated) Next(width, height float64) FlowState {
+	lines := p.getLines(width)
+	quantity := min(p.getLinesQuantity(height), len(lines))
+	if quantity == 0 {
+		return p.FlowState
+	}
+
+	return FlowState{Offset: lines[quantity-1].next}
+}
+
+// getLineStep returns the height of each line, including the vertical padding.
+func (p *Paginated) getLineStep() float64 {
+	return p.prop.GetLineHeight(p.prop.Size/pointsPerMM) + p.prop.VerticalPadding
+}
+
+// getLinesQuantity returns how many lines fit in the height.
+func (p *Paginated) getLinesQuantity(height float64) int {
+	return max(int(math.Floor((height-p.prop.Top)/p.getLineStep()+lineEpsilon)), 0)
+}
+
+// getLines breaks the text after the FlowState in the lines written in the width. The lines are broken
+// before the document is rendered, when the rows are split, so they are measured with the estimate
+// of fontmetrics, the same when they are split and rendered.
+func (p *Paginated) getLines(width float64) []paginatedLine {
+	measure := func(value string) float64 {
+		return fontmetrics.GetStringWidth(value, p.prop.Family, p.prop.Style, p.prop.Size)
+	}
+	width -= p.prop.Left + p.prop.Right
+
+	var lines []paginatedLine
+	start := min(max(p.FlowState.Offset, 0), len(p.value))
+	for start < len(p.value) {
+		end, next := len(p.value), len(p.value)
+		if index := strings.IndexByte(p.value[start:], '\n'); index >= 0 {
+			end, next = start+index, start+index+1
+		}
+
+		lines = append(lines, breakParagraph(p.value, start, end, next, width, measure)...)
+		start = next
+	}
+
+	return lines
+}
+
+// breakParagraph breaks the paragraph between start and end of the value in lines between the words,
+// the words wider than the width are written alone in a line. The last line continues at next.
+func breakParagraph(value string, start, end, next int, width float64, measure func(string) float64) []paginatedLine {
+	var lines []paginatedLine
+
+	lineStart, lineEnd := start, start
+	for wordStart := start; wordStart <= end; {
+		wordEnd := strings.IndexByte(value[wordStart:end], ' ')
+		if wordEnd < 0 {
+			wordEnd = end
+		} else {
+			wordEnd += wordStart
+		}
+
+		// gofpdf breaks the lines which are not narrower than the width
+		if lineEnd > lineStart && measure(value[lineStart:wordEnd]) >= width {
+			lines = append(lines, paginatedLine{value: value[lineStart:lineEnd], next: wordStart})
+			lineStart = wordStart
+		}
+
+		lineEnd = wordEnd
+		wordStart = wordEnd + 1
+	}
+
+	return append(lines, paginatedLine{value: value[lineStart:lineEnd], next: next})
+}
+
+// paginatedRow is the core.FlowRow of a Paginated, the style and the bookmarks are applied to the rows it's split in,
+// the bookmarks only to the first one.
+type paginatedRow struct {
+	paginated *Paginated
+	style     *props.Cell
+	bookmarks []paginatedBookmark
+	config    *entity.Config
+}
+
+type paginatedBookmark struct {
+	title string
+	level int
+}
+
+// Add does nothing, as the row has only the text.
+func (r *paginatedRow) Add(...core.Col) core.Row {
+	return r
+}
+
+// GetHeight returns 0, as the height depends on the space left on the page where the row is split.
+func (r *paginatedRow) GetHeight() float64 {
+	return 0
+}
+
+// WithStyle sets the style of the rows the text is split in.
+func (r *paginatedRow) WithStyle(style *props.Cell) core.Row {
+	r.style = style
+	return r
+}
+
+// WithBookmark adds an entry to the outline of the document pointing to the first row the text is split in.
+func (r *paginatedRow) WithBookmark(title string, level int) core.Row {
+	r.bookmarks = append(r.bookmarks, paginatedBookmark{title: title, level: level})
+	return r
+}
+
+// GetStructure returns the Structure of the row with the text.
+func (r *paginatedRow) GetStructure() *node.Node[core.Structure] {
+	return r.newRow(0, r.paginated, false).GetStructure()
+}
+
+// SetConfig sets the config.
+func (r *paginatedRow) SetConfig(config *entity.Config) {
+	r.config = config
+	r.paginated.SetConfig(config)
+}
+
+// Render does nothing, as maroto renders the rows returned by Split.
+func (r *paginatedRow) Render(core.Provider, entity.Cell) {}
+
+// Split returns a row with the lines which fit in the height, at least one, and a row with the rest of the text.
+func (r *paginatedRow) Split(height, width float64, config *entity.Config) (core.Row, core.FlowRow) {
+	r.SetConfig(config)
+
+	lines := r.paginated.getLines(width)
+	quantity := min(max(r.paginated.getLinesQuantity(height), 1), len(lines))
+	rowHeight := r.paginated.prop.Top + float64(quantity)*r.paginated.getLineStep()
+
+	part := r.newRow(rowHeight, r.paginated, true)
+	if quantity == len(lines) {
+		return part, nil
+	}
+
+	rest := &paginatedRow{
+		paginated: &Paginated{FlowState: FlowState{Offset: lines[quantity-1].next}, value: r.paginated.value, prop: r.paginated.prop},
+		style:     r.style,
+	}
+
+	return part, rest
+}
+
+// newRow returns a row of the height with a copy of the text, with the bookmarks when withBookmarks is true.
+func (r *paginatedRow) newRow(height float64, paginated *Paginated, withBookmarks bool) core.Row {
+	copied := *paginated
+	newRow := row.New(height).Add(col.New().Add(&copied))
+	if r.style != nil {
+		newRow.WithStyle(r.style)
+	}
+
+	if withBookmarks {
+		for _, b := range r.bookmarks {
+			newRow.WithBookmark(b.title, b.level)
+		}
+	}
+
+	return newRow
+}
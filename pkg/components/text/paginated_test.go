@@ -0,0 +1,216 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/whitespace"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+// lineHeight is the height of a line of the default font, 10pt in mm.
+const lineHeight = 10 * 25.4 / 72
+
+func TestNewPaginated(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := text.NewPaginated("terms and conditions")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_paginated_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := text.NewPaginated("terms and conditions", fixture.TextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_paginated_custom_prop.json")
+	})
+	t.Run("when flow state is set, should add the offset", func(t *testing.T) {
+		// Arrange
+		sut := text.NewPaginated("terms and conditions").(*text.Paginated)
+
+		// Act
+		sut.FlowState = text.FlowState{Offset: 6}
+
+		// Assert
+		assert.Equal(t, 6, sut.GetStructure().GetData().Details["prop_flow_offset"])
+	})
+}
+
+func TestNewPaginatedCol(t *testing.T) {
+	// Act
+	sut := text.NewPaginatedCol(12, "terms and conditions")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_paginated_col.json")
+}
+
+func TestNewPaginatedRow(t *testing.T) {
+	// Act
+	sut := text.NewPaginatedRow("terms and conditions")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_paginated_row.json")
+}
+
+func TestNewPaginatedRowFromState(t *testing.T) {
+	// Arrange
+	sut := text.NewPaginatedRowFromState(text.FlowState{Offset: 4}, "one\ntwo\nthree").(core.FlowRow)
+
+	// Act
+	part, rest := sut.Split(10*lineHeight, 100, newPaginatedConfig())
+
+	// Assert
+	assert.InDelta(t, 2*lineHeight, part.GetHeight(), 1e-9)
+	assert.Nil(t, rest)
+}
+
+func TestPaginated_Render(t *testing.T) {
+	t.Run("when text doesn't fit in the cell, should write the lines which fit", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{Width: 100, Height: 2.5 * lineHeight}
+		sut := newPaginated("one\ntwo\nthree", text.FlowState{})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("one\ntwo", &cell, &props.Text{
+			Family:            fontfamily.Arial,
+			Size:              10,
+			Align:             align.Left,
+			BreakLineStrategy: breakline.EmptySpaceStrategy,
+			WhiteSpace:        whitespace.Pre,
+		})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when flow state is set, should write from the offset", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{Width: 100, Height: 3 * lineHeight}
+		sut := newPaginated("one\ntwo\nthree", text.FlowState{Offset: 4})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("two\nthree", &cell, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when no line fits in the cell, should not write", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{Width: 100, Height: lineHeight / 2}
+		sut := newPaginated("one\ntwo\nthree", text.FlowState{})
+
+		provider := mocks.NewProvider(t)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "AddText")
+	})
+}
+
+func TestPaginated_Next(t *testing.T) {
+	t.Run("when lines are broken by the value, should return the offset after the lines which fit", func(t *testing.T) {
+		// Arrange
+		sut := newPaginated("one\ntwo\nthree", text.FlowState{})
+
+		// Act
+		state := sut.Next(100, 2*lineHeight)
+
+		// Assert
+		assert.Equal(t, text.FlowState{Offset: 8}, state)
+	})
+	t.Run("when words don't fit in the width, should break the lines between the words", func(t *testing.T) {
+		// Arrange
+		sut := newPaginated("aaa bbb ccc", text.FlowState{})
+
+		// Act
+		state := sut.Next(1, 2*lineHeight)
+
+		// Assert
+		assert.Equal(t, text.FlowState{Offset: 8}, state)
+	})
+	t.Run("when no line fits, should return the flow state", func(t *testing.T) {
+		// Arrange
+		sut := newPaginated("one\ntwo\nthree", text.FlowState{Offset: 4})
+
+		// Act
+		state := sut.Next(100, 0)
+
+		// Assert
+		assert.Equal(t, text.FlowState{Offset: 4}, state)
+	})
+}
+
+func TestPaginatedRow_Split(t *testing.T) {
+	t.Run("when text doesn't fit in the height, should return the lines which fit and the rest", func(t *testing.T) {
+		// Arrange
+		cfg := newPaginatedConfig()
+		sut := text.NewPaginatedRow("one\ntwo\nthree").(core.FlowRow)
+
+		// Act
+		part, rest := sut.Split(2.5*lineHeight, 100, cfg)
+
+		// Assert
+		assert.InDelta(t, 2*lineHeight, part.GetHeight(), 1e-9)
+		assert.NotNil(t, rest)
+		last, end := rest.Split(100, 100, cfg)
+		assert.InDelta(t, lineHeight, last.GetHeight(), 1e-9)
+		assert.Nil(t, end)
+	})
+	t.Run("when no line fits in the height, should return the first line", func(t *testing.T) {
+		// Arrange
+		cfg := newPaginatedConfig()
+		sut := text.NewPaginatedRow("one\ntwo").(core.FlowRow)
+
+		// Act
+		part, rest := sut.Split(0, 100, cfg)
+
+		// Assert
+		assert.InDelta(t, lineHeight, part.GetHeight(), 1e-9)
+		assert.NotNil(t, rest)
+	})
+	t.Run("when row has a bookmark, should add it only to the first part", func(t *testing.T) {
+		// Arrange
+		cfg := newPaginatedConfig()
+		sut := text.NewPaginatedRow("one\ntwo").WithBookmark("Terms", 0).(core.FlowRow)
+
+		// Act
+		part, rest := sut.Split(lineHeight, 100, cfg)
+		last, _ := rest.Split(lineHeight, 100, cfg)
+
+		// Assert
+		assert.NotNil(t, part.GetStructure().GetData().Details["bookmarks"])
+		assert.Nil(t, last.GetStructure().GetData().Details)
+	})
+}
+
+func newPaginated(value string, state text.FlowState) *text.Paginated {
+	sut := text.NewPaginated(value).(*text.Paginated)
+	sut.FlowState = state
+	sut.SetConfig(newPaginatedConfig())
+
+	return sut
+}
+
+func newPaginatedConfig() *entity.Config {
+	return &entity.Config{DefaultFont: &props.Font{Family: fontfamily.Arial, Size: 10}, MaxGridSize: 12}
+}
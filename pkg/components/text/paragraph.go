@@ -0,0 +1,97 @@
+package text
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type paragraphs struct {
+	values  []string
+	spacing float64
+	inlines []*inline
+	prop    props.Text
+	config  *entity.Config
+}
+
+// NewParagraphSpacing is responsible to create an instance of a Text with many paragraphs, written one below
+// the other with spacing mm between them, in addition to the height of the lines. The paragraphs are broken in
+// lines like NewInline, so they are aligned to the left, and the top space of the props is only applied above the
+// first paragraph.
+func NewParagraphSpacing(values []string, spacing float64, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	if spacing < 0 {
+		spacing = 0
+	}
+
+	inlines := make([]*inline, 0, len(values))
+	for i, value := range values {
+		paragraphProp := textProp
+		if i > 0 {
+			paragraphProp.Top = 0
+		}
+		inlines = append(inlines, newInline([]InlinePart{{Value: value}}, paragraphProp))
+	}
+
+	return &paragraphs{
+		values:  values,
+		spacing: spacing,
+		inlines: inlines,
+		prop:    textProp,
+	}
+}
+
+// NewParagraphSpacingCol is responsible to create an instance of a paragraphs Text wrapped in a Col.
+func NewParagraphSpacingCol(size int, values []string, spacing float64, ps ...props.Text) core.Col {
+	paragraphs := NewParagraphSpacing(values, spacing, ps...)
+	return col.New(size).Add(paragraphs)
+}
+
+// NewParagraphSpacingRow is responsible to create an instance of a paragraphs Text wrapped in a Row.
+func NewParagraphSpacingRow(height float64, values []string, spacing float64, ps ...props.Text) core.Row {
+	paragraphs := NewParagraphSpacing(values, spacing, ps...)
+	c := col.New().Add(paragraphs)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of a paragraphs Text.
+func (p *paragraphs) GetStructure() *node.Node[core.Structure] {
+	details := p.prop.ToMap()
+	details["spacing"] = p.spacing
+
+	str := core.Structure{
+		Type:    "paragraphs",
+		Value:   p.values,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (p *paragraphs) SetConfig(config *entity.Config) {
+	p.config = config
+	p.prop.MakeValid(p.config.DefaultFont)
+
+	for _, inline := range p.inlines {
+		inline.SetConfig(config)
+	}
+}
+
+// Render renders a paragraphs Text into a PDF context.
+func (p *paragraphs) Render(provider core.Provider, cell *entity.Cell) {
+	blocks := make([]markdownBlock, 0, len(p.inlines))
+	for _, inline := range p.inlines {
+		blocks = append(blocks, inline)
+	}
+
+	renderBlocks(provider, blocks, cell, p.spacing)
+}
@@ -0,0 +1,90 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewParagraphSpacing(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := text.NewParagraphSpacing([]string{"first", "second"}, 3)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_paragraph_spacing_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := text.NewParagraphSpacing([]string{"first", "second"}, 3, fixture.TextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_paragraph_spacing_custom_prop.json")
+	})
+	t.Run("when spacing is negative, should use zero", func(t *testing.T) {
+		// Act
+		sut := text.NewParagraphSpacing([]string{"first"}, -1)
+
+		// Assert
+		assert.Equal(t, 0.0, sut.GetStructure().GetData().Details["spacing"])
+	})
+}
+
+func TestNewParagraphSpacingCol(t *testing.T) {
+	// Act
+	sut := text.NewParagraphSpacingCol(12, []string{"first", "second"}, 3)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_paragraph_spacing_col.json")
+}
+
+func TestNewParagraphSpacingRow(t *testing.T) {
+	// Act
+	sut := text.NewParagraphSpacingRow(10, []string{"first", "second"}, 3)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_paragraph_spacing_row.json")
+}
+
+func TestParagraphs_Render(t *testing.T) {
+	t.Run("should write the paragraphs one below the other with the spacing between them", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 30}
+		fontProp := fixture.FontProp()
+
+		var values []string
+		var cells []*entity.Cell
+		var textProps []*props.Text
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		})
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything).Run(func(v string, c *entity.Cell, p *props.Text) {
+			values = append(values, v)
+			cells = append(cells, c)
+			textProps = append(textProps, p)
+		})
+
+		sut := text.NewParagraphSpacing([]string{"first", "second"}, 3, props.Text{Top: 2})
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, []string{"first", "second"}, values)
+		assert.Equal(t, 20.0, cells[0].Y)
+		assert.Equal(t, 2.0, textProps[0].Top)
+		assert.Equal(t, 29.0, cells[1].Y)
+		assert.Equal(t, 0.0, textProps[1].Top)
+	})
+}
@@ -0,0 +1,132 @@
+package text
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ErrInvalidPhone is returned when a phone number does not match the formats of a locale.
+var ErrInvalidPhone = errors.New("invalid phone number")
+
+// phoneFormat is the format of the phone numbers of a country. The patterns, by number of national digits
+// without the trunk prefix, replace each # by a digit.
+type phoneFormat struct {
+	countryCode string
+	trunk       string
+	patterns    map[int]string
+}
+
+var phoneFormats = map[string]phoneFormat{
+	"US": {countryCode: "1", patterns: map[int]string{10: "(###) ###-####"}},
+	"CA": {countryCode: "1", patterns: map[int]string{10: "(###) ###-####"}},
+	"GB": {countryCode: "44", trunk: "0", patterns: map[int]string{10: "## #### ####"}},
+	"FR": {countryCode: "33", trunk: "0", patterns: map[int]string{9: "# ## ## ## ##"}},
+	"DE": {countryCode: "49", trunk: "0", patterns: map[int]string{10: "## ########", 11: "### ########"}},
+	"ES": {countryCode: "34", patterns: map[int]string{9: "### ## ## ##"}},
+	"IT": {countryCode: "39", patterns: map[int]string{10: "### ### ####"}},
+	"PT": {countryCode: "351", patterns: map[int]string{9: "### ### ###"}},
+	"NL": {countryCode: "31", trunk: "0", patterns: map[int]string{9: "## ### ####"}},
+	"CH": {countryCode: "41", trunk: "0", patterns: map[int]string{9: "## ### ## ##"}},
+	"SE": {countryCode: "46", trunk: "0", patterns: map[int]string{9: "##-### ## ##"}},
+	"PL": {countryCode: "48", patterns: map[int]string{9: "### ### ###"}},
+	"RU": {countryCode: "7", trunk: "8", patterns: map[int]string{10: " (###) ###-##-##"}},
+	"BR": {countryCode: "55", patterns: map[int]string{10: "(##) ####-####", 11: "(##) #####-####"}},
+	"MX": {countryCode: "52", patterns: map[int]string{10: "## #### ####"}},
+	"AR": {countryCode: "54", patterns: map[int]string{10: "## ####-####"}},
+	"JP": {countryCode: "81", trunk: "0", patterns: map[int]string{9: "#-####-####", 10: "##-####-####"}},
+	"KR": {countryCode: "82", trunk: "0", patterns: map[int]string{9: "#-###-####", 10: "##-####-####"}},
+	"CN": {countryCode: "86", patterns: map[int]string{11: "### #### ####"}},
+	"IN": {countryCode: "91", patterns: map[int]string{10: "##### #####"}},
+	"AU": {countryCode: "61", trunk: "0", patterns: map[int]string{9: "# #### ####"}},
+}
+
+// NewPhone is responsible to create an instance of a Text with a phone number formatted according to the
+// country of a locale, ex: "(555) 123-4567" in en-US. Numbers starting with "+" are written in the international
+// format, ex: "+44 20 7946 0958". When the locale is not recognised or the number does not match its formats,
+// the phone is written as received, FormatPhone returns the reason.
+func NewPhone(phone, locale string, ps ...props.Text) core.Component {
+	value, err := FormatPhone(phone, locale)
+	if err != nil {
+		value = phone
+	}
+
+	return New(value, ps...)
+}
+
+// FormatPhone formats a phone number according to the country of a locale. It returns ErrUnknownLocale when
+// there is no format for the country and ErrInvalidPhone when the digits do not match its formats.
+func FormatPhone(phone, locale string) (string, error) {
+	format, err := matchPhoneLocale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	phone = strings.TrimSpace(phone)
+	international := strings.HasPrefix(phone, "+")
+
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+
+	if international {
+		if !strings.HasPrefix(digits, format.countryCode) {
+			return "", ErrInvalidPhone
+		}
+		digits = strings.TrimPrefix(digits, format.countryCode)
+	} else if format.trunk != "" {
+		digits = strings.TrimPrefix(digits, format.trunk)
+	}
+
+	pattern, ok := format.patterns[len(digits)]
+	if !ok {
+		return "", ErrInvalidPhone
+	}
+
+	var sb strings.Builder
+	if international {
+		sb.WriteString("+" + format.countryCode + " ")
+		pattern = strings.TrimSpace(pattern)
+	} else {
+		sb.WriteString(format.trunk)
+	}
+
+	next := 0
+	for _, r := range pattern {
+		if r != '#' {
+			sb.WriteRune(r)
+			continue
+		}
+
+		sb.WriteByte(digits[next])
+		next++
+	}
+
+	return sb.String(), nil
+}
+
+func matchPhoneLocale(locale string) (phoneFormat, error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return phoneFormat{}, ErrUnknownLocale
+	}
+
+	region, confidence := tag.Region()
+	if confidence == language.No {
+		return phoneFormat{}, ErrUnknownLocale
+	}
+
+	format, ok := phoneFormats[region.String()]
+	if !ok {
+		return phoneFormat{}, ErrUnknownLocale
+	}
+
+	return format, nil
+}
@@ -0,0 +1,84 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewPhone(t *testing.T) {
+	t.Run("when locale is known, should create text with formatted phone", func(t *testing.T) {
+		// Act
+		sut := text.NewPhone("5551234567", "en-US")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_phone_en_us.json")
+	})
+	t.Run("when locale is unknown, should create text with raw phone", func(t *testing.T) {
+		// Act
+		sut := text.NewPhone("555-1234", "xx")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_phone_unknown_locale.json")
+	})
+}
+
+func TestFormatPhone(t *testing.T) {
+	t.Run("when phone is national, should use national format", func(t *testing.T) {
+		// Act
+		us, errUs := text.FormatPhone("555.123.4567", "en-US")
+		fr, errFr := text.FormatPhone("0123456789", "fr")
+		br, errBr := text.FormatPhone("11912345678", "pt-BR")
+
+		// Assert
+		assert.Nil(t, errUs)
+		assert.Equal(t, "(555) 123-4567", us)
+		assert.Nil(t, errFr)
+		assert.Equal(t, "01 23 45 67 89", fr)
+		assert.Nil(t, errBr)
+		assert.Equal(t, "(11) 91234-5678", br)
+	})
+	t.Run("when phone starts with plus, should use international format", func(t *testing.T) {
+		// Act
+		gb, errGb := text.FormatPhone("+442079460958", "en-GB")
+		ru, errRu := text.FormatPhone("+7 495 123 45 67", "ru")
+
+		// Assert
+		assert.Nil(t, errGb)
+		assert.Equal(t, "+44 20 7946 0958", gb)
+		assert.Nil(t, errRu)
+		assert.Equal(t, "+7 (495) 123-45-67", ru)
+	})
+	t.Run("when phone has trunk prefix, should keep it in national format", func(t *testing.T) {
+		// Act
+		value, err := text.FormatPhone("03-1234-5678", "ja-JP")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "03-1234-5678", value)
+	})
+	t.Run("when phone does not match locale formats, should return error", func(t *testing.T) {
+		// Act
+		short, errShort := text.FormatPhone("123", "en-US")
+		_, errCode := text.FormatPhone("+33123456789", "en-GB")
+
+		// Assert
+		assert.Equal(t, text.ErrInvalidPhone, errShort)
+		assert.Empty(t, short)
+		assert.Equal(t, text.ErrInvalidPhone, errCode)
+	})
+	t.Run("when locale is unknown, should return error", func(t *testing.T) {
+		// Act
+		_, errUnknown := text.FormatPhone("123", "xx")
+		_, errMalformed := text.FormatPhone("123", "!!")
+		_, errRegion := text.FormatPhone("123", "en-ZA")
+
+		// Assert
+		assert.Equal(t, text.ErrUnknownLocale, errUnknown)
+		assert.Equal(t, text.ErrUnknownLocale, errMalformed)
+		assert.Equal(t, text.ErrUnknownLocale, errRegion)
+	})
+}
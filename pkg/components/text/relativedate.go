@@ -0,0 +1,141 @@
+package text
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// relativeDateMaxDays is the difference of days from which the dates are written as absolute dates.
+const relativeDateMaxDays = 7
+
+const (
+	relativeKeyToday     = "today"
+	relativeKeyYesterday = "yesterday"
+	relativeKeyTomorrow  = "tomorrow"
+	relativeKeyDaysAgo   = "%d days ago"
+	relativeKeyInDays    = "in %d days"
+)
+
+var (
+	relativeDateTranslations = map[language.Tag]map[string]string{
+		language.English: {
+			relativeKeyToday:     "Today",
+			relativeKeyYesterday: "Yesterday",
+			relativeKeyTomorrow:  "Tomorrow",
+			relativeKeyDaysAgo:   "%d days ago",
+			relativeKeyInDays:    "in %d days",
+		},
+		language.French: {
+			relativeKeyToday:     "aujourd'hui",
+			relativeKeyYesterday: "hier",
+			relativeKeyTomorrow:  "demain",
+			relativeKeyDaysAgo:   "il y a %d jours",
+			relativeKeyInDays:    "dans %d jours",
+		},
+		language.German: {
+			relativeKeyToday:     "heute",
+			relativeKeyYesterday: "gestern",
+			relativeKeyTomorrow:  "morgen",
+			relativeKeyDaysAgo:   "vor %d Tagen",
+			relativeKeyInDays:    "in %d Tagen",
+		},
+		language.Spanish: {
+			relativeKeyToday:     "hoy",
+			relativeKeyYesterday: "ayer",
+			relativeKeyTomorrow:  "mañana",
+			relativeKeyDaysAgo:   "hace %d días",
+			relativeKeyInDays:    "dentro de %d días",
+		},
+		language.Portuguese: {
+			relativeKeyToday:     "hoje",
+			relativeKeyYesterday: "ontem",
+			relativeKeyTomorrow:  "amanhã",
+			relativeKeyDaysAgo:   "há %d dias",
+			relativeKeyInDays:    "em %d dias",
+		},
+		language.Italian: {
+			relativeKeyToday:     "oggi",
+			relativeKeyYesterday: "ieri",
+			relativeKeyTomorrow:  "domani",
+			relativeKeyDaysAgo:   "%d giorni fa",
+			relativeKeyInDays:    "tra %d giorni",
+		},
+	}
+	relativeDateCatalog = buildRelativeDateCatalog()
+)
+
+// NewRelativeDate is responsible to create an instance of a Text with a date written relative to another one,
+// ex: "Yesterday" or "3 days ago" in en and "gestern" in de. The days are counted in the location of relativeTo
+// and, from 7 days of difference, the date is written like NewDate with the default layout of the locale.
+// When the locale is not recognised, english is used and a warning is written to the Logger of the config.
+func NewRelativeDate(t time.Time, relativeTo time.Time, locale string, ps ...props.Text) core.Component {
+	value, err := FormatRelativeDate(t, relativeTo, locale)
+	if err == nil {
+		return New(value, ps...)
+	}
+
+	value, _ = FormatRelativeDate(t, relativeTo, language.English.String())
+	relativeDate := New(value, ps...).(*text)
+	relativeDate.warning = fmt.Errorf("could not format relative date with locale %q, using en: %w", locale, err)
+
+	return relativeDate
+}
+
+// FormatRelativeDate writes a date relative to another one according to a locale, ex: "in 2 days" in en and
+// "hier" in fr. It returns ErrUnknownLocale when the locale is not recognised.
+func FormatRelativeDate(t time.Time, relativeTo time.Time, locale string) (string, error) {
+	tag, err := matchDateLocale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	days := getDaysBetween(t, relativeTo)
+	if days <= -relativeDateMaxDays || days >= relativeDateMaxDays {
+		return FormatDate(t, "", locale)
+	}
+
+	printer := message.NewPrinter(tag, message.Catalog(relativeDateCatalog))
+
+	switch {
+	case days == 0:
+		return printer.Sprintf(relativeKeyToday), nil
+	case days == 1:
+		return printer.Sprintf(relativeKeyYesterday), nil
+	case days == -1:
+		return printer.Sprintf(relativeKeyTomorrow), nil
+	case days > 1:
+		return printer.Sprintf(relativeKeyDaysAgo, days), nil
+	default:
+		return printer.Sprintf(relativeKeyInDays, -days), nil
+	}
+}
+
+// getDaysBetween returns how many calendar days t is before relativeTo, negative when it's after.
+func getDaysBetween(t time.Time, relativeTo time.Time) int {
+	location := relativeTo.Location()
+	t = t.In(location)
+
+	from := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(relativeTo.Year(), relativeTo.Month(), relativeTo.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func buildRelativeDateCatalog() *catalog.Builder {
+	builder := catalog.NewBuilder(catalog.Fallback(language.English))
+
+	for tag, translation := range relativeDateTranslations {
+		for key, value := range translation {
+			_ = builder.SetString(tag, key, value)
+		}
+	}
+
+	return builder
+}
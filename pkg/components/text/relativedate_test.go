@@ -0,0 +1,110 @@
+package text_test
+
+import (
+	"bytes"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewRelativeDate(t *testing.T) {
+	now := time.Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("when locale is known, should create text with relative date", func(t *testing.T) {
+		// Act
+		sut := text.NewRelativeDate(now.AddDate(0, 0, -1), now, "de")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_relative_date_de.json")
+	})
+	t.Run("when locale is unknown, should create text in english", func(t *testing.T) {
+		// Act
+		sut := text.NewRelativeDate(now.AddDate(0, 0, -1), now, "xx")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_relative_date_unknown_locale.json")
+	})
+	t.Run("when locale is unknown and config has logger, should log warning", func(t *testing.T) {
+		// Arrange
+		var output bytes.Buffer
+		cfg := config.NewBuilder().WithLogger(log.New(&output, "", 0)).Build()
+		sut := text.NewRelativeDate(now.AddDate(0, 0, -1), now, "xx")
+
+		// Act
+		sut.SetConfig(cfg)
+
+		// Assert
+		assert.Contains(t, output.String(), `maroto: could not format relative date with locale "xx", using en`)
+	})
+	t.Run("when locale is known and config has logger, should not log", func(t *testing.T) {
+		// Arrange
+		var output bytes.Buffer
+		cfg := config.NewBuilder().WithLogger(log.New(&output, "", 0)).Build()
+		sut := text.NewRelativeDate(now.AddDate(0, 0, -1), now, "de")
+
+		// Act
+		sut.SetConfig(cfg)
+
+		// Assert
+		assert.Empty(t, output.String())
+	})
+}
+
+func TestFormatRelativeDate(t *testing.T) {
+	now := time.Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		date     time.Time
+		locale   string
+		expected string
+	}{
+		{"when date is the same day, should write today", now.Add(-9 * time.Hour), "en", "Today"},
+		{"when date is the day before, should write yesterday", now.Add(-11 * time.Hour), "en", "Yesterday"},
+		{"when date is the day after, should write tomorrow", now.AddDate(0, 0, 1), "en", "Tomorrow"},
+		{"when date is days before, should write days ago", now.AddDate(0, 0, -3), "en", "3 days ago"},
+		{"when date is days after, should write in days", now.AddDate(0, 0, 6), "en", "in 6 days"},
+		{"when date is 7 days before, should write absolute date", now.AddDate(0, 0, -7), "en", "January 8, 2024"},
+		{"when locale is french, should translate", now.AddDate(0, 0, -1), "fr", "hier"},
+		{"when locale is portuguese, should translate days ago", now.AddDate(0, 0, -2), "pt-BR", "há 2 dias"},
+		{"when locale is german and date is old, should write german absolute date", now.AddDate(0, 0, -30), "de", "16. Dezember 2023"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// Act
+			value, err := text.FormatRelativeDate(c.date, now, c.locale)
+
+			// Assert
+			assert.Nil(t, err)
+			assert.Equal(t, c.expected, value)
+		})
+	}
+
+	t.Run("when dates have different locations, should count the days in the location of relative to", func(t *testing.T) {
+		// Arrange
+		brt := time.FixedZone("BRT", -3*60*60)
+		relativeTo := time.Date(2024, time.January, 15, 1, 0, 0, 0, brt)
+		date := time.Date(2024, time.January, 15, 2, 0, 0, 0, time.UTC)
+
+		// Act
+		value, err := text.FormatRelativeDate(date, relativeTo, "en")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "Yesterday", value)
+	})
+	t.Run("when locale is unknown, should return error", func(t *testing.T) {
+		// Act
+		_, err := text.FormatRelativeDate(now, now, "xx")
+
+		// Assert
+		assert.ErrorIs(t, err, text.ErrUnknownLocale)
+	})
+}
@@ -0,0 +1,25 @@
+package text
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// NewTemplate is responsible to create an instance of a Text from a text/template,
+// ex: "Dear {{.FirstName}},". It returns an error when the template cannot be parsed or executed.
+func NewTemplate(tmpl string, data interface{}, ps ...props.Text) (core.Component, error) {
+	t, err := template.New("text").Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	if err := t.Execute(&sb, data); err != nil {
+		return nil, err
+	}
+
+	return New(sb.String(), ps...), nil
+}
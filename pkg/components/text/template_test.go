@@ -0,0 +1,44 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewTemplate(t *testing.T) {
+	data := struct {
+		FirstName string
+	}{
+		FirstName: "John",
+	}
+
+	t.Run("when template is valid, should create text with executed template", func(t *testing.T) {
+		// Act
+		sut, err := text.NewTemplate("Dear {{.FirstName}},", data, fixture.TextProp())
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_text_template.json")
+	})
+	t.Run("when template cannot be parsed, should return error", func(t *testing.T) {
+		// Act
+		sut, err := text.NewTemplate("Dear {{.FirstName", data)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, sut)
+	})
+	t.Run("when template cannot be executed, should return error", func(t *testing.T) {
+		// Act
+		sut, err := text.NewTemplate("Dear {{.LastName}},", data)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, sut)
+	})
+}
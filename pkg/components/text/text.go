@@ -6,6 +6,7 @@ import (
 
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
@@ -15,6 +16,8 @@ type text struct {
 	value  string
 	prop   props.Text
 	config *entity.Config
+	// warning is written to the logger of the config, it's set when the value could not be formatted.
+	warning error
 }
 
 // New is responsible to create an instance of a Text.
@@ -57,6 +60,13 @@ func (t *text) GetStructure() *node.Node[core.Structure] {
 // SetConfig sets the config.
 func (t *text) SetConfig(config *entity.Config) {
 	t.config = config
+	if t.warning != nil && config.Logger != nil {
+		config.Logger.Printf("maroto: %v", t.warning)
+	}
+
+	if config.RTL && t.prop.Align == "" {
+		t.prop.Align = align.Right
+	}
 	t.prop.MakeValid(t.config.DefaultFont)
 }
 
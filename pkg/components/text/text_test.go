@@ -3,10 +3,16 @@ package text_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 )
 
@@ -93,4 +99,94 @@ func TestText_SetConfig(t *testing.T) {
 		// Act
 		sut.SetConfig(cfg)
 	})
+	t.Run("when layout is rtl and align is not sent, should align to the right", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		fontProp := fixture.FontProp()
+		sut := text.New("textValue")
+
+		var prop *props.Text
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("textValue", &cell, mock.Anything).Run(func(_ string, _ *entity.Cell, p *props.Text) {
+			prop = p
+		})
+
+		// Act
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp, RTL: true})
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, align.Right, prop.Align)
+	})
+	t.Run("when layout is rtl and align is sent, should keep the provided", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		fontProp := fixture.FontProp()
+		sut := text.New("textValue", props.Text{Align: align.Center})
+
+		var prop *props.Text
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("textValue", &cell, mock.Anything).Run(func(_ string, _ *entity.Cell, p *props.Text) {
+			prop = p
+		})
+
+		// Act
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp, RTL: true})
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, align.Center, prop.Align)
+	})
+}
+
+func TestNewAutoWidth(t *testing.T) {
+	t.Run("when prop is not sent, should measure with arial 10", func(t *testing.T) {
+		// Act
+		sut, width := text.NewAutoWidth("Total")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_text_auto_width.json")
+		assert.Equal(t, text.MeasureWidth("Total", props.Text{Family: fontfamily.Arial, Size: 10}), width)
+	})
+	t.Run("when font is bigger, should need a wider column", func(t *testing.T) {
+		// Act
+		_, small := text.NewAutoWidth("Total", props.Text{Size: 10})
+		_, big := text.NewAutoWidth("Total", props.Text{Size: 20})
+
+		// Assert
+		assert.InDelta(t, 2*small, big, 0.2)
+	})
+	t.Run("when text has paddings, should add them to the width", func(t *testing.T) {
+		// Act
+		_, width := text.NewAutoWidth("Total")
+		_, padded := text.NewAutoWidth("Total", props.Text{Left: 2, Right: 3})
+
+		// Assert
+		assert.InDelta(t, width+5, padded, 0.0001)
+	})
+}
+
+func TestMeasureWidth(t *testing.T) {
+	t.Run("when text has line breaks, should measure the widest line", func(t *testing.T) {
+		// Act
+		width := text.MeasureWidth("a\nwidest line\nab", props.Text{})
+
+		// Assert
+		assert.Equal(t, text.MeasureWidth("widest line", props.Text{}), width)
+	})
+	t.Run("when text is written in the measured width, should fit in one line", func(t *testing.T) {
+		// Act
+		width := text.MeasureWidth("Total", props.Text{Family: fontfamily.Courier, Size: 10})
+
+		// Assert
+		// courier has 0.6 em per character, 5 characters at 10pt are 10.58mm.
+		assert.InDelta(t, 10.6, width, 0.0001)
+	})
+	t.Run("when family is unknown, should measure with arial", func(t *testing.T) {
+		// Act
+		width := text.MeasureWidth("Total", props.Text{Family: "custom"})
+
+		// Assert
+		assert.Equal(t, text.MeasureWidth("Total", props.Text{Family: fontfamily.Arial}), width)
+	})
 }
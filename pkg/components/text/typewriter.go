@@ -0,0 +1,62 @@
+package text
+
+import (
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// NewTypewriter is responsible to create an instance of a Text written like a typewriter, as on POS receipts:
+// the lines are broken at exactly charsPerLine characters, even in the middle of a word, and the line breaks of
+// the value are kept. The text is written in Courier, unless props.Text.Family is sent with a monospaced
+// custom font. The size must make charsPerLine characters fit the col, otherwise the lines are wrapped again
+// at the width of the col. When charsPerLine is lower than 1, the lines are not broken.
+func NewTypewriter(value string, charsPerLine int, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	if textProp.Family == "" {
+		textProp.Family = fontfamily.Courier
+	}
+
+	return New(breakTypewriterLines(value, charsPerLine), textProp)
+}
+
+// NewTypewriterCol is responsible to create an instance of a Typewriter wrapped in a Col.
+func NewTypewriterCol(size int, value string, charsPerLine int, ps ...props.Text) core.Col {
+	typewriter := NewTypewriter(value, charsPerLine, ps...)
+	return col.New(size).Add(typewriter)
+}
+
+// NewTypewriterRow is responsible to create an instance of a Typewriter wrapped in a Row.
+func NewTypewriterRow(height float64, value string, charsPerLine int, ps ...props.Text) core.Row {
+	typewriter := NewTypewriter(value, charsPerLine, ps...)
+	c := col.New().Add(typewriter)
+	return row.New(height).Add(c)
+}
+
+// breakTypewriterLines breaks each line of the value every charsPerLine characters.
+func breakTypewriterLines(value string, charsPerLine int) string {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	if charsPerLine < 1 {
+		return value
+	}
+
+	var lines []string
+	for _, line := range strings.Split(value, "\n") {
+		chars := []rune(line)
+		for len(chars) > charsPerLine {
+			lines = append(lines, string(chars[:charsPerLine]))
+			chars = chars[charsPerLine:]
+		}
+		lines = append(lines, string(chars))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,94 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewTypewriter(t *testing.T) {
+	t.Run("when prop is not sent, should use courier", func(t *testing.T) {
+		// Act
+		sut := text.NewTypewriter("TOTAL 10.00", 8)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_typewriter_default_prop.json")
+	})
+	t.Run("when family is sent, should use the family sent", func(t *testing.T) {
+		// Act
+		sut := text.NewTypewriter("TOTAL 10.00", 8, props.Text{Family: "jetbrains-mono", Size: 8})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_typewriter_custom_prop.json")
+	})
+}
+
+func TestNewTypewriterCol(t *testing.T) {
+	// Act
+	sut := text.NewTypewriterCol(12, "TOTAL 10.00", 8)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_typewriter_col.json")
+}
+
+func TestNewTypewriterRow(t *testing.T) {
+	// Act
+	sut := text.NewTypewriterRow(10, "TOTAL 10.00", 8)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_typewriter_row.json")
+}
+
+func TestTypewriter_Render(t *testing.T) {
+	t.Run("when lines are longer than chars per line, should break them at exactly chars per line", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 10}
+		sut := text.NewTypewriter("coffee and cake\r\n2x latte", 6)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("coffee\n and c\nake\n2x lat\nte", &cell, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when line has multibyte characters, should count characters", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 10}
+		sut := text.NewTypewriter("café crème", 5)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("café \ncrème", &cell, mock.MatchedBy(func(p *props.Text) bool {
+			return p.Family == fontfamily.Courier
+		}))
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when chars per line is lower than 1, should not break lines", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 10}
+		sut := text.NewTypewriter("coffee and cake", 0)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("coffee and cake", &cell, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+}
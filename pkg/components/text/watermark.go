@@ -0,0 +1,72 @@
+package text
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// WatermarkType is the type of the structure of a Watermark, used by the pages to find the
+// watermarks returned by the PageTemplate.
+const WatermarkType = "watermark"
+
+type watermark struct {
+	value  string
+	prop   props.Watermark
+	config *entity.Config
+}
+
+// NewWatermark is responsible to create an instance of a Watermark, a text written diagonally over the
+// center of the page, whatever the cell it is added to. When it's returned by the PageTemplate of the
+// config, it replaces the watermark defined by WithWatermarkText on the page. When the prop is not sent,
+// props.NewWatermark is used.
+func NewWatermark(value string, ps ...props.Watermark) core.Component {
+	prop := props.NewWatermark()
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	return &watermark{
+		value: value,
+		prop:  prop,
+	}
+}
+
+// NewWatermarkCol is responsible to create an instance of a Watermark wrapped in a Col.
+func NewWatermarkCol(size int, value string, ps ...props.Watermark) core.Col {
+	watermark := NewWatermark(value, ps...)
+	return col.New(size).Add(watermark)
+}
+
+// NewWatermarkRow is responsible to create an instance of a Watermark wrapped in a Row.
+func NewWatermarkRow(height float64, value string, ps ...props.Watermark) core.Row {
+	watermark := NewWatermark(value, ps...)
+	c := col.New().Add(watermark)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of a Watermark.
+func (w *watermark) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    WatermarkType,
+		Value:   w.value,
+		Details: w.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config and the props, as the font family depends on the default font.
+func (w *watermark) SetConfig(config *entity.Config) {
+	w.config = config
+	w.prop.MakeValid(config.DefaultFont)
+}
+
+// Render renders a Watermark into a PDF context, the cell is ignored as it's centered on the page.
+func (w *watermark) Render(provider core.Provider, _ *entity.Cell) {
+	provider.AddWatermark(w.value, &w.prop)
+}
@@ -0,0 +1,71 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewWatermark(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := text.NewWatermark("DRAFT")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_watermark_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := text.NewWatermark("DRAFT", fixture.WatermarkProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_watermark_custom_prop.json")
+	})
+}
+
+func TestNewWatermarkCol(t *testing.T) {
+	// Act
+	sut := text.NewWatermarkCol(12, "DRAFT")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_watermark_col.json")
+}
+
+func TestNewWatermarkRow(t *testing.T) {
+	// Act
+	sut := text.NewWatermarkRow(10, "DRAFT")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_watermark_row.json")
+}
+
+func TestWatermark_Render(t *testing.T) {
+	// Arrange
+	cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 15}
+	fontProp := fixture.FontProp()
+
+	provider := mocks.NewProvider(t)
+	provider.EXPECT().AddWatermark("DRAFT", &props.Watermark{
+		Family:  fontProp.Family,
+		Style:   fontstyle.Bold,
+		Size:    60,
+		Color:   &props.Color{Red: 128, Green: 128, Blue: 128},
+		Angle:   45,
+		Opacity: 0.3,
+	})
+
+	sut := text.NewWatermark("DRAFT")
+	sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+	// Act
+	sut.Render(provider, &cell)
+
+	// Assert
+	provider.AssertNumberOfCalls(t, "AddWatermark", 1)
+}
@@ -0,0 +1,209 @@
+// Package gantt implements creation of Gantt charts with milestones and critical path highlighting.
+package gantt
+
+import (
+	"time"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// barPercent is how much of the task row height is filled by the bar.
+const barPercent = 0.6
+
+// Task is a task of a Gantt chart.
+type Task struct {
+	Name  string
+	Start time.Time
+	// End is ignored on milestones.
+	End time.Time
+	// Milestone tasks are drawn as a diamond on Start.
+	Milestone bool
+	// Critical tasks are on the critical path and are drawn with the critical color.
+	Critical bool
+}
+
+type gantt struct {
+	tasks  []Task
+	prop   props.Gantt
+	config *entity.Config
+}
+
+// New is responsible to create an instance of a Gantt chart.
+// The time axis is scaled from the earliest start to the latest end of the tasks.
+func New(tasks []Task, ps ...props.Gantt) core.Component {
+	prop := props.Gantt{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid(fontfamily.Arial)
+
+	return &gantt{
+		tasks: tasks,
+		prop:  prop,
+	}
+}
+
+// NewCol is responsible to create an instance of a Gantt chart wrapped in a Col.
+func NewCol(size int, tasks []Task, ps ...props.Gantt) core.Col {
+	gantt := New(tasks, ps...)
+	return col.New(size).Add(gantt)
+}
+
+// NewRow is responsible to create an instance of a Gantt chart wrapped in a Row.
+func NewRow(height float64, tasks []Task, ps ...props.Gantt) core.Row {
+	gantt := New(tasks, ps...)
+	c := col.New().Add(gantt)
+	return row.New(height).Add(c)
+}
+
+// Render renders a Gantt chart into a PDF context.
+func (g *gantt) Render(provider core.Provider, cell *entity.Cell) {
+	if len(g.tasks) == 0 {
+		return
+	}
+
+	fontHeight := provider.GetTextHeight(&g.prop.LabelFont)
+	labelWidth := cell.Width * g.prop.LabelPercent / 100.0
+	axisHeight := fontHeight * 1.5
+
+	grid := entity.Cell{
+		X:      cell.X + labelWidth,
+		Y:      cell.Y + axisHeight,
+		Width:  cell.Width - labelWidth,
+		Height: cell.Height - axisHeight,
+	}
+
+	start, end := g.getSpan()
+	g.renderAxis(provider, &grid, cell.Y, axisHeight, start, end)
+
+	taskHeight := grid.Height / float64(len(g.tasks))
+	barHeight := taskHeight * barPercent
+
+	for i, task := range g.tasks {
+		y := grid.Y + float64(i)*taskHeight
+		barY := y + (taskHeight-barHeight)/2.0
+
+		nameCell := &entity.Cell{X: cell.X, Y: y, Width: labelWidth, Height: taskHeight}
+		provider.AddText(task.Name, nameCell, g.prop.LabelFont.ToTextProp(align.Left, (taskHeight-fontHeight)/2.0, 0))
+
+		startX := g.getX(&grid, task.Start, start, end)
+		if task.Milestone {
+			g.renderMilestone(provider, startX, barY, barHeight)
+			continue
+		}
+
+		color := g.prop.BarColor
+		if task.Critical {
+			color = g.prop.CriticalColor
+		}
+
+		provider.DrawRect(&entity.Cell{
+			X:      startX,
+			Y:      barY,
+			Width:  g.getX(&grid, task.End, start, end) - startX,
+			Height: barHeight,
+		}, &props.Cell{BackgroundColor: color})
+	}
+
+	if !g.prop.Today.IsZero() && !g.prop.Today.Before(start) && !g.prop.Today.After(end) {
+		x := g.getX(&grid, g.prop.Today, start, end)
+		provider.DrawLine(entity.Point{X: x, Y: grid.Y}, entity.Point{X: x, Y: grid.Y + grid.Height},
+			&props.Line{Color: g.prop.TodayColor, Style: linestyle.Solid, Thickness: 0.2})
+	}
+}
+
+// GetStructure returns the Structure of a Gantt chart.
+func (g *gantt) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "gantt",
+		Value:   g.getTasksValue(),
+		Details: g.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (g *gantt) SetConfig(config *entity.Config) {
+	g.config = config
+}
+
+func (g *gantt) getTasksValue() []map[string]interface{} {
+	value := make([]map[string]interface{}, len(g.tasks))
+	for i, task := range g.tasks {
+		m := map[string]interface{}{
+			"name":  task.Name,
+			"start": task.Start.Format(time.RFC3339),
+		}
+
+		if task.Milestone {
+			m["milestone"] = true
+		} else {
+			m["end"] = task.End.Format(time.RFC3339)
+		}
+
+		if task.Critical {
+			m["critical"] = true
+		}
+
+		value[i] = m
+	}
+
+	return value
+}
+
+func (g *gantt) renderAxis(provider core.Provider, grid *entity.Cell, y, height float64, start, end time.Time) {
+	axis := &entity.Cell{X: grid.X, Y: y, Width: grid.Width, Height: height}
+
+	provider.AddText(start.Format(g.prop.DateLayout), axis, g.prop.LabelFont.ToTextProp(align.Left, 0, 0))
+	provider.AddText(end.Format(g.prop.DateLayout), axis, g.prop.LabelFont.ToTextProp(align.Right, 0, 0))
+}
+
+func (g *gantt) renderMilestone(provider core.Provider, x, y, size float64) {
+	half := size / 2.0
+	provider.DrawPolygon([]entity.Point{
+		{X: x, Y: y},
+		{X: x + half, Y: y + half},
+		{X: x, Y: y + size},
+		{X: x - half, Y: y + half},
+	}, &props.Line{Color: g.prop.MilestoneColor, FillColor: g.prop.MilestoneColor, Style: linestyle.Solid, Thickness: 0.2})
+}
+
+// getSpan returns the earliest start and the latest end of the tasks, milestones end on their start.
+func (g *gantt) getSpan() (time.Time, time.Time) {
+	start, end := g.tasks[0].Start, g.tasks[0].Start
+
+	for _, task := range g.tasks {
+		if task.Start.Before(start) {
+			start = task.Start
+		}
+
+		if task.Start.After(end) {
+			end = task.Start
+		}
+
+		if !task.Milestone && task.End.After(end) {
+			end = task.End
+		}
+	}
+
+	return start, end
+}
+
+func (g *gantt) getX(grid *entity.Cell, t, start, end time.Time) float64 {
+	span := end.Sub(start)
+	if span <= 0 {
+		return grid.X
+	}
+
+	return grid.X + grid.Width*float64(t.Sub(start))/float64(span)
+}
@@ -0,0 +1,132 @@
+package gantt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/timeline/gantt"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func day(d int) time.Time {
+	return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC)
+}
+
+var tasks = []gantt.Task{
+	{Name: "Design", Start: day(1), End: day(6)},
+	{Name: "Build", Start: day(6), End: day(21), Critical: true},
+	{Name: "Release", Start: day(21), Milestone: true},
+}
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := gantt.New(tasks)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/gantts/new_gantt_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := gantt.New(tasks, fixture.GanttProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/gantts/new_gantt_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := gantt.NewCol(12, tasks)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/gantts/new_gantt_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := gantt.NewRow(10, tasks)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/gantts/new_gantt_row.json")
+}
+
+func TestGantt_Render(t *testing.T) {
+	t.Run("when tasks are empty, should not call provider", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := gantt.New(nil)
+
+		provider := &mocks.Provider{}
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "DrawRect")
+	})
+	t.Run("when tasks are sent, should scale bars to the span of the tasks", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 36}
+		sut := gantt.New(tasks, props.Gantt{LabelPercent: 20})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().DrawPolygon(mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 5)
+		provider.AssertNumberOfCalls(t, "DrawRect", 2)
+		provider.AssertCalled(t, "DrawRect", &entity.Cell{X: 20, Y: 8, Width: 20, Height: 6},
+			&props.Cell{BackgroundColor: &props.Color{Red: 70, Green: 130, Blue: 180}})
+		provider.AssertCalled(t, "DrawRect", &entity.Cell{X: 40, Y: 18, Width: 60, Height: 6},
+			&props.Cell{BackgroundColor: &props.RedColor})
+		provider.AssertCalled(t, "DrawPolygon", []entity.Point{
+			{X: 100, Y: 28}, {X: 103, Y: 31}, {X: 100, Y: 34}, {X: 97, Y: 31},
+		}, mock.MatchedBy(func(l *props.Line) bool {
+			return l.Style == linestyle.Solid
+		}))
+		provider.AssertNotCalled(t, "DrawLine", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("when today is inside the span, should draw the today line", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 36}
+		sut := gantt.New(tasks, props.Gantt{LabelPercent: 20, Today: day(11)})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().DrawRect(mock.Anything, mock.Anything)
+		provider.EXPECT().DrawPolygon(mock.Anything, mock.Anything)
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertCalled(t, "DrawLine", entity.Point{X: 60, Y: 6}, entity.Point{X: 60, Y: 36},
+			&props.Line{Color: &props.RedColor, Style: linestyle.Solid, Thickness: 0.2})
+	})
+}
+
+func TestGantt_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := gantt.New(tasks)
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
@@ -0,0 +1,227 @@
+// Package milestone implements creation of milestone charts, with the events of a period on a timeline.
+package milestone
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// labelGap is the space, in mm, between the labels and the diamonds and between labels side by side.
+const labelGap = 1.0
+
+// labelPadding is added to the width, in mm, of the labels, so they are not broken in lines by rounding.
+const labelPadding = 0.1
+
+// Event is an event of a Milestone chart.
+type Event struct {
+	Date  time.Time
+	Label string
+	// Color define the color of the diamond, when nil the color of the props is used.
+	Color *props.Color
+}
+
+type milestone struct {
+	events []Event
+	start  time.Time
+	end    time.Time
+	prop   props.Milestone
+	config *entity.Config
+}
+
+// label is the area where the label of an event is written.
+type label struct {
+	value string
+	cell  entity.Cell
+}
+
+// New is responsible to create an instance of a Milestone chart, a horizontal line scaled from start to end
+// with a diamond on the date of each event. The events out of the period are not drawn. The labels are
+// written above and below the line, alternating, and stacked in levels when they would overlap.
+func New(events []Event, start, end time.Time, ps ...props.Milestone) core.Component {
+	prop := props.Milestone{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid(fontfamily.Arial)
+
+	return &milestone{
+		events: events,
+		start:  start,
+		end:    end,
+		prop:   prop,
+	}
+}
+
+// NewCol is responsible to create an instance of a Milestone chart wrapped in a Col.
+func NewCol(size int, events []Event, start, end time.Time, ps ...props.Milestone) core.Col {
+	milestone := New(events, start, end, ps...)
+	return col.New(size).Add(milestone)
+}
+
+// NewRow is responsible to create an instance of a Milestone chart wrapped in a Row.
+func NewRow(height float64, events []Event, start, end time.Time, ps ...props.Milestone) core.Row {
+	milestone := New(events, start, end, ps...)
+	c := col.New().Add(milestone)
+	return row.New(height).Add(c)
+}
+
+// Render renders a Milestone chart into a PDF context.
+func (m *milestone) Render(provider core.Provider, cell *entity.Cell) {
+	y := cell.Y + cell.Height/2.0
+	half := m.prop.DiamondSize / 2.0
+
+	provider.DrawLine(entity.Point{X: cell.X, Y: y}, entity.Point{X: cell.X + cell.Width, Y: y}, &props.Line{
+		Color:     m.prop.LineColor,
+		Style:     linestyle.Solid,
+		Thickness: m.prop.LineThickness,
+	})
+
+	events := m.getEvents()
+	for _, event := range events {
+		x := m.getX(cell, event.Date)
+		color := m.prop.Color
+		if event.Color != nil {
+			color = event.Color
+		}
+
+		provider.DrawPolygon([]entity.Point{
+			{X: x, Y: y - half},
+			{X: x + half, Y: y},
+			{X: x, Y: y + half},
+			{X: x - half, Y: y},
+		}, &props.Line{Color: color, FillColor: color, Style: linestyle.Solid, Thickness: 0.2})
+	}
+
+	labelProp := m.prop.LabelFont.ToTextProp(align.Center, 0, 0)
+	labels := m.getLabels(provider, cell, events)
+	for i := range labels {
+		provider.AddText(labels[i].value, &labels[i].cell, labelProp)
+	}
+}
+
+// GetStructure returns the Structure of a Milestone chart.
+func (m *milestone) GetStructure() *node.Node[core.Structure] {
+	details := m.prop.ToMap()
+	details["start"] = m.start.Format(time.RFC3339)
+	details["end"] = m.end.Format(time.RFC3339)
+
+	str := core.Structure{
+		Type:    "milestone",
+		Value:   m.getEventsValue(),
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (m *milestone) SetConfig(config *entity.Config) {
+	m.config = config
+}
+
+func (m *milestone) getEventsValue() []map[string]interface{} {
+	value := make([]map[string]interface{}, len(m.events))
+	for i, event := range m.events {
+		v := map[string]interface{}{
+			"date":  event.Date.Format(time.RFC3339),
+			"label": event.Label,
+		}
+
+		if event.Color != nil {
+			v["color"] = event.Color.ToString()
+		}
+
+		value[i] = v
+	}
+
+	return value
+}
+
+// getEvents returns the events in the period sorted by date.
+func (m *milestone) getEvents() []Event {
+	var events []Event
+	for _, event := range m.events {
+		if !event.Date.Before(m.start) && !event.Date.After(m.end) {
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Date.Before(events[j].Date)
+	})
+
+	return events
+}
+
+// getLabels places the labels centered on the events, limited to the cell. The slots are the levels above
+// and below the line, alternating from the nearest to the line, and each label takes the first slot where
+// it doesn't overlap the previous label. When there is no free slot, the one which ends first is used.
+func (m *milestone) getLabels(provider core.Provider, cell *entity.Cell, events []Event) []label {
+	height := provider.GetTextHeight(&m.prop.LabelFont)
+	y := cell.Y + cell.Height/2.0
+	offset := m.prop.DiamondSize/2.0 + labelGap
+	levels := max(int((cell.Height/2.0-offset)/height), 1)
+
+	slotsEnd := make([]float64, 2*levels)
+	for i := range slotsEnd {
+		slotsEnd[i] = math.Inf(-1)
+	}
+
+	labels := make([]label, 0, len(events))
+	for _, event := range events {
+		if event.Label == "" {
+			continue
+		}
+
+		width := math.Min(provider.GetStringWidth(event.Label, &m.prop.LabelFont)+labelPadding, cell.Width)
+		x := m.getX(cell, event.Date) - width/2.0
+		x = math.Max(cell.X, math.Min(x, cell.X+cell.Width-width))
+
+		slot := 0
+		for i, end := range slotsEnd {
+			if end+labelGap <= x {
+				slot = i
+				break
+			}
+
+			if end < slotsEnd[slot] {
+				slot = i
+			}
+		}
+		slotsEnd[slot] = x + width
+
+		level := float64(slot / 2)
+		top := y - offset - (level+1)*height
+		if slot%2 == 1 {
+			top = y + offset + level*height
+		}
+
+		labels = append(labels, label{
+			value: event.Label,
+			cell:  entity.Cell{X: x, Y: top, Width: width, Height: height},
+		})
+	}
+
+	return labels
+}
+
+func (m *milestone) getX(cell *entity.Cell, t time.Time) float64 {
+	span := m.end.Sub(m.start)
+	if span <= 0 {
+		return cell.X
+	}
+
+	return cell.X + cell.Width*float64(t.Sub(m.start))/float64(span)
+}
@@ -0,0 +1,161 @@
+package milestone_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/timeline/milestone"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func day(d int) time.Time {
+	return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC)
+}
+
+var events = []milestone.Event{
+	{Date: day(1), Label: "Kickoff"},
+	{Date: day(11), Label: "Beta", Color: &props.RedColor},
+	{Date: day(21), Label: "Release"},
+}
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := milestone.New(events, day(1), day(21))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/milestones/new_milestone_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := milestone.New(events, day(1), day(21), fixture.MilestoneProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/milestones/new_milestone_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := milestone.NewCol(12, events, day(1), day(21))
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/milestones/new_milestone_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := milestone.NewRow(10, events, day(1), day(21))
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/milestones/new_milestone_row.json")
+}
+
+func TestMilestone_Render(t *testing.T) {
+	t.Run("when events are sent, should draw the line and a diamond on each date", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 30}
+		sut := milestone.New(events, day(1), day(21))
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4)
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		})
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().DrawPolygon(mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertCalled(t, "DrawLine", entity.Point{X: 0, Y: 15}, entity.Point{X: 100, Y: 15}, mock.Anything)
+		provider.AssertNumberOfCalls(t, "DrawPolygon", 3)
+		provider.AssertCalled(t, "DrawPolygon", []entity.Point{
+			{X: 50, Y: 13.5}, {X: 51.5, Y: 15}, {X: 50, Y: 16.5}, {X: 48.5, Y: 15},
+		}, mock.MatchedBy(func(l *props.Line) bool {
+			return l.FillColor == &props.RedColor
+		}))
+		provider.AssertNumberOfCalls(t, "AddText", 3)
+	})
+	t.Run("when events are out of the period, should not draw them", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 30}
+		sut := milestone.New(events, day(5), day(15))
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4)
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		})
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().DrawPolygon(mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawPolygon", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when labels would overlap, should place them in other slots", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 30}
+		closeEvents := []milestone.Event{
+			{Date: day(10), Label: "First event"},
+			{Date: day(11), Label: "Second event"},
+			{Date: day(12), Label: "Third event"},
+			{Date: day(21), Label: "Last"},
+		}
+		sut := milestone.New(closeEvents, day(1), day(21))
+
+		var cells []*entity.Cell
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4)
+		provider.EXPECT().GetStringWidth(mock.Anything, mock.Anything).RunAndReturn(func(value string, _ *props.Font) float64 {
+			return 2 * float64(len(value))
+		})
+		provider.EXPECT().DrawLine(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().DrawPolygon(mock.Anything, mock.Anything)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything).
+			Run(func(_ string, c *entity.Cell, _ *props.Text) {
+				cells = append(cells, c)
+			})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Len(t, cells, 4)
+		assert.Equal(t, 8.5, cells[0].Y)
+		assert.Equal(t, 17.5, cells[1].Y)
+		assert.Equal(t, 4.5, cells[2].Y)
+		assert.Equal(t, 8.5, cells[3].Y)
+		assert.Equal(t, 100.0, cells[3].X+cells[3].Width)
+		for i := 0; i < 3; i++ {
+			for j := i + 1; j < 3; j++ {
+				overlapX := cells[i].X < cells[j].X+cells[j].Width && cells[j].X < cells[i].X+cells[i].Width
+				assert.False(t, overlapX && cells[i].Y == cells[j].Y, "labels %d and %d overlap", i, j)
+			}
+		}
+	})
+}
+
+func TestMilestone_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := milestone.New(events, day(1), day(21))
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
@@ -0,0 +1,86 @@
+// Package wave implements creation of wave dividers.
+package wave
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// controlFactor makes the peak of a cubic Bézier reach the amplitude when both control points share the same height.
+const controlFactor = 4.0 / 3.0
+
+type wave struct {
+	config *entity.Config
+	prop   props.Wave
+}
+
+// New is responsible to create an instance of a Wave.
+func New(ps ...props.Wave) core.Component {
+	waveProp := props.Wave{}
+	if len(ps) > 0 {
+		waveProp = ps[0]
+	}
+	waveProp.MakeValid()
+
+	return &wave{
+		prop: waveProp,
+	}
+}
+
+// NewCol is responsible to create an instance of a Wave wrapped in a Col.
+func NewCol(size int, ps ...props.Wave) core.Col {
+	w := New(ps...)
+	return col.New(size).Add(w)
+}
+
+// NewRow is responsible to create an instance of a Wave wrapped in a Row.
+func NewRow(height float64, ps ...props.Wave) core.Row {
+	w := New(ps...)
+	c := col.New().Add(w)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of a Wave.
+func (w *wave) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "wave",
+		Details: w.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (w *wave) SetConfig(config *entity.Config) {
+	w.config = config
+}
+
+// Render renders a Wave into a PDF context, each wave is drawn as two cubic Bézier curves.
+func (w *wave) Render(provider core.Provider, cell *entity.Cell) {
+	lineProp := w.prop.ToLineProp()
+
+	middle := cell.Y + cell.Height/2.0
+	amplitude := (cell.Height / 2.0) * (w.prop.AmplitudePercent / 100.0) * controlFactor
+	halfWidth := cell.Width / float64(w.prop.Count*2)
+
+	for i := 0; i < w.prop.Count*2; i++ {
+		x := cell.X + float64(i)*halfWidth
+		control := middle - amplitude
+		if i%2 != 0 {
+			control = middle + amplitude
+		}
+
+		provider.DrawBezier(
+			entity.Point{X: x, Y: middle},
+			entity.Point{X: x + halfWidth/3.0, Y: control},
+			entity.Point{X: x + halfWidth*2.0/3.0, Y: control},
+			entity.Point{X: x + halfWidth, Y: middle},
+			lineProp,
+		)
+	}
+}
@@ -0,0 +1,101 @@
+package wave_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/wave"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := wave.New()
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/waves/new_wave_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := wave.New(fixture.WaveProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/waves/new_wave_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := wave.NewCol(12)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/waves/new_wave_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := wave.NewCol(12, fixture.WaveProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/waves/new_wave_col_custom_prop.json")
+	})
+}
+
+func TestNewRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := wave.NewRow(10)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/waves/new_wave_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := wave.NewRow(10, fixture.WaveProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/waves/new_wave_row_custom_prop.json")
+	})
+}
+
+func TestWave_Render(t *testing.T) {
+	t.Run("should draw two curves per wave", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.WaveProp()
+		sut := wave.New(prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().DrawBezier(mock.Anything, mock.Anything, mock.Anything, mock.Anything, prop.ToLineProp())
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "DrawBezier", 4)
+		first := provider.Calls[0].Arguments
+		assert.Equal(t, entity.Point{X: 10, Y: 90}, first.Get(0))
+		assert.Equal(t, 10.0, first.Get(1).(entity.Point).Y)
+		assert.Equal(t, entity.Point{X: 35, Y: 90}, first.Get(3))
+		second := provider.Calls[1].Arguments
+		assert.Equal(t, 170.0, second.Get(1).(entity.Point).Y)
+		last := provider.Calls[3].Arguments
+		assert.Equal(t, entity.Point{X: 110, Y: 90}, last.Get(3))
+	})
+}
+
+func TestWave_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := wave.New()
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
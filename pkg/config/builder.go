@@ -2,12 +2,14 @@
 package config
 
 import (
+	"log"
 	"strings"
 	"time"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
+	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/protection"
@@ -16,6 +18,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/pagesize"
 	"github.com/johnfercher/maroto/v2/pkg/consts/provider"
+	"github.com/johnfercher/maroto/v2/pkg/pool"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
@@ -24,7 +27,9 @@ type Builder interface {
 	WithPageSize(size pagesize.Type) Builder
 	WithDimensions(width float64, height float64) Builder
 	WithMargins(left float64, top float64, right float64) Builder
+	WithBottomMargin(bottom float64) Builder
 	WithWorkerPoolSize(poolSize int) Builder
+	WithWorkerPoolStrategy(strategy pool.Strategy) Builder
 	WithDebug(on bool) Builder
 	WithMaxGridSize(maxGridSize int) Builder
 	WithDefaultFont(font *props.Font) Builder
@@ -36,29 +41,60 @@ type Builder interface {
 	WithCreator(creator string, isUTF8 bool) Builder
 	WithSubject(subject string, isUTF8 bool) Builder
 	WithTitle(title string, isUTF8 bool) Builder
+	WithKeywords(keywords string, isUTF8 bool) Builder
 	WithCreationDate(time time.Time) Builder
+	WithModificationDate(time time.Time) Builder
 	WithCustomFonts([]*entity.CustomFont) Builder
+	WithDisableAutoFonts(on bool) Builder
+	WithFallbackToDefaultFont(on bool) Builder
 	WithBackgroundImage([]byte, extension.Type) Builder
+	WithBleed(bleedMM float64) Builder
+	WithRTLLayout(enabled bool) Builder
+	WithJavaScriptEnabled(on bool) Builder
+	WithObjectStreams(enabled bool) Builder
+	WithPageNumberOffset(offset int) Builder
+	WithAutoPageNumbers() Builder
+	WithHeader(row core.Row) Builder
+	WithFooter(row core.Row) Builder
+	WithHeaderFooterOnFirstPage(on bool) Builder
+	WithPageTemplate(fn func(pageNumber, totalPages int) []core.Row) Builder
+	WithWatermarkText(text string, ps ...props.Watermark) Builder
+	WithLogger(logger *log.Logger) Builder
 	Build() *entity.Config
 }
 
 type builder struct {
-	providerType      provider.Type
-	dimensions        *entity.Dimensions
-	margins           *entity.Margins
-	workerPoolSize    int
-	debug             bool
-	maxGridSize       int
-	defaultFont       *props.Font
-	customFonts       []*entity.CustomFont
-	pageNumberPattern string
-	pageNumberPlace   props.Place
-	protection        *entity.Protection
-	compression       bool
-	pageSize          *pagesize.Type
-	orientation       orientation.Type
-	metadata          *entity.Metadata
-	backgroundImage   *entity.Image
+	providerType          provider.Type
+	dimensions            *entity.Dimensions
+	margins               *entity.Margins
+	workerPoolSize        int
+	workerStrategy        pool.Strategy
+	debug                 bool
+	maxGridSize           int
+	defaultFont           *props.Font
+	customFonts           []*entity.CustomFont
+	disableAutoFonts      bool
+	fallbackToDefaultFont bool
+	pageNumberPattern     string
+	pageNumberPlace       props.Place
+	protection            *entity.Protection
+	compression           bool
+	pageSize              *pagesize.Type
+	orientation           orientation.Type
+	metadata              *entity.Metadata
+	backgroundImage       *entity.Image
+	bleed                 *entity.BleedBox
+	rtl                   bool
+	javaScript            bool
+	objectStreams         bool
+	pageNumberOffset      int
+	autoNumberPages       bool
+	header                []entity.PageRow
+	footer                []entity.PageRow
+	skipFirstPage         bool
+	pageTemplate          entity.PageTemplate
+	watermark             *entity.Watermark
+	logger                *log.Logger
 }
 
 // NewBuilder is responsible to create an instance of Builder.
@@ -106,17 +142,17 @@ func (b *builder) WithDimensions(width float64, height float64) Builder {
 	return b
 }
 
-// WithMargins defines custom margins, bottom margin is not customizable due to gofpdf limitations.
+// WithMargins defines custom left, top and right margins, the bottom margin is defined by WithBottomMargin.
 func (b *builder) WithMargins(left float64, top float64, right float64) Builder {
 	if left < pagesize.MinLeftMargin {
 		return b
 	}
 
-	if top < pagesize.MinRightMargin {
+	if top < pagesize.MinTopMargin {
 		return b
 	}
 
-	if right < pagesize.MinTopMargin {
+	if right < pagesize.MinRightMargin {
 		return b
 	}
 
@@ -127,6 +163,17 @@ func (b *builder) WithMargins(left float64, top float64, right float64) Builder
 	return b
 }
 
+// WithBottomMargin defines a custom bottom margin, by default it is pagesize.DefaultBottomMargin.
+func (b *builder) WithBottomMargin(bottom float64) Builder {
+	if bottom < pagesize.MinBottomMargin {
+		return b
+	}
+
+	b.margins.Bottom = bottom
+
+	return b
+}
+
 // WithWorkerPoolSize defines go routine workers, when defined this will execute maroto concurrently.
 func (b *builder) WithWorkerPoolSize(poolSize int) Builder {
 	if poolSize < 0 {
@@ -137,6 +184,17 @@ func (b *builder) WithWorkerPoolSize(poolSize int) Builder {
 	return b
 }
 
+// WithWorkerPoolStrategy defines how the pages are distributed between the workers, pool.FIFO
+// is used when it is not defined. It only applies when WithWorkerPoolSize is defined.
+func (b *builder) WithWorkerPoolStrategy(strategy pool.Strategy) Builder {
+	if strategy == nil {
+		return b
+	}
+
+	b.workerStrategy = strategy
+	return b
+}
+
 // WithDebug defines a debug behaviour where maroto will draw borders in everything.
 func (b *builder) WithDebug(on bool) Builder {
 	b.debug = on
@@ -171,7 +229,7 @@ func (b *builder) WithDefaultFont(font *props.Font) Builder {
 		b.defaultFont.Style = font.Style
 	}
 
-	if font.Color != nil {
+	if !props.IsNilColor(font.Color) {
 		b.defaultFont.Color = font.Color
 	}
 
@@ -188,6 +246,28 @@ func (b *builder) WithCustomFonts(customFonts []*entity.CustomFont) Builder {
 	return b
 }
 
+// WithDisableAutoFonts defines that the custom fonts are not all registered when the document is created,
+// each family is registered on its first use, which speeds up documents with many custom fonts. The fonts
+// of the default font family are still registered when the document is created.
+func (b *builder) WithDisableAutoFonts(on bool) Builder {
+	b.disableAutoFonts = on
+	return b
+}
+
+// WithFallbackToDefaultFont defines that, when a component uses a font family which is not registered, the
+// family of the default font is used, instead of failing the document.
+func (b *builder) WithFallbackToDefaultFont(on bool) Builder {
+	b.fallbackToDefaultFont = on
+	return b
+}
+
+// WithLogger defines the logger which receives the warnings of the components, as a locale which is not
+// recognised. Nothing is logged when a logger is not defined.
+func (b *builder) WithLogger(logger *log.Logger) Builder {
+	b.logger = logger
+	return b
+}
+
 // WithPageNumber defines a string pattern to write the current page and total.
 func (b *builder) WithPageNumber(pattern string, place props.Place) Builder {
 	if !strings.Contains(pattern, "{current}") && !strings.Contains(pattern, "{total}") {
@@ -204,6 +284,98 @@ func (b *builder) WithPageNumber(pattern string, place props.Place) Builder {
 	return b
 }
 
+// WithPageNumberOffset defines the number of the first page, used on multi-volume documents or after a
+// separately numbered front matter. Both {current} and {total} of the page number pattern are shifted,
+// ex: a document with 6 pages and offset 5 is numbered from "5/10" to "10/10". Offsets lower than 1 are ignored.
+func (b *builder) WithPageNumberOffset(offset int) Builder {
+	if offset < 1 {
+		return b
+	}
+
+	b.pageNumberOffset = offset
+	return b
+}
+
+// WithAutoPageNumbers defines that all pages are numbered as "Page {current} of {total}" in the bottom
+// center of the page, with the default font at 8pt. A pattern defined by WithPageNumber takes precedence.
+func (b *builder) WithAutoPageNumbers() Builder {
+	b.autoNumberPages = true
+	return b
+}
+
+// WithHeader adds a row to the header repeated on the top of every page, calling it again adds
+// another row below the previous ones. The height of the header is removed from the useful area of the pages.
+// Maroto.RegisterHeader returns an error when the header is defined by the config.
+func (b *builder) WithHeader(row core.Row) Builder {
+	if row == nil {
+		return b
+	}
+
+	b.header = append(b.header, row)
+	return b
+}
+
+// WithFooter adds a row to the footer repeated on the bottom of every page, calling it again adds
+// another row below the previous ones. The height of the footer is removed from the useful area of the pages.
+// Maroto.RegisterFooter returns an error when the footer is defined by the config.
+func (b *builder) WithFooter(row core.Row) Builder {
+	if row == nil {
+		return b
+	}
+
+	b.footer = append(b.footer, row)
+	return b
+}
+
+// WithHeaderFooterOnFirstPage defines if the header and the footer are added to the first page, by default they are.
+func (b *builder) WithHeaderFooterOnFirstPage(on bool) Builder {
+	b.skipFirstPage = !on
+	return b
+}
+
+// WithPageTemplate defines a function called for each page which returns rows rendered over its content, ex:
+// a watermark, a background or a border on the first or on the even pages. The rows are written from the top of
+// the useful area of the page, after the content, the header, the footer and the page number, and unlike the header
+// and the footer they don't reduce the useful area. The page number and the total of pages are the ones written by
+// the page number pattern, returning nil renders nothing on the page.
+func (b *builder) WithPageTemplate(fn func(pageNumber, totalPages int) []core.Row) Builder {
+	if fn == nil {
+		b.pageTemplate = nil
+		return b
+	}
+
+	b.pageTemplate = func(pageNumber, totalPages int) []entity.PageRow {
+		var rows []entity.PageRow
+		for _, r := range fn(pageNumber, totalPages) {
+			if r != nil {
+				rows = append(rows, r)
+			}
+		}
+
+		return rows
+	}
+
+	return b
+}
+
+// WithWatermarkText defines a text written diagonally over the content of every page, ex: "DRAFT" or
+// "CONFIDENTIAL". The watermark is centered on the page and drawn after everything else, the pages whose
+// PageTemplate returns a text.NewWatermark use it instead. An empty text removes the watermark and, when the
+// prop is not sent, props.NewWatermark is used.
+func (b *builder) WithWatermarkText(text string, ps ...props.Watermark) Builder {
+	if text == "" {
+		b.watermark = nil
+		return b
+	}
+
+	b.watermark = &entity.Watermark{Text: text, Prop: props.NewWatermark()}
+	if len(ps) > 0 {
+		b.watermark.Prop = ps[0]
+	}
+
+	return b
+}
+
 // WithProtection defines protection types to the PDF document.
 func (b *builder) WithProtection(protectionType protection.Type, userPassword, ownerPassword string) Builder {
 	b.protection = &entity.Protection{
@@ -278,6 +450,19 @@ func (b *builder) WithTitle(title string, isUTF8 bool) Builder {
 	return b
 }
 
+func (b *builder) WithKeywords(keywords string, isUTF8 bool) Builder {
+	if keywords == "" {
+		return b
+	}
+
+	b.metadata.Keywords = &entity.Utf8Text{
+		Text: keywords,
+		UTF8: isUTF8,
+	}
+
+	return b
+}
+
 func (b *builder) WithCreationDate(time time.Time) Builder {
 	if time.IsZero() {
 		return b
@@ -288,6 +473,16 @@ func (b *builder) WithCreationDate(time time.Time) Builder {
 	return b
 }
 
+func (b *builder) WithModificationDate(time time.Time) Builder {
+	if time.IsZero() {
+		return b
+	}
+
+	b.metadata.ModificationDate = &time
+
+	return b
+}
+
 func (b *builder) WithBackgroundImage(bytes []byte, ext extension.Type) Builder {
 	b.backgroundImage = &entity.Image{
 		Bytes:     bytes,
@@ -297,22 +492,124 @@ func (b *builder) WithBackgroundImage(bytes []byte, ext extension.Type) Builder
 	return b
 }
 
+// WithBleed defines a bleed around the page, the page dimensions become the TrimBox
+// and the document grows bleedMM on each side, keeping the grid inside the TrimBox.
+func (b *builder) WithBleed(bleedMM float64) Builder {
+	if bleedMM <= 0 {
+		return b
+	}
+
+	b.bleed = &entity.BleedBox{
+		BleedMM: bleedMM,
+	}
+
+	return b
+}
+
+// WithRTLLayout defines a right-to-left layout, used on Arabic and Hebrew documents. The columns of each row
+// are written from right to left, the left and right margins are swapped and texts are aligned to the right
+// by default.
+func (b *builder) WithRTLLayout(enabled bool) Builder {
+	b.rtl = enabled
+	return b
+}
+
+// WithJavaScriptEnabled defines if interactive features based on JavaScript, like cells with hover color,
+// are written to the document. These features only work on viewers with JavaScript support, like Acrobat.
+func (b *builder) WithJavaScriptEnabled(on bool) Builder {
+	b.javaScript = on
+	return b
+}
+
+// WithObjectStreams defines if the indirect objects are grouped in compressed object streams (PDF 1.5),
+// which reduces the size of documents with many small objects. It's ignored on protected documents.
+func (b *builder) WithObjectStreams(enabled bool) Builder {
+	b.objectStreams = enabled
+	return b
+}
+
 func (b *builder) Build() *entity.Config {
 	return &entity.Config{
-		ProviderType:      b.providerType,
-		Dimensions:        b.getDimensions(),
-		Margins:           b.margins,
-		WorkersQuantity:   b.workerPoolSize,
-		Debug:             b.debug,
-		MaxGridSize:       b.maxGridSize,
-		DefaultFont:       b.defaultFont,
-		PageNumberPattern: b.pageNumberPattern,
-		PageNumberPlace:   b.pageNumberPlace,
-		Protection:        b.protection,
-		Compression:       b.compression,
-		Metadata:          b.metadata,
-		CustomFonts:       b.customFonts,
-		BackgroundImage:   b.backgroundImage,
+		ProviderType:          b.providerType,
+		Dimensions:            b.getBleedDimensions(),
+		Margins:               b.getRTLMargins(b.getBleedMargins()),
+		WorkersQuantity:       b.workerPoolSize,
+		WorkersStrategy:       b.workerStrategy,
+		Debug:                 b.debug,
+		MaxGridSize:           b.maxGridSize,
+		DefaultFont:           b.defaultFont,
+		PageNumberPattern:     b.pageNumberPattern,
+		PageNumberPlace:       b.pageNumberPlace,
+		Protection:            b.protection,
+		Compression:           b.compression,
+		Metadata:              b.metadata,
+		CustomFonts:           b.customFonts,
+		DisableAutoFonts:      b.disableAutoFonts,
+		FallbackToDefaultFont: b.fallbackToDefaultFont,
+		BackgroundImage:       b.backgroundImage,
+		Bleed:                 b.bleed,
+		RTL:                   b.rtl,
+		JavaScriptEnabled:     b.javaScript,
+		ObjectStreams:         b.objectStreams,
+		PageNumberOffset:      b.pageNumberOffset,
+		AutoNumberPages:       b.autoNumberPages,
+		Header:                b.header,
+		Footer:                b.footer,
+
+		SkipFirstPageHeaderFooter: b.skipFirstPage,
+		PageTemplate:              b.pageTemplate,
+		Watermark:                 b.getWatermark(),
+		Logger:                    b.logger,
+	}
+}
+
+func (b *builder) getBleedDimensions() *entity.Dimensions {
+	dimensions := b.getDimensions()
+	if b.bleed == nil {
+		return dimensions
+	}
+
+	return &entity.Dimensions{
+		Width:  dimensions.Width + 2*b.bleed.BleedMM,
+		Height: dimensions.Height + 2*b.bleed.BleedMM,
+	}
+}
+
+func (b *builder) getBleedMargins() *entity.Margins {
+	if b.bleed == nil {
+		return b.margins
+	}
+
+	return &entity.Margins{
+		Left:   b.margins.Left + b.bleed.BleedMM,
+		Right:  b.margins.Right + b.bleed.BleedMM,
+		Top:    b.margins.Top + b.bleed.BleedMM,
+		Bottom: b.margins.Bottom + b.bleed.BleedMM,
+	}
+}
+
+// getWatermark returns a copy of the watermark with valid props, as the default font can be defined after it.
+func (b *builder) getWatermark() *entity.Watermark {
+	if b.watermark == nil {
+		return nil
+	}
+
+	watermark := *b.watermark
+	watermark.Prop.MakeValid(b.defaultFont)
+
+	return &watermark
+}
+
+func (b *builder) getRTLMargins(margins *entity.Margins) *entity.Margins {
+	if !b.rtl {
+		return margins
+	}
+
+	return &entity.Margins{
+		Left:   margins.Right,
+		Right:  margins.Left,
+		Top:    margins.Top,
+		Bottom: margins.Bottom,
 	}
 }
 
@@ -2,17 +2,23 @@ package config_test
 
 import (
 	"fmt"
+	"io"
+	"log"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/config"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
 	"github.com/johnfercher/maroto/v2/pkg/consts/pagesize"
 	"github.com/johnfercher/maroto/v2/pkg/consts/provider"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/pool"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
@@ -45,9 +51,9 @@ func TestBuilder_Build(t *testing.T) {
 	assert.Equal(t, fontfamily.Arial, cfg.DefaultFont.Family)
 	assert.Equal(t, 10.0, cfg.DefaultFont.Size)
 	assert.Equal(t, fontstyle.Normal, cfg.DefaultFont.Style)
-	assert.Equal(t, 0, cfg.DefaultFont.Color.Red)
-	assert.Equal(t, 0, cfg.DefaultFont.Color.Green)
-	assert.Equal(t, 0, cfg.DefaultFont.Color.Blue)
+	assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Red)
+	assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Green)
+	assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Blue)
 }
 
 func TestBuilder_WithDebug(t *testing.T) {
@@ -73,9 +79,9 @@ func TestBuilder_WithFont(t *testing.T) {
 		assert.Equal(t, fontfamily.Arial, cfg.DefaultFont.Family)
 		assert.Equal(t, 10.0, cfg.DefaultFont.Size)
 		assert.Equal(t, fontstyle.Normal, cfg.DefaultFont.Style)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Red)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Green)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Blue)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Red)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Green)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Blue)
 	})
 
 	t.Run("when family is filled, should change the default value", func(t *testing.T) {
@@ -91,9 +97,9 @@ func TestBuilder_WithFont(t *testing.T) {
 		assert.Equal(t, "new family", cfg.DefaultFont.Family)
 		assert.Equal(t, 10.0, cfg.DefaultFont.Size)
 		assert.Equal(t, fontstyle.Normal, cfg.DefaultFont.Style)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Red)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Green)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Blue)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Red)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Green)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Blue)
 	})
 
 	t.Run("when style is filled, should change the default value", func(t *testing.T) {
@@ -109,9 +115,9 @@ func TestBuilder_WithFont(t *testing.T) {
 		assert.Equal(t, fontfamily.Arial, cfg.DefaultFont.Family)
 		assert.Equal(t, 10.0, cfg.DefaultFont.Size)
 		assert.Equal(t, fontstyle.Bold, cfg.DefaultFont.Style)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Red)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Green)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Blue)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Red)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Green)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Blue)
 	})
 
 	t.Run("when size is filled, should change the default value", func(t *testing.T) {
@@ -127,9 +133,9 @@ func TestBuilder_WithFont(t *testing.T) {
 		assert.Equal(t, fontfamily.Arial, cfg.DefaultFont.Family)
 		assert.Equal(t, 13.0, cfg.DefaultFont.Size)
 		assert.Equal(t, fontstyle.Normal, cfg.DefaultFont.Style)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Red)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Green)
-		assert.Equal(t, 0, cfg.DefaultFont.Color.Blue)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Red)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Green)
+		assert.Equal(t, 0, cfg.DefaultFont.Color.ToColor().Blue)
 	})
 
 	t.Run("when color is filled, should change the default value", func(t *testing.T) {
@@ -145,9 +151,9 @@ func TestBuilder_WithFont(t *testing.T) {
 		assert.Equal(t, fontfamily.Arial, cfg.DefaultFont.Family)
 		assert.Equal(t, 10.0, cfg.DefaultFont.Size)
 		assert.Equal(t, fontstyle.Normal, cfg.DefaultFont.Style)
-		assert.Equal(t, 10, cfg.DefaultFont.Color.Red)
-		assert.Equal(t, 10, cfg.DefaultFont.Color.Green)
-		assert.Equal(t, 10, cfg.DefaultFont.Color.Blue)
+		assert.Equal(t, 10, cfg.DefaultFont.Color.ToColor().Red)
+		assert.Equal(t, 10, cfg.DefaultFont.Color.ToColor().Green)
+		assert.Equal(t, 10, cfg.DefaultFont.Color.ToColor().Blue)
 	})
 }
 
@@ -200,6 +206,29 @@ func TestBuilder_WithWorkerPoolSize(t *testing.T) {
 	})
 }
 
+func TestBuilder_WithWorkerPoolStrategy(t *testing.T) {
+	t.Run("when strategy is nil, should not change the default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithWorkerPoolStrategy(nil).Build()
+
+		// Assert
+		assert.Nil(t, cfg.WorkersStrategy)
+	})
+	t.Run("when strategy is defined, should change the default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithWorkerPoolStrategy(pool.WorkStealing).Build()
+
+		// Assert
+		assert.Equal(t, pool.WorkStealing, cfg.WorkersStrategy)
+	})
+}
+
 func TestBuilder_WithDimensions(t *testing.T) {
 	t.Run("when dimensions has invalid width, should not change the default value", func(t *testing.T) {
 		// Arrange
@@ -328,6 +357,350 @@ func TestBuilder_WithMargins(t *testing.T) {
 	})
 }
 
+func TestBuilder_WithBottomMargin(t *testing.T) {
+	t.Run("when bottom margin is invalid, should not change the default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithBottomMargin(-1).Build()
+
+		// Assert
+		assert.Equal(t, pagesize.DefaultBottomMargin, cfg.Margins.Bottom)
+	})
+	t.Run("when bottom margin is valid, should change the default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithMargins(15, 15, 15).WithBottomMargin(5).Build()
+
+		// Assert
+		assert.Equal(t, 5.0, cfg.Margins.Bottom)
+		assert.Equal(t, 15.0, cfg.Margins.Left)
+	})
+}
+
+func TestBuilder_WithHeader(t *testing.T) {
+	t.Run("when row is nil, should not add header", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithHeader(nil).Build()
+
+		// Assert
+		assert.Empty(t, cfg.Header)
+	})
+	t.Run("when rows are sent, should add them in order", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+		first := row.New(10)
+		second := row.New(5)
+
+		// Act
+		cfg := sut.WithHeader(first).WithHeader(second).Build()
+
+		// Assert
+		assert.Equal(t, []entity.PageRow{first, second}, cfg.Header)
+	})
+}
+
+func TestBuilder_WithFooter(t *testing.T) {
+	t.Run("when row is nil, should not add footer", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithFooter(nil).Build()
+
+		// Assert
+		assert.Empty(t, cfg.Footer)
+	})
+	t.Run("when row is sent, should add footer", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+		footer := row.New(10)
+
+		// Act
+		cfg := sut.WithFooter(footer).Build()
+
+		// Assert
+		assert.Equal(t, []entity.PageRow{footer}, cfg.Footer)
+	})
+}
+
+func TestBuilder_WithHeaderFooterOnFirstPage(t *testing.T) {
+	t.Run("when not called, should add header and footer on first page", func(t *testing.T) {
+		// Act
+		cfg := config.NewBuilder().Build()
+
+		// Assert
+		assert.False(t, cfg.SkipFirstPageHeaderFooter)
+	})
+	t.Run("when off, should skip header and footer on first page", func(t *testing.T) {
+		// Act
+		cfg := config.NewBuilder().WithHeaderFooterOnFirstPage(false).Build()
+
+		// Assert
+		assert.True(t, cfg.SkipFirstPageHeaderFooter)
+	})
+}
+
+func TestBuilder_WithPageTemplate(t *testing.T) {
+	t.Run("when function is nil, should not define page template", func(t *testing.T) {
+		// Act
+		cfg := config.NewBuilder().WithPageTemplate(nil).Build()
+
+		// Assert
+		assert.Nil(t, cfg.PageTemplate)
+	})
+	t.Run("when function is sent, should return its rows without the nil ones", func(t *testing.T) {
+		// Arrange
+		watermark := row.New(10)
+		var pageNumber, totalPages int
+
+		// Act
+		cfg := config.NewBuilder().WithPageTemplate(func(number, total int) []core.Row {
+			pageNumber, totalPages = number, total
+			return []core.Row{nil, watermark}
+		}).Build()
+
+		// Assert
+		assert.Equal(t, []entity.PageRow{watermark}, cfg.PageTemplate(2, 3))
+		assert.Equal(t, 2, pageNumber)
+		assert.Equal(t, 3, totalPages)
+	})
+}
+
+func TestBuilder_WithWatermarkText(t *testing.T) {
+	t.Run("when text is empty, should not define watermark", func(t *testing.T) {
+		// Act
+		cfg := config.NewBuilder().WithWatermarkText("DRAFT").WithWatermarkText("").Build()
+
+		// Assert
+		assert.Nil(t, cfg.Watermark)
+	})
+	t.Run("when prop is not sent, should use the default prop with the default font", func(t *testing.T) {
+		// Act
+		cfg := config.NewBuilder().WithWatermarkText("DRAFT").WithDefaultFont(&props.Font{Family: fontfamily.Courier}).Build()
+
+		// Assert
+		assert.Equal(t, "DRAFT", cfg.Watermark.Text)
+		assert.Equal(t, fontfamily.Courier, cfg.Watermark.Prop.Family)
+		assert.Equal(t, 45.0, cfg.Watermark.Prop.Angle)
+		assert.Equal(t, 0.3, cfg.Watermark.Prop.Opacity)
+	})
+	t.Run("when prop is sent, should use the prop", func(t *testing.T) {
+		// Act
+		cfg := config.NewBuilder().WithWatermarkText("CONFIDENTIAL", props.Watermark{Size: 30, Angle: -45, Opacity: 0.5}).Build()
+
+		// Assert
+		assert.Equal(t, "CONFIDENTIAL", cfg.Watermark.Text)
+		assert.Equal(t, 30.0, cfg.Watermark.Prop.Size)
+		assert.Equal(t, -45.0, cfg.Watermark.Prop.Angle)
+		assert.Equal(t, 0.5, cfg.Watermark.Prop.Opacity)
+	})
+}
+
+func TestBuilder_WithBleed(t *testing.T) {
+	t.Run("when bleed is invalid, should not change the default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithBleed(-3).Build()
+
+		// Assert
+		assert.Nil(t, cfg.Bleed)
+		assert.Equal(t, 210.0, cfg.Dimensions.Width)
+		assert.Equal(t, 10.0, cfg.Margins.Left)
+	})
+	t.Run("when bleed is valid, should grow dimensions and margins on each side", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithDimensions(100, 200).WithBleed(3).Build()
+
+		// Assert
+		assert.Equal(t, 3.0, cfg.Bleed.BleedMM)
+		assert.Equal(t, 106.0, cfg.Dimensions.Width)
+		assert.Equal(t, 206.0, cfg.Dimensions.Height)
+		assert.Equal(t, 13.0, cfg.Margins.Left)
+		assert.Equal(t, 13.0, cfg.Margins.Top)
+		assert.Equal(t, 13.0, cfg.Margins.Right)
+		assert.Equal(t, pagesize.DefaultBottomMargin+3, cfg.Margins.Bottom)
+	})
+}
+
+func TestBuilder_WithRTLLayout(t *testing.T) {
+	t.Run("when rtl layout is not set, should keep the margins", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithMargins(10, 15, 20).Build()
+
+		// Assert
+		assert.False(t, cfg.RTL)
+		assert.Equal(t, 10.0, cfg.Margins.Left)
+		assert.Equal(t, 20.0, cfg.Margins.Right)
+	})
+	t.Run("when rtl layout is enabled, should swap left and right margins", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithMargins(10, 15, 20).WithRTLLayout(true).Build()
+
+		// Assert
+		assert.True(t, cfg.RTL)
+		assert.Equal(t, 20.0, cfg.Margins.Left)
+		assert.Equal(t, 10.0, cfg.Margins.Right)
+		assert.Equal(t, 15.0, cfg.Margins.Top)
+	})
+}
+
+func TestBuilder_WithJavaScriptEnabled(t *testing.T) {
+	t.Run("when javascript is not set, should keep it disabled", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.False(t, cfg.JavaScriptEnabled)
+	})
+	t.Run("when javascript is enabled, should apply correctly", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithJavaScriptEnabled(true).Build()
+
+		// Assert
+		assert.True(t, cfg.JavaScriptEnabled)
+	})
+}
+
+func TestBuilder_WithDisableAutoFonts(t *testing.T) {
+	t.Run("when disable auto fonts is not set, should keep auto fonts", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.False(t, cfg.DisableAutoFonts)
+	})
+	t.Run("when disable auto fonts is set, should apply correctly", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithDisableAutoFonts(true).Build()
+
+		// Assert
+		assert.True(t, cfg.DisableAutoFonts)
+	})
+}
+
+func TestBuilder_WithFallbackToDefaultFont(t *testing.T) {
+	t.Run("when fallback to default font is not set, should keep it disabled", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.False(t, cfg.FallbackToDefaultFont)
+	})
+	t.Run("when fallback to default font is set, should apply correctly", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithFallbackToDefaultFont(true).Build()
+
+		// Assert
+		assert.True(t, cfg.FallbackToDefaultFont)
+	})
+}
+
+func TestBuilder_WithLogger(t *testing.T) {
+	t.Run("when logger is not set, should keep it nil", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.Nil(t, cfg.Logger)
+	})
+	t.Run("when logger is set, should apply correctly", func(t *testing.T) {
+		// Arrange
+		logger := log.New(io.Discard, "", 0)
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithLogger(logger).Build()
+
+		// Assert
+		assert.Equal(t, logger, cfg.Logger)
+	})
+}
+
+func TestBuilder_WithObjectStreams(t *testing.T) {
+	t.Run("when object streams is not set, should keep it disabled", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.False(t, cfg.ObjectStreams)
+	})
+	t.Run("when object streams is enabled, should apply correctly", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithObjectStreams(true).Build()
+
+		// Assert
+		assert.True(t, cfg.ObjectStreams)
+	})
+}
+
+func TestBuilder_WithPageNumberOffset(t *testing.T) {
+	t.Run("when offset is lower than 1, should not apply", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithPageNumberOffset(0).Build()
+
+		// Assert
+		assert.Equal(t, 0, cfg.PageNumberOffset)
+	})
+	t.Run("when offset is valid, should apply correctly", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithPageNumberOffset(5).Build()
+
+		// Assert
+		assert.Equal(t, 5, cfg.PageNumberOffset)
+	})
+}
+
 func TestBuilder_WithOrientation(t *testing.T) {
 	t.Run("when using default page size and orientation is not set, should use vertical", func(t *testing.T) {
 		// Arrange
@@ -451,6 +824,30 @@ func TestBuilder_WithSubject(t *testing.T) {
 	})
 }
 
+func TestBuilder_WithKeywords(t *testing.T) {
+	t.Run("when keywords is empty, should ignore", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithKeywords("", true).Build()
+
+		// Assert
+		assert.Nil(t, cfg.Metadata.Keywords)
+	})
+	t.Run("when keywords valid, should apply", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithKeywords("invoice, report", true).Build()
+
+		// Assert
+		assert.Equal(t, "invoice, report", cfg.Metadata.Keywords.Text)
+		assert.Equal(t, true, cfg.Metadata.Keywords.UTF8)
+	})
+}
+
 func TestBuilder_WithTitle(t *testing.T) {
 	t.Run("when title is empty, should ignore", func(t *testing.T) {
 		// Arrange
@@ -498,3 +895,39 @@ func TestBuilder_WithCreationDate(t *testing.T) {
 		assert.Equal(t, &timeNow, cfg.Metadata.CreationDate)
 	})
 }
+
+func TestBuilder_WithModificationDate(t *testing.T) {
+	t.Run("when time is zero, should ignore", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithModificationDate(time.Time{}).Build()
+
+		// Assert
+		assert.Nil(t, cfg.Metadata.ModificationDate)
+	})
+	t.Run("when time valid, should apply", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+		timeNow := time.Now()
+
+		// Act
+		cfg := sut.WithModificationDate(timeNow).Build()
+
+		// Assert
+		assert.Equal(t, &timeNow, cfg.Metadata.ModificationDate)
+	})
+}
+
+func TestBuilder_WithAutoPageNumbers(t *testing.T) {
+	// Arrange
+	sut := config.NewBuilder()
+
+	// Act
+	cfg := sut.WithAutoPageNumbers().Build()
+
+	// Assert
+	assert.True(t, cfg.AutoNumberPages)
+	assert.Empty(t, cfg.PageNumberPattern)
+}
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+)
+
+// Change is a field which has different values in two configs.
+type Change struct {
+	// Field is the path of the field, ex: Margins.Left or CustomFonts[0].Family.
+	Field string
+	// From is the value of the field on the first config, nil when it's not defined.
+	From interface{}
+	// To is the value of the field on the second config, nil when it's not defined.
+	To interface{}
+}
+
+// String returns the change as "Field: 'From' → 'To'".
+func (c Change) String() string {
+	return fmt.Sprintf("%s: '%v' → '%v'", c.Field, c.From, c.To)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Diff compares the configs field by field and returns the fields which are different, in the order they
+// are declared, a nil config is considered empty. Pointers are dereferenced and structs are compared by their
+// fields, while slices are compared element by element, except bytes which are compared as a whole. Interfaces,
+// like the WorkersStrategy, times and structs with unexported fields are compared as a whole, and functions, like
+// the PageTemplate, are different when they are not the same function.
+func Diff(a, b *entity.Config) []Change {
+	if a == nil {
+		a = &entity.Config{}
+	}
+
+	if b == nil {
+		b = &entity.Config{}
+	}
+
+	var changes []Change
+	diffValue("", reflect.ValueOf(*a), reflect.ValueOf(*b), &changes)
+
+	return changes
+}
+
+func diffValue(field string, from, to reflect.Value, changes *[]Change) {
+	from = dereference(from)
+	to = dereference(to)
+
+	if !from.IsValid() || !to.IsValid() {
+		if from.IsValid() || to.IsValid() {
+			*changes = append(*changes, Change{Field: field, From: getInterface(from), To: getInterface(to)})
+		}
+
+		return
+	}
+
+	switch {
+	case from.Kind() == reflect.Struct && isComparableByFields(from.Type()):
+		for i := 0; i < from.NumField(); i++ {
+			diffValue(joinField(field, from.Type().Field(i).Name), from.Field(i), to.Field(i), changes)
+		}
+	case from.Kind() == reflect.Slice && from.Type().Elem().Kind() == reflect.Uint8:
+		if !bytes.Equal(from.Bytes(), to.Bytes()) {
+			*changes = append(*changes, Change{Field: field, From: from.Interface(), To: to.Interface()})
+		}
+	case from.Kind() == reflect.Slice:
+		for i := 0; i < max(from.Len(), to.Len()); i++ {
+			diffValue(fmt.Sprintf("%s[%d]", field, i), getIndex(from, i), getIndex(to, i), changes)
+		}
+	case from.Kind() == reflect.Func:
+		if from.Pointer() != to.Pointer() {
+			*changes = append(*changes, Change{Field: field, From: from.Interface(), To: to.Interface()})
+		}
+	case from.Type() == timeType:
+		if !from.Interface().(time.Time).Equal(to.Interface().(time.Time)) {
+			*changes = append(*changes, Change{Field: field, From: from.Interface(), To: to.Interface()})
+		}
+	default:
+		if !reflect.DeepEqual(from.Interface(), to.Interface()) {
+			*changes = append(*changes, Change{Field: field, From: from.Interface(), To: to.Interface()})
+		}
+	}
+}
+
+// dereference returns the value pointed by pointers, an invalid value when the pointer, the interface or
+// the function is nil. Interfaces are not dereferenced, so they are compared as a whole.
+func dereference(value reflect.Value) reflect.Value {
+	for value.IsValid() && value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+
+	if value.IsValid() && (value.Kind() == reflect.Interface || value.Kind() == reflect.Func) && value.IsNil() {
+		return reflect.Value{}
+	}
+
+	return value
+}
+
+func isComparableByFields(structType reflect.Type) bool {
+	if structType == timeType {
+		return false
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		if !structType.Field(i).IsExported() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func getIndex(slice reflect.Value, index int) reflect.Value {
+	if index >= slice.Len() {
+		return reflect.Value{}
+	}
+
+	return slice.Index(index)
+}
+
+func getInterface(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+
+	return value.Interface()
+}
+
+func joinField(parent, field string) string {
+	if parent == "" {
+		return field
+	}
+
+	return parent + "." + field
+}
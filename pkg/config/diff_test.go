@@ -0,0 +1,133 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("when configs are equal, should return no changes", func(t *testing.T) {
+		// Arrange
+		a := config.NewBuilder().WithBottomMargin(15).Build()
+		b := config.NewBuilder().WithBottomMargin(15).Build()
+
+		// Act
+		changes := config.Diff(a, b)
+
+		// Assert
+		assert.Empty(t, changes)
+	})
+	t.Run("when both configs are nil, should return no changes", func(t *testing.T) {
+		// Act
+		changes := config.Diff(nil, nil)
+
+		// Assert
+		assert.Empty(t, changes)
+	})
+	t.Run("when fields are different, should return the changes by field path", func(t *testing.T) {
+		// Arrange
+		a := &entity.Config{
+			MaxGridSize: 12,
+			Margins:     &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 20},
+			DefaultFont: &props.Font{Family: fontfamily.Arial, Size: 10},
+		}
+		b := &entity.Config{
+			MaxGridSize: 16,
+			Margins:     &entity.Margins{Left: 10, Top: 15, Right: 10, Bottom: 20},
+			DefaultFont: &props.Font{Family: fontfamily.Arial, Size: 10, Color: &props.Color{Red: 255}},
+		}
+
+		// Act
+		changes := config.Diff(a, b)
+
+		// Assert
+		assert.Equal(t, []config.Change{
+			{Field: "Margins.Top", From: 10.0, To: 15.0},
+			{Field: "DefaultFont.Color", From: nil, To: &props.Color{Red: 255}},
+			{Field: "MaxGridSize", From: 12, To: 16},
+		}, changes)
+	})
+	t.Run("when pointer is nil on one config, should return the dereferenced value", func(t *testing.T) {
+		// Arrange
+		b := &entity.Config{Bleed: &entity.BleedBox{}}
+
+		// Act
+		changes := config.Diff(nil, b)
+
+		// Assert
+		assert.Equal(t, []config.Change{{Field: "Bleed", From: nil, To: entity.BleedBox{}}}, changes)
+	})
+	t.Run("when slices are different, should compare element by element", func(t *testing.T) {
+		// Arrange
+		a := &entity.Config{CustomFonts: []*entity.CustomFont{
+			{Family: "roboto", Bytes: []byte{1, 2}},
+		}}
+		b := &entity.Config{CustomFonts: []*entity.CustomFont{
+			{Family: "roboto", Bytes: []byte{1, 3}},
+			{Family: "lato"},
+		}}
+
+		// Act
+		changes := config.Diff(a, b)
+
+		// Assert
+		assert.Equal(t, []config.Change{
+			{Field: "CustomFonts[0].Bytes", From: []byte{1, 2}, To: []byte{1, 3}},
+			{Field: "CustomFonts[1]", From: nil, To: entity.CustomFont{Family: "lato"}},
+		}, changes)
+	})
+	t.Run("when functions are the same, should return no changes", func(t *testing.T) {
+		// Arrange
+		template := func(int, int) []entity.PageRow { return nil }
+		a := &entity.Config{PageTemplate: template}
+		b := &entity.Config{PageTemplate: template}
+
+		// Act
+		changes := config.Diff(a, b)
+
+		// Assert
+		assert.Empty(t, changes)
+	})
+	t.Run("when function is nil on one config, should return the change", func(t *testing.T) {
+		// Arrange
+		b := &entity.Config{PageTemplate: func(int, int) []entity.PageRow { return nil }}
+
+		// Act
+		changes := config.Diff(nil, b)
+
+		// Assert
+		assert.Len(t, changes, 1)
+		assert.Equal(t, "PageTemplate", changes[0].Field)
+		assert.Nil(t, changes[0].From)
+	})
+	t.Run("when times are equal in different locations, should return no changes", func(t *testing.T) {
+		// Arrange
+		date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		a := config.NewBuilder().WithCreationDate(date).Build()
+		b := config.NewBuilder().WithCreationDate(date.In(time.FixedZone("BRT", -3*60*60))).Build()
+
+		// Act
+		changes := config.Diff(a, b)
+
+		// Assert
+		assert.Empty(t, changes)
+	})
+}
+
+func TestChange_String(t *testing.T) {
+	// Arrange
+	change := config.Change{Field: "Margins.Top", From: 10.0, To: 15.0}
+
+	// Act
+	value := change.String()
+
+	// Assert
+	assert.Equal(t, "Margins.Top: '10' → '15'", value)
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Merge returns a new config with the fields of base overridden by the non-zero fields of override,
+// a nil config is considered empty. Pointer fields are replaced as a whole and deep copied, except the
+// Logger which is shared, so the result does not share memory with base or override, and the CustomFonts
+// of override are appended to the ones of base, while a Header, Footer or PageTemplate of override replaces
+// the one of base. As false is the zero value, a boolean enabled on base cannot be disabled by override.
+func Merge(base, override *entity.Config) *entity.Config {
+	if base == nil {
+		base = &entity.Config{}
+	}
+
+	if override == nil {
+		override = &entity.Config{}
+	}
+
+	merged := &entity.Config{
+		ProviderType:          pick(override.ProviderType, base.ProviderType),
+		Dimensions:            copyValue(pickPointer(override.Dimensions, base.Dimensions)),
+		Margins:               copyValue(pickPointer(override.Margins, base.Margins)),
+		DefaultFont:           copyFont(pickPointer(override.DefaultFont, base.DefaultFont)),
+		DisableAutoFonts:      override.DisableAutoFonts || base.DisableAutoFonts,
+		FallbackToDefaultFont: override.FallbackToDefaultFont || base.FallbackToDefaultFont,
+		WorkersQuantity:       pick(override.WorkersQuantity, base.WorkersQuantity),
+		WorkersStrategy:       base.WorkersStrategy,
+		Debug:                 override.Debug || base.Debug,
+		MaxGridSize:           pick(override.MaxGridSize, base.MaxGridSize),
+		PageNumberPattern:     pick(override.PageNumberPattern, base.PageNumberPattern),
+		PageNumberPlace:       pick(override.PageNumberPlace, base.PageNumberPlace),
+		Protection:            copyValue(pickPointer(override.Protection, base.Protection)),
+		Compression:           override.Compression || base.Compression,
+		Metadata:              copyMetadata(pickPointer(override.Metadata, base.Metadata)),
+		BackgroundImage:       copyImage(pickPointer(override.BackgroundImage, base.BackgroundImage)),
+		Bleed:                 copyValue(pickPointer(override.Bleed, base.Bleed)),
+		RTL:                   override.RTL || base.RTL,
+		JavaScriptEnabled:     override.JavaScriptEnabled || base.JavaScriptEnabled,
+		ObjectStreams:         override.ObjectStreams || base.ObjectStreams,
+		PageNumberOffset:      pick(override.PageNumberOffset, base.PageNumberOffset),
+		AutoNumberPages:       override.AutoNumberPages || base.AutoNumberPages,
+		Header:                pickRows(override.Header, base.Header),
+		Footer:                pickRows(override.Footer, base.Footer),
+		Watermark:             copyWatermark(pickPointer(override.Watermark, base.Watermark)),
+		Logger:                pickPointer(override.Logger, base.Logger),
+
+		SkipFirstPageHeaderFooter: override.SkipFirstPageHeaderFooter || base.SkipFirstPageHeaderFooter,
+	}
+
+	if override.WorkersStrategy != nil {
+		merged.WorkersStrategy = override.WorkersStrategy
+	}
+
+	merged.PageTemplate = base.PageTemplate
+	if override.PageTemplate != nil {
+		merged.PageTemplate = override.PageTemplate
+	}
+
+	for _, font := range append(append([]*entity.CustomFont{}, base.CustomFonts...), override.CustomFonts...) {
+		merged.CustomFonts = append(merged.CustomFonts, copyCustomFont(font))
+	}
+
+	return merged
+}
+
+func pick[T comparable](override, base T) T {
+	var zero T
+	if override != zero {
+		return override
+	}
+
+	return base
+}
+
+func pickPointer[T any](override, base *T) *T {
+	if override != nil {
+		return override
+	}
+
+	return base
+}
+
+// pickRows returns a copy of the rows of override when they are defined, otherwise a copy of the rows of base.
+func pickRows(override, base []entity.PageRow) []entity.PageRow {
+	if len(override) > 0 {
+		return append([]entity.PageRow{}, override...)
+	}
+
+	return append([]entity.PageRow(nil), base...)
+}
+
+func copyValue[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+
+	copied := *value
+	return &copied
+}
+
+func copyBytes(bytes []byte) []byte {
+	if bytes == nil {
+		return nil
+	}
+
+	return append([]byte{}, bytes...)
+}
+
+func copyFont(font *props.Font) *props.Font {
+	copied := copyValue(font)
+	if copied != nil {
+		copied.Color = copyColor(font.Color)
+	}
+
+	return copied
+}
+
+func copyColor(color props.AnyColor) props.AnyColor {
+	switch c := color.(type) {
+	case *props.Color:
+		if c != nil {
+			return copyValue(c)
+		}
+	case *props.CMYKColor:
+		if c != nil {
+			return copyValue(c)
+		}
+	default:
+		return color
+	}
+
+	return nil
+}
+
+func copyCustomFont(font *entity.CustomFont) *entity.CustomFont {
+	copied := copyValue(font)
+	if copied != nil {
+		copied.Bytes = copyBytes(font.Bytes)
+	}
+
+	return copied
+}
+
+func copyMetadata(metadata *entity.Metadata) *entity.Metadata {
+	if metadata == nil {
+		return nil
+	}
+
+	return &entity.Metadata{
+		Author:           copyValue(metadata.Author),
+		Creator:          copyValue(metadata.Creator),
+		Subject:          copyValue(metadata.Subject),
+		Title:            copyValue(metadata.Title),
+		Keywords:         copyValue(metadata.Keywords),
+		CreationDate:     copyValue(metadata.CreationDate),
+		ModificationDate: copyValue(metadata.ModificationDate),
+	}
+}
+
+func copyWatermark(watermark *entity.Watermark) *entity.Watermark {
+	copied := copyValue(watermark)
+	if copied != nil {
+		copied.Prop.Color = copyValue(watermark.Prop.Color)
+	}
+
+	return copied
+}
+
+func copyImage(image *entity.Image) *entity.Image {
+	if image == nil {
+		return nil
+	}
+
+	return &entity.Image{
+		Bytes:      copyBytes(image.Bytes),
+		Extension:  image.Extension,
+		Dimensions: copyValue(image.Dimensions),
+	}
+}
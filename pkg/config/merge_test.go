@@ -0,0 +1,162 @@
+package config_test
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/provider"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/pool"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestMerge(t *testing.T) {
+	t.Run("when both configs are nil, should return an empty config", func(t *testing.T) {
+		// Act
+		cfg := config.Merge(nil, nil)
+
+		// Assert
+		assert.Equal(t, &entity.Config{}, cfg)
+	})
+	t.Run("when override is nil, should return a copy of base", func(t *testing.T) {
+		// Arrange
+		base := config.NewBuilder().WithPageNumber("{current}", props.Bottom).Build()
+
+		// Act
+		cfg := config.Merge(base, nil)
+
+		// Assert
+		assert.Equal(t, base, cfg)
+		assert.NotSame(t, base.Margins, cfg.Margins)
+		assert.NotSame(t, base.DefaultFont, cfg.DefaultFont)
+	})
+	t.Run("when override has non-zero fields, should use them", func(t *testing.T) {
+		// Arrange
+		base := &entity.Config{
+			ProviderType:      provider.Gofpdf,
+			Margins:           &entity.Margins{Left: 10, Top: 10, Right: 10},
+			DefaultFont:       &props.Font{Family: fontfamily.Arial, Size: 10},
+			WorkersQuantity:   2,
+			MaxGridSize:       12,
+			PageNumberPattern: "{current}",
+		}
+		override := &entity.Config{
+			Margins:         &entity.Margins{Left: 20},
+			MaxGridSize:     24,
+			WorkersStrategy: pool.WorkStealing,
+			Compression:     true,
+		}
+
+		// Act
+		cfg := config.Merge(base, override)
+
+		// Assert
+		assert.Equal(t, provider.Gofpdf, cfg.ProviderType)
+		assert.Equal(t, &entity.Margins{Left: 20}, cfg.Margins)
+		assert.Equal(t, base.DefaultFont, cfg.DefaultFont)
+		assert.Equal(t, 2, cfg.WorkersQuantity)
+		assert.Equal(t, 24, cfg.MaxGridSize)
+		assert.Equal(t, "{current}", cfg.PageNumberPattern)
+		assert.Equal(t, pool.WorkStealing, cfg.WorkersStrategy)
+		assert.True(t, cfg.Compression)
+	})
+	t.Run("when configs have pointer fields, should deep copy them", func(t *testing.T) {
+		// Arrange
+		base := &entity.Config{
+			DefaultFont: &props.Font{Color: &props.Color{Red: 255}},
+			Metadata:    &entity.Metadata{Title: &entity.Utf8Text{Text: "title"}},
+		}
+		override := &entity.Config{
+			BackgroundImage: &entity.Image{Bytes: []byte{1, 2}, Dimensions: &entity.Dimensions{Width: 10}},
+		}
+
+		// Act
+		cfg := config.Merge(base, override)
+		cfg.DefaultFont.Color.ToColor().Red = 0
+		cfg.Metadata.Title.Text = "changed"
+		cfg.BackgroundImage.Bytes[0] = 9
+		cfg.BackgroundImage.Dimensions.Width = 20
+
+		// Assert
+		assert.Equal(t, 255, base.DefaultFont.Color.ToColor().Red)
+		assert.Equal(t, "title", base.Metadata.Title.Text)
+		assert.Equal(t, byte(1), override.BackgroundImage.Bytes[0])
+		assert.Equal(t, 10.0, override.BackgroundImage.Dimensions.Width)
+	})
+	t.Run("when both configs have custom fonts, should concatenate them", func(t *testing.T) {
+		// Arrange
+		base := &entity.Config{CustomFonts: []*entity.CustomFont{{Family: "base", Bytes: []byte{1}}}}
+		override := &entity.Config{CustomFonts: []*entity.CustomFont{{Family: "override"}}}
+
+		// Act
+		cfg := config.Merge(base, override)
+		cfg.CustomFonts[0].Bytes[0] = 2
+
+		// Assert
+		assert.Len(t, cfg.CustomFonts, 2)
+		assert.Equal(t, "base", cfg.CustomFonts[0].Family)
+		assert.Equal(t, "override", cfg.CustomFonts[1].Family)
+		assert.NotSame(t, base.CustomFonts[0], cfg.CustomFonts[0])
+		assert.Equal(t, byte(1), base.CustomFonts[0].Bytes[0])
+	})
+	t.Run("when override has header, should replace the header of base", func(t *testing.T) {
+		// Arrange
+		baseHeader := row.New(10)
+		overrideHeader := row.New(20)
+		footer := row.New(5)
+		base := &entity.Config{Header: []entity.PageRow{baseHeader}, Footer: []entity.PageRow{footer}}
+		override := &entity.Config{Header: []entity.PageRow{overrideHeader}}
+
+		// Act
+		cfg := config.Merge(base, override)
+
+		// Assert
+		assert.Equal(t, []entity.PageRow{overrideHeader}, cfg.Header)
+		assert.Equal(t, []entity.PageRow{footer}, cfg.Footer)
+	})
+	t.Run("when override has page template, should replace the page template of base", func(t *testing.T) {
+		// Arrange
+		base := &entity.Config{PageTemplate: func(int, int) []entity.PageRow { return []entity.PageRow{row.New(10)} }}
+		override := &entity.Config{PageTemplate: func(int, int) []entity.PageRow { return nil }}
+
+		// Act
+		cfg := config.Merge(base, override)
+		kept := config.Merge(base, nil)
+
+		// Assert
+		assert.Nil(t, cfg.PageTemplate(1, 1))
+		assert.Len(t, kept.PageTemplate(1, 1), 1)
+	})
+	t.Run("when override has watermark, should copy the watermark of override", func(t *testing.T) {
+		// Arrange
+		base := &entity.Config{Watermark: &entity.Watermark{Text: "DRAFT"}}
+		override := &entity.Config{Watermark: &entity.Watermark{Text: "COPY", Prop: props.Watermark{Color: &props.Color{Red: 10}}}}
+
+		// Act
+		cfg := config.Merge(base, override)
+
+		// Assert
+		assert.Equal(t, override.Watermark, cfg.Watermark)
+		assert.NotSame(t, override.Watermark, cfg.Watermark)
+		assert.NotSame(t, override.Watermark.Prop.Color, cfg.Watermark.Prop.Color)
+	})
+	t.Run("when override has logger, should share the logger of override", func(t *testing.T) {
+		// Arrange
+		base := &entity.Config{Logger: log.New(io.Discard, "base", 0)}
+		override := &entity.Config{Logger: log.New(io.Discard, "override", 0)}
+
+		// Act
+		cfg := config.Merge(base, override)
+		kept := config.Merge(base, nil)
+
+		// Assert
+		assert.Same(t, override.Logger, cfg.Logger)
+		assert.Same(t, base.Logger, kept.Logger)
+	})
+}
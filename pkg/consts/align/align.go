@@ -17,4 +17,6 @@ const (
 	Bottom Type = "B"
 	// Middle represents a middle align (from gofpdf).
 	Middle Type = "M"
+	// Justify represents a horizontal align where the text fills the whole width.
+	Justify Type = "J"
 )
@@ -0,0 +1,24 @@
+// Package barcode contains all barcode types.
+package barcode
+
+// Type is a representation of a barcode symbology.
+type Type string
+
+const (
+	// Code128 represents a Code 128 barcode, it is used when no type is defined.
+	Code128 Type = "code128"
+	// Codabar represents a Codabar barcode.
+	Codabar Type = "codabar"
+	// Telepen represents a Telepen barcode, which encodes the full ASCII set.
+	Telepen Type = "telepen"
+	// TelepenNumeric represents a Telepen barcode where each pair of digits is encoded in one character.
+	TelepenNumeric Type = "telepen_numeric"
+	// EAN13 represents an EAN-13 barcode used on retail products, the code has 12 digits and the check digit,
+	// which is calculated when it is not sent.
+	EAN13 Type = "ean13"
+	// UPCA represents an UPC-A barcode used on retail products in North America, the code has 11 digits and the
+	// check digit, which is calculated when it is not sent.
+	UPCA Type = "upca"
+	// Code39 represents a Code 39 barcode, which encodes uppercase letters, digits and - . $ / + % and space.
+	Code39 Type = "code39"
+)
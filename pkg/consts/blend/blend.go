@@ -0,0 +1,52 @@
+// Package blend contains all blend modes.
+package blend
+
+// Mode is a representation of a PDF blend mode, which defines how the colors
+// of an element are combined with the colors already on the page.
+type Mode string
+
+const (
+	// Normal paints the colors of the element over the page.
+	Normal Mode = "Normal"
+	// Multiply multiplies the colors, the result is always darker.
+	Multiply Mode = "Multiply"
+	// Screen multiplies the complements of the colors, the result is always lighter.
+	Screen Mode = "Screen"
+	// Overlay multiplies or screens the colors, depending on the color of the page.
+	Overlay Mode = "Overlay"
+	// Darken keeps the darker of the colors.
+	Darken Mode = "Darken"
+	// Lighten keeps the lighter of the colors.
+	Lighten Mode = "Lighten"
+	// ColorDodge brightens the color of the page to reflect the color of the element.
+	ColorDodge Mode = "ColorDodge"
+	// ColorBurn darkens the color of the page to reflect the color of the element.
+	ColorBurn Mode = "ColorBurn"
+	// HardLight multiplies or screens the colors, depending on the color of the element.
+	HardLight Mode = "HardLight"
+	// SoftLight darkens or lightens the colors, depending on the color of the element.
+	SoftLight Mode = "SoftLight"
+	// Difference subtracts the darker of the colors from the lighter one.
+	Difference Mode = "Difference"
+	// Exclusion is like Difference, but with lower contrast.
+	Exclusion Mode = "Exclusion"
+	// Hue uses the hue of the element with the saturation and luminosity of the page.
+	Hue Mode = "Hue"
+	// Saturation uses the saturation of the element with the hue and luminosity of the page.
+	Saturation Mode = "Saturation"
+	// Color uses the hue and saturation of the element with the luminosity of the page.
+	Color Mode = "Color"
+	// Luminosity uses the luminosity of the element with the hue and saturation of the page.
+	Luminosity Mode = "Luminosity"
+)
+
+// IsValid returns true when the mode is one of the blend modes defined by the PDF specification.
+func (m Mode) IsValid() bool {
+	switch m {
+	case Normal, Multiply, Screen, Overlay, Darken, Lighten, ColorDodge, ColorBurn,
+		HardLight, SoftLight, Difference, Exclusion, Hue, Saturation, Color, Luminosity:
+		return true
+	default:
+		return false
+	}
+}
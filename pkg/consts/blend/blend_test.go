@@ -0,0 +1,39 @@
+package blend_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/blend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMode_IsValid(t *testing.T) {
+	t.Run("when mode is invalid, should be invalid", func(t *testing.T) {
+		// Arrange
+		mode := blend.Mode("invalid")
+
+		// Act & Assert
+		assert.False(t, mode.IsValid())
+	})
+	t.Run("when mode is empty, should be invalid", func(t *testing.T) {
+		// Arrange
+		mode := blend.Mode("")
+
+		// Act & Assert
+		assert.False(t, mode.IsValid())
+	})
+	t.Run("when mode is multiply, should be valid", func(t *testing.T) {
+		// Arrange
+		mode := blend.Multiply
+
+		// Act & Assert
+		assert.True(t, mode.IsValid())
+	})
+	t.Run("when mode is screen, should be valid", func(t *testing.T) {
+		// Arrange
+		mode := blend.Screen
+
+		// Act & Assert
+		assert.True(t, mode.IsValid())
+	})
+}
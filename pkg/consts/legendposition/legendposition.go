@@ -0,0 +1,14 @@
+// Package legendposition contains all legend positions of the charts.
+package legendposition
+
+// Type is a representation of where the legend of a chart is written.
+type Type string
+
+const (
+	// None hides the legend.
+	None Type = ""
+	// Top writes the legend above the chart, in a single line.
+	Top Type = "top"
+	// Inside writes the legend in the upper left corner of the chart area, over the series.
+	Inside Type = "inside"
+)
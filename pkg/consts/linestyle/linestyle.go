@@ -14,4 +14,15 @@ const (
 	Solid Type = "solid"
 	// Dashed represents a dashed style.
 	Dashed Type = "dashed"
+	// Dotted represents a dotted style, the dots and the spaces between them depend on the line thickness.
+	Dotted Type = "dotted"
 )
+
+// GetDotPattern returns the on/off dash pattern which draws dots with a line of the thickness.
+func GetDotPattern(thickness float64) []float64 {
+	if thickness <= 0 {
+		thickness = DefaultLineThickness
+	}
+
+	return []float64{thickness, thickness * 2}
+}
@@ -1,6 +1,8 @@
 // Package pagesize contains all default page sizes.
 package pagesize
 
+import "sync"
+
 // Type is a representation of a page size.
 type Type string
 
@@ -30,7 +32,7 @@ const (
 	// DefaultRightMargin represents the default right margin in page size.
 	DefaultRightMargin = 10.0
 	// DefaultBottomMargin represents the default bottom margin in page size.
-	DefaultBottomMargin = MinBottomMargin
+	DefaultBottomMargin = 20.0025
 	// MinTopMargin represents the minimum top margin in page size.
 	MinTopMargin = 0.0
 	// MinLeftMargin represents the minimum left margin in page size.
@@ -38,14 +40,34 @@ const (
 	// MinRightMargin represents the minimum right margin in page size.
 	MinRightMargin = 0.0
 	// MinBottomMargin represents the minimum bottom margin in page size.
-	MinBottomMargin = 20.0025
+	MinBottomMargin = 0.0
 	// DefaultFontSize represents the default font size in page size.
 	DefaultFontSize = 10.0
 	// DefaultMaxGridSum represents the default max grid sum in page size.
 	DefaultMaxGridSum = 12.0
 )
 
-// GetDimensions returns the width and height of the page size.
+var (
+	registered   = make(map[Type][2]float64)
+	registeredMu sync.RWMutex
+)
+
+// Register defines a custom page size with the width and height in mm, so it can be sent to WithPageSize
+// like the default ones, ex: pagesize.Register("receipt", 80, 200). Registering a name again replaces its
+// dimensions. The default page sizes cannot be replaced and dimensions lower or equal to zero are ignored.
+func Register(name Type, widthMM, heightMM float64) {
+	if widthMM <= 0 || heightMM <= 0 {
+		return
+	}
+
+	registeredMu.Lock()
+	defer registeredMu.Unlock()
+
+	registered[name] = [2]float64{widthMM, heightMM}
+}
+
+// GetDimensions returns the width and height of the page size, the registered page sizes are
+// looked up when it's not a default one and A4 is returned when it's unknown.
 func GetDimensions(pageSize Type) (float64, float64) {
 	switch pageSize {
 	case A1:
@@ -64,7 +86,20 @@ func GetDimensions(pageSize Type) (float64, float64) {
 		return 215.9, 355.6
 	case Tabloid:
 		return 279.4, 431.8
-	default: // A4
+	case A4:
 		return 210.0, 297.0
+	default:
+		return getRegistered(pageSize)
+	}
+}
+
+func getRegistered(pageSize Type) (float64, float64) {
+	registeredMu.RLock()
+	defer registeredMu.RUnlock()
+
+	if dimensions, ok := registered[pageSize]; ok {
+		return dimensions[0], dimensions[1]
 	}
+
+	return 210.0, 297.0
 }
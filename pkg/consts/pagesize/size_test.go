@@ -119,4 +119,63 @@ func TestGetDimensions(t *testing.T) {
 		assert.Equal(t, 279.4, w)
 		assert.Equal(t, 431.8, h)
 	})
+	t.Run("when pageSize is unknown, should return a4", func(t *testing.T) {
+		// Arrange
+		pageSize := pagesize.Type("unknown")
+
+		// Act
+		w, h := pagesize.GetDimensions(pageSize)
+
+		// Arrange
+		assert.Equal(t, 210.0, w)
+		assert.Equal(t, 297.0, h)
+	})
+}
+
+func TestRegister(t *testing.T) {
+	t.Run("when page size is registered, should return its dimensions", func(t *testing.T) {
+		// Arrange
+		pagesize.Register("receipt", 80, 200)
+
+		// Act
+		w, h := pagesize.GetDimensions("receipt")
+
+		// Assert
+		assert.Equal(t, 80.0, w)
+		assert.Equal(t, 200.0, h)
+	})
+	t.Run("when page size is registered again, should replace its dimensions", func(t *testing.T) {
+		// Arrange
+		pagesize.Register("ticket", 50, 100)
+		pagesize.Register("ticket", 60, 120)
+
+		// Act
+		w, h := pagesize.GetDimensions("ticket")
+
+		// Assert
+		assert.Equal(t, 60.0, w)
+		assert.Equal(t, 120.0, h)
+	})
+	t.Run("when a default page size is registered, should keep the default dimensions", func(t *testing.T) {
+		// Arrange
+		pagesize.Register(pagesize.Letter, 100, 100)
+
+		// Act
+		w, h := pagesize.GetDimensions(pagesize.Letter)
+
+		// Assert
+		assert.Equal(t, 215.9, w)
+		assert.Equal(t, 279.4, h)
+	})
+	t.Run("when dimensions are invalid, should not register", func(t *testing.T) {
+		// Arrange
+		pagesize.Register("invalid", 0, 100)
+
+		// Act
+		w, h := pagesize.GetDimensions("invalid")
+
+		// Assert
+		assert.Equal(t, 210.0, w)
+		assert.Equal(t, 297.0, h)
+	})
 }
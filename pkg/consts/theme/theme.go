@@ -0,0 +1,12 @@
+// Package theme contains all syntax highlighting themes.
+package theme
+
+// Name is a representation of a syntax highlighting theme of a code block.
+type Name string
+
+const (
+	// Light writes the code with dark colors over a light background, it is used when no theme is defined.
+	Light Name = "light"
+	// Dark writes the code with light colors over a dark background.
+	Dark Name = "dark"
+)
@@ -0,0 +1,14 @@
+// Package whitespace contains all white space modes.
+package whitespace
+
+// Mode is a representation of how line breaks and wrapping are handled on a text.
+type Mode string
+
+const (
+	// Normal wraps the text at the width of the column.
+	Normal Mode = "normal"
+	// NoWrap writes the text in a single line, truncating what does not fit in the column.
+	NoWrap Mode = "nowrap"
+	// Pre keeps the line breaks of the text and still wraps the lines at the width of the column.
+	Pre Mode = "pre"
+)
@@ -16,17 +16,25 @@ type Math interface {
 // Code is the abstraction which deals of how to add QrCodes or Barcode in a PDF.
 type Code interface {
 	GenQr(code string) (*entity.Image, error)
+	GenMicroQr(code string) (*entity.Image, error)
 	GenDataMatrix(code string) (*entity.Image, error)
+	GenAztec(code string) (*entity.Image, error)
+	GenPDF417(code string, prop *props.PDF417) (*entity.Image, error)
 	GenBar(code string, cell *entity.Cell, prop *props.Barcode) (*entity.Image, error)
 }
 
 // Image is the abstraction which deals of how to add images in a PDF.
 type Image interface {
 	Add(img *entity.Image, cell *entity.Cell, margins *entity.Margins, prop *props.Rect, extension extension.Type, flow bool) error
+	AddTiled(img *entity.Image, cell *entity.Cell, margins *entity.Margins, prop *props.Rect, extension extension.Type) error
 }
 
 type Line interface {
 	Add(cell *entity.Cell, prop *props.Line)
+	AddArc(center entity.Point, radius, innerRadius, startAngle, endAngle float64, prop *props.Line)
+	AddBezier(p0, p1, p2, p3 entity.Point, prop *props.Line)
+	AddSegment(p0, p1 entity.Point, prop *props.Line)
+	AddPolygon(points []entity.Point, prop *props.Line)
 }
 
 // Text is the abstraction which deals of how to add text inside PDF.
@@ -46,6 +54,6 @@ type Font interface {
 	GetSize() float64
 	GetFont() (string, fontstyle.Type, float64)
 	GetHeight(family string, style fontstyle.Type, size float64) float64
-	SetColor(color *props.Color)
-	GetColor() *props.Color
+	SetColor(color props.AnyColor)
+	GetColor() props.AnyColor
 }
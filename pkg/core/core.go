@@ -2,9 +2,12 @@
 package core
 
 import (
+	"io"
+
 	"github.com/johnfercher/go-tree/node"
 
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/exporter"
 	"github.com/johnfercher/maroto/v2/pkg/metrics"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
@@ -16,8 +19,11 @@ type Maroto interface {
 	AddRows(rows ...Row)
 	AddRow(rowHeight float64, cols ...Col) Row
 	AddPages(pages ...Page)
+	AddBookmark(title string, level int)
+	InsertAt(position int, rows ...Row) error
 	GetStructure() *node.Node[Structure]
 	Generate() (Document, error)
+	GenerateTo(w io.Writer) error
 }
 
 // Document is the interface that wraps the basic methods of a document.
@@ -26,7 +32,11 @@ type Document interface {
 	GetBase64() string
 	Save(file string) error
 	GetReport() *metrics.Report
+	GetBookmarks() []entity.Bookmark
+	Errors() []PageError
 	Merge([]byte) error
+	FlattenForms() (Document, error)
+	ExportToZIP(pages []int, format exporter.Format, opts ...exporter.Option) ([]byte, error)
 }
 
 // Node is the interface that wraps the basic methods of a node.
@@ -47,6 +57,10 @@ type Col interface {
 	Add(components ...Component) Col
 	GetSize() int
 	WithStyle(style *props.Cell) Col
+	WithID(id string) Col
+	WithTooltip(tooltip string) Col
+	Span(n int) Col
+	GetSpan() int
 	Render(provider Provider, cell entity.Cell, createCell bool)
 }
 
@@ -56,9 +70,19 @@ type Row interface {
 	Add(cols ...Col) Row
 	GetHeight() float64
 	WithStyle(style *props.Cell) Row
+	WithBookmark(title string, level int) Row
 	Render(provider Provider, cell entity.Cell)
 }
 
+// FlowRow is the interface of the rows whose content continues on the next pages when it doesn't fit in the
+// space left on the page, ex: text.NewPaginatedRow. Maroto replaces them by the rows returned by Split.
+type FlowRow interface {
+	Row
+	// Split returns a row with the content which fits in the height, at least the first line of it, and a
+	// FlowRow with the rest of the content, nil when nothing is left. The width is the width of the page.
+	Split(height, width float64, config *entity.Config) (Row, FlowRow)
+}
+
 // Page is the interface that wraps the basic methods of a page.
 type Page interface {
 	Node
@@ -0,0 +1,15 @@
+package entity
+
+// BleedBox is the representation of the print bleed around the trimmed page.
+type BleedBox struct {
+	BleedMM float64
+}
+
+// AppendMap appends the bleed box to a map.
+func (b *BleedBox) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if b.BleedMM != 0 {
+		m["config_bleed_mm"] = b.BleedMM
+	}
+
+	return m
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBleedBox_AppendMap(t *testing.T) {
+	// Arrange
+	sut := BleedBox{BleedMM: 3}
+	m := make(map[string]interface{})
+
+	// Act
+	m = sut.AppendMap(m)
+
+	// Assert
+	assert.Equal(t, 3.0, m["config_bleed_mm"])
+}
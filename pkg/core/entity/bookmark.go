@@ -0,0 +1,11 @@
+package entity
+
+// Bookmark is the representation of an outline entry of the document.
+type Bookmark struct {
+	// Title is the text shown in the outline.
+	Title string
+	// Page is the 1-indexed page where the bookmark points to.
+	Page int
+	// Level is the depth of the bookmark in the outline, 0 is the top level.
+	Level int
+}
@@ -1,26 +1,59 @@
 package entity
 
 import (
+	"log"
+
 	"github.com/johnfercher/maroto/v2/pkg/consts/provider"
+	"github.com/johnfercher/maroto/v2/pkg/pool"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
 // Config is the configuration of a maroto instance.
 type Config struct {
-	ProviderType      provider.Type
-	Dimensions        *Dimensions
-	Margins           *Margins
-	DefaultFont       *props.Font
-	CustomFonts       []*CustomFont
-	WorkersQuantity   int
-	Debug             bool
-	MaxGridSize       int
-	PageNumberPattern string
-	PageNumberPlace   props.Place
-	Protection        *Protection
-	Compression       bool
-	Metadata          *Metadata
-	BackgroundImage   *Image
+	ProviderType          provider.Type
+	Dimensions            *Dimensions
+	Margins               *Margins
+	DefaultFont           *props.Font
+	CustomFonts           []*CustomFont
+	DisableAutoFonts      bool
+	FallbackToDefaultFont bool
+	WorkersQuantity       int
+	WorkersStrategy       pool.Strategy
+	Debug                 bool
+	MaxGridSize           int
+	PageNumberPattern     string
+	PageNumberPlace       props.Place
+	Protection            *Protection
+	Compression           bool
+	Metadata              *Metadata
+	BackgroundImage       *Image
+	Bleed                 *BleedBox
+	RTL                   bool
+	JavaScriptEnabled     bool
+	ObjectStreams         bool
+	PageNumberOffset      int
+	AutoNumberPages       bool
+	Header                []PageRow
+	Footer                []PageRow
+	// SkipFirstPageHeaderFooter define that the Header and Footer are not added to the first page.
+	SkipFirstPageHeaderFooter bool
+	// PageTemplate returns the rows rendered over the content of each page.
+	PageTemplate PageTemplate
+	// Watermark is the text written over the content of each page, unless the PageTemplate adds one.
+	Watermark *Watermark
+	// Logger receives the warnings of the components, nothing is logged when it's nil.
+	Logger *log.Logger
+}
+
+// PageTemplate returns the rows rendered over the content of a page, the rows are core.Row like the
+// ones of the Header and Footer. The page number and the total of pages are the ones written by the
+// page number pattern.
+type PageTemplate func(pageNumber, totalPages int) []PageRow
+
+// PageRow is a row of the Header, Footer or PageTemplate of Config, the rows are core.Row, declared
+// by the height only because the core package depends on entity.
+type PageRow interface {
+	GetHeight() float64
 }
 
 // ToMap converts Config to a map[string]interface{} .
@@ -43,10 +76,22 @@ func (c *Config) ToMap() map[string]interface{} {
 		m = c.DefaultFont.AppendMap(m)
 	}
 
+	if c.DisableAutoFonts {
+		m["config_disable_auto_fonts"] = c.DisableAutoFonts
+	}
+
+	if c.FallbackToDefaultFont {
+		m["config_fallback_to_default_font"] = c.FallbackToDefaultFont
+	}
+
 	if c.WorkersQuantity != 0 {
 		m["config_workers"] = c.WorkersQuantity
 	}
 
+	if c.WorkersStrategy != nil {
+		m["config_workers_strategy"] = c.WorkersStrategy.String()
+	}
+
 	if c.Debug {
 		m["config_debug"] = c.Debug
 	}
@@ -79,5 +124,58 @@ func (c *Config) ToMap() map[string]interface{} {
 		m = c.BackgroundImage.AppendMap(m)
 	}
 
+	if c.Bleed != nil {
+		m = c.Bleed.AppendMap(m)
+	}
+
+	if c.RTL {
+		m["config_rtl"] = c.RTL
+	}
+
+	if c.JavaScriptEnabled {
+		m["config_javascript_enabled"] = c.JavaScriptEnabled
+	}
+
+	if c.ObjectStreams {
+		m["config_object_streams"] = c.ObjectStreams
+	}
+
+	if c.PageNumberOffset != 0 {
+		m["config_page_number_offset"] = c.PageNumberOffset
+	}
+
+	if c.AutoNumberPages {
+		m["config_auto_number_pages"] = c.AutoNumberPages
+	}
+
+	if len(c.Header) > 0 {
+		m["config_header_height"] = getRowsHeight(c.Header)
+	}
+
+	if len(c.Footer) > 0 {
+		m["config_footer_height"] = getRowsHeight(c.Footer)
+	}
+
+	if c.SkipFirstPageHeaderFooter {
+		m["config_skip_first_page_header_footer"] = c.SkipFirstPageHeaderFooter
+	}
+
+	if c.PageTemplate != nil {
+		m["config_page_template"] = true
+	}
+
+	if c.Watermark != nil {
+		m = c.Watermark.AppendMap(m)
+	}
+
 	return m
 }
+
+func getRowsHeight(rows []PageRow) float64 {
+	var height float64
+	for _, r := range rows {
+		height += r.GetHeight()
+	}
+
+	return height
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/protection"
 	"github.com/johnfercher/maroto/v2/pkg/consts/provider"
+	"github.com/johnfercher/maroto/v2/pkg/pool"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
@@ -32,7 +33,10 @@ func TestConfig_ToMap(t *testing.T) {
 	assert.Equal(t, fontstyle.Bold, m["prop_font_style"])
 	assert.Equal(t, 15.0, m["prop_font_size"])
 	assert.Equal(t, "RGB(255, 0, 0)", m["prop_font_color"])
+	assert.Equal(t, true, m["config_disable_auto_fonts"])
+	assert.Equal(t, true, m["config_fallback_to_default_font"])
 	assert.Equal(t, 7, m["config_workers"])
+	assert.Equal(t, "work_stealing", m["config_workers_strategy"])
 	assert.Equal(t, true, m["config_debug"])
 	assert.Equal(t, 15, m["config_max_grid_sum"])
 	assert.Equal(t, "pattern", m["config_page_number_pattern"])
@@ -50,6 +54,25 @@ func TestConfig_ToMap(t *testing.T) {
 	assert.Equal(t, extension.Png, m["entity_extension"])
 	assert.Equal(t, 100.0, m["background_dimension_width"])
 	assert.Equal(t, 200.0, m["background_dimension_height"])
+	assert.Equal(t, 3.0, m["config_bleed_mm"])
+	assert.Equal(t, true, m["config_rtl"])
+	assert.Equal(t, true, m["config_javascript_enabled"])
+	assert.Equal(t, true, m["config_object_streams"])
+	assert.Equal(t, 5, m["config_page_number_offset"])
+	assert.Equal(t, true, m["config_auto_number_pages"])
+	assert.Equal(t, 30.0, m["config_header_height"])
+	assert.Equal(t, 5.0, m["config_footer_height"])
+	assert.Equal(t, true, m["config_skip_first_page_header_footer"])
+	assert.Equal(t, true, m["config_page_template"])
+	assert.Equal(t, "DRAFT", m["config_watermark_text"])
+	assert.Equal(t, 45.0, m["config_watermark_angle"])
+}
+
+// heightRow is a Row with only the height.
+type heightRow float64
+
+func (h heightRow) GetHeight() float64 {
+	return float64(h)
 }
 
 func fixtureConfig() Config {
@@ -61,19 +84,34 @@ func fixtureConfig() Config {
 	image := fixtureImage()
 
 	return Config{
-		ProviderType:      provider.Gofpdf,
-		Dimensions:        &dimensions,
-		Margins:           &margins,
-		DefaultFont:       &font,
-		WorkersQuantity:   7,
-		Debug:             true,
-		MaxGridSize:       15,
-		PageNumberPattern: "pattern",
-		PageNumberPlace:   props.Bottom,
-		Protection:        &protection,
-		Compression:       true,
-		Metadata:          &metadata,
-		BackgroundImage:   &image,
+		ProviderType:          provider.Gofpdf,
+		Dimensions:            &dimensions,
+		Margins:               &margins,
+		DefaultFont:           &font,
+		DisableAutoFonts:      true,
+		FallbackToDefaultFont: true,
+		WorkersQuantity:       7,
+		WorkersStrategy:       pool.WorkStealing,
+		Debug:                 true,
+		MaxGridSize:           15,
+		PageNumberPattern:     "pattern",
+		PageNumberPlace:       props.Bottom,
+		Protection:            &protection,
+		Compression:           true,
+		Metadata:              &metadata,
+		BackgroundImage:       &image,
+		Bleed:                 &BleedBox{BleedMM: 3},
+		RTL:                   true,
+		JavaScriptEnabled:     true,
+		ObjectStreams:         true,
+		PageNumberOffset:      5,
+		AutoNumberPages:       true,
+		Header:                []PageRow{heightRow(10), heightRow(20)},
+		Footer:                []PageRow{heightRow(5)},
+
+		SkipFirstPageHeaderFooter: true,
+		PageTemplate:              func(int, int) []PageRow { return nil },
+		Watermark:                 &Watermark{Text: "DRAFT", Prop: props.Watermark{Angle: 45}},
 	}
 }
 
@@ -4,8 +4,14 @@ import "github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 
 // CustomFont is the representation of a font that can be added to the pdf.
 type CustomFont struct {
+	// Family is the name used to reference the font, variants of the same font
+	// should share the same family.
 	Family string
-	Style  fontstyle.Type
-	File   string
-	Bytes  []byte
+	// Style is the variant of the family this font represents, ex: fontstyle.Bold.
+	// It allows props.Font.Style to select the right variant when rendering.
+	Style fontstyle.Type
+	// File is the path of the font file.
+	File string
+	// Bytes is the content of the font file.
+	Bytes []byte
 }
@@ -7,11 +7,13 @@ import (
 
 // Metadata is the representation of a PDF metadata.
 type Metadata struct {
-	Author       *Utf8Text
-	Creator      *Utf8Text
-	Subject      *Utf8Text
-	Title        *Utf8Text
-	CreationDate *time.Time
+	Author           *Utf8Text
+	Creator          *Utf8Text
+	Subject          *Utf8Text
+	Title            *Utf8Text
+	Keywords         *Utf8Text
+	CreationDate     *time.Time
+	ModificationDate *time.Time
 }
 
 // AppendMap appends the metadata to a map.
@@ -32,10 +34,18 @@ func (m *Metadata) AppendMap(mp map[string]interface{}) map[string]interface{} {
 		mp["config_metadata_title"] = m.Title.ToString()
 	}
 
+	if m.Keywords != nil {
+		mp["config_metadata_keywords"] = m.Keywords.ToString()
+	}
+
 	if m.CreationDate != nil {
 		mp["config_metadata_creation_date"] = true
 	}
 
+	if m.ModificationDate != nil {
+		mp["config_metadata_modification_date"] = true
+	}
+
 	return mp
 }
 
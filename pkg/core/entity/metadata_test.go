@@ -20,7 +20,9 @@ func TestMetadata_AppendMap(t *testing.T) {
 	assert.Equal(t, "Utf8Text(creator, false)", m["config_metadata_creator"])
 	assert.Equal(t, "Utf8Text(subject, true)", m["config_metadata_subject"])
 	assert.Equal(t, "Utf8Text(title, true)", m["config_metadata_title"])
+	assert.Equal(t, "Utf8Text(keywords, false)", m["config_metadata_keywords"])
 	assert.Equal(t, true, m["config_metadata_creation_date"])
+	assert.Equal(t, true, m["config_metadata_modification_date"])
 }
 
 func fixtureMetadata() Metadata {
@@ -42,6 +44,11 @@ func fixtureMetadata() Metadata {
 			Text: "title",
 			UTF8: true,
 		},
-		CreationDate: &now,
+		Keywords: &Utf8Text{
+			Text: "keywords",
+			UTF8: false,
+		},
+		CreationDate:     &now,
+		ModificationDate: &now,
 	}
 }
@@ -0,0 +1,7 @@
+package entity
+
+// Point is the representation of a position, relative to the margins, in the document.
+type Point struct {
+	X float64
+	Y float64
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Watermark is the representation of the text written diagonally over the content of every page.
+type Watermark struct {
+	Text string
+	Prop props.Watermark
+}
+
+// AppendMap appends the watermark fields to a map.
+func (w *Watermark) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if w.Text != "" {
+		m["config_watermark_text"] = w.Text
+	}
+
+	for key, value := range w.Prop.ToMap() {
+		m["config_watermark_"+strings.TrimPrefix(key, "prop_")] = value
+	}
+
+	return m
+}
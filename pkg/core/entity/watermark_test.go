@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestWatermark_AppendMap(t *testing.T) {
+	// Arrange
+	sut := Watermark{Text: "DRAFT", Prop: props.Watermark{Size: 40, Opacity: 0.5}}
+	m := make(map[string]interface{})
+
+	// Act
+	m = sut.AppendMap(m)
+
+	// Assert
+	assert.Equal(t, "DRAFT", m["config_watermark_text"])
+	assert.Equal(t, 40.0, m["config_watermark_font_size"])
+	assert.Equal(t, 0.5, m["config_watermark_opacity"])
+}
@@ -0,0 +1,29 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPageRender is wrapped by the PageError of a page whose rendering panicked.
+var ErrPageRender = errors.New("page rendering panicked")
+
+// PageError is an error which happened while generating a page of the document.
+type PageError struct {
+	// PageIndex is the index of the page, starting from 0.
+	PageIndex int
+	// Worker is the ID of the worker which generated the page, it's always 0 on the sequential generation.
+	Worker int
+	// Err is the error which happened.
+	Err error
+}
+
+// Error returns the error with the page and the worker.
+func (p PageError) Error() string {
+	return fmt.Sprintf("page %d, worker %d: %v", p.PageIndex, p.Worker, p.Err)
+}
+
+// Unwrap returns the error which happened.
+func (p PageError) Unwrap() error {
+	return p.Err
+}
@@ -0,0 +1,29 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+)
+
+func TestPageError_Error(t *testing.T) {
+	// Arrange
+	sut := core.PageError{PageIndex: 3, Worker: 1, Err: errors.New("failed")}
+
+	// Act
+	s := sut.Error()
+
+	// Assert
+	assert.Equal(t, "page 3, worker 1: failed", s)
+}
+
+func TestPageError_Unwrap(t *testing.T) {
+	// Arrange
+	var err error = core.PageError{PageIndex: 3, Worker: 1, Err: core.ErrPageRender}
+
+	// Act & Assert
+	assert.ErrorIs(t, err, core.ErrPageRender)
+}
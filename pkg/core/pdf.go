@@ -4,25 +4,48 @@ package core
 import (
 	"encoding/base64"
 	"os"
+	"sort"
 
 	"github.com/johnfercher/maroto/v2/internal/time"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/exporter"
+	"github.com/johnfercher/maroto/v2/pkg/flatten"
 	"github.com/johnfercher/maroto/v2/pkg/merge"
 	"github.com/johnfercher/maroto/v2/pkg/metrics"
 )
 
 type pdf struct {
-	bytes  []byte
-	report *metrics.Report
+	bytes     []byte
+	report    *metrics.Report
+	bookmarks []entity.Bookmark
+	errors    []PageError
 }
 
 // NewPDF is responsible to create a new instance of PDF.
-func NewPDF(bytes []byte, report *metrics.Report) Document {
+// It's optional to provide the bookmarks created during rendering,
+// they will be sorted by page, keeping the order they were created in each page.
+func NewPDF(bytes []byte, report *metrics.Report, bookmarks ...entity.Bookmark) Document {
+	sorted := append([]entity.Bookmark(nil), bookmarks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Page < sorted[j].Page
+	})
+
 	return &pdf{
-		bytes:  bytes,
-		report: report,
+		bytes:     bytes,
+		report:    report,
+		bookmarks: sorted,
 	}
 }
 
+// NewPDFWithErrors is responsible to create a new instance of PDF like NewPDF, with the errors
+// which happened while generating its pages.
+func NewPDFWithErrors(bytes []byte, report *metrics.Report, errs []PageError, bookmarks ...entity.Bookmark) Document {
+	document := NewPDF(bytes, report, bookmarks...).(*pdf)
+	document.errors = errs
+
+	return document
+}
+
 // GetBytes returns the PDF bytes.
 func (p *pdf) GetBytes() []byte {
 	return p.bytes
@@ -38,6 +61,17 @@ func (p *pdf) GetReport() *metrics.Report {
 	return p.report
 }
 
+// GetBookmarks returns the outline created during rendering.
+func (p *pdf) GetBookmarks() []entity.Bookmark {
+	return p.bookmarks
+}
+
+// Errors returns the errors which happened while generating the pages, ordered by page.
+// The pages with errors are still in the document, but they may be incomplete.
+func (p *pdf) Errors() []PageError {
+	return p.errors
+}
+
 // Save saves the PDF in a file.
 func (p *pdf) Save(file string) error {
 	return os.WriteFile(file, p.bytes, os.ModePerm)
@@ -62,6 +96,29 @@ func (p *pdf) Merge(bytes []byte) error {
 	return nil
 }
 
+// FlattenForms returns a copy of the PDF without interactive form fields, the current appearance of
+// each field is drawn in the content of its page, the PDF is not changed.
+func (p *pdf) FlattenForms() (Document, error) {
+	bytes, err := flatten.Bytes(p.bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pdf{
+		bytes:     bytes,
+		report:    p.report,
+		bookmarks: p.bookmarks,
+		errors:    p.errors,
+	}, nil
+}
+
+// ExportToZIP rasterizes the pages, starting from 1, and returns them in a ZIP archive,
+// when pages is nil every page is exported. The pages are rasterized by exporter.DefaultRasterizer, unless
+// another one is sent with exporter.WithRasterizer.
+func (p *pdf) ExportToZIP(pages []int, format exporter.Format, opts ...exporter.Option) ([]byte, error) {
+	return exporter.ZIP(p.bytes, pages, format, opts...)
+}
+
 func (p *pdf) appendMetric(timeSpent *metrics.Time) {
 	timeMetric := metrics.TimeMetric{
 		Key:   "merge_pdf",
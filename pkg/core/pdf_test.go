@@ -9,7 +9,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
 	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/exporter"
 	"github.com/johnfercher/maroto/v2/pkg/metrics"
 )
 
@@ -89,6 +93,62 @@ func TestPdf_Save(t *testing.T) {
 	})
 }
 
+func TestPdf_GetBookmarks(t *testing.T) {
+	t.Run("when bookmarks are not sent, should return empty", func(t *testing.T) {
+		// Arrange
+		sut := core.NewPDF(nil, nil)
+
+		// Act
+		bookmarks := sut.GetBookmarks()
+
+		// Assert
+		assert.Empty(t, bookmarks)
+	})
+	t.Run("when bookmarks are sent, should return sorted by page keeping the order in the page", func(t *testing.T) {
+		// Arrange
+		sut := core.NewPDF(nil, nil,
+			entity.Bookmark{Title: "c", Page: 2, Level: 0},
+			entity.Bookmark{Title: "b", Page: 1, Level: 1},
+			entity.Bookmark{Title: "a", Page: 1, Level: 0},
+		)
+
+		// Act
+		bookmarks := sut.GetBookmarks()
+
+		// Assert
+		assert.Equal(t, []entity.Bookmark{
+			{Title: "b", Page: 1, Level: 1},
+			{Title: "a", Page: 1, Level: 0},
+			{Title: "c", Page: 2, Level: 0},
+		}, bookmarks)
+	})
+}
+
+func TestPdf_Errors(t *testing.T) {
+	t.Run("when created without errors, should return empty", func(t *testing.T) {
+		// Arrange
+		sut := core.NewPDF(nil, nil)
+
+		// Act
+		errs := sut.Errors()
+
+		// Assert
+		assert.Empty(t, errs)
+	})
+	t.Run("when created with errors, should return them and keep the bookmarks", func(t *testing.T) {
+		// Arrange
+		pageErrors := []core.PageError{{PageIndex: 1, Worker: 2, Err: core.ErrPageRender}}
+		sut := core.NewPDFWithErrors(nil, nil, pageErrors, entity.Bookmark{Title: "a", Page: 1})
+
+		// Act
+		errs := sut.Errors()
+
+		// Assert
+		assert.Equal(t, pageErrors, errs)
+		assert.Len(t, sut.GetBookmarks(), 1)
+	})
+}
+
 func buildPath(file string) string {
 	dir, err := os.Getwd()
 	if err != nil {
@@ -98,3 +158,61 @@ func buildPath(file string) string {
 	dir = strings.ReplaceAll(dir, "pkg/core/entity", "")
 	return path.Join(dir, file)
 }
+
+func TestPdf_ExportToZIP(t *testing.T) {
+	t.Run("when rasterizer is nil, should return error", func(t *testing.T) {
+		// Arrange
+		sut := core.NewPDF([]byte{1, 2, 3}, nil)
+
+		// Act
+		b, err := sut.ExportToZIP(nil, exporter.PNG, exporter.WithRasterizer(nil))
+
+		// Assert
+		assert.Nil(t, b)
+		assert.Equal(t, exporter.ErrNoRasterizer, err)
+	})
+	t.Run("when bytes are not a pdf, should return error", func(t *testing.T) {
+		// Arrange
+		sut := core.NewPDF([]byte{1, 2, 3}, nil)
+
+		// Act
+		b, err := sut.ExportToZIP(nil, exporter.PNG)
+
+		// Assert
+		assert.Nil(t, b)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPdf_FlattenForms(t *testing.T) {
+	t.Run("when bytes are not a pdf, should return error", func(t *testing.T) {
+		// Arrange
+		sut := core.NewPDF([]byte{1, 2, 3}, nil)
+
+		// Act
+		doc, err := sut.FlattenForms()
+
+		// Assert
+		assert.Nil(t, doc)
+		assert.NotNil(t, err)
+	})
+	t.Run("when bytes are a pdf, should return a new document with the report and bookmarks", func(t *testing.T) {
+		// Arrange
+		m := maroto.New()
+		m.AddRows(text.NewRow(10, "text"))
+		generated, _ := m.Generate()
+		report := &metrics.Report{}
+		bookmarks := []entity.Bookmark{{Title: "a", Page: 1}}
+		sut := core.NewPDF(generated.GetBytes(), report, bookmarks...)
+
+		// Act
+		doc, err := sut.FlattenForms()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotEmpty(t, doc.GetBytes())
+		assert.Equal(t, report, doc.GetReport())
+		assert.Equal(t, bookmarks, doc.GetBookmarks())
+		assert.Equal(t, generated.GetBytes(), sut.GetBytes())
+	})
+}
@@ -1,6 +1,9 @@
 package core
 
 import (
+	"image"
+	"io"
+
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
@@ -11,20 +14,48 @@ type Provider interface {
 	// Grid
 	CreateRow(height float64)
 	CreateCol(width, height float64, config *entity.Config, prop *props.Cell)
+	GetCursor() (x, y float64)
+	SetCursor(x, y float64)
 
 	// Features
 	AddLine(cell *entity.Cell, prop *props.Line)
+	DrawArc(center entity.Point, radius, innerRadius, startAngle, endAngle float64, prop *props.Line)
+	DrawBezier(p0, p1, p2, p3 entity.Point, prop *props.Line)
+	DrawLine(p0, p1 entity.Point, prop *props.Line)
+	DrawPolygon(points []entity.Point, prop *props.Line)
+	DrawRect(cell *entity.Cell, prop *props.Cell)
 	AddText(text string, cell *entity.Cell, prop *props.Text)
 	GetTextHeight(prop *props.Font) float64
+	GetStringWidth(text string, prop *props.Font) float64
+	GetPageSize() (width, height float64)
 	AddMatrixCode(code string, cell *entity.Cell, prop *props.Rect)
 	AddQrCode(code string, cell *entity.Cell, rect *props.Rect)
+	AddMicroQrCode(code string, cell *entity.Cell, rect *props.Rect)
 	AddBarCode(code string, cell *entity.Cell, prop *props.Barcode)
+	AddAztecCode(code string, cell *entity.Cell, prop *props.Rect)
+	AddPDF417Code(code string, cell *entity.Cell, prop *props.PDF417)
 	AddImageFromFile(value string, cell *entity.Cell, prop *props.Rect)
 	AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type)
+	AddTiledImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type)
 	AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type)
+	DrawImage(img image.Image, cell *entity.Cell, prop *props.Rect)
+	AddBookmark(title string, level int, cell *entity.Cell)
+	AddHoverArea(id string, cell *entity.Cell, color *props.Color)
+	AddTooltipArea(tooltip string, cell *entity.Cell)
+	AddWatermark(text string, prop *props.Watermark)
+
+	// Clipping
+	SetClipRect(x, y, width, height float64)
+	SetClipCircle(x, y, radius float64)
+	ResetClip()
+
+	// Graphics state
+	SetGraphicsState(state props.GraphicsState)
 
 	// General
 	GenerateBytes() ([]byte, error)
+	GenerateTo(w io.Writer) error
+	GetBookmarks() []entity.Bookmark
 
 	SetProtection(protection *entity.Protection)
 	SetCompression(compression bool)
@@ -0,0 +1,172 @@
+// Package exporter implements the export of PDF pages as images.
+package exporter
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+)
+
+// Format is the image format of the exported pages.
+type Format string
+
+const (
+	// PNG exports the pages as png images.
+	PNG Format = "png"
+	// JPEG exports the pages as jpeg images.
+	JPEG Format = "jpg"
+)
+
+// DefaultDPI is the resolution used when the DPI is not defined.
+const DefaultDPI = 150.0
+
+var (
+	// ErrNoRasterizer is returned when exporting pages with a nil Rasterizer.
+	ErrNoRasterizer = errors.New("no rasterizer defined to export pages")
+	// ErrUnsupportedFormat is returned when the Format is not PNG or JPEG.
+	ErrUnsupportedFormat = errors.New("unsupported export format")
+	// ErrPageOutOfRange is returned when a requested page does not exist in the document.
+	ErrPageOutOfRange = errors.New("page is out of range")
+)
+
+// Rasterizer converts a page of a PDF into an image. The pages are rasterized by PDFium by default,
+// an implementation wrapping another renderer, like MuPDF or Poppler, can be sent with WithRasterizer.
+type Rasterizer interface {
+	// Rasterize returns the image of the page, starting from 1, of the PDF.
+	Rasterize(pdf []byte, page int, format Format, dpi float64) ([]byte, error)
+}
+
+// Options are the options used to export the pages.
+type Options struct {
+	Rasterizer Rasterizer
+	DPI        float64
+}
+
+// Option changes the Options used to export the pages.
+type Option func(*Options)
+
+// NewOptions returns the Options with the DefaultRasterizer and DefaultDPI changed by the opts.
+func NewOptions(opts ...Option) Options {
+	options := Options{Rasterizer: DefaultRasterizer, DPI: DefaultDPI}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// WithRasterizer defines the Rasterizer used to convert the pages into images, instead of DefaultRasterizer.
+func WithRasterizer(rasterizer Rasterizer) Option {
+	return func(o *Options) {
+		o.Rasterizer = rasterizer
+	}
+}
+
+// WithDPI defines the resolution of the images, values less or equal to zero are ignored.
+func WithDPI(dpi float64) Option {
+	return func(o *Options) {
+		if dpi > 0 {
+			o.DPI = dpi
+		}
+	}
+}
+
+// IsValid checks if the format is valid.
+func (f Format) IsValid() bool {
+	return f == PNG || f == JPEG
+}
+
+// ZIP rasterizes the pages, starting from 1, of the PDF and returns them in a ZIP archive
+// named page_001.png, page_002.png and etc. When pages is nil, every page is exported.
+func ZIP(pdf []byte, pages []int, format Format, opts ...Option) ([]byte, error) {
+	options := NewOptions(opts...)
+
+	if options.Rasterizer == nil {
+		return nil, ErrNoRasterizer
+	}
+
+	if !format.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+
+	pages, err := getPages(pdf, pages)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	for _, page := range pages {
+		image, err := options.Rasterizer.Rasterize(pdf, page, format, options.DPI)
+		if err != nil {
+			return nil, fmt.Errorf("rasterizing page %d: %w", page, err)
+		}
+
+		file, err := archive.Create(fmt.Sprintf("page_%03d.%s", page, format))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := file.Write(image); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Image rasterizes the page, starting from 1, of the PDF and returns it in the format.
+func Image(pdf []byte, page int, format Format, opts ...Option) ([]byte, error) {
+	options := NewOptions(opts...)
+
+	if options.Rasterizer == nil {
+		return nil, ErrNoRasterizer
+	}
+
+	if !format.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+
+	if _, err := getPages(pdf, []int{page}); err != nil {
+		return nil, err
+	}
+
+	image, err := options.Rasterizer.Rasterize(pdf, page, format, options.DPI)
+	if err != nil {
+		return nil, fmt.Errorf("rasterizing page %d: %w", page, err)
+	}
+
+	return image, nil
+}
+
+func getPages(pdf []byte, pages []int) ([]int, error) {
+	count, err := api.PageCount(bytes.NewReader(pdf), api.LoadConfiguration())
+	if err != nil {
+		return nil, err
+	}
+
+	if pages == nil {
+		pages = make([]int, count)
+		for i := range pages {
+			pages[i] = i + 1
+		}
+
+		return pages, nil
+	}
+
+	for _, page := range pages {
+		if page < 1 || page > count {
+			return nil, fmt.Errorf("%w: page %d, document has %d pages", ErrPageOutOfRange, page, count)
+		}
+	}
+
+	return pages, nil
+}
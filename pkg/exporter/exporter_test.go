@@ -0,0 +1,190 @@
+package exporter_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/exporter"
+)
+
+type fakeRasterizer struct {
+	err error
+}
+
+func (f *fakeRasterizer) Rasterize(_ []byte, page int, format exporter.Format, dpi float64) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return []byte(fmt.Sprintf("page %d %s %.0f", page, format, dpi)), nil
+}
+
+func newPDF(t *testing.T, pages int) []byte {
+	fpdf := gofpdf.New("P", "mm", "A4", "")
+	for i := 0; i < pages; i++ {
+		fpdf.AddPage()
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(t, fpdf.Output(&buf))
+
+	return buf.Bytes()
+}
+
+func readZIP(t *testing.T, b []byte) map[string]string {
+	reader, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	assert.Nil(t, err)
+
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		assert.Nil(t, err)
+		content, err := io.ReadAll(rc)
+		assert.Nil(t, err)
+		_ = rc.Close()
+		files[file.Name] = string(content)
+	}
+
+	return files
+}
+
+func TestZIP(t *testing.T) {
+	pdf := newPDF(t, 3)
+
+	t.Run("when rasterizer is nil, should return error", func(t *testing.T) {
+		// Act
+		b, err := exporter.ZIP(pdf, nil, exporter.PNG, exporter.WithRasterizer(nil))
+
+		// Assert
+		assert.Nil(t, b)
+		assert.Equal(t, exporter.ErrNoRasterizer, err)
+	})
+	t.Run("when rasterizer is not sent, should export png pages with the default rasterizer", func(t *testing.T) {
+		// Act
+		b, err := exporter.ZIP(pdf, []int{2}, exporter.PNG, exporter.WithDPI(36))
+
+		// Assert
+		assert.Nil(t, err)
+		files := readZIP(t, b)
+		assert.Len(t, files, 1)
+		config, err := png.DecodeConfig(strings.NewReader(files["page_002.png"]))
+		assert.Nil(t, err)
+		assert.Equal(t, 298, config.Width)
+		assert.Equal(t, 421, config.Height)
+	})
+	t.Run("when format is invalid, should return error", func(t *testing.T) {
+		// Act
+		_, err := exporter.ZIP(pdf, nil, "gif", exporter.WithRasterizer(&fakeRasterizer{}))
+
+		// Assert
+		assert.ErrorIs(t, err, exporter.ErrUnsupportedFormat)
+	})
+	t.Run("when pages is nil, should export every page", func(t *testing.T) {
+		// Act
+		b, err := exporter.ZIP(pdf, nil, exporter.PNG, exporter.WithRasterizer(&fakeRasterizer{}))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"page_001.png": "page 1 png 150",
+			"page_002.png": "page 2 png 150",
+			"page_003.png": "page 3 png 150",
+		}, readZIP(t, b))
+	})
+	t.Run("when pages are sent, should export only them", func(t *testing.T) {
+		// Act
+		b, err := exporter.ZIP(pdf, []int{3, 1}, exporter.JPEG,
+			exporter.WithRasterizer(&fakeRasterizer{}), exporter.WithDPI(300))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"page_001.jpg": "page 1 jpg 300",
+			"page_003.jpg": "page 3 jpg 300",
+		}, readZIP(t, b))
+	})
+	t.Run("when page does not exist, should return error", func(t *testing.T) {
+		// Act
+		_, err := exporter.ZIP(pdf, []int{4}, exporter.PNG, exporter.WithRasterizer(&fakeRasterizer{}))
+
+		// Assert
+		assert.ErrorIs(t, err, exporter.ErrPageOutOfRange)
+	})
+	t.Run("when rasterizer fails, should return error", func(t *testing.T) {
+		// Arrange
+		errRasterize := errors.New("rasterize error")
+
+		// Act
+		_, err := exporter.ZIP(pdf, nil, exporter.PNG, exporter.WithRasterizer(&fakeRasterizer{err: errRasterize}))
+
+		// Assert
+		assert.ErrorIs(t, err, errRasterize)
+	})
+	t.Run("when bytes are not a pdf, should return error", func(t *testing.T) {
+		// Act
+		_, err := exporter.ZIP([]byte{1, 2, 3}, nil, exporter.PNG, exporter.WithRasterizer(&fakeRasterizer{}))
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+}
+
+func TestImage(t *testing.T) {
+	pdf := newPDF(t, 2)
+
+	t.Run("when rasterizer is nil, should return error", func(t *testing.T) {
+		// Act
+		b, err := exporter.Image(pdf, 1, exporter.PNG, exporter.WithRasterizer(nil))
+
+		// Assert
+		assert.Nil(t, b)
+		assert.Equal(t, exporter.ErrNoRasterizer, err)
+	})
+	t.Run("when format is invalid, should return error", func(t *testing.T) {
+		// Act
+		_, err := exporter.Image(pdf, 1, "gif", exporter.WithRasterizer(&fakeRasterizer{}))
+
+		// Assert
+		assert.ErrorIs(t, err, exporter.ErrUnsupportedFormat)
+	})
+	t.Run("when page does not exist, should return error", func(t *testing.T) {
+		// Act
+		_, err := exporter.Image(pdf, 3, exporter.PNG, exporter.WithRasterizer(&fakeRasterizer{}))
+
+		// Assert
+		assert.ErrorIs(t, err, exporter.ErrPageOutOfRange)
+	})
+	t.Run("when rasterizer fails, should return error", func(t *testing.T) {
+		// Arrange
+		errRasterize := errors.New("rasterize error")
+
+		// Act
+		_, err := exporter.Image(pdf, 1, exporter.PNG, exporter.WithRasterizer(&fakeRasterizer{err: errRasterize}))
+
+		// Assert
+		assert.ErrorIs(t, err, errRasterize)
+	})
+	t.Run("when page exists, should rasterize only it", func(t *testing.T) {
+		// Act
+		b, err := exporter.Image(pdf, 2, exporter.JPEG, exporter.WithRasterizer(&fakeRasterizer{}), exporter.WithDPI(72))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "page 2 jpg 72", string(b))
+	})
+}
+
+func TestFormat_IsValid(t *testing.T) {
+	assert.True(t, exporter.PNG.IsValid())
+	assert.True(t, exporter.JPEG.IsValid())
+	assert.False(t, exporter.Format("gif").IsValid())
+}
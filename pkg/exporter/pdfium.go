@@ -0,0 +1,119 @@
+package exporter
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/webassembly"
+	"github.com/tetratelabs/wazero"
+)
+
+// pdfiumTimeout is how long a page waits for the PDFium instance while other pages are rasterized.
+const pdfiumTimeout = time.Minute
+
+// DefaultRasterizer is the Rasterizer used when WithRasterizer is not sent.
+var DefaultRasterizer Rasterizer = NewPDFium()
+
+// PDFium is a Rasterizer which renders the pages with PDFium compiled to WebAssembly, so it doesn't need
+// cgo nor external tools. PDFium is started on the first page rasterized, which takes about 2 seconds,
+// and kept for the next ones, the pages are rasterized one at a time.
+type PDFium struct {
+	once sync.Once
+	pool pdfium.Pool
+	err  error
+}
+
+// NewPDFium is responsible to create an instance of a PDFium Rasterizer.
+func NewPDFium() *PDFium {
+	return &PDFium{}
+}
+
+// Rasterize returns the image of the page, starting from 1, of the PDF.
+func (p *PDFium) Rasterize(pdf []byte, page int, format Format, dpi float64) ([]byte, error) {
+	if !format.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+
+	img, err := p.render(pdf, page, dpi)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if format == JPEG {
+		err = jpeg.Encode(&buf, img, nil)
+	} else {
+		err = png.Encode(&buf, img)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (p *PDFium) render(pdf []byte, page int, dpi float64) (image.Image, error) {
+	p.once.Do(func() {
+		// the instance has no access to the file system, as the documents are sent as bytes
+		p.pool, p.err = webassembly.Init(webassembly.Config{
+			MinIdle:  1,
+			MaxIdle:  1,
+			MaxTotal: 1,
+			FSConfig: wazero.NewFSConfig(),
+			Stdout:   io.Discard,
+			Stderr:   io.Discard,
+		})
+	})
+
+	if p.err != nil {
+		return nil, fmt.Errorf("starting pdfium: %w", p.err)
+	}
+
+	instance, err := p.pool.GetInstance(pdfiumTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer instance.Close()
+
+	document, err := instance.OpenDocument(&requests.OpenDocument{File: &pdf})
+	if err != nil {
+		return nil, err
+	}
+	defer instance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{Document: document.Document})
+
+	count, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{Document: document.Document})
+	if err != nil {
+		return nil, err
+	}
+
+	if page < 1 || page > count.PageCount {
+		return nil, fmt.Errorf("%w: page %d, document has %d pages", ErrPageOutOfRange, page, count.PageCount)
+	}
+
+	rendered, err := instance.RenderPageInDPI(&requests.RenderPageInDPI{
+		DPI: int(math.Round(dpi)),
+		Page: requests.Page{
+			ByIndex: &requests.PageByIndex{Document: document.Document, Index: page - 1},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer rendered.Cleanup()
+
+	// the image uses the memory of PDFium, which is released by Cleanup
+	img := image.NewRGBA(rendered.Result.Image.Bounds())
+	copy(img.Pix, rendered.Result.Image.Pix)
+
+	return img, nil
+}
@@ -0,0 +1,71 @@
+package exporter_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/exporter"
+)
+
+func TestPDFium_Rasterize(t *testing.T) {
+	sut := exporter.NewPDFium()
+
+	t.Run("when format is png, should render the page in the dpi", func(t *testing.T) {
+		// Arrange
+		fpdf := gofpdf.New("P", "mm", "A4", "")
+		fpdf.AddPage()
+		fpdf.SetFillColor(255, 0, 0)
+		fpdf.Rect(0, 0, 105, 148.5, "F")
+		var buf bytes.Buffer
+		assert.Nil(t, fpdf.Output(&buf))
+
+		// Act
+		b, err := sut.Rasterize(buf.Bytes(), 1, exporter.PNG, 72)
+
+		// Assert
+		assert.Nil(t, err)
+		img, err := png.Decode(bytes.NewReader(b))
+		assert.Nil(t, err)
+		assert.Equal(t, image.Rect(0, 0, 596, 842), img.Bounds())
+		assert.Equal(t, color.RGBA{R: 255, A: 255}, color.RGBAModel.Convert(img.At(10, 10)))
+		assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, color.RGBAModel.Convert(img.At(500, 800)))
+	})
+	t.Run("when format is jpeg, should encode the page as jpeg", func(t *testing.T) {
+		// Act
+		b, err := sut.Rasterize(newPDF(t, 2), 2, exporter.JPEG, 36)
+
+		// Assert
+		assert.Nil(t, err)
+		img, err := jpeg.Decode(bytes.NewReader(b))
+		assert.Nil(t, err)
+		assert.Equal(t, image.Rect(0, 0, 298, 421), img.Bounds())
+	})
+	t.Run("when page does not exist, should return error", func(t *testing.T) {
+		// Act
+		_, err := sut.Rasterize(newPDF(t, 1), 2, exporter.PNG, 72)
+
+		// Assert
+		assert.ErrorIs(t, err, exporter.ErrPageOutOfRange)
+	})
+	t.Run("when format is invalid, should return error", func(t *testing.T) {
+		// Act
+		_, err := sut.Rasterize(newPDF(t, 1), 1, "gif", 72)
+
+		// Assert
+		assert.ErrorIs(t, err, exporter.ErrUnsupportedFormat)
+	})
+	t.Run("when bytes are not a pdf, should return error", func(t *testing.T) {
+		// Act
+		_, err := sut.Rasterize([]byte{1, 2, 3}, 1, exporter.PNG, 72)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+}
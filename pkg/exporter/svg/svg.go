@@ -0,0 +1,388 @@
+// Package svg implements the export of maroto pages as SVG images, to render documents on screens without a PDF viewer.
+package svg
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/internal/code"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/exporter"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// pointsToMM converts the font sizes, in points, to the millimeters used by the page coordinates.
+const pointsToMM = 25.4 / 72.0
+
+// ErrUnsupportedComponent is returned when the page has a component which cannot be written as SVG.
+var ErrUnsupportedComponent = errors.New("component is not supported by the svg exporter")
+
+// RenderPage returns a self-contained SVG of the page, starting from 1, of the document. The SVG uses
+// millimeters as user units, so the coordinates are the same of the PDF. The backgrounds and borders of rows
+// and cols are written as <rect>, texts as <text> in a single line, file images, barcodes, QR codes and
+// matrix codes as embedded <image> and lines as <line>. Pages with other components return
+// ErrUnsupportedComponent. When cfg is nil, the default config is used.
+func RenderPage(m core.Maroto, cfg *entity.Config, page int) ([]byte, error) {
+	if cfg == nil {
+		cfg = config.NewBuilder().Build()
+	}
+
+	var pages []*node.Node[core.Structure]
+	for _, n := range m.GetStructure().GetNexts() {
+		if n.GetData().Type == "page" {
+			pages = append(pages, n)
+		}
+	}
+
+	if page < 1 || page > len(pages) {
+		return nil, exporter.ErrPageOutOfRange
+	}
+
+	r := &renderer{cfg: cfg}
+	if err := r.renderPage(pages[page-1]); err != nil {
+		return nil, err
+	}
+
+	return r.buf.Bytes(), nil
+}
+
+type renderer struct {
+	cfg *entity.Config
+	buf bytes.Buffer
+}
+
+func (r *renderer) renderPage(page *node.Node[core.Structure]) error {
+	width, height := r.cfg.Dimensions.Width, r.cfg.Dimensions.Height
+
+	fmt.Fprintf(&r.buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%smm" height="%smm" viewBox="0 0 %s %s">`,
+		formatNumber(width), formatNumber(height), formatNumber(width), formatNumber(height))
+	r.buf.WriteString("\n")
+	fmt.Fprintf(&r.buf, `<rect x="0" y="0" width="%s" height="%s" fill="white"/>`, formatNumber(width), formatNumber(height))
+	r.buf.WriteString("\n")
+
+	contentWidth := width - r.cfg.Margins.Left - r.cfg.Margins.Right
+	y := r.cfg.Margins.Top
+
+	for _, row := range page.GetNexts() {
+		data := row.GetData()
+		rowHeight := getFloat(data.Value)
+		rowCell := entity.Cell{X: r.cfg.Margins.Left, Y: y, Width: contentWidth, Height: rowHeight}
+
+		r.writeCell(rowCell, data.Details)
+
+		x := rowCell.X
+		for _, col := range row.GetNexts() {
+			colWidth := r.getColWidth(col.GetData(), contentWidth)
+			colCell := entity.Cell{X: x, Y: y, Width: colWidth, Height: rowHeight}
+
+			r.writeCell(colCell, col.GetData().Details)
+
+			for _, component := range col.GetNexts() {
+				if err := r.writeComponent(component.GetData(), colCell); err != nil {
+					return err
+				}
+			}
+
+			x += colWidth
+		}
+
+		y += rowHeight
+	}
+
+	r.buf.WriteString("</svg>\n")
+
+	return nil
+}
+
+func (r *renderer) getColWidth(col core.Structure, contentWidth float64) float64 {
+	size := getFloat(col.Value)
+	if size == 0 || col.Details["is_max"] == true {
+		return contentWidth
+	}
+
+	return contentWidth * size / float64(r.cfg.MaxGridSize)
+}
+
+// writeCell writes a <rect> with the background and border of a row or col, when they are defined.
+func (r *renderer) writeCell(cell entity.Cell, details map[string]interface{}) {
+	fill := getColor(details["prop_background_color"])
+	stroke := getColor(details["prop_border_color"])
+	hasBorder := getString(details["prop_border_type"]) != ""
+
+	if fill == "" && !hasBorder {
+		return
+	}
+
+	if fill == "" {
+		fill = "none"
+	}
+
+	if stroke == "" {
+		stroke = "black"
+	}
+
+	fmt.Fprintf(&r.buf, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"`,
+		formatNumber(cell.X), formatNumber(cell.Y), formatNumber(cell.Width), formatNumber(cell.Height), fill)
+
+	if hasBorder {
+		thickness := getFloat(details["prop_border_thickness"])
+		if thickness == 0 {
+			thickness = 0.2
+		}
+		fmt.Fprintf(&r.buf, ` stroke="%s" stroke-width="%s"`, stroke, formatNumber(thickness))
+	}
+
+	r.buf.WriteString("/>\n")
+}
+
+func (r *renderer) writeComponent(component core.Structure, cell entity.Cell) error {
+	switch component.Type {
+	case "text":
+		r.writeText(getString(component.Value), component.Details, cell)
+	case "fileImage":
+		return r.writeFileImage(getString(component.Value), component.Details, cell)
+	case "lineStyle":
+		r.writeLine(component.Details, cell)
+	case "barcode":
+		return r.writeBarcode(getString(component.Value), component.Details, cell)
+	case "qrcode":
+		return r.writeCode(code.New().GenQr, getString(component.Value), component.Details, cell)
+	case "matrixcode":
+		return r.writeCode(code.New().GenDataMatrix, getString(component.Value), component.Details, cell)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedComponent, component.Type)
+	}
+
+	return nil
+}
+
+func (r *renderer) writeText(value string, details map[string]interface{}, cell entity.Cell) {
+	size := getFloat(details["prop_font_size"])
+	family := getString(details["prop_font_family"])
+	if r.cfg.DefaultFont != nil {
+		if size == 0 {
+			size = r.cfg.DefaultFont.Size
+		}
+		if family == "" {
+			family = r.cfg.DefaultFont.Family
+		}
+	}
+
+	x := cell.X + getFloat(details["prop_left"])
+	anchor := "start"
+
+	switch align.Type(getString(details["prop_align"])) {
+	case align.Center:
+		x = cell.X + cell.Width/2
+		anchor = "middle"
+	case align.Right:
+		x = cell.X + cell.Width - getFloat(details["prop_right"])
+		anchor = "end"
+	}
+
+	y := cell.Y + getFloat(details["prop_top"]) + size*pointsToMM
+
+	fmt.Fprintf(&r.buf, `<text x="%s" y="%s" font-family="%s" font-size="%s" text-anchor="%s"`,
+		formatNumber(x), formatNumber(y), escape(family), formatNumber(size*pointsToMM), anchor)
+
+	style := fontstyle.Type(getString(details["prop_font_style"]))
+	if style == fontstyle.Bold || style == fontstyle.BoldItalic {
+		r.buf.WriteString(` font-weight="bold"`)
+	}
+
+	if style == fontstyle.Italic || style == fontstyle.BoldItalic {
+		r.buf.WriteString(` font-style="italic"`)
+	}
+
+	if color := getColor(details["prop_color"]); color != "" {
+		fmt.Fprintf(&r.buf, ` fill="%s"`, color)
+	}
+
+	fmt.Fprintf(&r.buf, ">%s</text>\n", escape(value))
+}
+
+func (r *renderer) writeFileImage(path string, details map[string]interface{}, cell entity.Cell) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	mimeType := "image/" + strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+	if mimeType == "image/jpg" {
+		mimeType = "image/jpeg"
+	}
+
+	r.writeImage(content, mimeType, details, cell)
+
+	return nil
+}
+
+// writeBarcode writes the bars as an image and, when the text is shown, the code below them with the default font.
+func (r *renderer) writeBarcode(value string, details map[string]interface{}, cell entity.Cell) error {
+	prop := props.Barcode{
+		Type: barcode.Type(getString(details["prop_type"])),
+		Proportion: props.Proportion{
+			Width:  getFloat(details["prop_proportion_width"]),
+			Height: getFloat(details["prop_proportion_height"]),
+		},
+		ShowText: details["prop_show_text"] == true,
+	}
+	prop.MakeValid()
+
+	barCell := cell
+	if prop.ShowText && r.cfg.DefaultFont != nil {
+		barCell.Height = max(cell.Height-r.cfg.DefaultFont.Size*pointsToMM, 0)
+	}
+
+	image, err := code.New().GenBar(value, &barCell, &prop)
+	if err != nil {
+		return fmt.Errorf("generating barcode: %w", err)
+	}
+
+	r.writeImage(image.Bytes, "image/jpeg", details, barCell)
+
+	if prop.ShowText {
+		textCell := entity.Cell{X: cell.X, Y: barCell.Y + barCell.Height, Width: cell.Width, Height: cell.Height - barCell.Height}
+		r.writeText(prop.GetText(value), map[string]interface{}{"prop_align": string(align.Center)}, textCell)
+	}
+
+	return nil
+}
+
+// writeCode writes a two-dimensional code, generated by gen, as an image.
+func (r *renderer) writeCode(gen func(string) (*entity.Image, error), value string, details map[string]interface{},
+	cell entity.Cell,
+) error {
+	image, err := gen(value)
+	if err != nil {
+		return fmt.Errorf("generating code: %w", err)
+	}
+
+	r.writeImage(image.Bytes, "image/jpeg", details, cell)
+
+	return nil
+}
+
+// writeImage embeds the content as a data URI, the image keeps its aspect ratio inside the percent of the cell.
+func (r *renderer) writeImage(content []byte, mimeType string, details map[string]interface{}, cell entity.Cell) {
+	percent := getFloat(details["prop_percent"])
+	if percent == 0 {
+		percent = 100
+	}
+
+	width := cell.Width * percent / 100
+	height := cell.Height * percent / 100
+	x := cell.X + getFloat(details["prop_left"])
+	y := cell.Y + getFloat(details["prop_top"])
+
+	position := "xMinYMin"
+	if details["prop_center"] == true {
+		x = cell.X + (cell.Width-width)/2
+		y = cell.Y + (cell.Height-height)/2
+		position = "xMidYMid"
+	}
+
+	fmt.Fprintf(&r.buf, `<image x="%s" y="%s" width="%s" height="%s" preserveAspectRatio="%s meet" href="data:%s;base64,%s"/>`,
+		formatNumber(x), formatNumber(y), formatNumber(width), formatNumber(height), position, mimeType,
+		base64.StdEncoding.EncodeToString(content))
+	r.buf.WriteString("\n")
+}
+
+// writeLine writes a horizontal line in the middle of the cell, or a vertical one when the orientation is vertical.
+func (r *renderer) writeLine(details map[string]interface{}, cell entity.Cell) {
+	color := getColor(details["prop_color"])
+	if color == "" {
+		color = "black"
+	}
+
+	thickness := getFloat(details["prop_thickness"])
+	if thickness == 0 {
+		thickness = 0.2
+	}
+
+	sizePercent := getFloat(details["prop_size_percent"])
+	if sizePercent == 0 {
+		sizePercent = 90
+	}
+
+	offsetPercent := getFloat(details["prop_offset_percent"])
+	if offsetPercent == 0 {
+		offsetPercent = 50
+	}
+
+	x1, y1, x2, y2 := 0.0, 0.0, 0.0, 0.0
+	if orientation.Type(getString(details["prop_orientation"])) == orientation.Vertical {
+		size := cell.Height * sizePercent / 100
+		x1 = cell.X + cell.Width*offsetPercent/100
+		x2 = x1
+		y1 = cell.Y + (cell.Height-size)/2
+		y2 = y1 + size
+	} else {
+		size := cell.Width * sizePercent / 100
+		x1 = cell.X + (cell.Width-size)/2
+		x2 = x1 + size
+		y1 = cell.Y + cell.Height*offsetPercent/100
+		y2 = y1
+	}
+
+	fmt.Fprintf(&r.buf, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-width="%s"/>`,
+		formatNumber(x1), formatNumber(y1), formatNumber(x2), formatNumber(y2), color, formatNumber(thickness))
+	r.buf.WriteString("\n")
+}
+
+func getFloat(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	default:
+		return 0
+	}
+}
+
+func getString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	return fmt.Sprint(value)
+}
+
+// getColor converts the "RGB(r, g, b)" of props.Color.ToString to the rgb function of SVG. SVG has no CMYK,
+// so the "CMYK(c, m, y, k)" of props.CMYKColor.ToString is converted to its RGB approximation.
+func getColor(value interface{}) string {
+	color := getString(value)
+
+	cmyk := props.CMYKColor{}
+	if _, err := fmt.Sscanf(color, "CMYK(%g, %g, %g, %g)", &cmyk.Cyan, &cmyk.Magenta, &cmyk.Yellow, &cmyk.Key); err == nil {
+		rgb := cmyk.ToColor()
+		return fmt.Sprintf("rgb(%d, %d, %d)", rgb.Red, rgb.Green, rgb.Blue)
+	}
+
+	return strings.ToLower(color)
+}
+
+func formatNumber(value float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.3f", value), "0"), ".")
+}
+
+func escape(value string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(value))
+	return buf.String()
+}
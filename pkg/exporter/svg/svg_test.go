@@ -0,0 +1,171 @@
+package svg_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/components/line"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/signature"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/exporter"
+	"github.com/johnfercher/maroto/v2/pkg/exporter/svg"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	mtesting "github.com/johnfercher/maroto/v2/pkg/testing"
+)
+
+func TestRenderPage(t *testing.T) {
+	cfg := config.NewBuilder().Build()
+
+	t.Run("when page does not exist, should return error", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(text.NewRow(10, "text"))
+
+		// Act
+		bytes, err := svg.RenderPage(m, cfg, 2)
+
+		// Assert
+		assert.ErrorIs(t, err, exporter.ErrPageOutOfRange)
+		assert.Nil(t, bytes)
+	})
+	t.Run("when page has texts, should write text elements in the cells", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(row.New(10).Add(
+			text.NewCol(6, "a < b & c", props.Text{Top: 2, Size: 12, Style: fontstyle.Bold}),
+			text.NewCol(6, "right", props.Text{Align: align.Right, Color: &props.Color{Red: 255}}),
+		))
+
+		// Act
+		bytes, err := svg.RenderPage(m, cfg, 1)
+
+		// Assert
+		assert.Nil(t, err)
+		content := string(bytes)
+		assert.True(t, strings.HasPrefix(content, `<svg xmlns="http://www.w3.org/2000/svg" width="210mm" height="297mm"`))
+		assert.Contains(t, content, `<text x="10" y="16.233" font-family="arial" font-size="4.233" text-anchor="start" `+
+			`font-weight="bold">a &lt; b &amp; c</text>`)
+		assert.Contains(t, content, `<text x="200" y="13.528" font-family="arial" font-size="3.528" text-anchor="end" `+
+			`fill="rgb(255, 0, 0)">right</text>`)
+		assert.True(t, strings.HasSuffix(content, "</svg>\n"))
+	})
+	t.Run("when rows and cols have style, should write rect elements", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(row.New(20).WithStyle(&props.Cell{BackgroundColor: &props.Color{Blue: 255}}).Add(
+			col.New(4).WithStyle(&props.Cell{BorderType: "1", BorderThickness: 0.5}),
+		))
+
+		// Act
+		bytes, err := svg.RenderPage(m, cfg, 1)
+
+		// Assert
+		assert.Nil(t, err)
+		content := string(bytes)
+		assert.Contains(t, content, `<rect x="10" y="10" width="190" height="20" fill="rgb(0, 0, 255)"/>`)
+		assert.Contains(t, content, `<rect x="10" y="10" width="63.333" height="20" fill="none" stroke="black" stroke-width="0.5"/>`)
+	})
+	t.Run("when page has lines, should write line elements", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(line.NewRow(10))
+
+		// Act
+		bytes, err := svg.RenderPage(m, cfg, 1)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, string(bytes), `<line x1="19.5" y1="10.5" x2="190.5" y2="10.5" stroke="black" stroke-width="0.2"/>`)
+	})
+	t.Run("when page has file images, should embed the images", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(image.NewFromFileRow(20, "../../../docs/assets/images/biplane.jpg", props.Rect{Center: true, Percent: 80}))
+
+		// Act
+		bytes, err := svg.RenderPage(m, cfg, 1)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, string(bytes), `<image x="29" y="12" width="152" height="16" preserveAspectRatio="xMidYMid meet" `+
+			`href="data:image/jpeg;base64,/9j/`)
+	})
+	t.Run("when page has codes, should embed the codes as images", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(
+			code.NewBarRow(20, "123456789", props.Barcode{ShowText: true}),
+			code.NewQrRow(20, "https://maroto.io"),
+			code.NewMatrixRow(20, "https://maroto.io"),
+		)
+
+		// Act
+		bytes, err := svg.RenderPage(m, cfg, 1)
+
+		// Assert
+		assert.Nil(t, err)
+		content := string(bytes)
+		assert.Equal(t, 3, strings.Count(content, `href="data:image/jpeg;base64,`))
+		assert.Contains(t, content, `text-anchor="middle">123456789</text>`)
+	})
+	t.Run("when text has cmyk color, should write the rgb approximation", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(text.NewRow(10, "cmyk", props.Text{Color: &props.CMYKColor{Cyan: 100}}))
+
+		// Act
+		bytes, err := svg.RenderPage(m, cfg, 1)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, string(bytes), `fill="rgb(0, 255, 255)">cmyk</text>`)
+	})
+	t.Run("when page has unsupported component, should return error", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(signature.NewRow(20, "signature"))
+
+		// Act
+		bytes, err := svg.RenderPage(m, cfg, 1)
+
+		// Assert
+		assert.ErrorIs(t, err, svg.ErrUnsupportedComponent)
+		assert.Nil(t, bytes)
+	})
+	t.Run("when page is rendered, should not change the document", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(text.NewRow(10, "text"))
+
+		// Act
+		_, err := svg.RenderPage(m, cfg, 1)
+
+		// Assert
+		assert.Nil(t, err)
+		document, err := m.Generate()
+		assert.Nil(t, err)
+		mtesting.AssertPageCount(t, document, 1)
+	})
+	t.Run("when file image cannot be read, should return error", func(t *testing.T) {
+		// Arrange
+		m := maroto.New(cfg)
+		m.AddRows(image.NewFromFileRow(20, "not_found.png"))
+
+		// Act
+		bytes, err := svg.RenderPage(m, nil, 1)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+}
@@ -0,0 +1,304 @@
+// Package flatten implements the flattening of PDF forms.
+package flatten
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+)
+
+// xObjectPrefix is the prefix of the names of the appearances added to the page resources.
+const xObjectPrefix = "MarotoFlat"
+
+// Bytes flattens the form fields of a PDF, the current appearance of each widget is drawn in the content
+// of its page and the widgets and the AcroForm are removed, so the result has no interactive fields.
+// Hidden widgets and widgets without an appearance are removed without drawing anything, while the other
+// annotations, like links, are kept.
+func Bytes(pdf []byte) ([]byte, error) {
+	conf := model.NewDefaultConfiguration()
+	conf.ValidationMode = model.ValidationRelaxed
+
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = api.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	count := 0
+	for page := 1; page <= ctx.PageCount; page++ {
+		if err = flattenPage(ctx, page, &count); err != nil {
+			return nil, fmt.Errorf("flattening page %d: %w", page, err)
+		}
+	}
+
+	root, err := ctx.Catalog()
+	if err != nil {
+		return nil, err
+	}
+
+	delete(root, "AcroForm")
+
+	var buffer bytes.Buffer
+	if err = api.WriteContext(ctx, &buffer); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func flattenPage(ctx *model.Context, page int, count *int) error {
+	pageDict, _, inherited, err := ctx.PageDict(page, false)
+	if err != nil {
+		return err
+	}
+
+	annots, err := ctx.DereferenceArray(pageDict["Annots"])
+	if err != nil || len(annots) == 0 {
+		return err
+	}
+
+	var kept types.Array
+	var content bytes.Buffer
+	xObjects := types.Dict{}
+
+	for _, annot := range annots {
+		annotDict, err := ctx.DereferenceDict(annot)
+		if err != nil {
+			return err
+		}
+
+		if annotDict == nil || annotDict.NameEntry("Subtype") == nil || *annotDict.NameEntry("Subtype") != "Widget" {
+			kept = append(kept, annot)
+			continue
+		}
+
+		ref, matrix, err := getAppearance(ctx, annotDict)
+		if err != nil {
+			return err
+		}
+
+		if ref == nil {
+			continue
+		}
+
+		*count++
+		name := fmt.Sprintf("%s%d", xObjectPrefix, *count)
+		xObjects[name] = *ref
+		fmt.Fprintf(&content, "q %.4f 0 0 %.4f %.4f %.4f cm /%s Do Q\n", matrix[0], matrix[1], matrix[2], matrix[3], name)
+	}
+
+	if len(kept) == 0 {
+		delete(pageDict, "Annots")
+	} else {
+		pageDict["Annots"] = kept
+	}
+
+	if len(xObjects) == 0 {
+		return nil
+	}
+
+	if err = addXObjects(ctx, pageDict, inherited, xObjects); err != nil {
+		return err
+	}
+
+	return appendContent(ctx, pageDict, content.Bytes())
+}
+
+// getAppearance returns the normal appearance of the widget and the scale and translation which fit it in
+// the rect of the widget, the appearance is nil when the widget is hidden or it has no appearance.
+func getAppearance(ctx *model.Context, widget types.Dict) (*types.IndirectRef, [4]float64, error) {
+	var matrix [4]float64
+
+	if flags := widget.IntEntry("F"); flags != nil {
+		if model.AnnotationFlags(*flags)&(model.AnnHidden|model.AnnNoView) != 0 {
+			return nil, matrix, nil
+		}
+	}
+
+	appearances, err := ctx.DereferenceDict(widget["AP"])
+	if err != nil || appearances == nil {
+		return nil, matrix, err
+	}
+
+	normal := appearances["N"]
+	states, err := ctx.DereferenceDict(normal)
+	if err == nil && states != nil {
+		state := widget.NameEntry("AS")
+		if state == nil {
+			return nil, matrix, nil
+		}
+
+		normal = states[*state]
+	}
+
+	ref, ok := normal.(types.IndirectRef)
+	if !ok {
+		return nil, matrix, nil
+	}
+
+	stream, _, err := ctx.DereferenceStreamDict(ref)
+	if err != nil || stream == nil {
+		return nil, matrix, err
+	}
+
+	stream.InsertName("Type", "XObject")
+	stream.InsertName("Subtype", "Form")
+
+	rect, err := getNumbers(ctx, widget["Rect"], 4)
+	if err != nil || rect == nil {
+		return nil, matrix, err
+	}
+
+	bbox, err := getNumbers(ctx, stream.Dict["BBox"], 4)
+	if err != nil {
+		return nil, matrix, err
+	}
+
+	if bbox == nil {
+		bbox = []float64{0, 0, math.Abs(rect[2] - rect[0]), math.Abs(rect[3] - rect[1])}
+	}
+
+	form, err := getNumbers(ctx, stream.Dict["Matrix"], 6)
+	if err != nil {
+		return nil, matrix, err
+	}
+
+	if form == nil {
+		form = []float64{1, 0, 0, 1, 0, 0}
+	}
+
+	return &ref, fit(rect, transform(bbox, form)), nil
+}
+
+// transform returns the bounding box of the bbox transformed by the matrix of the form.
+func transform(bbox, form []float64) [4]float64 {
+	corners := [][2]float64{{bbox[0], bbox[1]}, {bbox[2], bbox[1]}, {bbox[0], bbox[3]}, {bbox[2], bbox[3]}}
+
+	box := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	for _, corner := range corners {
+		x := form[0]*corner[0] + form[2]*corner[1] + form[4]
+		y := form[1]*corner[0] + form[3]*corner[1] + form[5]
+		box = [4]float64{math.Min(box[0], x), math.Min(box[1], y), math.Max(box[2], x), math.Max(box[3], y)}
+	}
+
+	return box
+}
+
+// fit returns the scale and translation which map the box to the rect, as described by the
+// algorithm of the appearance streams of the PDF specification.
+func fit(rect []float64, box [4]float64) [4]float64 {
+	left, bottom := math.Min(rect[0], rect[2]), math.Min(rect[1], rect[3])
+	width, height := math.Abs(rect[2]-rect[0]), math.Abs(rect[3]-rect[1])
+
+	scaleX, scaleY := 1.0, 1.0
+	if boxWidth := box[2] - box[0]; boxWidth > 0 {
+		scaleX = width / boxWidth
+	}
+
+	if boxHeight := box[3] - box[1]; boxHeight > 0 {
+		scaleY = height / boxHeight
+	}
+
+	return [4]float64{scaleX, scaleY, left - box[0]*scaleX, bottom - box[1]*scaleY}
+}
+
+func addXObjects(ctx *model.Context, pageDict types.Dict, inherited *model.InheritedPageAttrs, xObjects types.Dict) error {
+	resources, err := ctx.DereferenceDict(pageDict["Resources"])
+	if err != nil {
+		return err
+	}
+
+	if resources == nil {
+		resources = types.Dict{}
+		if inherited != nil && inherited.Resources != nil {
+			resources = inherited.Resources.Clone().(types.Dict)
+		}
+
+		pageDict["Resources"] = resources
+	}
+
+	pageXObjects, err := ctx.DereferenceDict(resources["XObject"])
+	if err != nil {
+		return err
+	}
+
+	if pageXObjects == nil {
+		pageXObjects = types.Dict{}
+		resources["XObject"] = pageXObjects
+	}
+
+	for name, ref := range xObjects {
+		pageXObjects[name] = ref
+	}
+
+	return nil
+}
+
+// appendContent wraps the current content of the page in a saved graphics state and appends the content,
+// so the appearances are drawn with the default graphics state over the page.
+func appendContent(ctx *model.Context, pageDict types.Dict, content []byte) error {
+	var contents types.Array
+
+	switch current := pageDict["Contents"].(type) {
+	case types.IndirectRef:
+		contents = types.Array{current}
+	case types.Array:
+		contents = current
+	case nil:
+	default:
+		return fmt.Errorf("unexpected page contents %T", current)
+	}
+
+	save, err := newContentRef(ctx, []byte("q\n"))
+	if err != nil {
+		return err
+	}
+
+	restore, err := newContentRef(ctx, append([]byte("Q\n"), content...))
+	if err != nil {
+		return err
+	}
+
+	pageDict["Contents"] = append(append(types.Array{*save}, contents...), *restore)
+
+	return nil
+}
+
+func newContentRef(ctx *model.Context, content []byte) (*types.IndirectRef, error) {
+	stream, err := ctx.NewStreamDictForBuf(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = stream.Encode(); err != nil {
+		return nil, err
+	}
+
+	return ctx.IndRefForNewObject(*stream)
+}
+
+func getNumbers(ctx *model.Context, obj types.Object, n int) ([]float64, error) {
+	array, err := ctx.DereferenceArray(obj)
+	if err != nil || len(array) != n {
+		return nil, err
+	}
+
+	numbers := make([]float64, n)
+	for i, value := range array {
+		number, err := ctx.DereferenceNumber(value)
+		if err != nil {
+			return nil, err
+		}
+
+		numbers[i] = number
+	}
+
+	return numbers, nil
+}
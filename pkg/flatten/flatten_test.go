@@ -0,0 +1,125 @@
+package flatten_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/flatten"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestBytes(t *testing.T) {
+	t.Run("when bytes are not a pdf, should return error", func(t *testing.T) {
+		// Act
+		b, err := flatten.Bytes([]byte{1, 2, 3})
+
+		// Assert
+		assert.Nil(t, b)
+		assert.NotNil(t, err)
+	})
+	t.Run("when pdf has fields without appearance, should remove the fields", func(t *testing.T) {
+		// Arrange
+		m := maroto.New()
+		m.AddRows(row.New(10).Add(
+			col.New(12).WithID("total").WithStyle(&props.Cell{HoverColor: &props.Color{Red: 200}}).Add(text.New("total")),
+		))
+		doc, _ := m.Generate()
+
+		// Act
+		b, err := flatten.Bytes(doc.GetBytes())
+
+		// Assert
+		assert.Nil(t, err)
+		ctx := readContext(t, b)
+		root, _ := ctx.Catalog()
+		assert.Nil(t, root["AcroForm"])
+		pageDict, _, _, _ := ctx.PageDict(1, false)
+		assert.Nil(t, pageDict["Annots"])
+	})
+	t.Run("when pdf has fields with appearance, should draw the appearance in the page", func(t *testing.T) {
+		// Arrange
+		m := maroto.New()
+		m.AddRows(text.NewRow(10, "signed by"))
+		doc, _ := m.Generate()
+		pdf := addWidget(t, doc.GetBytes(), []byte("0 0 1 rg 0 0 100 20 re f"))
+
+		// Act
+		b, err := flatten.Bytes(pdf)
+
+		// Assert
+		assert.Nil(t, err)
+		ctx := readContext(t, b)
+		root, _ := ctx.Catalog()
+		assert.Nil(t, root["AcroForm"])
+
+		pageDict, _, _, _ := ctx.PageDict(1, false)
+		assert.Nil(t, pageDict["Annots"])
+
+		resources, _ := ctx.DereferenceDict(pageDict["Resources"])
+		xObjects, _ := ctx.DereferenceDict(resources["XObject"])
+		assert.NotNil(t, xObjects["MarotoFlat1"])
+
+		content, _ := ctx.PageContent(pageDict)
+		assert.Contains(t, string(content), "q 2.0000 0 0 2.0000 50.0000 700.0000 cm /MarotoFlat1 Do Q")
+	})
+}
+
+// addWidget adds a text field to the first page with the appearance in a 100x20 bbox drawn in a 200x40 rect.
+func addWidget(t *testing.T, pdf []byte, appearance []byte) []byte {
+	ctx := readContext(t, pdf)
+
+	stream, _ := ctx.NewStreamDictForBuf(appearance)
+	stream.InsertName("Type", "XObject")
+	stream.InsertName("Subtype", "Form")
+	stream.Insert("BBox", types.NewNumberArray(0, 0, 100, 20))
+	_ = stream.Encode()
+	streamRef, _ := ctx.IndRefForNewObject(*stream)
+
+	pageDict, pageRef, _, _ := ctx.PageDict(1, false)
+	widgetRef, _ := ctx.IndRefForNewObject(types.Dict{
+		"Type":    types.Name("Annot"),
+		"Subtype": types.Name("Widget"),
+		"FT":      types.Name("Tx"),
+		"T":       types.StringLiteral("signature"),
+		"DA":      types.StringLiteral("/Helv 0 Tf 0 g"),
+		"P":       *pageRef,
+		"Rect":    types.NewNumberArray(50, 700, 250, 740),
+		"AP":      types.Dict{"N": *streamRef},
+	})
+	pageDict["Annots"] = types.Array{*widgetRef}
+
+	root, _ := ctx.Catalog()
+	root["AcroForm"] = types.Dict{"Fields": types.Array{*widgetRef}}
+
+	var buffer bytes.Buffer
+	if err := api.WriteContext(ctx, &buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	return buffer.Bytes()
+}
+
+func readContext(t *testing.T, pdf []byte) *model.Context {
+	conf := model.NewDefaultConfiguration()
+	conf.ValidationMode = model.ValidationRelaxed
+
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = api.ValidateContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	return ctx
+}
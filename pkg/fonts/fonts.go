@@ -0,0 +1,35 @@
+// Package fonts contains open-licensed fonts embedded, ready to be used with config.Builder WithCustomFonts.
+package fonts
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/fonts/notosans"
+	"github.com/johnfercher/maroto/v2/pkg/fonts/robotomono"
+)
+
+const (
+	// NotoSansFamily is the family name used to register Noto Sans.
+	NotoSansFamily = "notosans"
+	// RobotoMonoFamily is the family name used to register Roboto Mono.
+	RobotoMonoFamily = "robotomono"
+)
+
+// NotoSans returns all the styles of Noto Sans, licensed under the SIL Open Font License 1.1.
+func NotoSans() []*entity.CustomFont {
+	return newFamily(NotoSansFamily, notosans.Regular, notosans.Bold, notosans.Italic, notosans.BoldItalic)
+}
+
+// RobotoMono returns all the styles of Roboto Mono, licensed under the Apache License 2.0.
+func RobotoMono() []*entity.CustomFont {
+	return newFamily(RobotoMonoFamily, robotomono.Regular, robotomono.Bold, robotomono.Italic, robotomono.BoldItalic)
+}
+
+func newFamily(family string, normal, bold, italic, boldItalic []byte) []*entity.CustomFont {
+	return []*entity.CustomFont{
+		{Family: family, Style: fontstyle.Normal, Bytes: normal},
+		{Family: family, Style: fontstyle.Bold, Bytes: bold},
+		{Family: family, Style: fontstyle.Italic, Bytes: italic},
+		{Family: family, Style: fontstyle.BoldItalic, Bytes: boldItalic},
+	}
+}
@@ -0,0 +1,56 @@
+package fonts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/fonts"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestNotoSans(t *testing.T) {
+	// Act
+	sut := fonts.NotoSans()
+
+	// Assert
+	assertFamily(t, fonts.NotoSansFamily, sut)
+}
+
+func TestRobotoMono(t *testing.T) {
+	// Act
+	sut := fonts.RobotoMono()
+
+	// Assert
+	assertFamily(t, fonts.RobotoMonoFamily, sut)
+}
+
+func assertFamily(t *testing.T, family string, customFonts []*entity.CustomFont) {
+	styles := []fontstyle.Type{fontstyle.Normal, fontstyle.Bold, fontstyle.Italic, fontstyle.BoldItalic}
+	assert.Len(t, customFonts, len(styles))
+
+	for i, customFont := range customFonts {
+		assert.Equal(t, family, customFont.Family)
+		assert.Equal(t, styles[i], customFont.Style)
+		assert.NotEmpty(t, customFont.Bytes)
+	}
+
+	cfg := config.NewBuilder().
+		WithCustomFonts(customFonts).
+		WithDefaultFont(&props.Font{Family: family}).
+		Build()
+
+	m := maroto.New(cfg)
+	for _, style := range styles {
+		m.AddRows(text.NewRow(10, "Maroto ãé", props.Text{Style: style}))
+	}
+
+	doc, err := m.Generate()
+	assert.Nil(t, err)
+	assert.NotEmpty(t, doc.GetBytes())
+}
@@ -0,0 +1,22 @@
+// Package notosans embeds the TrueType files of Noto Sans, licensed under the SIL Open Font License 1.1. It's kept apart from
+// package fonts so the files are only added to the binaries which use them.
+package notosans
+
+import (
+	_ "embed"
+)
+
+var (
+	// Regular is the normal style of Noto Sans.
+	//go:embed NotoSans-Regular.ttf
+	Regular []byte
+	// Bold is the bold style of Noto Sans.
+	//go:embed NotoSans-Bold.ttf
+	Bold []byte
+	// Italic is the italic style of Noto Sans.
+	//go:embed NotoSans-Italic.ttf
+	Italic []byte
+	// BoldItalic is the bold italic style of Noto Sans.
+	//go:embed NotoSans-BoldItalic.ttf
+	BoldItalic []byte
+)
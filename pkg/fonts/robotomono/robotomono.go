@@ -0,0 +1,22 @@
+// Package robotomono embeds the TrueType files of Roboto Mono, licensed under the Apache License 2.0. It's kept apart from
+// package fonts so the files are only added to the binaries which use them.
+package robotomono
+
+import (
+	_ "embed"
+)
+
+var (
+	// Regular is the normal style of Roboto Mono.
+	//go:embed RobotoMono-Regular.ttf
+	Regular []byte
+	// Bold is the bold style of Roboto Mono.
+	//go:embed RobotoMono-Bold.ttf
+	Bold []byte
+	// Italic is the italic style of Roboto Mono.
+	//go:embed RobotoMono-Italic.ttf
+	Italic []byte
+	// BoldItalic is the bold italic style of Roboto Mono.
+	//go:embed RobotoMono-BoldItalic.ttf
+	BoldItalic []byte
+)
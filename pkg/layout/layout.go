@@ -0,0 +1,114 @@
+// Package layout implements helpers to create rows with common column layouts.
+package layout
+
+import (
+	"fmt"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// FullWidth is responsible to create a Row with one column filling the whole width.
+func FullWidth(component core.Component, height float64) core.Row {
+	return EqualColumns(height, component)
+}
+
+// TwoColumnEqual is responsible to create a Row split in two columns with the same width.
+func TwoColumnEqual(left, right core.Component, height float64) core.Row {
+	return EqualColumns(height, left, right)
+}
+
+// ThreeColumn is responsible to create a Row split in three columns with the same width.
+func ThreeColumn(left, center, right core.Component, height float64) core.Row {
+	return EqualColumns(height, left, center, right)
+}
+
+// EqualColumns is responsible to create a Row with one column with the same width for each component.
+// The sizes are computed from the MaxGridSize of the config, when it's not divisible by the quantity
+// of components, the remainder is distributed so the columns always fill the whole row.
+func EqualColumns(height float64, components ...core.Component) core.Row {
+	r := row.New(height)
+	for i, component := range components {
+		r.Add(newFraction(i, len(components), component))
+	}
+
+	return r
+}
+
+// fraction is a col which size is the slice [index, index+1) of the grid divided in total parts.
+type fraction struct {
+	core.Col
+	index  int
+	total  int
+	config *entity.Config
+}
+
+func newFraction(index, total int, component core.Component) core.Col {
+	return &fraction{
+		Col:   col.New().Add(component),
+		index: index,
+		total: total,
+	}
+}
+
+// Add is responsible to add a component to the col.
+func (f *fraction) Add(components ...core.Component) core.Col {
+	f.Col.Add(components...)
+	return f
+}
+
+// WithStyle sets the style for the col.
+func (f *fraction) WithStyle(style *props.Cell) core.Col {
+	f.Col.WithStyle(style)
+	return f
+}
+
+// WithID sets the id for the col.
+func (f *fraction) WithID(id string) core.Col {
+	f.Col.WithID(id)
+	return f
+}
+
+// Span sets how many col slots of the row the col occupies.
+func (f *fraction) Span(n int) core.Col {
+	f.Col.Span(n)
+	return f
+}
+
+// GetSize returns the size of the col in the grid of the config.
+func (f *fraction) GetSize() int {
+	maxGridSize := f.config.MaxGridSize
+	return maxGridSize*(f.index+1)/f.total - maxGridSize*f.index/f.total
+}
+
+// GetStructure returns the Structure of the col.
+func (f *fraction) GetStructure() *node.Node[core.Structure] {
+	inner := f.Col.GetStructure()
+	str := inner.GetData()
+
+	details := make(map[string]interface{})
+	for key, value := range str.Details {
+		details[key] = value
+	}
+	delete(details, "is_max")
+	details["fraction"] = fmt.Sprintf("1/%d", f.total)
+	str.Details = details
+
+	n := node.New(str)
+	for _, next := range inner.GetNexts() {
+		n.AddNext(next)
+	}
+
+	return n
+}
+
+// SetConfig sets the config for the col and its components.
+func (f *fraction) SetConfig(config *entity.Config) {
+	f.config = config
+	f.Col.SetConfig(config)
+}
@@ -0,0 +1,84 @@
+package layout_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/layout"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestFullWidth(t *testing.T) {
+	// Act
+	sut := layout.FullWidth(text.New("full"), 10)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/layouts/full_width.json")
+}
+
+func TestTwoColumnEqual(t *testing.T) {
+	// Act
+	sut := layout.TwoColumnEqual(text.New("left"), text.New("right"), 10)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/layouts/two_column_equal.json")
+}
+
+func TestThreeColumn(t *testing.T) {
+	// Act
+	sut := layout.ThreeColumn(text.New("left"), text.New("center"), text.New("right"), 10)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/layouts/three_column.json")
+}
+
+func TestEqualColumns(t *testing.T) {
+	t.Run("when grid is divisible, should render columns with the same width", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{MaxGridSize: 12}
+		cell := entity.Cell{X: 0, Y: 0, Width: 120, Height: 10}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().CreateCol(40.0, 10.0, cfg, (*props.Cell)(nil)).Times(3)
+		provider.EXPECT().CreateRow(10.0)
+
+		left, center, right := mocks.NewComponent(t), mocks.NewComponent(t), mocks.NewComponent(t)
+		for _, component := range []*mocks.Component{left, center, right} {
+			component.EXPECT().SetConfig(cfg)
+		}
+		left.EXPECT().Render(provider, &entity.Cell{X: 0, Y: 0, Width: 40, Height: 10})
+		center.EXPECT().Render(provider, &entity.Cell{X: 40, Y: 0, Width: 40, Height: 10})
+		right.EXPECT().Render(provider, &entity.Cell{X: 80, Y: 0, Width: 40, Height: 10})
+
+		sut := layout.EqualColumns(10, left, center, right)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+	})
+	t.Run("when grid is not divisible, should fill the whole row", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{MaxGridSize: 13}
+		cell := entity.Cell{X: 0, Y: 0, Width: 130, Height: 10}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().CreateCol(60.0, 10.0, cfg, (*props.Cell)(nil))
+		provider.EXPECT().CreateCol(70.0, 10.0, cfg, (*props.Cell)(nil))
+		provider.EXPECT().CreateRow(10.0)
+
+		left, right := mocks.NewComponent(t), mocks.NewComponent(t)
+		left.EXPECT().SetConfig(cfg)
+		right.EXPECT().SetConfig(cfg)
+		left.EXPECT().Render(provider, &entity.Cell{X: 0, Y: 0, Width: 60, Height: 10})
+		right.EXPECT().Render(provider, &entity.Cell{X: 60, Y: 0, Width: 70, Height: 10})
+
+		sut := layout.TwoColumnEqual(left, right, 10)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+	})
+}
@@ -0,0 +1,162 @@
+// Package pool implements the strategies used to distribute the pages between the workers on concurrent generation.
+package pool
+
+import (
+	"sync"
+)
+
+// stealingTasksPerWorker is the quantity of tasks created for each worker on WorkStealing,
+// smaller tasks allow idle workers to steal from the busy ones.
+const stealingTasksPerWorker = 4
+
+// Strategy defines how the tasks are split and distributed between the workers.
+type Strategy interface {
+	// GetTaskSize returns the quantity of items processed by each task.
+	GetTaskSize(items, workers int) int
+	// Run calls process for each task, from 0 to tasks-1, on the workers and returns when all tasks are processed.
+	// The worker is the ID of the worker processing the task, from 0 to workers-1.
+	Run(workers, tasks int, process func(worker, task int))
+	// String returns the name of the strategy.
+	String() string
+}
+
+var (
+	// FIFO splits the items in one task for each worker, the tasks are processed in submission order.
+	FIFO Strategy = &fifo{}
+	// WorkStealing splits the items in many small tasks, each worker has its own deque of tasks and,
+	// when its deque is empty, steals the oldest tasks of the other workers. It improves the CPU
+	// utilization when some pages are much more expensive than others.
+	WorkStealing Strategy = &workStealing{}
+)
+
+type fifo struct{}
+
+func (f *fifo) GetTaskSize(items, workers int) int {
+	return max(items/max(workers, 1), 1)
+}
+
+func (f *fifo) Run(workers, tasks int, process func(worker, task int)) {
+	queue := make(chan int, tasks)
+	for task := 0; task < tasks; task++ {
+		queue <- task
+	}
+	close(queue)
+
+	var wg sync.WaitGroup
+	for i := 0; i < min(workers, tasks); i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for task := range queue {
+				process(id, task)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func (f *fifo) String() string {
+	return "fifo"
+}
+
+type workStealing struct{}
+
+func (w *workStealing) GetTaskSize(items, workers int) int {
+	return max(items/(max(workers, 1)*stealingTasksPerWorker), 1)
+}
+
+func (w *workStealing) Run(workers, tasks int, process func(worker, task int)) {
+	workers = min(workers, tasks)
+	if workers <= 0 {
+		return
+	}
+
+	deques := make([]*deque, workers)
+	for i := range deques {
+		deques[i] = &deque{}
+	}
+
+	for task := 0; task < tasks; task++ {
+		deques[task*workers/tasks].pushBottom(task)
+	}
+
+	var wg sync.WaitGroup
+	for i := range deques {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for {
+				task, ok := deques[id].popBottom()
+				if !ok {
+					task, ok = steal(deques, id)
+				}
+
+				if !ok {
+					return
+				}
+
+				process(id, task)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func (w *workStealing) String() string {
+	return "work_stealing"
+}
+
+// steal takes the oldest task of the first other worker which still has tasks. As tasks are
+// never added after Run starts, a worker stops when there is nothing left to steal.
+func steal(deques []*deque, id int) (int, bool) {
+	for i := 1; i < len(deques); i++ {
+		if task, ok := deques[(id+i)%len(deques)].popTop(); ok {
+			return task, true
+		}
+	}
+
+	return 0, false
+}
+
+// deque is a double-ended queue of tasks, the owner works on the bottom and the thieves on the top.
+type deque struct {
+	mutex sync.Mutex
+	tasks []int
+}
+
+func (d *deque) pushBottom(task int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.tasks = append(d.tasks, task)
+}
+
+func (d *deque) popBottom() (int, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.tasks) == 0 {
+		return 0, false
+	}
+
+	task := d.tasks[len(d.tasks)-1]
+	d.tasks = d.tasks[:len(d.tasks)-1]
+
+	return task, true
+}
+
+func (d *deque) popTop() (int, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.tasks) == 0 {
+		return 0, false
+	}
+
+	task := d.tasks[0]
+	d.tasks = d.tasks[1:]
+
+	return task, true
+}
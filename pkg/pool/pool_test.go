@@ -0,0 +1,98 @@
+package pool_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/pool"
+)
+
+func TestFIFO(t *testing.T) {
+	t.Run("when getting task size, should create one task for each worker", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, 3, pool.FIFO.GetTaskSize(10, 3))
+		assert.Equal(t, 1, pool.FIFO.GetTaskSize(2, 7))
+		assert.Equal(t, "fifo", pool.FIFO.String())
+	})
+	t.Run("when there is one worker, should process tasks in submission order", func(t *testing.T) {
+		// Arrange
+		var processed []int
+
+		// Act
+		pool.FIFO.Run(1, 5, func(_, task int) {
+			processed = append(processed, task)
+		})
+
+		// Assert
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, processed)
+	})
+	t.Run("when there are many workers, should process each task once", func(t *testing.T) {
+		assertProcessedOnce(t, pool.FIFO, 4, 50)
+	})
+}
+
+func TestWorkStealing(t *testing.T) {
+	t.Run("when getting task size, should create many tasks for each worker", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, 2, pool.WorkStealing.GetTaskSize(20, 2))
+		assert.Equal(t, 1, pool.WorkStealing.GetTaskSize(5, 2))
+		assert.Equal(t, "work_stealing", pool.WorkStealing.String())
+	})
+	t.Run("when there are many workers, should process each task once", func(t *testing.T) {
+		assertProcessedOnce(t, pool.WorkStealing, 4, 50)
+	})
+	t.Run("when there are no tasks, should return", func(t *testing.T) {
+		// Act & Assert
+		pool.WorkStealing.Run(4, 0, func(int, int) {
+			t.Fail()
+		})
+	})
+	t.Run("when a worker is blocked, should steal its tasks", func(t *testing.T) {
+		// Arrange
+		var first atomic.Bool
+		var others sync.WaitGroup
+		others.Add(3)
+		done := make(chan struct{})
+
+		// Act
+		go func() {
+			pool.WorkStealing.Run(2, 4, func(int, int) {
+				if first.CompareAndSwap(false, true) {
+					others.Wait()
+					return
+				}
+				others.Done()
+			})
+			close(done)
+		}()
+
+		// Assert
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("tasks of the blocked worker were not stolen")
+		}
+	})
+}
+
+func assertProcessedOnce(t *testing.T, strategy pool.Strategy, workers, tasks int) {
+	// Arrange
+	counts := make([]int32, tasks)
+	workerIDs := make([]int32, tasks)
+
+	// Act
+	strategy.Run(workers, tasks, func(worker, task int) {
+		atomic.AddInt32(&counts[task], 1)
+		atomic.StoreInt32(&workerIDs[task], int32(worker))
+	})
+
+	// Assert
+	for task, count := range counts {
+		assert.Equal(t, int32(1), count, task)
+		assert.True(t, workerIDs[task] >= 0 && workerIDs[task] < int32(workers), task)
+	}
+}
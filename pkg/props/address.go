@@ -0,0 +1,106 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+// Address represents properties from an Address inside a cell.
+type Address struct {
+	// Family of the text, ex: constf.Arial, helvetica and etc.
+	Family string
+	// Style of the text, ex: constf.Normal, bold and etc.
+	Style fontstyle.Type
+	// Size of the text.
+	Size float64
+	// Color define the font color.
+	Color *Color
+	// Align of the text.
+	Align align.Type
+	// LineSpacing define the space between lines, 1 is the height of the font and 2 doubles it.
+	LineSpacing float64
+}
+
+// ToMap returns a map with the Address fields.
+func (a *Address) ToMap() map[string]interface{} {
+	if a == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if a.Family != "" {
+		m["prop_font_family"] = a.Family
+	}
+
+	if a.Style != "" {
+		m["prop_font_style"] = a.Style
+	}
+
+	if a.Size != 0 {
+		m["prop_font_size"] = a.Size
+	}
+
+	if a.Color != nil {
+		m["prop_color"] = a.Color.ToString()
+	}
+
+	if a.Align != "" {
+		m["prop_align"] = a.Align
+	}
+
+	if a.LineSpacing != 0 {
+		m["prop_line_spacing"] = a.LineSpacing
+	}
+
+	return m
+}
+
+// MakeValid from Address define default values for an Address.
+func (a *Address) MakeValid(defaultFamily string) {
+	if a.Family == "" {
+		a.Family = defaultFamily
+	}
+
+	if a.Style == "" {
+		a.Style = fontstyle.Normal
+	}
+
+	if a.Size == 0.0 {
+		a.Size = 10.0
+	}
+
+	if a.Align == "" {
+		a.Align = align.Left
+	}
+
+	if a.LineSpacing <= 0 {
+		a.LineSpacing = 1.0
+	}
+}
+
+// ToFontProp returns the props.Font used to measure the Address lines.
+func (a *Address) ToFontProp() *Font {
+	return &Font{
+		Family: a.Family,
+		Style:  a.Style,
+		Size:   a.Size,
+		Color:  a.Color,
+	}
+}
+
+// ToTextProp returns the props.Text used to write an Address line.
+func (a *Address) ToTextProp(top float64) *Text {
+	textProp := &Text{
+		Family: a.Family,
+		Style:  a.Style,
+		Size:   a.Size,
+		Color:  a.Color,
+		Align:  a.Align,
+		Top:    top,
+	}
+
+	textProp.MakeValid(a.ToFontProp())
+
+	return textProp
+}
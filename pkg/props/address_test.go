@@ -0,0 +1,74 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestAddress_ToMap(t *testing.T) {
+	t.Run("when address is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Address
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when address is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.AddressProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+		assert.Equal(t, fontstyle.Bold, m["prop_font_style"])
+		assert.Equal(t, 12.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, align.Center, m["prop_align"])
+		assert.Equal(t, 1.5, m["prop_line_spacing"])
+	})
+}
+
+func TestAddress_MakeValid(t *testing.T) {
+	// Arrange
+	sut := props.Address{}
+
+	// Act
+	sut.MakeValid(fontfamily.Courier)
+
+	// Assert
+	assert.Equal(t, fontfamily.Courier, sut.Family)
+	assert.Equal(t, fontstyle.Normal, sut.Style)
+	assert.Equal(t, 10.0, sut.Size)
+	assert.Equal(t, align.Left, sut.Align)
+	assert.Equal(t, 1.0, sut.LineSpacing)
+}
+
+func TestAddress_ToTextProp(t *testing.T) {
+	// Arrange
+	sut := fixture.AddressProp()
+
+	// Act
+	textProp := sut.ToTextProp(5)
+
+	// Assert
+	assert.Equal(t, sut.Family, textProp.Family)
+	assert.Equal(t, sut.Style, textProp.Style)
+	assert.Equal(t, sut.Size, textProp.Size)
+	assert.Equal(t, sut.Color, textProp.Color)
+	assert.Equal(t, sut.Align, textProp.Align)
+	assert.Equal(t, 5.0, textProp.Top)
+	assert.Equal(t, breakline.EmptySpaceStrategy, textProp.BreakLineStrategy)
+}
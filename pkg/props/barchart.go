@@ -0,0 +1,83 @@
+package props
+
+// BarChart represents properties from a BarChart inside a cell.
+type BarChart struct {
+	// Horizontal define if the bars grow from left to right, with the categories on the vertical axis.
+	Horizontal bool
+	// Stacked define if the values of the series are stacked in a single bar by category, instead of side by side.
+	Stacked bool
+	// ShowValues define if the values are written at the end of the bars, the totals when stacked.
+	ShowValues bool
+	// Categories define the labels of the category axis, the position of each label is the index of the values.
+	Categories []string
+	// AxisColor define the color of the axis lines.
+	AxisColor *Color
+	// LabelPercent define how much of the width is reserved to the labels on the left of the chart,
+	// the values of the axis when vertical and the categories when horizontal.
+	LabelPercent float64
+	// LegendPercent define how much of the width is reserved to the legend on the right of the chart.
+	LegendPercent float64
+	// Font define the font used to write the labels, values and legend.
+	Font Font
+}
+
+// ToMap returns a map with the BarChart fields.
+func (b *BarChart) ToMap() map[string]interface{} {
+	if b == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if b.Horizontal {
+		m["prop_horizontal"] = b.Horizontal
+	}
+
+	if b.Stacked {
+		m["prop_stacked"] = b.Stacked
+	}
+
+	if b.ShowValues {
+		m["prop_show_values"] = b.ShowValues
+	}
+
+	if len(b.Categories) > 0 {
+		m["prop_categories"] = b.Categories
+	}
+
+	if b.AxisColor != nil {
+		m["prop_axis_color"] = b.AxisColor.ToString()
+	}
+
+	if b.LabelPercent != 0 {
+		m["prop_label_percent"] = b.LabelPercent
+	}
+
+	if b.LegendPercent != 0 {
+		m["prop_legend_percent"] = b.LegendPercent
+	}
+
+	return b.Font.AppendMap(m)
+}
+
+// MakeValid from BarChart define default values for a BarChart.
+func (b *BarChart) MakeValid(defaultFamily string) {
+	if b.AxisColor == nil {
+		b.AxisColor = &BlackColor
+	}
+
+	if b.LabelPercent <= 0 || b.LabelPercent >= 100 {
+		b.LabelPercent = 15
+	}
+
+	if b.LegendPercent <= 0 || b.LegendPercent >= 100 {
+		b.LegendPercent = 20
+	}
+
+	if b.LabelPercent+b.LegendPercent >= 100 {
+		b.LabelPercent = 15
+		b.LegendPercent = 20
+	}
+
+	b.Font.MakeValid(defaultFamily)
+}
@@ -0,0 +1,95 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestBarChart_ToMap(t *testing.T) {
+	t.Run("when bar chart is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.BarChart
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when bar chart is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.BarChartProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, true, m["prop_horizontal"])
+		assert.Equal(t, true, m["prop_stacked"])
+		assert.Equal(t, true, m["prop_show_values"])
+		assert.Equal(t, []string{"Q1", "Q2"}, m["prop_categories"])
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_axis_color"])
+		assert.Equal(t, 25.0, m["prop_label_percent"])
+		assert.Equal(t, 30.0, m["prop_legend_percent"])
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+	})
+}
+
+func TestBarChart_MakeValid(t *testing.T) {
+	t.Run("when axis color is nil, should apply black", func(t *testing.T) {
+		// Arrange
+		prop := props.BarChart{}
+
+		// Act
+		prop.MakeValid(fontfamily.Arial)
+
+		// Assert
+		assert.Equal(t, &props.BlackColor, prop.AxisColor)
+	})
+	t.Run("when percents are invalid, should apply defaults", func(t *testing.T) {
+		// Arrange
+		prop := props.BarChart{
+			LabelPercent:  -1,
+			LegendPercent: 100,
+		}
+
+		// Act
+		prop.MakeValid(fontfamily.Arial)
+
+		// Assert
+		assert.Equal(t, 15.0, prop.LabelPercent)
+		assert.Equal(t, 20.0, prop.LegendPercent)
+	})
+	t.Run("when percents together fill the width, should apply defaults", func(t *testing.T) {
+		// Arrange
+		prop := props.BarChart{
+			LabelPercent:  60,
+			LegendPercent: 40,
+		}
+
+		// Act
+		prop.MakeValid(fontfamily.Arial)
+
+		// Assert
+		assert.Equal(t, 15.0, prop.LabelPercent)
+		assert.Equal(t, 20.0, prop.LegendPercent)
+	})
+	t.Run("when font is empty, should apply default font", func(t *testing.T) {
+		// Arrange
+		prop := props.BarChart{}
+
+		// Act
+		prop.MakeValid(fontfamily.Courier)
+
+		// Assert
+		assert.Equal(t, fontfamily.Courier, prop.Font.Family)
+		assert.Equal(t, fontstyle.Normal, prop.Font.Style)
+		assert.Equal(t, 8.0, prop.Font.Size)
+	})
+}
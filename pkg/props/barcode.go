@@ -1,5 +1,12 @@
 package props
 
+import "github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+
+const (
+	ean13DataLength = 12
+	upcaDataLength  = 11
+)
+
 // Barcode represents properties from a barcode inside a cell.
 type Barcode struct {
 	// Left is the space between the left cell boundary to the barcode, if center is false.
@@ -15,6 +22,11 @@ type Barcode struct {
 	Proportion Proportion
 	// Center define that the barcode will be vertically and horizontally centralized.
 	Center bool
+	// Type define the barcode symbology, when empty Code 128 is used.
+	Type barcode.Type
+	// ShowText define that the code is written below the bars with the default font, the check digit
+	// of EAN-13 and UPC-A codes is included.
+	ShowText bool
 }
 
 // ToMap from Barcode will return a map representation from Barcode.
@@ -49,9 +61,48 @@ func (b *Barcode) ToMap() map[string]interface{} {
 		m["prop_center"] = b.Center
 	}
 
+	if b.Type != "" {
+		m["prop_type"] = b.Type
+	}
+
+	if b.ShowText {
+		m["prop_show_text"] = b.ShowText
+	}
+
 	return m
 }
 
+// GetText returns the code written below the bars when ShowText is true, the check digit is added
+// to the EAN-13 and UPC-A codes sent without it.
+func (b *Barcode) GetText(code string) string {
+	if (b.Type == barcode.EAN13 && len(code) == ean13DataLength) || (b.Type == barcode.UPCA && len(code) == upcaDataLength) {
+		if digit, ok := getCheckDigit(code); ok {
+			return code + string(digit)
+		}
+	}
+
+	return code
+}
+
+// getCheckDigit returns the GS1 check digit of the code, false when the code has a character which is not a digit.
+func getCheckDigit(code string) (rune, bool) {
+	sum := 0
+	for i := 0; i < len(code); i++ {
+		digit := code[len(code)-1-i]
+		if digit < '0' || digit > '9' {
+			return 0, false
+		}
+
+		weight := 1
+		if i%2 == 0 {
+			weight = 3
+		}
+		sum += int(digit-'0') * weight
+	}
+
+	return rune('0' + (10-sum%10)%10), true
+}
+
 // ToRectProp from Barcode will return a Rect representation from Barcode.
 func (b *Barcode) ToRectProp() *Rect {
 	return &Rect{
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
@@ -24,6 +25,7 @@ func TestBarcode_ToMap(t *testing.T) {
 		// Arrange
 		sut := fixture.BarcodeProp()
 		sut.Center = true
+		sut.Type = barcode.Codabar
 
 		// Act
 		m := sut.ToMap()
@@ -35,6 +37,18 @@ func TestBarcode_ToMap(t *testing.T) {
 		assert.Equal(t, 16.0, m["prop_proportion_width"])
 		assert.Equal(t, 3.2, m["prop_proportion_height"])
 		assert.Equal(t, true, m["prop_center"])
+		assert.Equal(t, barcode.Codabar, m["prop_type"])
+	})
+	t.Run("when barcode shows the text, should return map with show text", func(t *testing.T) {
+		// Arrange
+		sut := fixture.BarcodeProp()
+		sut.ShowText = true
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, true, m["prop_show_text"])
 	})
 }
 
@@ -175,3 +189,56 @@ func TestBarcode_ToRectProp(t *testing.T) {
 	assert.Equal(t, prop.Percent, rect.Percent)
 	assert.Equal(t, prop.Center, rect.Center)
 }
+
+func TestBarcode_GetText(t *testing.T) {
+	t.Run("when type is code128, should return the code", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{Type: barcode.Code128}
+
+		// Act
+		text := prop.GetText("400638133393")
+
+		// Assert
+		assert.Equal(t, "400638133393", text)
+	})
+	t.Run("when type is ean13 and code has no check digit, should add the check digit", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{Type: barcode.EAN13}
+
+		// Act
+		text := prop.GetText("400638133393")
+
+		// Assert
+		assert.Equal(t, "4006381333931", text)
+	})
+	t.Run("when type is ean13 and code has the check digit, should return the code", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{Type: barcode.EAN13}
+
+		// Act
+		text := prop.GetText("4006381333931")
+
+		// Assert
+		assert.Equal(t, "4006381333931", text)
+	})
+	t.Run("when type is upca and code has no check digit, should add the check digit", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{Type: barcode.UPCA}
+
+		// Act
+		text := prop.GetText("03600029145")
+
+		// Assert
+		assert.Equal(t, "036000291452", text)
+	})
+	t.Run("when type is upca and code has letters, should return the code", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{Type: barcode.UPCA}
+
+		// Act
+		text := prop.GetText("0360002914A")
+
+		// Assert
+		assert.Equal(t, "0360002914A", text)
+	})
+}
@@ -7,11 +7,22 @@ import (
 
 // Cell is the representation of a cell in the grid system.
 type Cell struct {
-	BackgroundColor *Color
-	BorderColor     *Color
+	// BackgroundColor define the color filling the cell, used by rows and cols with WithStyle.
+	// When nil, the cell is transparent.
+	BackgroundColor AnyColor
+	BorderColor     AnyColor
 	BorderType      border.Type
 	BorderThickness float64
 	LineStyle       linestyle.Type
+	// DashPattern define an arbitrary on/off dash pattern to the borders. When defined, it overrides LineStyle,
+	// a pattern with a negative value or without a positive value is ignored.
+	DashPattern []float64
+	// HoverColor define the background color applied when the mouse is over the cell. It needs the col ID
+	// and the JavaScript enabled on the config, and only works on viewers with JavaScript support.
+	HoverColor *Color
+	// Tooltip define a text shown when the mouse is over the cell, only used by cols. It's written as a form field
+	// annotation, so it depends on the viewer support. When the col is created with WithTooltip, its text is used.
+	Tooltip string
 }
 
 // ToMap adds the Cell fields to the map.
@@ -34,13 +45,25 @@ func (c *Cell) ToMap() map[string]interface{} {
 		m["prop_border_line_style"] = c.LineStyle
 	}
 
-	if c.BackgroundColor != nil {
+	if len(c.DashPattern) > 0 {
+		m["prop_border_dash_pattern"] = c.DashPattern
+	}
+
+	if !IsNilColor(c.BackgroundColor) {
 		m["prop_background_color"] = c.BackgroundColor.ToString()
 	}
 
-	if c.BorderColor != nil {
+	if !IsNilColor(c.BorderColor) {
 		m["prop_border_color"] = c.BorderColor.ToString()
 	}
 
+	if c.HoverColor != nil {
+		m["prop_hover_color"] = c.HoverColor.ToString()
+	}
+
+	if c.Tooltip != "" {
+		m["prop_tooltip"] = c.Tooltip
+	}
+
 	return m
 }
@@ -36,4 +36,40 @@ func TestCell_ToMap(t *testing.T) {
 		assert.Equal(t, "RGB(255, 100, 50)", m["prop_background_color"])
 		assert.Equal(t, "RGB(200, 80, 60)", m["prop_border_color"])
 	})
+	t.Run("when cell has dash pattern, should return map with dash pattern", func(t *testing.T) {
+		// Arrange
+		sut := props.Cell{
+			DashPattern: []float64{2, 1},
+		}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, []float64{2, 1}, m["prop_border_dash_pattern"])
+	})
+	t.Run("when cell has hover color, should return map with hover color", func(t *testing.T) {
+		// Arrange
+		sut := props.Cell{
+			HoverColor: &props.Color{Red: 10, Green: 20, Blue: 30},
+		}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(10, 20, 30)", m["prop_hover_color"])
+	})
+	t.Run("when cell has tooltip, should return map with tooltip", func(t *testing.T) {
+		// Arrange
+		sut := props.Cell{
+			Tooltip: "tooltip",
+		}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "tooltip", m["prop_tooltip"])
+	})
 }
@@ -0,0 +1,43 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/legendposition"
+
+// Chart represents properties from a Chart inside a cell.
+type Chart struct {
+	// BackgroundColor define the color behind the chart, white when empty.
+	BackgroundColor *Color
+	// HideAxes define if the axes and their values are hidden, they are always hidden on sparklines.
+	HideAxes bool
+	// Title is written above the chart, it's not written on sparklines.
+	Title string
+	// LegendPosition define where the labels of the series are written, they are not written when empty,
+	// on sparklines nor on bar charts.
+	LegendPosition legendposition.Type
+}
+
+// ToMap returns a map with the Chart fields.
+func (c *Chart) ToMap() map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if c.BackgroundColor != nil {
+		m["prop_background_color"] = c.BackgroundColor.ToString()
+	}
+
+	if c.HideAxes {
+		m["prop_hide_axes"] = c.HideAxes
+	}
+
+	if c.Title != "" {
+		m["prop_title"] = c.Title
+	}
+
+	if c.LegendPosition != legendposition.None {
+		m["prop_legend_position"] = c.LegendPosition
+	}
+
+	return m
+}
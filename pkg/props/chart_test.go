@@ -0,0 +1,47 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/legendposition"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestChart_ToMap(t *testing.T) {
+	t.Run("when chart is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Chart
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when chart is empty, should return an empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Chart{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when chart is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.ChartProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_background_color"])
+		assert.Equal(t, true, m["prop_hide_axes"])
+		assert.Equal(t, "Revenue", m["prop_title"])
+		assert.Equal(t, legendposition.Top, m["prop_legend_position"])
+	})
+}
@@ -0,0 +1,93 @@
+package props
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalidCMYK is returned when a component of a CMYKColor is out of the 0 to 100 range.
+var ErrInvalidCMYK = errors.New("cmyk components must be between 0 and 100")
+
+// AnyColor is a color in any of the supported spaces, it's implemented by Color and CMYKColor.
+// The providers write a CMYKColor in the CMYK space and a Color in the RGB space.
+type AnyColor interface {
+	// ToString returns a string representation of the color.
+	ToString() string
+	// ToColor returns the color in the RGB space.
+	ToColor() *Color
+}
+
+// IsNilColor returns true when the color is nil, including a nil Color or CMYKColor sent as AnyColor.
+func IsNilColor(color AnyColor) bool {
+	return color == nil || color.ToColor() == nil
+}
+
+// CMYKColor represents a color in the CMYK (Cyan, Magenta, Yellow, Key) space used by printers,
+// each component is a percentage from 0 to 100. When all values are 0 the result color is white
+// and when Key is 100 the result color is black.
+type CMYKColor struct {
+	// Cyan is the percentage of cyan
+	Cyan float64
+	// Magenta is the percentage of magenta
+	Magenta float64
+	// Yellow is the percentage of yellow
+	Yellow float64
+	// Key is the percentage of black
+	Key float64
+}
+
+// NewCMYKColor is responsible to create a CMYKColor, it returns ErrInvalidCMYK when a component
+// is out of the 0 to 100 range.
+func NewCMYKColor(cyan, magenta, yellow, key float64) (*CMYKColor, error) {
+	c := &CMYKColor{Cyan: cyan, Magenta: magenta, Yellow: yellow, Key: key}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Validate returns ErrInvalidCMYK when a component is out of the 0 to 100 range.
+func (c *CMYKColor) Validate() error {
+	components := map[string]float64{"cyan": c.Cyan, "magenta": c.Magenta, "yellow": c.Yellow, "key": c.Key}
+	for _, name := range []string{"cyan", "magenta", "yellow", "key"} {
+		if value := components[name]; value < 0 || value > 100 || math.IsNaN(value) {
+			return fmt.Errorf("%w, got %s %g", ErrInvalidCMYK, name, value)
+		}
+	}
+
+	return nil
+}
+
+// ToString returns a string representation of the CMYKColor.
+func (c *CMYKColor) ToString() string {
+	if c == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("CMYK(%g, %g, %g, %g)", c.Cyan, c.Magenta, c.Yellow, c.Key)
+}
+
+// ToColor returns the RGB approximation of the CMYKColor, used where CMYK is not supported.
+func (c *CMYKColor) ToColor() *Color {
+	if c == nil {
+		return nil
+	}
+
+	black := 1 - clampPercent(c.Key)/100
+
+	return &Color{
+		Red:   toRGBComponent(c.Cyan, black),
+		Green: toRGBComponent(c.Magenta, black),
+		Blue:  toRGBComponent(c.Yellow, black),
+	}
+}
+
+func toRGBComponent(value, black float64) int {
+	return int(math.Round(255 * (1 - clampPercent(value)/100) * black))
+}
+
+func clampPercent(value float64) float64 {
+	return math.Max(0, math.Min(100, value))
+}
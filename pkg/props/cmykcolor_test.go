@@ -0,0 +1,116 @@
+package props_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestNewCMYKColor(t *testing.T) {
+	t.Run("when components are in range, should return color", func(t *testing.T) {
+		// Act
+		color, err := props.NewCMYKColor(10, 20, 30, 40)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, &props.CMYKColor{Cyan: 10, Magenta: 20, Yellow: 30, Key: 40}, color)
+	})
+	t.Run("when a component is out of range, should return error", func(t *testing.T) {
+		// Act
+		color, err := props.NewCMYKColor(10, 120, 30, 40)
+
+		// Assert
+		assert.Nil(t, color)
+		assert.True(t, errors.Is(err, props.ErrInvalidCMYK))
+		assert.Equal(t, "cmyk components must be between 0 and 100, got magenta 120", err.Error())
+	})
+}
+
+func TestCMYKColor_Validate(t *testing.T) {
+	t.Run("when components are the limits, should not return error", func(t *testing.T) {
+		// Arrange
+		color := &props.CMYKColor{Cyan: 0, Magenta: 100, Yellow: 0, Key: 100}
+
+		// Act
+		err := color.Validate()
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when a component is negative, should return error", func(t *testing.T) {
+		// Arrange
+		color := &props.CMYKColor{Key: -1}
+
+		// Act
+		err := color.Validate()
+
+		// Assert
+		assert.True(t, errors.Is(err, props.ErrInvalidCMYK))
+	})
+}
+
+func TestCMYKColor_ToString(t *testing.T) {
+	t.Run("when color is nil, should return empty", func(t *testing.T) {
+		// Arrange
+		var color *props.CMYKColor
+
+		// Act
+		s := color.ToString()
+
+		// Assert
+		assert.Equal(t, "", s)
+	})
+	t.Run("when color is filled, should return correctly", func(t *testing.T) {
+		// Arrange
+		color := &props.CMYKColor{Cyan: 10, Magenta: 20.5, Yellow: 30, Key: 40}
+
+		// Act
+		s := color.ToString()
+
+		// Assert
+		assert.Equal(t, "CMYK(10, 20.5, 30, 40)", s)
+	})
+}
+
+func TestCMYKColor_ToColor(t *testing.T) {
+	t.Run("when color is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var color *props.CMYKColor
+
+		// Act
+		c := color.ToColor()
+
+		// Assert
+		assert.Nil(t, c)
+	})
+	t.Run("when color is filled, should return color with rgb approximation", func(t *testing.T) {
+		// Arrange
+		color := &props.CMYKColor{Cyan: 100, Magenta: 50, Yellow: 0, Key: 20}
+
+		// Act
+		c := color.ToColor()
+
+		// Assert
+		assert.Equal(t, &props.Color{Red: 0, Green: 102, Blue: 204}, c)
+	})
+}
+
+func TestIsNilColor(t *testing.T) {
+	t.Run("when color is nil, should return true", func(t *testing.T) {
+		assert.True(t, props.IsNilColor(nil))
+	})
+	t.Run("when color is a nil pointer, should return true", func(t *testing.T) {
+		var rgb *props.Color
+		var cmyk *props.CMYKColor
+
+		assert.True(t, props.IsNilColor(rgb))
+		assert.True(t, props.IsNilColor(cmyk))
+	})
+	t.Run("when color is defined, should return false", func(t *testing.T) {
+		assert.False(t, props.IsNilColor(&props.Color{}))
+		assert.False(t, props.IsNilColor(&props.CMYKColor{}))
+	})
+}
@@ -35,3 +35,8 @@ func (c *Color) ToString() string {
 
 	return fmt.Sprintf("RGB(%d, %d, %d)", c.Red, c.Green, c.Blue)
 }
+
+// ToColor returns the Color itself, so it implements AnyColor.
+func (c *Color) ToColor() *Color {
+	return c
+}
@@ -14,7 +14,7 @@ type Font struct {
 	// Size of the text.
 	Size float64
 	// Color define the font color.
-	Color *Color
+	Color AnyColor
 }
 
 // AppendMap appends the font fields to a map.
@@ -31,7 +31,7 @@ func (f *Font) AppendMap(m map[string]interface{}) map[string]interface{} {
 		m["prop_font_size"] = f.Size
 	}
 
-	if f.Color != nil {
+	if !IsNilColor(f.Color) {
 		m["prop_font_color"] = f.Color.ToString()
 	}
 
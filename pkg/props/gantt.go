@@ -0,0 +1,91 @@
+package props
+
+import "time"
+
+// Gantt represents properties from a Gantt chart inside a cell.
+type Gantt struct {
+	// BarColor define the color of the task bars.
+	BarColor *Color
+	// CriticalColor define the color of the bars of tasks on the critical path.
+	CriticalColor *Color
+	// MilestoneColor define the color of the milestone diamonds.
+	MilestoneColor *Color
+	// Today define where the today line is drawn, when zero the line is not drawn.
+	Today time.Time
+	// TodayColor define the color of the today line.
+	TodayColor *Color
+	// DateLayout define the time.Format layout used to write the dates of the time axis.
+	DateLayout string
+	// LabelPercent define how much of the width is reserved to the task names.
+	LabelPercent float64
+	// LabelFont define the font used to write the task names and the dates.
+	LabelFont Font
+}
+
+// ToMap returns a map with the Gantt fields.
+func (g *Gantt) ToMap() map[string]interface{} {
+	if g == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if g.BarColor != nil {
+		m["prop_bar_color"] = g.BarColor.ToString()
+	}
+
+	if g.CriticalColor != nil {
+		m["prop_critical_color"] = g.CriticalColor.ToString()
+	}
+
+	if g.MilestoneColor != nil {
+		m["prop_milestone_color"] = g.MilestoneColor.ToString()
+	}
+
+	if !g.Today.IsZero() {
+		m["prop_today"] = g.Today.Format(time.RFC3339)
+	}
+
+	if g.TodayColor != nil {
+		m["prop_today_color"] = g.TodayColor.ToString()
+	}
+
+	if g.DateLayout != "" {
+		m["prop_date_layout"] = g.DateLayout
+	}
+
+	if g.LabelPercent != 0 {
+		m["prop_label_percent"] = g.LabelPercent
+	}
+
+	return g.LabelFont.AppendMap(m)
+}
+
+// MakeValid from Gantt define default values for a Gantt.
+func (g *Gantt) MakeValid(defaultFamily string) {
+	if g.BarColor == nil {
+		g.BarColor = &Color{Red: 70, Green: 130, Blue: 180}
+	}
+
+	if g.CriticalColor == nil {
+		g.CriticalColor = &RedColor
+	}
+
+	if g.MilestoneColor == nil {
+		g.MilestoneColor = &BlackColor
+	}
+
+	if g.TodayColor == nil {
+		g.TodayColor = &RedColor
+	}
+
+	if g.DateLayout == "" {
+		g.DateLayout = "2006-01-02"
+	}
+
+	if g.LabelPercent <= 0 || g.LabelPercent >= 100 {
+		g.LabelPercent = 25
+	}
+
+	g.LabelFont.MakeValid(defaultFamily)
+}
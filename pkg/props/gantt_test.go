@@ -0,0 +1,73 @@
+package props_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestGantt_ToMap(t *testing.T) {
+	t.Run("when gantt is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Gantt
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when gantt is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.GanttProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_bar_color"])
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_critical_color"])
+		assert.Equal(t, "RGB(0, 0, 255)", m["prop_milestone_color"])
+		assert.Equal(t, "2024-01-10T00:00:00Z", m["prop_today"])
+		assert.Equal(t, "RGB(0, 0, 255)", m["prop_today_color"])
+		assert.Equal(t, "02/01", m["prop_date_layout"])
+		assert.Equal(t, 20.0, m["prop_label_percent"])
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+	})
+}
+
+func TestGantt_MakeValid(t *testing.T) {
+	t.Run("when fields are empty, should apply defaults", func(t *testing.T) {
+		// Arrange
+		prop := props.Gantt{}
+
+		// Act
+		prop.MakeValid(fontfamily.Courier)
+
+		// Assert
+		assert.Equal(t, &props.Color{Red: 70, Green: 130, Blue: 180}, prop.BarColor)
+		assert.Equal(t, &props.RedColor, prop.CriticalColor)
+		assert.Equal(t, &props.BlackColor, prop.MilestoneColor)
+		assert.Equal(t, &props.RedColor, prop.TodayColor)
+		assert.Equal(t, "2006-01-02", prop.DateLayout)
+		assert.Equal(t, 25.0, prop.LabelPercent)
+		assert.Equal(t, fontfamily.Courier, prop.LabelFont.Family)
+		assert.True(t, prop.Today.IsZero())
+	})
+	t.Run("when today is sent, should keep it", func(t *testing.T) {
+		// Arrange
+		today := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+		prop := props.Gantt{Today: today}
+
+		// Act
+		prop.MakeValid(fontfamily.Arial)
+
+		// Assert
+		assert.Equal(t, today, prop.Today)
+	})
+}
@@ -0,0 +1,49 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/blend"
+
+// GraphicsState represents the parameters of a PDF extended graphics state (/ExtGState),
+// which are applied to everything drawn after it is set.
+type GraphicsState struct {
+	// BlendMode define how the colors drawn are combined with the colors already on the page.
+	BlendMode blend.Mode
+	// Opacity define the opacity of fills and texts, from 0 (transparent) to 1 (opaque). When it's not
+	// set, the content is opaque.
+	Opacity float64
+	// StrokeOpacity define the opacity of lines and borders, from 0 (transparent) to 1 (opaque). When it's
+	// not set, the Opacity is used.
+	StrokeOpacity float64
+}
+
+// ToMap returns a map with the GraphicsState fields.
+func (g *GraphicsState) ToMap() map[string]interface{} {
+	if g == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"prop_blend_mode":     g.BlendMode,
+		"prop_opacity":        g.Opacity,
+		"prop_stroke_opacity": g.StrokeOpacity,
+	}
+}
+
+// MakeValid from GraphicsState define default values for a GraphicsState, an invalid blend mode
+// becomes Normal, an opacity not set becomes 1, a stroke opacity not set becomes the opacity and the
+// opacities are limited between 0 and 1.
+func (g *GraphicsState) MakeValid() {
+	if !g.BlendMode.IsValid() {
+		g.BlendMode = blend.Normal
+	}
+
+	if g.Opacity == 0 {
+		g.Opacity = 1
+	}
+
+	if g.StrokeOpacity == 0 {
+		g.StrokeOpacity = g.Opacity
+	}
+
+	g.Opacity = min(max(g.Opacity, 0), 1)
+	g.StrokeOpacity = min(max(g.StrokeOpacity, 0), 1)
+}
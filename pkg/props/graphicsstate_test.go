@@ -0,0 +1,110 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/blend"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestGraphicsState_ToMap(t *testing.T) {
+	t.Run("when state is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.GraphicsState
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when state is filled, should return the fields", func(t *testing.T) {
+		// Arrange
+		sut := &props.GraphicsState{BlendMode: blend.Screen, Opacity: 0.4, StrokeOpacity: 0.6}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, blend.Screen, m["prop_blend_mode"])
+		assert.Equal(t, 0.4, m["prop_opacity"])
+		assert.Equal(t, 0.6, m["prop_stroke_opacity"])
+	})
+}
+
+func TestGraphicsState_MakeValid(t *testing.T) {
+	t.Run("when blend mode is invalid, should use normal", func(t *testing.T) {
+		// Arrange
+		sut := props.GraphicsState{BlendMode: "invalid"}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, blend.Normal, sut.BlendMode)
+	})
+	t.Run("when blend mode is valid, should keep it", func(t *testing.T) {
+		// Arrange
+		sut := props.GraphicsState{BlendMode: blend.Multiply}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, blend.Multiply, sut.BlendMode)
+	})
+	t.Run("when opacity is greater than 1, should limit it to 1", func(t *testing.T) {
+		// Arrange
+		sut := props.GraphicsState{Opacity: 1.5}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 1.0, sut.Opacity)
+	})
+	t.Run("when opacity is negative, should limit it to 0", func(t *testing.T) {
+		// Arrange
+		sut := props.GraphicsState{Opacity: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, sut.Opacity)
+	})
+	t.Run("when only blend mode is set, should be opaque", func(t *testing.T) {
+		// Arrange
+		sut := props.GraphicsState{BlendMode: blend.Multiply}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 1.0, sut.Opacity)
+		assert.Equal(t, 1.0, sut.StrokeOpacity)
+	})
+	t.Run("when stroke opacity is not set, should use the opacity", func(t *testing.T) {
+		// Arrange
+		sut := props.GraphicsState{Opacity: 0.4}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.4, sut.StrokeOpacity)
+	})
+	t.Run("when stroke opacity is out of range, should limit it", func(t *testing.T) {
+		// Arrange
+		sut := props.GraphicsState{Opacity: 0.4, StrokeOpacity: 1.5}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.4, sut.Opacity)
+		assert.Equal(t, 1.0, sut.StrokeOpacity)
+	})
+}
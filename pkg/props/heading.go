@@ -0,0 +1,121 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+// Heading represents properties from a Heading inside a cell.
+type Heading struct {
+	// Family of the text, ex: constf.Arial, helvetica and etc, the default font family when empty.
+	Family string
+	// Style of the text, ex: constf.Normal, bold and etc, Bold in NewHeading.
+	Style fontstyle.Type
+	// Size define the font size of the body text, the headings are scaled from it, the default
+	// font size when empty.
+	Size float64
+	// Color define the font color.
+	Color AnyColor
+	// Align of the text.
+	Align align.Type
+	// Top is the amount of space between the upper cell limit and the text.
+	Top float64
+	// Scale define the scale of the font size of each heading level, from 1 to 6.
+	Scale []float64
+}
+
+// NewHeading returns the Heading used when the props are not sent, which is bold. The other fields are
+// defined by MakeValid.
+func NewHeading() Heading {
+	return Heading{Style: fontstyle.Bold}
+}
+
+// ToMap returns a map with the Heading fields.
+func (h *Heading) ToMap() map[string]interface{} {
+	if h == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if h.Family != "" {
+		m["prop_font_family"] = h.Family
+	}
+
+	if h.Style != "" {
+		m["prop_font_style"] = h.Style
+	}
+
+	if h.Size != 0 {
+		m["prop_font_size"] = h.Size
+	}
+
+	if !IsNilColor(h.Color) {
+		m["prop_color"] = h.Color.ToString()
+	}
+
+	if h.Align != "" {
+		m["prop_align"] = h.Align
+	}
+
+	if h.Top != 0 {
+		m["prop_top"] = h.Top
+	}
+
+	if len(h.Scale) > 0 {
+		m["prop_scale"] = h.Scale
+	}
+
+	return m
+}
+
+// MakeValid from Heading define default values for a Heading, the scales which are not
+// defined or are not positive are the same of the Markdown headings. The style is kept, as an
+// empty style is normal, NewHeading defines its default.
+func (h *Heading) MakeValid(font *Font) {
+	if h.Family == "" {
+		h.Family = font.Family
+	}
+
+	if h.Size <= 0 {
+		h.Size = font.Size
+	}
+
+	if IsNilColor(h.Color) {
+		h.Color = font.Color
+	}
+
+	if h.Top < 0 {
+		h.Top = 0
+	}
+
+	scales := make([]float64, len(defaultHeadingScales))
+	for i, scale := range defaultHeadingScales {
+		scales[i] = scale
+		if i < len(h.Scale) && h.Scale[i] > 0 {
+			scales[i] = h.Scale[i]
+		}
+	}
+	h.Scale = scales
+}
+
+// ToTextProp returns the props.Text used to write a heading of the level, from 1 to 6.
+func (h *Heading) ToTextProp(level int) *Text {
+	size := h.Size
+	if level >= 1 && level <= len(h.Scale) {
+		size *= h.Scale[level-1]
+	}
+
+	textProp := &Text{
+		Family: h.Family,
+		Style:  h.Style,
+		Size:   size,
+		Color:  h.Color,
+		Align:  h.Align,
+		Top:    h.Top,
+	}
+
+	textProp.MakeValid(&Font{Family: h.Family, Style: h.Style, Size: size, Color: h.Color})
+
+	return textProp
+}
@@ -0,0 +1,96 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestHeading_ToMap(t *testing.T) {
+	t.Run("when heading is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Heading
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when heading is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.HeadingProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+		assert.Equal(t, fontstyle.BoldItalic, m["prop_font_style"])
+		assert.Equal(t, 12.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, align.Center, m["prop_align"])
+		assert.Equal(t, 2.0, m["prop_top"])
+		assert.Equal(t, []float64{3, 2, 1.25, 1.1, 1, 0.9}, m["prop_scale"])
+	})
+}
+
+func TestNewHeading(t *testing.T) {
+	// Act
+	sut := props.NewHeading()
+
+	// Assert
+	assert.Equal(t, fontstyle.Bold, sut.Style)
+}
+
+func TestHeading_MakeValid(t *testing.T) {
+	t.Run("when heading is empty, should use the default font and keep the normal style", func(t *testing.T) {
+		// Arrange
+		fontProp := fixture.FontProp()
+		sut := props.Heading{Top: -1}
+
+		// Act
+		sut.MakeValid(&fontProp)
+
+		// Assert
+		assert.Equal(t, fontProp.Family, sut.Family)
+		assert.Equal(t, fontstyle.Normal, sut.Style)
+		assert.Equal(t, fontProp.Size, sut.Size)
+		assert.Equal(t, fontProp.Color, sut.Color)
+		assert.Equal(t, 0.0, sut.Top)
+		assert.Equal(t, []float64{2, 1.5, 1.25, 1.1, 1, 0.9}, sut.Scale)
+	})
+	t.Run("when scale is not positive, should use the default scale of the level", func(t *testing.T) {
+		// Arrange
+		fontProp := fixture.FontProp()
+		sut := props.Heading{Scale: []float64{0, 3, -1}}
+
+		// Act
+		sut.MakeValid(&fontProp)
+
+		// Assert
+		assert.Equal(t, []float64{2, 3, 1.25, 1.1, 1, 0.9}, sut.Scale)
+	})
+}
+
+func TestHeading_ToTextProp(t *testing.T) {
+	// Arrange
+	sut := fixture.HeadingProp()
+
+	// Act
+	textProp := sut.ToTextProp(2)
+
+	// Assert
+	assert.Equal(t, sut.Family, textProp.Family)
+	assert.Equal(t, sut.Style, textProp.Style)
+	assert.Equal(t, 24.0, textProp.Size)
+	assert.Equal(t, sut.Color, textProp.Color)
+	assert.Equal(t, sut.Align, textProp.Align)
+	assert.Equal(t, 2.0, textProp.Top)
+}
@@ -0,0 +1,66 @@
+package props
+
+// Heatmap represents properties from a Heatmap inside a cell.
+type Heatmap struct {
+	// MinColor define the color applied to the cells with the lowest value.
+	MinColor *Color
+	// MaxColor define the color applied to the cells with the highest value.
+	MaxColor *Color
+	// RowLabels define the labels written on the left of each row.
+	RowLabels []string
+	// ColLabels define the labels written above each column.
+	ColLabels []string
+	// LabelPercent define how much of the width is reserved to the row labels.
+	LabelPercent float64
+	// LabelFont define the font used to write the labels, its family is the default family of the document
+	// when not defined.
+	LabelFont Font
+}
+
+// ToMap returns a map with the Heatmap fields.
+func (h *Heatmap) ToMap() map[string]interface{} {
+	if h == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if h.MinColor != nil {
+		m["prop_min_color"] = h.MinColor.ToString()
+	}
+
+	if h.MaxColor != nil {
+		m["prop_max_color"] = h.MaxColor.ToString()
+	}
+
+	if len(h.RowLabels) > 0 {
+		m["prop_row_labels"] = h.RowLabels
+	}
+
+	if len(h.ColLabels) > 0 {
+		m["prop_col_labels"] = h.ColLabels
+	}
+
+	if h.LabelPercent != 0 {
+		m["prop_label_percent"] = h.LabelPercent
+	}
+
+	return h.LabelFont.AppendMap(m)
+}
+
+// MakeValid from Heatmap define default values for a Heatmap.
+func (h *Heatmap) MakeValid(defaultFamily string) {
+	if h.MinColor == nil {
+		h.MinColor = &WhiteColor
+	}
+
+	if h.MaxColor == nil {
+		h.MaxColor = &RedColor
+	}
+
+	if h.LabelPercent <= 0 || h.LabelPercent >= 100 {
+		h.LabelPercent = 15
+	}
+
+	h.LabelFont.MakeValid(defaultFamily)
+}
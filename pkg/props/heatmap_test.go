@@ -0,0 +1,78 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestHeatmap_ToMap(t *testing.T) {
+	t.Run("when heatmap is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Heatmap
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when heatmap is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.HeatmapProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(255, 255, 255)", m["prop_min_color"])
+		assert.Equal(t, "RGB(0, 0, 255)", m["prop_max_color"])
+		assert.Equal(t, []string{"mon", "tue"}, m["prop_row_labels"])
+		assert.Equal(t, []string{"08h", "09h", "10h"}, m["prop_col_labels"])
+		assert.Equal(t, 20.0, m["prop_label_percent"])
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+	})
+}
+
+func TestHeatmap_MakeValid(t *testing.T) {
+	t.Run("when colors are nil, should apply white and red", func(t *testing.T) {
+		// Arrange
+		prop := props.Heatmap{}
+
+		// Act
+		prop.MakeValid(fontfamily.Arial)
+
+		// Assert
+		assert.Equal(t, &props.WhiteColor, prop.MinColor)
+		assert.Equal(t, &props.RedColor, prop.MaxColor)
+	})
+	t.Run("when label percent is invalid, should apply 15", func(t *testing.T) {
+		// Arrange
+		prop := props.Heatmap{
+			LabelPercent: 100,
+		}
+
+		// Act
+		prop.MakeValid(fontfamily.Arial)
+
+		// Assert
+		assert.Equal(t, 15.0, prop.LabelPercent)
+	})
+	t.Run("when label font is empty, should apply default font", func(t *testing.T) {
+		// Arrange
+		prop := props.Heatmap{}
+
+		// Act
+		prop.MakeValid(fontfamily.Courier)
+
+		// Assert
+		assert.Equal(t, fontfamily.Courier, prop.LabelFont.Family)
+		assert.Equal(t, fontstyle.Normal, prop.LabelFont.Style)
+		assert.Equal(t, 8.0, prop.LabelFont.Size)
+	})
+}
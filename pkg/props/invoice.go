@@ -0,0 +1,85 @@
+package props
+
+// Invoice represents properties from an Invoice.
+type Invoice struct {
+	// Family of the texts, ex: consts.Arial, helvetica and etc.
+	Family string
+	// Size of the texts, titles are written with a bigger size.
+	Size float64
+	// Color define the font color.
+	Color *Color
+	// HeaderColor define the background color of the line items header.
+	HeaderColor *Color
+	// Currency define the symbol written before the amounts, ex: $, € and etc.
+	Currency string
+	// DateLayout define the time.Format layout used to write the dates.
+	DateLayout string
+	// ItemHeight define the height of each line item row.
+	ItemHeight float64
+}
+
+// ToMap returns a map with the Invoice fields.
+func (i *Invoice) ToMap() map[string]interface{} {
+	if i == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if i.Family != "" {
+		m["prop_font_family"] = i.Family
+	}
+
+	if i.Size != 0 {
+		m["prop_font_size"] = i.Size
+	}
+
+	if i.Color != nil {
+		m["prop_color"] = i.Color.ToString()
+	}
+
+	if i.HeaderColor != nil {
+		m["prop_header_color"] = i.HeaderColor.ToString()
+	}
+
+	if i.Currency != "" {
+		m["prop_currency"] = i.Currency
+	}
+
+	if i.DateLayout != "" {
+		m["prop_date_layout"] = i.DateLayout
+	}
+
+	if i.ItemHeight != 0 {
+		m["prop_item_height"] = i.ItemHeight
+	}
+
+	return m
+}
+
+// MakeValid from Invoice define default values for an Invoice.
+func (i *Invoice) MakeValid(defaultFamily string) {
+	if i.Family == "" {
+		i.Family = defaultFamily
+	}
+
+	if i.Size <= 0 {
+		i.Size = 9
+	}
+
+	if i.HeaderColor == nil {
+		i.HeaderColor = &Color{Red: 220, Green: 220, Blue: 220}
+	}
+
+	if i.Currency == "" {
+		i.Currency = "$"
+	}
+
+	if i.DateLayout == "" {
+		i.DateLayout = "2006-01-02"
+	}
+
+	if i.ItemHeight <= 0 {
+		i.ItemHeight = 7
+	}
+}
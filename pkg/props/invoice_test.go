@@ -0,0 +1,56 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestInvoice_ToMap(t *testing.T) {
+	t.Run("when invoice is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Invoice
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when invoice is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.InvoiceProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+		assert.Equal(t, 10.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_header_color"])
+		assert.Equal(t, "€", m["prop_currency"])
+		assert.Equal(t, "02/01/2006", m["prop_date_layout"])
+		assert.Equal(t, 8.0, m["prop_item_height"])
+	})
+}
+
+func TestInvoice_MakeValid(t *testing.T) {
+	// Arrange
+	sut := props.Invoice{}
+
+	// Act
+	sut.MakeValid(fontfamily.Courier)
+
+	// Assert
+	assert.Equal(t, fontfamily.Courier, sut.Family)
+	assert.Equal(t, 9.0, sut.Size)
+	assert.Equal(t, &props.Color{Red: 220, Green: 220, Blue: 220}, sut.HeaderColor)
+	assert.Equal(t, "$", sut.Currency)
+	assert.Equal(t, "2006-01-02", sut.DateLayout)
+	assert.Equal(t, 7.0, sut.ItemHeight)
+}
@@ -8,9 +8,12 @@ import (
 // Line represents properties from a Line inside a cell.
 type Line struct {
 	// Color define the line color.
-	Color *Color
-	// Style define the line style (solid or dashed).
+	Color AnyColor
+	// Style define the line style (solid, dashed or dotted).
 	Style linestyle.Type
+	// DashPattern define an arbitrary on/off dash pattern, ex: [2, 1, 4, 1]. When defined, it overrides Style,
+	// a pattern with a negative value or without a positive value is ignored.
+	DashPattern []float64
 	// Thickness define the line thicknesl.
 	Thickness float64
 	// Orientation define if line would be horizontal or vertical.
@@ -19,6 +22,8 @@ type Line struct {
 	OffsetPercent float64
 	// SizePercent define the size of the line inside cell.
 	SizePercent float64
+	// FillColor define the color used to fill closed shapes, like polygons. When nil, only the outline is drawn.
+	FillColor AnyColor
 }
 
 // ToMap returns a map with the Line fields.
@@ -29,7 +34,7 @@ func (l *Line) ToMap() map[string]interface{} {
 
 	m := make(map[string]interface{})
 
-	if l.Color != nil {
+	if !IsNilColor(l.Color) {
 		m["prop_color"] = l.Color.ToString()
 	}
 
@@ -37,6 +42,10 @@ func (l *Line) ToMap() map[string]interface{} {
 		m["prop_style"] = l.Style
 	}
 
+	if len(l.DashPattern) > 0 {
+		m["prop_dash_pattern"] = l.DashPattern
+	}
+
 	if l.Thickness != 0 {
 		m["prop_thickness"] = l.Thickness
 	}
@@ -53,6 +62,10 @@ func (l *Line) ToMap() map[string]interface{} {
 		m["prop_size_percent"] = l.SizePercent
 	}
 
+	if !IsNilColor(l.FillColor) {
+		m["prop_fill_color"] = l.FillColor.ToString()
+	}
+
 	return m
 }
 
@@ -62,6 +75,10 @@ func (l *Line) MakeValid() {
 		l.Style = linestyle.Solid
 	}
 
+	if !IsValidDashPattern(l.DashPattern) {
+		l.DashPattern = nil
+	}
+
 	if l.Thickness == 0 {
 		l.Thickness = linestyle.DefaultLineThickness
 	}
@@ -86,3 +103,19 @@ func (l *Line) MakeValid() {
 		l.SizePercent = 100
 	}
 }
+
+// IsValidDashPattern checks if a dash pattern has only non-negative values and at least one positive value.
+func IsValidDashPattern(pattern []float64) bool {
+	hasPositive := false
+	for _, value := range pattern {
+		if value < 0 {
+			return false
+		}
+
+		if value > 0 {
+			hasPositive = true
+		}
+	}
+
+	return hasPositive
+}
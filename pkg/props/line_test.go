@@ -12,6 +12,42 @@ import (
 )
 
 func TestLine_MakeValid(t *testing.T) {
+	t.Run("when dash pattern is valid, should keep it", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{
+			DashPattern: []float64{2, 1, 4, 1},
+		}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, []float64{2, 1, 4, 1}, prop.DashPattern)
+	})
+	t.Run("when dash pattern has negative value, should remove it", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{
+			DashPattern: []float64{2, -1},
+		}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Nil(t, prop.DashPattern)
+	})
+	t.Run("when dash pattern has only zeros, should remove it", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{
+			DashPattern: []float64{0, 0},
+		}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Nil(t, prop.DashPattern)
+	})
 	t.Run("when style is empty, should apply solid", func(t *testing.T) {
 		// Arrange
 		prop := props.Line{
@@ -124,4 +160,28 @@ func TestLine_ToMap(t *testing.T) {
 		assert.Equal(t, 50.0, m["prop_offset_percent"])
 		assert.Equal(t, 20.0, m["prop_size_percent"])
 	})
+	t.Run("when line has fill color, should return map with fill color", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{
+			FillColor: &props.RedColor,
+		}
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_fill_color"])
+	})
+	t.Run("when line has dash pattern, should return map with dash pattern", func(t *testing.T) {
+		// Arrange
+		prop := props.Line{
+			DashPattern: []float64{2, 1, 4, 1},
+		}
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Equal(t, []float64{2, 1, 4, 1}, m["prop_dash_pattern"])
+	})
 }
@@ -0,0 +1,122 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/theme"
+)
+
+// defaultHeadingScales are the font scales of the headings h1 to h6.
+var defaultHeadingScales = []float64{2, 1.5, 1.25, 1.1, 1, 0.9}
+
+// Markdown represents properties from a Markdown inside a cell.
+type Markdown struct {
+	// Family define the font family of the text, when empty the default font family is used.
+	Family string
+	// Size define the font size of the paragraphs, the headings are scaled from it.
+	Size float64
+	// Color define the color of the text.
+	Color AnyColor
+	// HeadingScales define the scale of the font size of each heading level, from h1 to h6.
+	HeadingScales []float64
+	// CodeFamily define the font family of code spans and code blocks, by default it is courier.
+	CodeFamily string
+	// CodeTheme define the colors of the code blocks, by default it is theme.Light.
+	CodeTheme theme.Name
+	// LinkColor define the color of the links, by default it is blue.
+	LinkColor *Color
+	// BlockSpacing define the vertical space, in mm, between blocks, ex: paragraphs and headings.
+	BlockSpacing float64
+	// Indent define the indentation, in mm, of lists and blockquotes.
+	Indent float64
+}
+
+// ToMap returns a map with the Markdown fields.
+func (m *Markdown) ToMap() map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+
+	mp := make(map[string]interface{})
+
+	if m.Family != "" {
+		mp["prop_font_family"] = m.Family
+	}
+
+	if m.Size != 0 {
+		mp["prop_font_size"] = m.Size
+	}
+
+	if !IsNilColor(m.Color) {
+		mp["prop_font_color"] = m.Color.ToString()
+	}
+
+	if len(m.HeadingScales) > 0 {
+		mp["prop_heading_scales"] = m.HeadingScales
+	}
+
+	if m.CodeFamily != "" {
+		mp["prop_code_family"] = m.CodeFamily
+	}
+
+	if m.CodeTheme != "" {
+		mp["prop_code_theme"] = m.CodeTheme
+	}
+
+	if m.LinkColor != nil {
+		mp["prop_link_color"] = m.LinkColor.ToString()
+	}
+
+	if m.BlockSpacing != 0 {
+		mp["prop_block_spacing"] = m.BlockSpacing
+	}
+
+	if m.Indent != 0 {
+		mp["prop_indent"] = m.Indent
+	}
+
+	return mp
+}
+
+// MakeValid from Markdown define default values for a Markdown.
+func (m *Markdown) MakeValid(font *Font) {
+	if m.Family == "" {
+		m.Family = font.Family
+	}
+
+	if m.Size <= 0 {
+		m.Size = font.Size
+	}
+
+	if IsNilColor(m.Color) {
+		m.Color = font.Color
+	}
+
+	scales := make([]float64, len(defaultHeadingScales))
+	for i, scale := range defaultHeadingScales {
+		scales[i] = scale
+		if i < len(m.HeadingScales) && m.HeadingScales[i] > 0 {
+			scales[i] = m.HeadingScales[i]
+		}
+	}
+	m.HeadingScales = scales
+
+	if m.CodeFamily == "" {
+		m.CodeFamily = fontfamily.Courier
+	}
+
+	if m.CodeTheme == "" {
+		m.CodeTheme = theme.Light
+	}
+
+	if m.LinkColor == nil {
+		m.LinkColor = &BlueColor
+	}
+
+	if m.BlockSpacing <= 0 {
+		m.BlockSpacing = 2
+	}
+
+	if m.Indent <= 0 {
+		m.Indent = 5
+	}
+}
@@ -0,0 +1,85 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/theme"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestMarkdown_ToMap(t *testing.T) {
+	t.Run("when markdown is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Markdown
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when markdown is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.MarkdownProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+		assert.Equal(t, 12.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_font_color"])
+		assert.Equal(t, []float64{3, 2, 1.25, 1.1, 1, 0.9}, m["prop_heading_scales"])
+		assert.Equal(t, fontfamily.Arial, m["prop_code_family"])
+		assert.Equal(t, theme.Dark, m["prop_code_theme"])
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_link_color"])
+		assert.Equal(t, 4.0, m["prop_block_spacing"])
+		assert.Equal(t, 8.0, m["prop_indent"])
+	})
+}
+
+func TestMarkdown_MakeValid(t *testing.T) {
+	t.Run("when font is not defined, should apply the default font", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		prop := props.Markdown{}
+
+		// Act
+		prop.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, font.Family, prop.Family)
+		assert.Equal(t, font.Size, prop.Size)
+		assert.Equal(t, font.Color, prop.Color)
+	})
+	t.Run("when heading scales are missing or invalid, should apply the default scales", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		prop := props.Markdown{HeadingScales: []float64{3, -1}}
+
+		// Act
+		prop.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, []float64{3, 1.5, 1.25, 1.1, 1, 0.9}, prop.HeadingScales)
+	})
+	t.Run("when code and spacing are not defined, should apply the defaults", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		prop := props.Markdown{}
+
+		// Act
+		prop.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, fontfamily.Courier, prop.CodeFamily)
+		assert.Equal(t, theme.Light, prop.CodeTheme)
+		assert.Equal(t, &props.BlueColor, prop.LinkColor)
+		assert.Equal(t, 2.0, prop.BlockSpacing)
+		assert.Equal(t, 5.0, prop.Indent)
+	})
+}
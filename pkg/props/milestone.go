@@ -0,0 +1,65 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+
+// Milestone represents properties from a Milestone chart inside a cell.
+type Milestone struct {
+	// LineColor define the color of the timeline.
+	LineColor *Color
+	// LineThickness define the thickness of the timeline.
+	LineThickness float64
+	// Color define the color of the diamonds of the events without a color.
+	Color *Color
+	// DiamondSize define the width and height, in mm, of the diamonds.
+	DiamondSize float64
+	// LabelFont define the font used to write the labels of the events.
+	LabelFont Font
+}
+
+// ToMap returns a map with the Milestone fields.
+func (m *Milestone) ToMap() map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+
+	mp := make(map[string]interface{})
+
+	if m.LineColor != nil {
+		mp["prop_line_color"] = m.LineColor.ToString()
+	}
+
+	if m.LineThickness != 0 {
+		mp["prop_line_thickness"] = m.LineThickness
+	}
+
+	if m.Color != nil {
+		mp["prop_color"] = m.Color.ToString()
+	}
+
+	if m.DiamondSize != 0 {
+		mp["prop_diamond_size"] = m.DiamondSize
+	}
+
+	return m.LabelFont.AppendMap(mp)
+}
+
+// MakeValid from Milestone define default values for a Milestone.
+func (m *Milestone) MakeValid(defaultFamily string) {
+	if m.LineColor == nil {
+		m.LineColor = &BlackColor
+	}
+
+	if m.LineThickness <= 0 {
+		m.LineThickness = linestyle.DefaultLineThickness
+	}
+
+	if m.Color == nil {
+		m.Color = &Color{Red: 70, Green: 130, Blue: 180}
+	}
+
+	if m.DiamondSize <= 0 {
+		m.DiamondSize = 3
+	}
+
+	m.LabelFont.MakeValid(defaultFamily)
+}
@@ -0,0 +1,54 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestMilestone_ToMap(t *testing.T) {
+	t.Run("when milestone is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Milestone
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when milestone is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.MilestoneProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_line_color"])
+		assert.Equal(t, 0.5, m["prop_line_thickness"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, 4.0, m["prop_diamond_size"])
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+	})
+}
+
+func TestMilestone_MakeValid(t *testing.T) {
+	// Arrange
+	prop := props.Milestone{}
+
+	// Act
+	prop.MakeValid(fontfamily.Courier)
+
+	// Assert
+	assert.Equal(t, &props.BlackColor, prop.LineColor)
+	assert.Equal(t, linestyle.DefaultLineThickness, prop.LineThickness)
+	assert.Equal(t, &props.Color{Red: 70, Green: 130, Blue: 180}, prop.Color)
+	assert.Equal(t, 3.0, prop.DiamondSize)
+	assert.Equal(t, fontfamily.Courier, prop.LabelFont.Family)
+}
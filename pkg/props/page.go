@@ -27,6 +27,13 @@ const (
 	RightBottom Place = "right_bottom"
 )
 
+const (
+	// AutoPageNumberPattern is the pattern of the page numbers written by entity.Config.AutoNumberPages.
+	AutoPageNumberPattern = "Page {current} of {total}"
+	// AutoPageNumberSize is the font size of the page numbers written by entity.Config.AutoNumberPages.
+	AutoPageNumberSize = 8.0
+)
+
 // IsValid checks if the place is valid.
 func (p Place) IsValid() bool {
 	return p == LeftTop || p == Top || p == RightTop ||
@@ -40,7 +47,7 @@ type Page struct {
 	Family  string
 	Style   fontstyle.Type
 	Size    float64
-	Color   *Color
+	Color   AnyColor
 }
 
 // GetNumberTextProp returns the Text properties of the page number.
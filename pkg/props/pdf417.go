@@ -0,0 +1,66 @@
+package props
+
+const (
+	// DefaultPDF417SecurityLevel is the security level used when the level of a PDF417 is invalid.
+	DefaultPDF417SecurityLevel = 2
+	// MaxPDF417SecurityLevel is the highest security level of a PDF417.
+	MaxPDF417SecurityLevel = 8
+	// MaxPDF417Columns is the maximum number of data columns of a PDF417.
+	MaxPDF417Columns = 30
+	// MaxPDF417Rows is the maximum number of rows of a PDF417.
+	MaxPDF417Rows = 30
+)
+
+// PDF417 represents properties from a PDF417 inside a cell.
+type PDF417 struct {
+	// Columns is the maximum number of data columns of the symbol, 0 means no limit.
+	// The columns and rows are chosen by the encoder to keep the symbol close to the 3:1 aspect ratio,
+	// when the code needs more columns or rows than the maximum, the code is not generated.
+	Columns int
+	// Rows is the maximum number of rows of the symbol, 0 means no limit.
+	Rows int
+	// SecurityLevel is the error correction level, from 0 to 8. The higher the level,
+	// the more error correction codewords are added.
+	SecurityLevel int
+	// Rect define the position and size of the symbol inside the cell.
+	Rect Rect
+}
+
+// ToMap from PDF417 will return a map representation from PDF417.
+func (p *PDF417) ToMap() map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+
+	m := p.Rect.ToMap()
+
+	if p.Columns != 0 {
+		m["prop_columns"] = p.Columns
+	}
+
+	if p.Rows != 0 {
+		m["prop_rows"] = p.Rows
+	}
+
+	m["prop_security_level"] = p.SecurityLevel
+
+	return m
+}
+
+// MakeValid from PDF417 will make the properties from a PDF417 reliable to fit inside a cell
+// and define default values for a PDF417.
+func (p *PDF417) MakeValid() {
+	if p.SecurityLevel < 0 || p.SecurityLevel > MaxPDF417SecurityLevel {
+		p.SecurityLevel = DefaultPDF417SecurityLevel
+	}
+
+	if p.Columns < 0 || p.Columns > MaxPDF417Columns {
+		p.Columns = 0
+	}
+
+	if p.Rows < 0 || p.Rows > MaxPDF417Rows {
+		p.Rows = 0
+	}
+
+	p.Rect.MakeValid()
+}
@@ -0,0 +1,80 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestPDF417_MakeValid(t *testing.T) {
+	t.Run("when security level is less than 0, should use default", func(t *testing.T) {
+		// Arrange
+		prop := props.PDF417{SecurityLevel: -1}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, props.DefaultPDF417SecurityLevel, prop.SecurityLevel)
+	})
+	t.Run("when security level is greater than 8, should use default", func(t *testing.T) {
+		// Arrange
+		prop := props.PDF417{SecurityLevel: 9}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, props.DefaultPDF417SecurityLevel, prop.SecurityLevel)
+	})
+	t.Run("when columns and rows are out of range, should become 0", func(t *testing.T) {
+		// Arrange
+		prop := props.PDF417{Columns: 31, Rows: -1}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0, prop.Columns)
+		assert.Equal(t, 0, prop.Rows)
+	})
+	t.Run("when rect is invalid, should make rect valid", func(t *testing.T) {
+		// Arrange
+		prop := props.PDF417{Rect: props.Rect{Percent: 102}}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 100.0, prop.Rect.Percent)
+	})
+}
+
+func TestPDF417_ToMap(t *testing.T) {
+	t.Run("when pdf417 is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var prop *props.PDF417
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when pdf417 is filled, should return the map with the rect", func(t *testing.T) {
+		// Arrange
+		prop := fixture.PDF417Prop()
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Equal(t, 10, m["prop_columns"])
+		assert.Equal(t, 20, m["prop_rows"])
+		assert.Equal(t, 4, m["prop_security_level"])
+		assert.Equal(t, 98.0, m["prop_percent"])
+	})
+}
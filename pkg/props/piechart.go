@@ -0,0 +1,53 @@
+package props
+
+// PieChart represents properties from a PieChart inside a cell.
+type PieChart struct {
+	// DonutRadius define the radius, in mm, of the hole in the middle of the chart. When 0, the chart is a filled pie.
+	DonutRadius float64
+	// BorderColor define the color of the lines between the slices.
+	BorderColor *Color
+	// LegendPercent define how much of the width is reserved to the legend on the right of the chart.
+	LegendPercent float64
+	// LegendFont define the font used to write the legend.
+	LegendFont Font
+}
+
+// ToMap returns a map with the PieChart fields.
+func (p *PieChart) ToMap() map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if p.DonutRadius != 0 {
+		m["prop_donut_radius"] = p.DonutRadius
+	}
+
+	if p.BorderColor != nil {
+		m["prop_border_color"] = p.BorderColor.ToString()
+	}
+
+	if p.LegendPercent != 0 {
+		m["prop_legend_percent"] = p.LegendPercent
+	}
+
+	return p.LegendFont.AppendMap(m)
+}
+
+// MakeValid from PieChart define default values for a PieChart.
+func (p *PieChart) MakeValid(defaultFamily string) {
+	if p.DonutRadius < 0 {
+		p.DonutRadius = 0
+	}
+
+	if p.BorderColor == nil {
+		p.BorderColor = &WhiteColor
+	}
+
+	if p.LegendPercent <= 0 || p.LegendPercent >= 100 {
+		p.LegendPercent = 30
+	}
+
+	p.LegendFont.MakeValid(defaultFamily)
+}
@@ -0,0 +1,87 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestPieChart_ToMap(t *testing.T) {
+	t.Run("when pie chart is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.PieChart
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when pie chart is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.PieChartProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 5.0, m["prop_donut_radius"])
+		assert.Equal(t, "RGB(0, 0, 0)", m["prop_border_color"])
+		assert.Equal(t, 40.0, m["prop_legend_percent"])
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+	})
+}
+
+func TestPieChart_MakeValid(t *testing.T) {
+	t.Run("when donut radius is negative, should apply a filled pie", func(t *testing.T) {
+		// Arrange
+		prop := props.PieChart{
+			DonutRadius: -1,
+		}
+
+		// Act
+		prop.MakeValid(fontfamily.Arial)
+
+		// Assert
+		assert.Equal(t, 0.0, prop.DonutRadius)
+	})
+	t.Run("when border color is nil, should apply white", func(t *testing.T) {
+		// Arrange
+		prop := props.PieChart{}
+
+		// Act
+		prop.MakeValid(fontfamily.Arial)
+
+		// Assert
+		assert.Equal(t, &props.WhiteColor, prop.BorderColor)
+	})
+	t.Run("when legend percent is invalid, should apply 30", func(t *testing.T) {
+		// Arrange
+		prop := props.PieChart{
+			LegendPercent: 100,
+		}
+
+		// Act
+		prop.MakeValid(fontfamily.Arial)
+
+		// Assert
+		assert.Equal(t, 30.0, prop.LegendPercent)
+	})
+	t.Run("when legend font is empty, should apply default font", func(t *testing.T) {
+		// Arrange
+		prop := props.PieChart{}
+
+		// Act
+		prop.MakeValid(fontfamily.Courier)
+
+		// Assert
+		assert.Equal(t, fontfamily.Courier, prop.LegendFont.Family)
+		assert.Equal(t, fontstyle.Normal, prop.LegendFont.Style)
+		assert.Equal(t, 8.0, prop.LegendFont.Size)
+	})
+}
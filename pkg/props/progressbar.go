@@ -0,0 +1,67 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+
+// ProgressBar represents properties from a ProgressBar inside a cell.
+type ProgressBar struct {
+	// FilledColor define the color of the filled part of the bar, by default blue.
+	FilledColor *Color
+	// EmptyColor define the color of the empty part of the bar, by default light gray.
+	EmptyColor *Color
+	// BorderColor define the color of the border around the bar. When nil, the border is not drawn.
+	BorderColor *Color
+	// BorderThickness define the thickness of the border.
+	BorderThickness float64
+	// CornerRadius define the radius of the corners, limited to half of the bar height. When 0, the corners are square.
+	CornerRadius float64
+}
+
+// ToMap returns a map with the ProgressBar fields.
+func (p *ProgressBar) ToMap() map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if p.FilledColor != nil {
+		m["prop_filled_color"] = p.FilledColor.ToString()
+	}
+
+	if p.EmptyColor != nil {
+		m["prop_empty_color"] = p.EmptyColor.ToString()
+	}
+
+	if p.BorderColor != nil {
+		m["prop_border_color"] = p.BorderColor.ToString()
+	}
+
+	if p.BorderThickness != 0 {
+		m["prop_border_thickness"] = p.BorderThickness
+	}
+
+	if p.CornerRadius != 0 {
+		m["prop_corner_radius"] = p.CornerRadius
+	}
+
+	return m
+}
+
+// MakeValid from ProgressBar define default values for a ProgressBar.
+func (p *ProgressBar) MakeValid() {
+	if p.FilledColor == nil {
+		p.FilledColor = &Color{Red: 66, Green: 133, Blue: 244}
+	}
+
+	if p.EmptyColor == nil {
+		p.EmptyColor = &Color{Red: 224, Green: 224, Blue: 224}
+	}
+
+	if p.BorderThickness <= 0 {
+		p.BorderThickness = linestyle.DefaultLineThickness
+	}
+
+	if p.CornerRadius < 0 {
+		p.CornerRadius = 0
+	}
+}
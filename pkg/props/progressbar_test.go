@@ -0,0 +1,65 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestProgressBar_ToMap(t *testing.T) {
+	t.Run("when progress bar is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.ProgressBar
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when progress bar is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.ProgressBarProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_filled_color"])
+		assert.Equal(t, "RGB(255, 255, 255)", m["prop_empty_color"])
+		assert.Equal(t, "RGB(0, 0, 0)", m["prop_border_color"])
+		assert.Equal(t, 0.5, m["prop_border_thickness"])
+		assert.Equal(t, 2.0, m["prop_corner_radius"])
+	})
+}
+
+func TestProgressBar_MakeValid(t *testing.T) {
+	t.Run("when fields are empty, should define defaults", func(t *testing.T) {
+		// Arrange
+		sut := props.ProgressBar{}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, &props.Color{Red: 66, Green: 133, Blue: 244}, sut.FilledColor)
+		assert.Equal(t, &props.Color{Red: 224, Green: 224, Blue: 224}, sut.EmptyColor)
+		assert.Nil(t, sut.BorderColor)
+		assert.Equal(t, linestyle.DefaultLineThickness, sut.BorderThickness)
+		assert.Equal(t, 0.0, sut.CornerRadius)
+	})
+	t.Run("when corner radius is negative, should become 0", func(t *testing.T) {
+		// Arrange
+		sut := props.ProgressBar{CornerRadius: -2}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, sut.CornerRadius)
+	})
+}
@@ -12,6 +12,13 @@ type Rect struct {
 	Percent float64
 	// Center define that the barcode will be vertically and horizontally centralized.
 	Center bool
+	// TileOffsetX define how much the tiles of a tiled image are shifted to the right.
+	TileOffsetX float64
+	// TileOffsetY define how much the tiles of a tiled image are shifted down.
+	TileOffsetY float64
+	// FallbackOnError define that a gray placeholder is drawn when an image fetched from an url cannot be
+	// loaded, instead of the error message.
+	FallbackOnError bool
 }
 
 // ToMap from Rect will return a map representation from Rect.
@@ -34,6 +41,18 @@ func (r *Rect) ToMap() map[string]interface{} {
 		m["prop_center"] = r.Center
 	}
 
+	if r.TileOffsetX != 0 {
+		m["prop_tile_offset_x"] = r.TileOffsetX
+	}
+
+	if r.TileOffsetY != 0 {
+		m["prop_tile_offset_y"] = r.TileOffsetY
+	}
+
+	if r.FallbackOnError {
+		m["prop_fallback_on_error"] = r.FallbackOnError
+	}
+
 	return m
 }
 
@@ -67,6 +67,9 @@ func TestRect_ToMap(t *testing.T) {
 	// Arrange
 	sut := fixture.RectProp()
 	sut.Center = true
+	sut.TileOffsetX = 2
+	sut.TileOffsetY = 3
+	sut.FallbackOnError = true
 
 	// Act
 	m := sut.ToMap()
@@ -76,4 +79,7 @@ func TestRect_ToMap(t *testing.T) {
 	assert.Equal(t, 10.0, m["prop_top"])
 	assert.Equal(t, 98.0, m["prop_percent"])
 	assert.Equal(t, true, m["prop_center"])
+	assert.Equal(t, 2.0, m["prop_tile_offset_x"])
+	assert.Equal(t, 3.0, m["prop_tile_offset_y"])
+	assert.Equal(t, true, m["prop_fallback_on_error"])
 }
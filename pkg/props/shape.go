@@ -0,0 +1,73 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+
+// Shape represents properties from a Shape inside a cell.
+type Shape struct {
+	// Color define the outline color.
+	Color *Color
+	// FillColor define the color used to fill the shape. When nil, only the outline is drawn.
+	FillColor *Color
+	// Style define the outline style (solid or dashed).
+	Style linestyle.Type
+	// Thickness define the outline thickness.
+	Thickness float64
+	// Percent define how much of the cell the shape occupies, the shape is always centered.
+	Percent float64
+}
+
+// ToMap returns a map with the Shape fields.
+func (s *Shape) ToMap() map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if s.Color != nil {
+		m["prop_color"] = s.Color.ToString()
+	}
+
+	if s.FillColor != nil {
+		m["prop_fill_color"] = s.FillColor.ToString()
+	}
+
+	if s.Style != "" {
+		m["prop_style"] = s.Style
+	}
+
+	if s.Thickness != 0 {
+		m["prop_thickness"] = s.Thickness
+	}
+
+	if s.Percent != 0 {
+		m["prop_percent"] = s.Percent
+	}
+
+	return m
+}
+
+// ToLineProp returns the props.Line used to draw the Shape polygon.
+func (s *Shape) ToLineProp() *Line {
+	return &Line{
+		Color:     s.Color,
+		FillColor: s.FillColor,
+		Style:     s.Style,
+		Thickness: s.Thickness,
+	}
+}
+
+// MakeValid from Shape define default values for a Shape.
+func (s *Shape) MakeValid() {
+	if s.Style == "" {
+		s.Style = linestyle.Solid
+	}
+
+	if s.Thickness <= 0 {
+		s.Thickness = linestyle.DefaultLineThickness
+	}
+
+	if s.Percent <= 0 || s.Percent > 100 {
+		s.Percent = 100
+	}
+}
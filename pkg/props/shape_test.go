@@ -0,0 +1,77 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestShape_ToMap(t *testing.T) {
+	t.Run("when shape is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Shape
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when shape is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.ShapeProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_fill_color"])
+		assert.Equal(t, linestyle.Dashed, m["prop_style"])
+		assert.Equal(t, 0.5, m["prop_thickness"])
+		assert.Equal(t, 50.0, m["prop_percent"])
+	})
+}
+
+func TestShape_MakeValid(t *testing.T) {
+	t.Run("when fields are empty, should define defaults", func(t *testing.T) {
+		// Arrange
+		sut := props.Shape{}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, linestyle.Solid, sut.Style)
+		assert.Equal(t, linestyle.DefaultLineThickness, sut.Thickness)
+		assert.Equal(t, 100.0, sut.Percent)
+	})
+	t.Run("when percent is greater than 100, should become 100", func(t *testing.T) {
+		// Arrange
+		sut := props.Shape{Percent: 150}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 100.0, sut.Percent)
+	})
+}
+
+func TestShape_ToLineProp(t *testing.T) {
+	// Arrange
+	sut := fixture.ShapeProp()
+
+	// Act
+	line := sut.ToLineProp()
+
+	// Assert
+	assert.Equal(t, sut.Color, line.Color)
+	assert.Equal(t, sut.FillColor, line.FillColor)
+	assert.Equal(t, sut.Style, line.Style)
+	assert.Equal(t, sut.Thickness, line.Thickness)
+}
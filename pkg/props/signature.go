@@ -2,6 +2,7 @@ package props
 
 import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/border"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
 )
@@ -15,13 +16,20 @@ type Signature struct {
 	// FontSize of the text.
 	FontSize float64
 	// FontColor define the font color.
-	FontColor *Color
+	FontColor AnyColor
 	// LineColor define the line color.
-	LineColor *Color
+	LineColor AnyColor
 	// LineStyle define the line style (solid or dashed).
 	LineStyle linestyle.Type
 	// LineThickness define the line thickness.
 	LineThickness float64
+	// BorderThickness define the thickness of a box drawn around the signature, with the LineColor.
+	// When it's zero and the BackgroundColor is nil, the box is not drawn.
+	BorderThickness float64
+	// BackgroundColor define the color of the box of the signature.
+	BackgroundColor *Color
+	// DrawX define that an X is drawn with the diagonals of the area above the line, marking where to sign.
+	DrawX bool
 }
 
 // ToMap returns a map with the Signature fields.
@@ -52,14 +60,26 @@ func (s *Signature) ToMap() map[string]interface{} {
 		m["prop_line_thickness"] = s.LineThickness
 	}
 
-	if s.FontColor != nil {
+	if !IsNilColor(s.FontColor) {
 		m["prop_font_color"] = s.FontColor.ToString()
 	}
 
-	if s.LineColor != nil {
+	if !IsNilColor(s.LineColor) {
 		m["prop_line_color"] = s.LineColor.ToString()
 	}
 
+	if s.BorderThickness != 0 {
+		m["prop_border_thickness"] = s.BorderThickness
+	}
+
+	if s.BackgroundColor != nil {
+		m["prop_background_color"] = s.BackgroundColor.ToString()
+	}
+
+	if s.DrawX {
+		m["prop_draw_x"] = s.DrawX
+	}
+
 	return m
 }
 
@@ -84,6 +104,30 @@ func (s *Signature) MakeValid(defaultFontFamily string) {
 	if s.LineThickness == 0 {
 		s.LineThickness = linestyle.DefaultLineThickness
 	}
+
+	if s.BorderThickness < 0 {
+		s.BorderThickness = 0
+	}
+}
+
+// HasBox returns true when a box is drawn around the signature.
+func (s *Signature) HasBox() bool {
+	return s.BorderThickness > 0 || s.BackgroundColor != nil
+}
+
+// ToCellProp from Signature return the Cell used to draw the box of the Signature.
+func (s *Signature) ToCellProp() *Cell {
+	cell := &Cell{
+		BackgroundColor: s.BackgroundColor,
+	}
+
+	if s.BorderThickness > 0 {
+		cell.BorderType = border.Full
+		cell.BorderThickness = s.BorderThickness
+		cell.BorderColor = s.LineColor
+	}
+
+	return cell
 }
 
 // ToLineProp from Signature return a Line based on Signature.
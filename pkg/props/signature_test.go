@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/border"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
@@ -42,6 +43,40 @@ func TestSignature_ToMap(t *testing.T) {
 	})
 }
 
+func TestSignature_ToCellProp(t *testing.T) {
+	t.Run("when border thickness is zero, should not have border", func(t *testing.T) {
+		// Arrange
+		prop := props.Signature{BackgroundColor: &props.Color{Red: 200}}
+
+		// Act
+		cellProp := prop.ToCellProp()
+
+		// Assert
+		assert.True(t, prop.HasBox())
+		assert.Equal(t, &props.Cell{BackgroundColor: &props.Color{Red: 200}}, cellProp)
+	})
+	t.Run("when border thickness is defined, should have full border with line color", func(t *testing.T) {
+		// Arrange
+		prop := props.Signature{BorderThickness: 0.4, LineColor: &props.Color{Blue: 100}}
+
+		// Act
+		cellProp := prop.ToCellProp()
+
+		// Assert
+		assert.True(t, prop.HasBox())
+		assert.Equal(t, border.Full, cellProp.BorderType)
+		assert.Equal(t, 0.4, cellProp.BorderThickness)
+		assert.Equal(t, &props.Color{Blue: 100}, cellProp.BorderColor)
+	})
+	t.Run("when nothing is defined, should not have box", func(t *testing.T) {
+		// Arrange
+		prop := props.Signature{}
+
+		// Act & Assert
+		assert.False(t, prop.HasBox())
+	})
+}
+
 func TestSignature_ToLineProp(t *testing.T) {
 	// Arrange
 	prop := fixture.SignatureProp()
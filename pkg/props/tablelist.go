@@ -0,0 +1,106 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+// TableList represents properties from a TableList.
+type TableList struct {
+	// HeaderColor define the background color of the header.
+	HeaderColor *Color
+	// OddRowColor define the background color of the 1st, 3rd, 5th... rows, nil to not fill them.
+	OddRowColor *Color
+	// EvenRowColor define the background color of the 2nd, 4th, 6th... rows, nil to not fill them.
+	EvenRowColor *Color
+	// HeaderFontStyle define the font style of the header, ex: Bold, Italic and etc, Bold when empty.
+	HeaderFontStyle fontstyle.Type
+	// ContentFontStyle define the font style of the rows, ex: Bold, Italic and etc, the style of the
+	// default font when empty.
+	ContentFontStyle fontstyle.Type
+	// ColumnProportions define the width of each column relative to the others, ex: []float64{2, 1, 1}
+	// makes the first column twice as wide as the others. When there isn't a proportion for each column,
+	// all the columns have the same width.
+	ColumnProportions []float64
+	// ColumnAligns define the alignment of the texts of each column, the columns without one are aligned to the left.
+	ColumnAligns []align.Type
+	// FontFamily of the texts, ex: consts.Arial, helvetica and etc, the family of the default font when empty.
+	FontFamily string
+	// FontSize of the texts, the size of the default font when zero.
+	FontSize float64
+	// FontColor define the color of the texts, the color of the default font when nil.
+	FontColor AnyColor
+}
+
+// ToMap returns a map with the TableList fields.
+func (t *TableList) ToMap() map[string]interface{} {
+	if t == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if t.HeaderColor != nil {
+		m["prop_header_color"] = t.HeaderColor.ToString()
+	}
+
+	if t.OddRowColor != nil {
+		m["prop_odd_row_color"] = t.OddRowColor.ToString()
+	}
+
+	if t.EvenRowColor != nil {
+		m["prop_even_row_color"] = t.EvenRowColor.ToString()
+	}
+
+	if t.HeaderFontStyle != "" {
+		m["prop_header_font_style"] = t.HeaderFontStyle
+	}
+
+	if t.ContentFontStyle != "" {
+		m["prop_content_font_style"] = t.ContentFontStyle
+	}
+
+	if len(t.ColumnProportions) > 0 {
+		m["prop_column_proportions"] = t.ColumnProportions
+	}
+
+	if len(t.ColumnAligns) > 0 {
+		m["prop_column_aligns"] = t.ColumnAligns
+	}
+
+	if t.FontFamily != "" {
+		m["prop_font_family"] = t.FontFamily
+	}
+
+	if t.FontSize != 0 {
+		m["prop_font_size"] = t.FontSize
+	}
+
+	if !IsNilColor(t.FontColor) {
+		m["prop_font_color"] = t.FontColor.ToString()
+	}
+
+	return m
+}
+
+// MakeValid from TableList define default values for a TableList.
+func (t *TableList) MakeValid() {
+	if t.HeaderColor == nil {
+		t.HeaderColor = &Color{Red: 200, Green: 200, Blue: 200}
+	}
+
+	if t.EvenRowColor == nil {
+		t.EvenRowColor = &Color{Red: 240, Green: 240, Blue: 240}
+	}
+
+	if t.HeaderFontStyle == "" {
+		t.HeaderFontStyle = fontstyle.Bold
+	}
+
+	for _, proportion := range t.ColumnProportions {
+		if proportion <= 0 {
+			t.ColumnProportions = nil
+			break
+		}
+	}
+}
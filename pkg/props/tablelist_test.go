@@ -0,0 +1,72 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestTableList_ToMap(t *testing.T) {
+	t.Run("when table list is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.TableList
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when table list is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.TableListProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_header_color"])
+		assert.Equal(t, "RGB(255, 255, 255)", m["prop_odd_row_color"])
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_even_row_color"])
+		assert.Equal(t, fontstyle.BoldItalic, m["prop_header_font_style"])
+		assert.Equal(t, fontstyle.Italic, m["prop_content_font_style"])
+		assert.Equal(t, []float64{2, 1}, m["prop_column_proportions"])
+		assert.Equal(t, []align.Type{align.Left, align.Right}, m["prop_column_aligns"])
+		assert.Equal(t, fontfamily.Courier, m["prop_font_family"])
+		assert.Equal(t, 10.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_font_color"])
+	})
+}
+
+func TestTableList_MakeValid(t *testing.T) {
+	t.Run("when table list is empty, should define the defaults", func(t *testing.T) {
+		// Arrange
+		sut := props.TableList{}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, &props.Color{Red: 200, Green: 200, Blue: 200}, sut.HeaderColor)
+		assert.Nil(t, sut.OddRowColor)
+		assert.Equal(t, &props.Color{Red: 240, Green: 240, Blue: 240}, sut.EvenRowColor)
+		assert.Equal(t, fontstyle.Bold, sut.HeaderFontStyle)
+		assert.Equal(t, fontstyle.Normal, sut.ContentFontStyle)
+	})
+	t.Run("when a column proportion is not positive, should remove the proportions", func(t *testing.T) {
+		// Arrange
+		sut := props.TableList{ColumnProportions: []float64{2, 0, 1}}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Nil(t, sut.ColumnProportions)
+	})
+}
@@ -2,11 +2,18 @@
 package props
 
 import (
+	"math"
+	"sort"
+
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/theme"
+	"github.com/johnfercher/maroto/v2/pkg/consts/whitespace"
 )
 
+const defaultMinFontSize = 6.0
+
 // Text represents properties from a Text inside a cell.
 type Text struct {
 	// Top is the amount of space between the upper cell limit and the text.
@@ -26,10 +33,35 @@ type Text struct {
 	BreakLineStrategy breakline.Strategy
 	// VerticalPadding define an additional space between linet.
 	VerticalPadding float64
+	// LineHeight define the distance between the lines as a multiple of the font size, like CSS, ex: 1.5 writes
+	// the lines one and a half font size apart. When zero, the lines are one font size apart, as 1.0.
+	LineHeight float64
 	// Color define the font style color.
-	Color *Color
+	Color AnyColor
 	// Hyperlink define a link to be opened when the text is clicked.
 	Hyperlink *string
+	// UnderlineLink define that the text is underlined when it has a Hyperlink.
+	UnderlineLink bool
+	// AlignLastLine define the align of the last line when the text is justified, by default it is left.
+	AlignLastLine align.Type
+	// TabStops define the positions, in mm from the left of the cell, where each tab character aligns the text.
+	TabStops []float64
+	// AutoFontSize define that the font size shrinks, down to MinFontSize, until the text fits in one line.
+	AutoFontSize bool
+	// MinFontSize define the smallest font size used by AutoFontSize, by default it is 6.
+	MinFontSize float64
+	// WhiteSpace define how line breaks and wrapping are handled, when empty it is whitespace.Normal.
+	WhiteSpace whitespace.Mode
+	// BulletChar define the character written before the text of a bullet, by default it is "•".
+	BulletChar string
+	// CodeTheme define the colors used to highlight a code block, by default it is theme.Light.
+	CodeTheme theme.Name
+	// Rotate define the angle, in degrees counter-clockwise, in which the text is written.
+	Rotate float64
+	// RotateOriginX define the pivot of the rotation from the left of the cell, 0 is the left and 1 the right.
+	RotateOriginX float64
+	// RotateOriginY define the pivot of the rotation from the top of the cell, 0 is the top and 1 the bottom.
+	RotateOriginY float64
 }
 
 // ToMap converts a Text to a map.
@@ -63,6 +95,10 @@ func (t *Text) ToMap() map[string]interface{} {
 		m["prop_align"] = t.Align
 	}
 
+	if t.AlignLastLine != "" {
+		m["prop_align_last_line"] = t.AlignLastLine
+	}
+
 	if t.BreakLineStrategy != "" {
 		m["prop_breakline_strategy"] = t.BreakLineStrategy
 	}
@@ -71,7 +107,11 @@ func (t *Text) ToMap() map[string]interface{} {
 		m["prop_vertical_padding"] = t.VerticalPadding
 	}
 
-	if t.Color != nil {
+	if t.LineHeight != 0 {
+		m["prop_line_height"] = t.LineHeight
+	}
+
+	if !IsNilColor(t.Color) {
 		m["prop_color"] = t.Color.ToString()
 	}
 
@@ -79,6 +119,46 @@ func (t *Text) ToMap() map[string]interface{} {
 		m["prop_hyperlink"] = *t.Hyperlink
 	}
 
+	if t.UnderlineLink {
+		m["prop_underline_link"] = t.UnderlineLink
+	}
+
+	if len(t.TabStops) > 0 {
+		m["prop_tab_stops"] = t.TabStops
+	}
+
+	if t.AutoFontSize {
+		m["prop_auto_font_size"] = t.AutoFontSize
+	}
+
+	if t.MinFontSize != 0 {
+		m["prop_min_font_size"] = t.MinFontSize
+	}
+
+	if t.WhiteSpace != "" {
+		m["prop_white_space"] = t.WhiteSpace
+	}
+
+	if t.BulletChar != "" {
+		m["prop_bullet_char"] = t.BulletChar
+	}
+
+	if t.CodeTheme != "" {
+		m["prop_code_theme"] = t.CodeTheme
+	}
+
+	if t.Rotate != 0 {
+		m["prop_rotate"] = t.Rotate
+	}
+
+	if t.RotateOriginX != 0 {
+		m["prop_rotate_origin_x"] = t.RotateOriginX
+	}
+
+	if t.RotateOriginY != 0 {
+		m["prop_rotate_origin_y"] = t.RotateOriginY
+	}
+
 	return m
 }
 
@@ -99,7 +179,7 @@ func (t *Text) MakeValid(font *Font) {
 		t.Size = font.Size
 	}
 
-	if t.Color == nil {
+	if IsNilColor(t.Color) {
 		t.Color = font.Color
 	}
 
@@ -107,6 +187,10 @@ func (t *Text) MakeValid(font *Font) {
 		t.Align = align.Left
 	}
 
+	if t.Align == align.Justify && (t.AlignLastLine == "" || t.AlignLastLine == align.Justify) {
+		t.AlignLastLine = align.Left
+	}
+
 	if t.Top < minValue {
 		t.Top = minValue
 	}
@@ -123,7 +207,44 @@ func (t *Text) MakeValid(font *Font) {
 		t.VerticalPadding = 0
 	}
 
+	if t.LineHeight < 0 {
+		t.LineHeight = 0
+	}
+
 	if t.BreakLineStrategy == "" {
 		t.BreakLineStrategy = breakline.EmptySpaceStrategy
 	}
+
+	if len(t.TabStops) > 0 {
+		t.TabStops = makeValidTabStops(t.TabStops)
+	}
+
+	if t.AutoFontSize && t.MinFontSize <= 0 {
+		t.MinFontSize = defaultMinFontSize
+	}
+
+	t.RotateOriginX = math.Max(0, math.Min(1, t.RotateOriginX))
+	t.RotateOriginY = math.Max(0, math.Min(1, t.RotateOriginY))
+}
+
+// GetLineHeight returns the distance between two lines of the text written with the font height, without
+// the VerticalPadding.
+func (t *Text) GetLineHeight(fontHeight float64) float64 {
+	if t.LineHeight <= 0 {
+		return fontHeight
+	}
+
+	return fontHeight * t.LineHeight
+}
+
+func makeValidTabStops(tabStops []float64) []float64 {
+	valid := []float64{}
+	for _, tabStop := range tabStops {
+		if tabStop >= 0 {
+			valid = append(valid, tabStop)
+		}
+	}
+
+	sort.Float64s(valid)
+	return valid
 }
@@ -89,6 +89,55 @@ func TestText_MakeValid(t *testing.T) {
 				assert.Equal(t, prop.VerticalPadding, 0.0)
 			},
 		},
+		{
+			"When line height is less than 0, should become 0",
+			&props.Text{
+				LineHeight: -1.5,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.LineHeight, 0.0)
+			},
+		},
+		{
+			"When align is justify and last line align is not defined, should define Left",
+			&props.Text{
+				Align: align.Justify,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.AlignLastLine, align.Left)
+			},
+		},
+		{
+			"When auto font size is enabled and min font size is not defined, should define 6",
+			&props.Text{
+				AutoFontSize: true,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.MinFontSize, 6.0)
+			},
+		},
+		{
+			"When tab stops are unordered or negative, should sort and drop negatives",
+			&props.Text{
+				TabStops: []float64{40, -5, 10},
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, []float64{10, 40}, prop.TabStops)
+			},
+		},
+		{
+			"When rotate origin is out of the cell, should clamp it between 0 and 1",
+			&props.Text{
+				Rotate:        90,
+				RotateOriginX: -1,
+				RotateOriginY: 2,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, 90.0, prop.Rotate)
+				assert.Equal(t, 0.0, prop.RotateOriginX)
+				assert.Equal(t, 1.0, prop.RotateOriginY)
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -96,3 +145,65 @@ func TestText_MakeValid(t *testing.T) {
 		c.assert(t, c.fontProp)
 	}
 }
+
+func TestText_ToMap(t *testing.T) {
+	t.Run("when text is rotated, should return map with rotation", func(t *testing.T) {
+		// Arrange
+		sut := props.Text{
+			Rotate:        45,
+			RotateOriginX: 0.5,
+			RotateOriginY: 0.25,
+		}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 45.0, m["prop_rotate"])
+		assert.Equal(t, 0.5, m["prop_rotate_origin_x"])
+		assert.Equal(t, 0.25, m["prop_rotate_origin_y"])
+	})
+	t.Run("when line height is defined, should return map with line height", func(t *testing.T) {
+		// Arrange
+		sut := props.Text{LineHeight: 1.5}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 1.5, m["prop_line_height"])
+	})
+	t.Run("when text is not rotated, should not return rotation", func(t *testing.T) {
+		// Arrange
+		sut := props.Text{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.NotContains(t, m, "prop_rotate")
+	})
+}
+
+func TestText_GetLineHeight(t *testing.T) {
+	t.Run("when line height is not defined, should return the font height", func(t *testing.T) {
+		// Arrange
+		sut := props.Text{}
+
+		// Act
+		lineHeight := sut.GetLineHeight(4)
+
+		// Assert
+		assert.Equal(t, 4.0, lineHeight)
+	})
+	t.Run("when line height is defined, should multiply the font height", func(t *testing.T) {
+		// Arrange
+		sut := props.Text{LineHeight: 1.5}
+
+		// Act
+		lineHeight := sut.GetLineHeight(4)
+
+		// Assert
+		assert.Equal(t, 6.0, lineHeight)
+	})
+}
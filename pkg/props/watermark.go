@@ -0,0 +1,92 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+
+const (
+	defaultWatermarkSize    = 60.0
+	defaultWatermarkAngle   = 45.0
+	defaultWatermarkOpacity = 0.3
+)
+
+// defaultWatermarkColor is the light gray of the watermarks without a color.
+var defaultWatermarkColor = Color{Red: 128, Green: 128, Blue: 128}
+
+// Watermark represents properties from a text written diagonally over the content of a page.
+type Watermark struct {
+	// Family of the text, ex: constf.Arial, helvetica and etc, the default font family when empty.
+	Family string
+	// Style of the text, ex: constf.Normal, bold and etc, Bold in NewWatermark.
+	Style fontstyle.Type
+	// Size of the text, 60 when empty.
+	Size float64
+	// Color define the font color, gray when empty.
+	Color *Color
+	// Angle is the counterclockwise rotation of the text in degrees around the center of the page, 45 in NewWatermark.
+	Angle float64
+	// Opacity define the opacity of the text, from 0 (transparent) to 1 (opaque), 0.3 when empty.
+	Opacity float64
+}
+
+// NewWatermark returns the Watermark used when the props are not sent, bold and rotated 45 degrees. The
+// other fields are defined by MakeValid.
+func NewWatermark() Watermark {
+	return Watermark{Style: fontstyle.Bold, Angle: defaultWatermarkAngle}
+}
+
+// ToMap returns a map with the Watermark fields.
+func (w *Watermark) ToMap() map[string]interface{} {
+	if w == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if w.Family != "" {
+		m["prop_font_family"] = w.Family
+	}
+
+	if w.Style != "" {
+		m["prop_font_style"] = w.Style
+	}
+
+	if w.Size != 0 {
+		m["prop_font_size"] = w.Size
+	}
+
+	if w.Color != nil {
+		m["prop_color"] = w.Color.ToString()
+	}
+
+	if w.Angle != 0 {
+		m["prop_angle"] = w.Angle
+	}
+
+	if w.Opacity != 0 {
+		m["prop_opacity"] = w.Opacity
+	}
+
+	return m
+}
+
+// MakeValid from Watermark define default values for a Watermark, the opacity is limited to 1. The style and
+// the angle are kept, as an empty style is normal and 0 is horizontal, NewWatermark defines their defaults.
+func (w *Watermark) MakeValid(font *Font) {
+	if w.Family == "" {
+		w.Family = font.Family
+	}
+
+	if w.Size <= 0 {
+		w.Size = defaultWatermarkSize
+	}
+
+	if w.Color == nil {
+		color := defaultWatermarkColor
+		w.Color = &color
+	}
+
+	if w.Opacity <= 0 {
+		w.Opacity = defaultWatermarkOpacity
+	}
+
+	w.Opacity = min(w.Opacity, 1)
+}
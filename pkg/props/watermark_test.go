@@ -0,0 +1,96 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestWatermark_ToMap(t *testing.T) {
+	t.Run("when watermark is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Watermark
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when watermark is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.WatermarkProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+		assert.Equal(t, fontstyle.Italic, m["prop_font_style"])
+		assert.Equal(t, 40.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, 30.0, m["prop_angle"])
+		assert.Equal(t, 0.5, m["prop_opacity"])
+	})
+}
+
+func TestNewWatermark(t *testing.T) {
+	// Act
+	sut := props.NewWatermark()
+
+	// Assert
+	assert.Equal(t, fontstyle.Bold, sut.Style)
+	assert.Equal(t, 45.0, sut.Angle)
+}
+
+func TestWatermark_MakeValid(t *testing.T) {
+	t.Run("when watermark is empty, should use the default font and the default values", func(t *testing.T) {
+		// Arrange
+		fontProp := fixture.FontProp()
+		sut := props.Watermark{}
+
+		// Act
+		sut.MakeValid(&fontProp)
+
+		// Assert
+		assert.Equal(t, fontProp.Family, sut.Family)
+		assert.Equal(t, fontstyle.Normal, sut.Style)
+		assert.Equal(t, 60.0, sut.Size)
+		assert.Equal(t, &props.Color{Red: 128, Green: 128, Blue: 128}, sut.Color)
+		assert.Equal(t, 0.0, sut.Angle)
+		assert.Equal(t, 0.3, sut.Opacity)
+	})
+	t.Run("when opacity is greater than 1, should become 1", func(t *testing.T) {
+		// Arrange
+		fontProp := fixture.FontProp()
+		sut := props.Watermark{Opacity: 2}
+
+		// Act
+		sut.MakeValid(&fontProp)
+
+		// Assert
+		assert.Equal(t, 1.0, sut.Opacity)
+	})
+	t.Run("when watermark is filled, should keep the values", func(t *testing.T) {
+		// Arrange
+		fontProp := fixture.FontProp()
+		colorProp := fixture.ColorProp()
+		sut := props.Watermark{Family: fontfamily.Courier, Style: fontstyle.BoldItalic, Size: 20, Color: &colorProp, Angle: -30, Opacity: 0.8}
+
+		// Act
+		sut.MakeValid(&fontProp)
+
+		// Assert
+		assert.Equal(t, fontfamily.Courier, sut.Family)
+		assert.Equal(t, fontstyle.BoldItalic, sut.Style)
+		assert.Equal(t, 20.0, sut.Size)
+		assert.Equal(t, &colorProp, sut.Color)
+		assert.Equal(t, -30.0, sut.Angle)
+		assert.Equal(t, 0.8, sut.Opacity)
+	})
+}
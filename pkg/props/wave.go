@@ -0,0 +1,76 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+
+// Wave represents properties from a Wave inside a cell.
+type Wave struct {
+	// Color define the wave color.
+	Color *Color
+	// Style define the wave style (solid or dashed).
+	Style linestyle.Type
+	// Thickness define the wave thickness.
+	Thickness float64
+	// Count define how many waves would be drawn along the cell width.
+	Count int
+	// AmplitudePercent define the height of the wave, 100 uses the whole cell height.
+	AmplitudePercent float64
+}
+
+// ToMap returns a map with the Wave fields.
+func (w *Wave) ToMap() map[string]interface{} {
+	if w == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if w.Color != nil {
+		m["prop_color"] = w.Color.ToString()
+	}
+
+	if w.Style != "" {
+		m["prop_style"] = w.Style
+	}
+
+	if w.Thickness != 0 {
+		m["prop_thickness"] = w.Thickness
+	}
+
+	if w.Count != 0 {
+		m["prop_count"] = w.Count
+	}
+
+	if w.AmplitudePercent != 0 {
+		m["prop_amplitude_percent"] = w.AmplitudePercent
+	}
+
+	return m
+}
+
+// ToLineProp returns the props.Line used to draw the Wave curves.
+func (w *Wave) ToLineProp() *Line {
+	return &Line{
+		Color:     w.Color,
+		Style:     w.Style,
+		Thickness: w.Thickness,
+	}
+}
+
+// MakeValid from Wave define default values for a Wave.
+func (w *Wave) MakeValid() {
+	if w.Style == "" {
+		w.Style = linestyle.Solid
+	}
+
+	if w.Thickness <= 0 {
+		w.Thickness = linestyle.DefaultLineThickness
+	}
+
+	if w.Count <= 0 {
+		w.Count = 3
+	}
+
+	if w.AmplitudePercent <= 0 || w.AmplitudePercent > 100 {
+		w.AmplitudePercent = 50
+	}
+}
@@ -0,0 +1,79 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestWave_ToMap(t *testing.T) {
+	t.Run("when wave is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Wave
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when wave is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.WaveProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, linestyle.Dashed, m["prop_style"])
+		assert.Equal(t, 0.5, m["prop_thickness"])
+		assert.Equal(t, 2, m["prop_count"])
+		assert.Equal(t, 80.0, m["prop_amplitude_percent"])
+	})
+}
+
+func TestWave_ToLineProp(t *testing.T) {
+	// Arrange
+	sut := fixture.WaveProp()
+
+	// Act
+	line := sut.ToLineProp()
+
+	// Assert
+	assert.Equal(t, sut.Color, line.Color)
+	assert.Equal(t, sut.Style, line.Style)
+	assert.Equal(t, sut.Thickness, line.Thickness)
+}
+
+func TestWave_MakeValid(t *testing.T) {
+	t.Run("when wave is empty, should apply defaults", func(t *testing.T) {
+		// Arrange
+		prop := props.Wave{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, linestyle.Solid, prop.Style)
+		assert.Equal(t, linestyle.DefaultLineThickness, prop.Thickness)
+		assert.Equal(t, 3, prop.Count)
+		assert.Equal(t, 50.0, prop.AmplitudePercent)
+	})
+	t.Run("when amplitude percent is greater than 100, should apply default", func(t *testing.T) {
+		// Arrange
+		prop := props.Wave{
+			AmplitudePercent: 101,
+		}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 50.0, prop.AmplitudePercent)
+	})
+}
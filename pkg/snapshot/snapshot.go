@@ -0,0 +1,99 @@
+// Package snapshot implements golden file tests of the PDFs generated by maroto.
+package snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+)
+
+// UpdateEnv is the environment variable which regenerates the snapshots when it is "1".
+const UpdateEnv = "UPDATE_SNAPSHOTS"
+
+// Dir is the directory of the snapshots, relative to the package of the test.
+var Dir = filepath.Join("testdata", "snapshots")
+
+// Assert generates the document of m and compares its SHA-256 with the snapshot name.sha256. When the
+// hashes differ, the generated document is stored in name.actual.pdf to be inspected. The snapshot is
+// written instead of compared when UPDATE_SNAPSHOTS=1.
+//
+// The cfg is the config used to build m, it must fix the creation and the modification dates, otherwise
+// the current time is written in the document and the hash changes on every run.
+func Assert(t testing.TB, m core.Maroto, cfg *entity.Config, name string) bool {
+	t.Helper()
+
+	if cfg == nil || cfg.Metadata == nil || cfg.Metadata.CreationDate == nil || cfg.Metadata.ModificationDate == nil {
+		t.Errorf("snapshot %s needs a config with the creation and the modification dates", name)
+		return false
+	}
+
+	doc, err := m.Generate()
+	if err != nil {
+		t.Errorf("could not generate document: %s", err.Error())
+		return false
+	}
+
+	sum := sha256.Sum256(doc.GetBytes())
+	actual := hex.EncodeToString(sum[:])
+
+	hashFile := filepath.Join(Dir, name+".sha256")
+	actualFile := filepath.Join(Dir, name+".actual.pdf")
+
+	if os.Getenv(UpdateEnv) == "1" {
+		if err = write(hashFile, []byte(actual+"\n")); err != nil {
+			t.Errorf("could not update snapshot %s: %s", name, err.Error())
+			return false
+		}
+
+		return remove(t, actualFile)
+	}
+
+	expected, err := os.ReadFile(hashFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("snapshot %s not found, run the tests with %s=1 to create it", hashFile, UpdateEnv)
+		return false
+	}
+
+	if err != nil {
+		t.Errorf("could not read snapshot %s: %s", name, err.Error())
+		return false
+	}
+
+	if strings.TrimSpace(string(expected)) == actual {
+		return remove(t, actualFile)
+	}
+
+	if err = write(actualFile, doc.GetBytes()); err != nil {
+		t.Errorf("snapshot %s does not match and could not store the document: %s", name, err.Error())
+		return false
+	}
+
+	t.Errorf("snapshot %s does not match, the document was stored in %s", name, actualFile)
+	return false
+}
+
+func write(file string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, content, 0o644)
+}
+
+// remove deletes the document stored by a previous mismatch, as the snapshot matches now.
+func remove(t testing.TB, file string) bool {
+	if err := os.Remove(file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("could not remove %s: %s", file, err.Error())
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,124 @@
+package snapshot_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/snapshot"
+)
+
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssert(t *testing.T) {
+	date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	cfg := config.NewBuilder().WithCreationDate(date).WithModificationDate(date).Build()
+
+	t.Run("when config has no dates, should fail", func(t *testing.T) {
+		// Arrange
+		useDir(t)
+		fake := &fakeT{}
+		cfg := config.NewBuilder().Build()
+
+		// Act
+		ok := snapshot.Assert(fake, newMaroto(cfg, "report"), cfg, "report")
+
+		// Assert
+		assert.False(t, ok)
+		assert.Equal(t, []string{"snapshot report needs a config with the creation and the modification dates"}, fake.errors)
+	})
+	t.Run("when snapshot does not exist, should fail", func(t *testing.T) {
+		// Arrange
+		dir := useDir(t)
+		fake := &fakeT{}
+
+		// Act
+		ok := snapshot.Assert(fake, newMaroto(cfg, "report"), cfg, "report")
+
+		// Assert
+		assert.False(t, ok)
+		assert.Equal(t, []string{
+			fmt.Sprintf("snapshot %s not found, run the tests with UPDATE_SNAPSHOTS=1 to create it", filepath.Join(dir, "report.sha256")),
+		}, fake.errors)
+	})
+	t.Run("when update is enabled, should write the snapshot", func(t *testing.T) {
+		// Arrange
+		dir := useDir(t)
+		t.Setenv(snapshot.UpdateEnv, "1")
+
+		// Act
+		ok := snapshot.Assert(t, newMaroto(cfg, "report"), cfg, "report")
+
+		// Assert
+		assert.True(t, ok)
+		assert.FileExists(t, filepath.Join(dir, "report.sha256"))
+	})
+	t.Run("when document matches the snapshot, should pass and remove the previous actual pdf", func(t *testing.T) {
+		// Arrange
+		dir := useDir(t)
+		t.Setenv(snapshot.UpdateEnv, "1")
+		snapshot.Assert(t, newMaroto(cfg, "report"), cfg, "report")
+		t.Setenv(snapshot.UpdateEnv, "")
+		_ = os.WriteFile(filepath.Join(dir, "report.actual.pdf"), []byte{1}, os.ModePerm)
+
+		// Act
+		ok := snapshot.Assert(t, newMaroto(cfg, "report"), cfg, "report")
+
+		// Assert
+		assert.True(t, ok)
+		assert.NoFileExists(t, filepath.Join(dir, "report.actual.pdf"))
+	})
+	t.Run("when document does not match the snapshot, should fail and store the actual pdf", func(t *testing.T) {
+		// Arrange
+		dir := useDir(t)
+		t.Setenv(snapshot.UpdateEnv, "1")
+		snapshot.Assert(t, newMaroto(cfg, "report"), cfg, "report")
+		t.Setenv(snapshot.UpdateEnv, "")
+		fake := &fakeT{}
+
+		// Act
+		ok := snapshot.Assert(fake, newMaroto(cfg, "changed report"), cfg, "report")
+
+		// Assert
+		actualFile := filepath.Join(dir, "report.actual.pdf")
+		assert.False(t, ok)
+		assert.Equal(t, []string{fmt.Sprintf("snapshot report does not match, the document was stored in %s", actualFile)}, fake.errors)
+		actual, _ := os.ReadFile(actualFile)
+		assert.Equal(t, "%PDF", string(actual[:4]))
+	})
+}
+
+func newMaroto(cfg *entity.Config, value string) core.Maroto {
+	m := maroto.New(cfg)
+	m.AddRows(text.NewRow(10, value))
+	return m
+}
+
+// useDir stores the snapshots of the test in a temporary directory.
+func useDir(t *testing.T) string {
+	dir := snapshot.Dir
+	t.Cleanup(func() {
+		snapshot.Dir = dir
+	})
+
+	snapshot.Dir = filepath.Join(t.TempDir(), "snapshots")
+	return snapshot.Dir
+}
@@ -0,0 +1,204 @@
+// Package testing implements assertion helpers to test documents generated by maroto.
+package testing
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+)
+
+// TestingT is the interface of *testing.T used by the assertions.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// AssertPageCount asserts that the document has n pages.
+func AssertPageCount(t TestingT, doc core.Document, n int) bool {
+	t.Helper()
+
+	ctx, err := readContext(doc)
+	if err != nil {
+		t.Errorf("could not read document: %s", err.Error())
+		return false
+	}
+
+	if ctx.PageCount != n {
+		t.Errorf("expected %d pages, but document has %d", n, ctx.PageCount)
+		return false
+	}
+
+	return true
+}
+
+// AssertContainsText asserts that the text is written in some page of the document.
+// Only text written with the standard fonts can be extracted, texts written with UTF8 custom fonts
+// are stored as glyph ids and are not found.
+func AssertContainsText(t TestingT, doc core.Document, text string) bool {
+	t.Helper()
+
+	ctx, err := readContext(doc)
+	if err != nil {
+		t.Errorf("could not read document: %s", err.Error())
+		return false
+	}
+
+	for page := 1; page <= ctx.PageCount; page++ {
+		content, err := pdfcpu.ExtractPageContent(ctx, page)
+		if err != nil {
+			t.Errorf("could not extract content from page %d: %s", page, err.Error())
+			return false
+		}
+
+		if content == nil {
+			continue
+		}
+
+		raw, err := io.ReadAll(content)
+		if err != nil {
+			t.Errorf("could not read content from page %d: %s", page, err.Error())
+			return false
+		}
+
+		for _, value := range extractStrings(raw) {
+			if strings.Contains(value, text) {
+				return true
+			}
+		}
+	}
+
+	t.Errorf("expected document to contain text \"%s\"", text)
+	return false
+}
+
+// AssertImageCount asserts that the document has n embedded images, an image reused in many pages is counted once.
+func AssertImageCount(t TestingT, doc core.Document, n int) bool {
+	t.Helper()
+
+	pages, err := api.Images(bytes.NewReader(doc.GetBytes()), nil, newConfiguration())
+	if err != nil {
+		t.Errorf("could not read document images: %s", err.Error())
+		return false
+	}
+
+	images := make(map[int]bool)
+	for _, page := range pages {
+		for objNr := range page {
+			images[objNr] = true
+		}
+	}
+
+	if len(images) != n {
+		t.Errorf("expected %d images, but document has %d", n, len(images))
+		return false
+	}
+
+	return true
+}
+
+func readContext(doc core.Document) (*model.Context, error) {
+	ctx, err := api.ReadContext(bytes.NewReader(doc.GetBytes()), newConfiguration())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := api.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return ctx, nil
+}
+
+func newConfiguration() *model.Configuration {
+	conf := model.NewDefaultConfiguration()
+	conf.ValidationMode = model.ValidationRelaxed
+	return conf
+}
+
+// extractStrings returns the literal strings of a content stream, the parts of a TJ array are joined.
+func extractStrings(content []byte) []string {
+	var values []string
+	var current []byte
+
+	inArray := false
+	for i := 0; i < len(content); i++ {
+		switch content[i] {
+		case '[':
+			inArray = true
+		case ']':
+			inArray = false
+			values = appendString(values, current)
+			current = nil
+		case '(':
+			var value []byte
+			value, i = readLiteral(content, i+1)
+			current = append(current, value...)
+			if !inArray {
+				values = appendString(values, current)
+				current = nil
+			}
+		}
+	}
+
+	return values
+}
+
+func appendString(values []string, value []byte) []string {
+	if len(value) == 0 {
+		return values
+	}
+
+	decoded, err := charmap.Windows1252.NewDecoder().Bytes(value)
+	if err != nil {
+		return append(values, string(value))
+	}
+
+	return append(values, string(decoded))
+}
+
+// readLiteral reads a literal string starting after its opening parenthesis and returns the index of its closing one.
+func readLiteral(content []byte, start int) ([]byte, int) {
+	var value []byte
+
+	depth := 0
+	for i := start; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\' && i+1 < len(content):
+			i++
+			value = append(value, unescape(content[i]))
+		case c == '(':
+			depth++
+			value = append(value, c)
+		case c == ')' && depth == 0:
+			return value, i
+		case c == ')':
+			depth--
+			value = append(value, c)
+		default:
+			value = append(value, c)
+		}
+	}
+
+	return value, len(content)
+}
+
+func unescape(c byte) byte {
+	switch c {
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	default:
+		return c
+	}
+}
@@ -0,0 +1,141 @@
+package testing_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	mtesting "github.com/johnfercher/maroto/v2/pkg/testing"
+)
+
+type fakeT struct {
+	errors []string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertPageCount(t *testing.T) {
+	doc := generate(t)
+
+	t.Run("when page count matches, should pass", func(t *testing.T) {
+		// Arrange
+		fake := &fakeT{}
+
+		// Act
+		ok := mtesting.AssertPageCount(fake, doc, 2)
+
+		// Assert
+		assert.True(t, ok)
+		assert.Empty(t, fake.errors)
+	})
+	t.Run("when page count does not match, should fail", func(t *testing.T) {
+		// Arrange
+		fake := &fakeT{}
+
+		// Act
+		ok := mtesting.AssertPageCount(fake, doc, 3)
+
+		// Assert
+		assert.False(t, ok)
+		assert.Equal(t, []string{"expected 3 pages, but document has 2"}, fake.errors)
+	})
+	t.Run("when document is invalid, should fail", func(t *testing.T) {
+		// Arrange
+		fake := &fakeT{}
+
+		// Act
+		ok := mtesting.AssertPageCount(fake, core.NewPDF([]byte("invalid"), nil), 1)
+
+		// Assert
+		assert.False(t, ok)
+		assert.Len(t, fake.errors, 1)
+	})
+}
+
+func TestAssertContainsText(t *testing.T) {
+	doc := generate(t)
+
+	t.Run("when text is in the document, should pass", func(t *testing.T) {
+		// Arrange
+		fake := &fakeT{}
+
+		// Act
+		ok := mtesting.AssertContainsText(fake, doc, "Relatório (final)")
+
+		// Assert
+		assert.True(t, ok)
+		assert.Empty(t, fake.errors)
+	})
+	t.Run("when text is in the last page, should pass", func(t *testing.T) {
+		// Arrange
+		fake := &fakeT{}
+
+		// Act
+		ok := mtesting.AssertContainsText(fake, doc, "second page")
+
+		// Assert
+		assert.True(t, ok)
+	})
+	t.Run("when text is not in the document, should fail", func(t *testing.T) {
+		// Arrange
+		fake := &fakeT{}
+
+		// Act
+		ok := mtesting.AssertContainsText(fake, doc, "missing")
+
+		// Assert
+		assert.False(t, ok)
+		assert.Equal(t, []string{"expected document to contain text \"missing\""}, fake.errors)
+	})
+}
+
+func TestAssertImageCount(t *testing.T) {
+	doc := generate(t)
+
+	t.Run("when image count matches, should pass", func(t *testing.T) {
+		// Arrange
+		fake := &fakeT{}
+
+		// Act
+		ok := mtesting.AssertImageCount(fake, doc, 1)
+
+		// Assert
+		assert.True(t, ok)
+		assert.Empty(t, fake.errors)
+	})
+	t.Run("when image count does not match, should fail", func(t *testing.T) {
+		// Arrange
+		fake := &fakeT{}
+
+		// Act
+		ok := mtesting.AssertImageCount(fake, doc, 2)
+
+		// Assert
+		assert.False(t, ok)
+		assert.Equal(t, []string{"expected 2 images, but document has 1"}, fake.errors)
+	})
+}
+
+func generate(t *testing.T) core.Document {
+	m := maroto.New()
+	m.AddRows(text.NewRow(10, "Relatório (final)"))
+	m.AddRows(image.NewFromFileRow(20, "../../docs/assets/images/biplane.jpg"))
+	m.AddPages(page.New().Add(text.NewRow(10, "second page")))
+
+	doc, err := m.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return doc
+}
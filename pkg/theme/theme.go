@@ -0,0 +1,142 @@
+// Package theme contains the visual themes which can be applied to the whole document, the syntax
+// highlighting themes of the code blocks are in pkg/consts/theme.
+package theme
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/border"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Theme is the set of visual defaults of a document. The font, margins and page number are applied
+// to the config with Apply, while the props of the components are used when creating them,
+// ex: text.New("Invoice", theme.Material.Title).
+type Theme struct {
+	// Font is the default font of the document.
+	Font *props.Font
+	// Margins are the margins of the pages, the default margins are kept when nil.
+	Margins *entity.Margins
+	// PageNumberPattern is the pattern of the page number, ex: "{current}/{total}", no number is written when empty.
+	PageNumberPattern string
+	// PageNumberPlace is the place of the page number.
+	PageNumberPlace props.Place
+	// Title is the prop of the titles.
+	Title props.Text
+	// Subtitle is the prop of the subtitles.
+	Subtitle props.Text
+	// Text is the prop of the body texts.
+	Text props.Text
+	// Line is the prop of the lines which divide the sections.
+	Line props.Line
+	// Header is the style of the header rows, ex: the header of the tables.
+	Header props.Cell
+	// Highlight is the style of the highlighted rows, ex: the even rows of the tables.
+	Highlight props.Cell
+}
+
+// Apply chains the builder calls which define the font, margins and page number of the theme and
+// returns the builder, so other customizations can be chained after it.
+func Apply(builder config.Builder, t Theme) config.Builder {
+	if t.Font != nil {
+		font := *t.Font
+		switch color := font.Color.(type) {
+		case *props.Color:
+			if color != nil {
+				copied := *color
+				font.Color = &copied
+			}
+		case *props.CMYKColor:
+			if color != nil {
+				copied := *color
+				font.Color = &copied
+			}
+		}
+
+		builder = builder.WithDefaultFont(&font)
+	}
+
+	if t.Margins != nil {
+		builder = builder.WithMargins(t.Margins.Left, t.Margins.Top, t.Margins.Right).
+			WithBottomMargin(t.Margins.Bottom)
+	}
+
+	if t.PageNumberPattern != "" {
+		builder = builder.WithPageNumber(t.PageNumberPattern, t.PageNumberPlace)
+	}
+
+	return builder
+}
+
+var (
+	materialPrimary = &props.Color{Red: 98, Green: 0, Blue: 238}
+	materialText    = &props.Color{Red: 33, Green: 33, Blue: 33}
+	materialMuted   = &props.Color{Red: 117, Green: 117, Blue: 117}
+	materialSurface = &props.Color{Red: 243, Green: 229, Blue: 245}
+
+	bootstrapPrimary = &props.Color{Red: 13, Green: 110, Blue: 253}
+	bootstrapText    = &props.Color{Red: 33, Green: 37, Blue: 41}
+	bootstrapMuted   = &props.Color{Red: 108, Green: 117, Blue: 125}
+	bootstrapBorder  = &props.Color{Red: 222, Green: 226, Blue: 230}
+	bootstrapLight   = &props.Color{Red: 248, Green: 249, Blue: 250}
+
+	minimalText   = &props.Color{Red: 0, Green: 0, Blue: 0}
+	minimalMuted  = &props.Color{Red: 128, Green: 128, Blue: 128}
+	minimalBorder = &props.Color{Red: 200, Green: 200, Blue: 200}
+)
+
+// Material is a theme based on the Material Design palette, with purple titles and headers.
+var Material = Theme{
+	Font:              &props.Font{Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, Color: materialText},
+	Margins:           &entity.Margins{Left: 16, Top: 16, Right: 16, Bottom: 16},
+	PageNumberPattern: "{current}/{total}",
+	PageNumberPlace:   props.RightBottom,
+	Title:             props.Text{Family: fontfamily.Arial, Style: fontstyle.Bold, Size: 20, Color: materialPrimary},
+	Subtitle:          props.Text{Family: fontfamily.Arial, Style: fontstyle.Bold, Size: 14, Color: materialText},
+	Text:              props.Text{Family: fontfamily.Arial, Style: fontstyle.Normal, Size: 10, Color: materialText},
+	Line:              props.Line{Color: materialPrimary, Thickness: 0.5},
+	Header: props.Cell{
+		BackgroundColor: materialPrimary,
+	},
+	Highlight: props.Cell{
+		BackgroundColor: materialSurface,
+	},
+}
+
+// Bootstrap is a theme based on the Bootstrap palette, with blue titles and light gray borders.
+var Bootstrap = Theme{
+	Font:              &props.Font{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: 10, Color: bootstrapText},
+	Margins:           &entity.Margins{Left: 12, Top: 12, Right: 12, Bottom: 15},
+	PageNumberPattern: "{current}/{total}",
+	PageNumberPlace:   props.Bottom,
+	Title:             props.Text{Family: fontfamily.Helvetica, Style: fontstyle.Bold, Size: 18, Color: bootstrapPrimary},
+	Subtitle:          props.Text{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: 13, Color: bootstrapMuted},
+	Text:              props.Text{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: 10, Color: bootstrapText},
+	Line:              props.Line{Color: bootstrapBorder, Thickness: 0.3},
+	Header: props.Cell{
+		BackgroundColor: bootstrapLight,
+		BorderColor:     bootstrapBorder,
+		BorderType:      border.Bottom,
+		BorderThickness: 0.5,
+	},
+	Highlight: props.Cell{
+		BackgroundColor: bootstrapLight,
+	},
+}
+
+// Minimal is a black and white theme, with thin gray lines and no page number.
+var Minimal = Theme{
+	Font:     &props.Font{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: 9, Color: minimalText},
+	Margins:  &entity.Margins{Left: 20, Top: 20, Right: 20, Bottom: 20},
+	Title:    props.Text{Family: fontfamily.Helvetica, Style: fontstyle.Bold, Size: 16, Color: minimalText},
+	Subtitle: props.Text{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: 11, Color: minimalMuted},
+	Text:     props.Text{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: 9, Color: minimalText},
+	Line:     props.Line{Color: minimalBorder, Thickness: 0.2},
+	Header: props.Cell{
+		BorderColor:     minimalText,
+		BorderType:      border.Bottom,
+		BorderThickness: 0.3,
+	},
+}
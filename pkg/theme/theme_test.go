@@ -0,0 +1,85 @@
+package theme_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/theme"
+)
+
+func TestApply(t *testing.T) {
+	t.Run("when theme is complete, should apply font, margins and page number", func(t *testing.T) {
+		// Arrange
+		sut := theme.Theme{
+			Font:              &props.Font{Family: fontfamily.Courier, Size: 12, Color: &props.Color{Red: 10}},
+			Margins:           &entity.Margins{Left: 11, Top: 12, Right: 13, Bottom: 14},
+			PageNumberPattern: "{current}",
+			PageNumberPlace:   props.Top,
+		}
+
+		// Act
+		cfg := theme.Apply(config.NewBuilder(), sut).Build()
+
+		// Assert
+		assert.Equal(t, fontfamily.Courier, cfg.DefaultFont.Family)
+		assert.Equal(t, 12.0, cfg.DefaultFont.Size)
+		assert.Equal(t, 10, cfg.DefaultFont.Color.ToColor().Red)
+		assert.Equal(t, &entity.Margins{Left: 11, Top: 12, Right: 13, Bottom: 14}, cfg.Margins)
+		assert.Equal(t, "{current}", cfg.PageNumberPattern)
+		assert.Equal(t, props.Top, cfg.PageNumberPlace)
+	})
+	t.Run("when theme is empty, should keep the defaults", func(t *testing.T) {
+		// Act
+		cfg := theme.Apply(config.NewBuilder(), theme.Theme{}).Build()
+
+		// Assert
+		assert.Equal(t, config.NewBuilder().Build(), cfg)
+	})
+	t.Run("when font color of the config changes, should not change the theme", func(t *testing.T) {
+		// Arrange
+		sut := theme.Theme{Font: &props.Font{Color: &props.Color{Red: 10}}}
+
+		// Act
+		cfg := theme.Apply(config.NewBuilder(), sut).Build()
+		cfg.DefaultFont.Color.ToColor().Red = 20
+
+		// Assert
+		assert.Equal(t, 10, sut.Font.Color.ToColor().Red)
+	})
+	t.Run("when theme is applied, should allow chaining other builder calls", func(t *testing.T) {
+		// Act
+		cfg := theme.Apply(config.NewBuilder(), theme.Minimal).
+			WithMaxGridSize(24).
+			Build()
+
+		// Assert
+		assert.Equal(t, 24, cfg.MaxGridSize)
+		assert.Equal(t, 9.0, cfg.DefaultFont.Size)
+	})
+}
+
+func TestBuiltInThemes(t *testing.T) {
+	themes := map[string]theme.Theme{
+		"material":  theme.Material,
+		"bootstrap": theme.Bootstrap,
+		"minimal":   theme.Minimal,
+	}
+
+	for name, builtIn := range themes {
+		t.Run("when theme is "+name+", should apply its font and margins", func(t *testing.T) {
+			// Act
+			cfg := theme.Apply(config.NewBuilder(), builtIn).Build()
+
+			// Assert
+			assert.Equal(t, builtIn.Font.Family, cfg.DefaultFont.Family)
+			assert.Equal(t, builtIn.Font.Size, cfg.DefaultFont.Size)
+			assert.Equal(t, builtIn.Margins, cfg.Margins)
+			assert.Equal(t, builtIn.PageNumberPattern, cfg.PageNumberPattern)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package maroto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/exporter"
+)
+
+// previewDates matches the dates written by the provider at each generation, which are not part of the cache key.
+var previewDates = regexp.MustCompile(`/(CreationDate|ModDate) \(D:[^)]*\)`)
+
+// GeneratePreview generates the document and returns the page, starting from 1, as a PNG. Only the
+// requested page is rasterized, by exporter.DefaultRasterizer at exporter.DefaultDPI unless
+// exporter.WithRasterizer or exporter.WithDPI is sent. When page is lower than 1, the first page is returned.
+// When previewCache is not nil, the PNGs are cached by the bytes of the document, without its creation and
+// modification dates, the page and the DPI, so a document built again with the same content is not rasterized.
+// Encrypted documents change at each generation and are always rasterized.
+func GeneratePreview(m core.Maroto, page int, previewCache *sync.Map, opts ...exporter.Option) ([]byte, error) {
+	options := exporter.NewOptions(opts...)
+
+	if options.Rasterizer == nil {
+		return nil, exporter.ErrNoRasterizer
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	document, err := m.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	pdf := document.GetBytes()
+
+	key := ""
+	if previewCache != nil {
+		key = getPreviewKey(pdf, page, options.DPI)
+		if cached, ok := previewCache.Load(key); ok {
+			return cached.([]byte), nil
+		}
+	}
+
+	png, err := exporter.Image(pdf, page, exporter.PNG, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if key != "" {
+		previewCache.Store(key, png)
+	}
+
+	return png, nil
+}
+
+// getPreviewKey returns the hash of the document, without its dates, the page and the DPI.
+func getPreviewKey(pdf []byte, page int, dpi float64) string {
+	hash := sha256.New()
+	hash.Write(previewDates.ReplaceAll(pdf, nil))
+	fmt.Fprintf(hash, ":%d:%g", page, dpi)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
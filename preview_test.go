@@ -0,0 +1,144 @@
+package maroto_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image/png"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/exporter"
+	mtesting "github.com/johnfercher/maroto/v2/pkg/testing"
+)
+
+type previewRasterizer struct {
+	calls int
+	pdf   []byte
+	err   error
+}
+
+func (p *previewRasterizer) Rasterize(pdf []byte, page int, format exporter.Format, dpi float64) ([]byte, error) {
+	p.calls++
+	p.pdf = pdf
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return []byte(fmt.Sprintf("page %d %s %.0f", page, format, dpi)), nil
+}
+
+func TestGeneratePreview(t *testing.T) {
+	t.Run("when rasterizer is nil, should return error", func(t *testing.T) {
+		// Arrange
+		m := maroto.New()
+		m.AddRows(text.NewRow(10, "preview"))
+
+		// Act
+		png, err := maroto.GeneratePreview(m, 1, nil, exporter.WithRasterizer(nil))
+
+		// Assert
+		assert.Nil(t, png)
+		assert.ErrorIs(t, err, exporter.ErrNoRasterizer)
+	})
+	t.Run("when page is out of range, should return error", func(t *testing.T) {
+		// Arrange
+		rasterizer := &previewRasterizer{}
+		m := maroto.New()
+		m.AddRows(text.NewRow(10, "preview"))
+
+		// Act
+		png, err := maroto.GeneratePreview(m, 2, nil, exporter.WithRasterizer(rasterizer))
+
+		// Assert
+		assert.Nil(t, png)
+		assert.ErrorIs(t, err, exporter.ErrPageOutOfRange)
+		assert.Equal(t, 0, rasterizer.calls)
+	})
+	t.Run("when page is not defined, should rasterize the first page at default dpi", func(t *testing.T) {
+		// Arrange
+		rasterizer := &previewRasterizer{}
+		m := maroto.New()
+		m.AddRows(text.NewRow(10, "preview"))
+
+		// Act
+		png, err := maroto.GeneratePreview(m, 0, nil, exporter.WithRasterizer(rasterizer))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "page 1 png 150", string(png))
+	})
+	t.Run("when rasterizer is not sent, should rasterize with the default rasterizer", func(t *testing.T) {
+		// Arrange
+		m := maroto.New()
+		m.AddRows(text.NewRow(10, "preview"))
+
+		// Act
+		b, err := maroto.GeneratePreview(m, 1, nil, exporter.WithDPI(36))
+
+		// Assert
+		assert.Nil(t, err)
+		config, err := png.DecodeConfig(bytes.NewReader(b))
+		assert.Nil(t, err)
+		assert.Equal(t, 298, config.Width)
+		assert.Equal(t, 421, config.Height)
+	})
+	t.Run("when document has one page, should rasterize a document with one page", func(t *testing.T) {
+		// Arrange
+		rasterizer := &previewRasterizer{}
+		m := maroto.New()
+		m.AddRows(text.NewRow(10, "preview"))
+
+		// Act
+		_, err := maroto.GeneratePreview(m, 1, nil, exporter.WithRasterizer(rasterizer))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 1, rasterizer.calls)
+		mtesting.AssertPageCount(t, core.NewPDF(rasterizer.pdf, nil), 1)
+	})
+	t.Run("when rasterizer fails, should return error", func(t *testing.T) {
+		// Arrange
+		rasterizer := &previewRasterizer{err: errors.New("anyError")}
+		m := maroto.New()
+		m.AddRows(text.NewRow(10, "preview"))
+
+		// Act
+		png, err := maroto.GeneratePreview(m, 1, nil, exporter.WithRasterizer(rasterizer))
+
+		// Assert
+		assert.Nil(t, png)
+		assert.ErrorIs(t, err, rasterizer.err)
+	})
+	t.Run("when cache is sent, should rasterize again only when document changes", func(t *testing.T) {
+		// Arrange
+		rasterizer := &previewRasterizer{}
+		cache := &sync.Map{}
+		newDocument := func(values ...string) core.Maroto {
+			m := maroto.New()
+			for _, value := range values {
+				m.AddRows(text.NewRow(10, value))
+			}
+			return m
+		}
+
+		// Act
+		first, _ := maroto.GeneratePreview(newDocument("preview"), 1, cache,
+			exporter.WithRasterizer(rasterizer), exporter.WithDPI(72))
+		second, _ := maroto.GeneratePreview(newDocument("preview"), 1, cache,
+			exporter.WithRasterizer(rasterizer), exporter.WithDPI(72))
+		third, _ := maroto.GeneratePreview(newDocument("preview", "changed"), 1, cache,
+			exporter.WithRasterizer(rasterizer), exporter.WithDPI(72))
+
+		// Assert
+		assert.Equal(t, "page 1 png 72", string(first))
+		assert.Equal(t, first, second)
+		assert.Equal(t, first, third)
+		assert.Equal(t, 2, rasterizer.calls)
+	})
+}